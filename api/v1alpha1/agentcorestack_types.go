@@ -0,0 +1,318 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AgentCoreStack phases
+const (
+	// StackPhaseProvisioning means the stack components are being created
+	StackPhaseProvisioning = "Provisioning"
+	// StackPhaseReady means every stack component is ready
+	StackPhaseReady = "Ready"
+	// StackPhaseDegraded means a change to a previously ready stack failed; its components are kept
+	StackPhaseDegraded = "Degraded"
+	// StackPhaseRollingBack means provisioning failed and created components are being deleted
+	StackPhaseRollingBack = "RollingBack"
+	// StackPhaseFailed means provisioning failed and was rolled back; the stack waits for a spec change
+	StackPhaseFailed = "Failed"
+	// StackPhaseDeleting means the stack is being deleted
+	StackPhaseDeleting = "Deleting"
+)
+
+// AgentCoreStackSpec defines the desired state of AgentCoreStack
+type AgentCoreStackSpec struct {
+	// Gateway is the gateway created for the stack
+	// +kubebuilder:validation:Required
+	Gateway StackGatewaySpec `json:"gateway"`
+
+	// CredentialProviders are the OAuth2 credential providers created for the stack
+	// +optional
+	CredentialProviders []StackCredentialProviderSpec `json:"credentialProviders,omitempty"`
+
+	// TokenVault configures the token vault holding the stack credential providers
+	// +optional
+	TokenVault *TokenVaultSpec `json:"tokenVault,omitempty"`
+
+	// Targets are the MCP servers registered with the stack gateway.
+	// Each target is managed as an MCPServer named <stack>-<target>.
+	// +optional
+	Targets []StackTargetSpec `json:"targets,omitempty"`
+}
+
+// StackGatewaySpec describes the gateway of an AgentCoreStack
+type StackGatewaySpec struct {
+	// Name is the gateway name
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([0-9a-zA-Z][-]?){1,100}$`
+	Name string `json:"name"`
+
+	// Description is the gateway description
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// RoleArn is the IAM role the gateway assumes to call targets
+	// +kubebuilder:validation:Required
+	RoleArn string `json:"roleArn"`
+
+	// AuthorizerType is the inbound authorizer of the gateway
+	// +kubebuilder:validation:Enum=CUSTOM_JWT;AWS_IAM;NONE
+	// +kubebuilder:default="AWS_IAM"
+	// +optional
+	AuthorizerType string `json:"authorizerType,omitempty"`
+
+	// JWTAuthorizer configures the CUSTOM_JWT authorizer
+	// +optional
+	JWTAuthorizer *JWTAuthorizerSpec `json:"jwtAuthorizer,omitempty"`
+
+	// MetadataDefaults are the metadata allowlists inherited by every target of the gateway.
+	// They are merged into the allowlists of each target, so that headers mandated by the
+	// platform, such as trace or tenant IDs, need not be repeated for every target.
+	// +optional
+	MetadataDefaults *MetadataAllowlists `json:"metadataDefaults,omitempty"`
+}
+
+// MetadataAllowlists are the headers and query parameters propagated between agents and a target
+type MetadataAllowlists struct {
+	// AllowedRequestHeaders are the request headers propagated to the target
+	// +optional
+	AllowedRequestHeaders []string `json:"allowedRequestHeaders,omitempty"`
+
+	// AllowedQueryParameters are the query parameters propagated to the target
+	// +optional
+	AllowedQueryParameters []string `json:"allowedQueryParameters,omitempty"`
+
+	// AllowedResponseHeaders are the response headers propagated back to the agent
+	// +optional
+	AllowedResponseHeaders []string `json:"allowedResponseHeaders,omitempty"`
+}
+
+// JWTAuthorizerSpec configures JWT validation for inbound gateway requests
+type JWTAuthorizerSpec struct {
+	// DiscoveryURL is the OpenID Connect discovery URL of the token issuer
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https://.*`
+	DiscoveryURL string `json:"discoveryUrl"`
+
+	// AllowedAudience are the accepted token audiences
+	// +optional
+	AllowedAudience []string `json:"allowedAudience,omitempty"`
+
+	// AllowedClients are the accepted token client IDs
+	// +optional
+	AllowedClients []string `json:"allowedClients,omitempty"`
+}
+
+// StackCredentialProviderSpec describes a custom OAuth2 credential provider of an AgentCoreStack
+type StackCredentialProviderSpec struct {
+	// Name is the credential provider name, unique within the AWS account
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9\-_]{1,128}$`
+	Name string `json:"name"`
+
+	// DiscoveryURL is the OpenID Connect discovery URL of the authorization server
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https://.*`
+	DiscoveryURL string `json:"discoveryUrl"`
+
+	// ClientID is the OAuth2 client ID
+	// +kubebuilder:validation:Required
+	ClientID string `json:"clientId"`
+
+	// ClientSecretRef selects the key of a Secret holding the OAuth2 client secret.
+	// The Secret must carry the mcpgateway.bedrock.aws/watch=true label.
+	// +kubebuilder:validation:Required
+	ClientSecretRef corev1.SecretKeySelector `json:"clientSecretRef"`
+}
+
+// TokenVaultSpec configures the token vault of an AgentCoreStack
+type TokenVaultSpec struct {
+	// Name is the token vault ID. AgentCore currently creates credential providers only in the
+	// "default" vault, so other vaults can only be used by stacks without credential providers.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9\-_]{1,64}$`
+	// +kubebuilder:default="default"
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// KMSKeyArn is the customer managed KMS key encrypting the vault. The vault is switched to the
+	// key before credential providers are created. When empty, the vault's encryption is left as is.
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:kms:.*`
+	// +optional
+	KMSKeyArn string `json:"kmsKeyArn,omitempty"`
+}
+
+// StackTargetSpec describes an MCP server target of an AgentCoreStack
+type StackTargetSpec struct {
+	// Name identifies the target within the stack
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Endpoint is the HTTPS endpoint of the MCP server
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https://.*`
+	Endpoint string `json:"endpoint"`
+
+	// Capabilities are the server capabilities (must include "tools")
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Capabilities []string `json:"capabilities"`
+
+	// Description is the target description
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// CredentialProvider is the name of the stack credential provider used to call the target
+	// +kubebuilder:validation:Required
+	CredentialProvider string `json:"credentialProvider"`
+
+	// Scopes are the OAuth scopes to request
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Scopes []string `json:"scopes"`
+
+	// Metadata are the metadata allowlists of the target, in addition to the defaults of the
+	// gateway. Targets without allowlists of their own or from the gateway keep the allowlists
+	// of their gateway target.
+	// +optional
+	Metadata *MetadataAllowlists `json:"metadata,omitempty"`
+}
+
+// AgentCoreStackStatus defines the observed state of AgentCoreStack.
+type AgentCoreStackStatus struct {
+	// ObservedGeneration is the generation observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase is the aggregate state of the stack
+	// (Provisioning, Ready, Degraded, RollingBack, Failed or Deleting)
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// GatewayID is the ID of the gateway created for the stack
+	// +optional
+	GatewayID string `json:"gatewayId,omitempty"`
+
+	// GatewayArn is the ARN of the gateway created for the stack
+	// +optional
+	GatewayArn string `json:"gatewayArn,omitempty"`
+
+	// GatewayURL is the URL agents use to reach the gateway
+	// +optional
+	GatewayURL string `json:"gatewayUrl,omitempty"`
+
+	// CredentialProviders are the credential providers created for the stack
+	// +optional
+	CredentialProviders []StackCredentialProviderStatus `json:"credentialProviders,omitempty"`
+
+	// Targets are the observed states of the stack targets
+	// +optional
+	Targets []StackTargetStatus `json:"targets,omitempty"`
+
+	// MCPServers are all MCPServers bound to the stack gateway in any namespace, including
+	// those not managed by the stack
+	// +optional
+	MCPServers []GatewayMCPServerReference `json:"mcpServers,omitempty"`
+
+	// conditions represent the current state of the AgentCoreStack resource.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// StackCredentialProviderStatus records a credential provider created for a stack
+type StackCredentialProviderStatus struct {
+	// Name is the credential provider name
+	Name string `json:"name"`
+
+	// Arn is the credential provider ARN
+	Arn string `json:"arn"`
+}
+
+// StackTargetStatus records the state of a stack target
+type StackTargetStatus struct {
+	// Name is the target name within the stack
+	Name string `json:"name"`
+
+	// MCPServer is the name of the MCPServer managing the target
+	MCPServer string `json:"mcpServer"`
+
+	// TargetStatus is the gateway target status reported by the MCPServer
+	// +optional
+	TargetStatus string `json:"targetStatus,omitempty"`
+}
+
+// GatewayMCPServerReference refers to an MCPServer bound to a gateway
+type GatewayMCPServerReference struct {
+	// Namespace is the namespace of the MCPServer
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the MCPServer
+	Name string `json:"name"`
+
+	// TargetID is the ID of the gateway target of the MCPServer
+	// +optional
+	TargetID string `json:"targetId,omitempty"`
+
+	// Ready is the status of the Ready condition of the MCPServer
+	Ready bool `json:"ready"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=acs
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Gateway",type=string,JSONPath=`.status.gatewayId`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AgentCoreStack is the Schema for the agentcorestacks API.
+// It declares a gateway, its credential providers and its targets, which are provisioned
+// together and rolled back together if provisioning fails.
+type AgentCoreStack struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of AgentCoreStack
+	// +required
+	Spec AgentCoreStackSpec `json:"spec"`
+
+	// status defines the observed state of AgentCoreStack
+	// +optional
+	Status AgentCoreStackStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// AgentCoreStackList contains a list of AgentCoreStack
+type AgentCoreStackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []AgentCoreStack `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AgentCoreStack{}, &AgentCoreStackList{})
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AWSProviderConfigSpec defines how the operator calls the AgentCore control plane for the
+// MCPServers referencing the AWSProviderConfig
+type AWSProviderConfigSpec struct {
+	// Region is the AWS region of the AgentCore control plane
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Region string `json:"region"`
+
+	// RoleARN is the IAM role assumed with the credentials of the operator. The gateway targets
+	// are managed with the operator's own credentials if it is not set.
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::\d{12}:role/.+$`
+	// +optional
+	RoleARN string `json:"roleArn,omitempty"`
+
+	// ExternalID is passed to sts:AssumeRole if the trust policy of the role requires one
+	// +optional
+	ExternalID string `json:"externalId,omitempty"`
+
+	// EndpointURL overrides the endpoint of the AgentCore control plane, e.g. the DNS name of
+	// an interface VPC endpoint. Defaults to the operator's --agentcore-endpoint-url if the
+	// region is the operator's region, and to the regional endpoint otherwise.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	EndpointURL string `json:"endpointUrl,omitempty"`
+
+	// Retry overrides the retry policy of the AWS calls made with the AWSProviderConfig
+	// +optional
+	Retry *AWSRetrySpec `json:"retry,omitempty"`
+}
+
+// AWSRetrySpec overrides fields of the operator's retry policy. Unset fields keep their value.
+type AWSRetrySpec struct {
+	// MaxRetries is the number of retries after the first attempt
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// InitialBackoff is the wait before the first retry
+	// +optional
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+
+	// MaxBackoff caps the wait between retries
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=awspc
+// +kubebuilder:printcolumn:name="Region",type=string,JSONPath=`.spec.region`
+// +kubebuilder:printcolumn:name="Role",type=string,JSONPath=`.spec.roleArn`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AWSProviderConfig is the Schema for the awsproviderconfigs API.
+// It holds the region, role, endpoint and retry settings of the AgentCore client used for the
+// MCPServers that reference it with spec.providerConfigRef, so that one operator can manage
+// gateway targets in several accounts and regions.
+type AWSProviderConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the AgentCore client of the AWSProviderConfig
+	// +required
+	Spec AWSProviderConfigSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// AWSProviderConfigList contains a list of AWSProviderConfig
+type AWSProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []AWSProviderConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AWSProviderConfig{}, &AWSProviderConfigList{})
+}
@@ -17,7 +17,79 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Gateway target types of an MCPServer
+const (
+	// TargetTypeMcpServer registers spec.endpoint, an MCP server, as the gateway target
+	TargetTypeMcpServer = "McpServer"
+	// TargetTypeLambda registers spec.lambdaArn, a Lambda function serving the tools of
+	// spec.toolSchema, as the gateway target
+	TargetTypeLambda = "Lambda"
+	// TargetTypeOpenAPISchema registers the REST API described by spec.openApiSchema as the
+	// gateway target
+	TargetTypeOpenAPISchema = "OpenApiSchema"
+)
+
+// Dependent deletion policies of an MCPServer
+const (
+	// DependentDeletionBackground leaves the objects owned by the MCPServer to the garbage
+	// collector, which deletes them after the MCPServer is gone
+	DependentDeletionBackground = "Background"
+	// DependentDeletionForeground deletes the objects owned by the MCPServer, one kind at a time,
+	// and waits for them to be gone before the gateway target is deleted
+	DependentDeletionForeground = "Foreground"
+)
+
+// Deletion policies of an MCPServer
+const (
+	// DeletionPolicyDelete deletes the gateway target with the MCPServer
+	DeletionPolicyDelete = "Delete"
+	// DeletionPolicyRetain leaves the gateway target in AWS when the MCPServer is deleted
+	DeletionPolicyRetain = "Retain"
+)
+
+// Readiness policies of the workload referenced by an MCPServer
+const (
+	// WorkloadReadinessNone registers the gateway target regardless of the workload
+	WorkloadReadinessNone = "None"
+	// WorkloadReadinessWaitForAvailable creates the gateway target only once the workload has an
+	// available replica
+	WorkloadReadinessWaitForAvailable = "WaitForAvailable"
+	// WorkloadReadinessRemoveWhenScaledToZero waits for an available replica like
+	// WaitForAvailable, and also deletes the gateway target while the workload is scaled to zero
+	WorkloadReadinessRemoveWhenScaledToZero = "RemoveWhenScaledToZero"
+)
+
+// Expiration policies of an MCPServer
+const (
+	// ExpirationPolicyDeleteTarget deletes the gateway target once the MCPServer expires and keeps
+	// the MCPServer with an Expired condition
+	ExpirationPolicyDeleteTarget = "DeleteTarget"
+	// ExpirationPolicyDeleteResource deletes the MCPServer, and with it its gateway target, once
+	// it expires
+	ExpirationPolicyDeleteResource = "DeleteResource"
+)
+
+// Reconcile priorities of an MCPServer
+const (
+	// PriorityHigh reconciles the MCPServer before those with a lower priority
+	PriorityHigh = "High"
+	// PriorityNormal is the priority of MCPServers that do not set one
+	PriorityNormal = "Normal"
+	// PriorityLow reconciles the MCPServer after those with a higher priority
+	PriorityLow = "Low"
+)
+
+// Outcomes of a synchronization of an MCPServer with its gateway target
+const (
+	// SyncOutcomeSucceeded records that the last synchronization succeeded
+	SyncOutcomeSucceeded = "Succeeded"
+	// SyncOutcomeFailed records that the last synchronization failed
+	SyncOutcomeFailed = "Failed"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -30,20 +102,52 @@ type MCPServerSpec struct {
 	// The following markers will use OpenAPI v3 schema to validate the value
 	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
 
-	// Endpoint is the HTTPS endpoint of the MCP server
-	// +kubebuilder:validation:Required
+	// Endpoint is the HTTPS endpoint of the MCP server. Required if targetType is McpServer,
+	// unless serviceRef or httpRouteRef is set.
 	// +kubebuilder:validation:Pattern=`^https://.*`
-	Endpoint string `json:"endpoint"`
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// TargetType is the type of the gateway target: McpServer (the default) registers the
+	// endpoint, Lambda registers the Lambda function of lambdaArn with the tools of toolSchema,
+	// OpenApiSchema registers the operations of the REST API described by openApiSchema as tools
+	// +kubebuilder:validation:Enum=McpServer;Lambda;OpenApiSchema
+	// +optional
+	TargetType string `json:"targetType,omitempty"`
+
+	// LambdaArn is the ARN of the Lambda function invoked by the gateway. Required if targetType
+	// is Lambda.
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:lambda:.*`
+	// +optional
+	LambdaArn string `json:"lambdaArn,omitempty"`
+
+	// ToolSchema describes the tools of the Lambda function. Required if targetType is Lambda.
+	// +optional
+	ToolSchema *ToolSchemaSpec `json:"toolSchema,omitempty"`
+
+	// OpenAPISchema is the OpenAPI specification of the REST API. Required if targetType is
+	// OpenApiSchema.
+	// +optional
+	OpenAPISchema *OpenAPISchemaSpec `json:"openApiSchema,omitempty"`
 
 	// Capabilities are the server capabilities (must include "tools")
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
 	Capabilities []string `json:"capabilities"`
 
-	// GatewayID is the gateway identifier (defaults to env var if not specified)
+	// GatewayID is the gateway identifier (defaults to env var if not specified).
+	// Either the gateway ID or the gateway ARN; ARNs must be in the region of the operator, or of
+	// the AWSProviderConfig referenced by providerConfigRef.
 	// +optional
 	GatewayID string `json:"gatewayId,omitempty"`
 
+	// ProviderConfigRef references the cluster-scoped AWSProviderConfig whose region, role,
+	// endpoint and retry settings are used for the gateway target. The operator's own AWS
+	// configuration is used if it is not set. The name cannot be changed once set.
+	// +kubebuilder:validation:XValidation:rule="self.name == oldSelf.name",message="providerConfigRef.name is immutable"
+	// +optional
+	ProviderConfigRef *ProviderConfigReference `json:"providerConfigRef,omitempty"`
+
 	// TargetName is the custom target name (defaults to resource name if not specified)
 	// +optional
 	TargetName string `json:"targetName,omitempty"`
@@ -61,28 +165,351 @@ type MCPServerSpec struct {
 	AuthType string `json:"authType,omitempty"`
 
 	// OauthProviderArn is the OAuth provider ARN
-	// Required for MCP server targets (AuthType must be OAuth2)
+	// Required for MCP server targets (AuthType must be OAuth2) unless CredentialProviders is set
 	// Example: arn:aws:bedrock-agentcore:us-west-2:123456789012:token-vault/default/oauth2credentialprovider/my-provider
-	// +kubebuilder:validation:Required
-	OauthProviderArn string `json:"oauthProviderArn"`
+	// +optional
+	OauthProviderArn string `json:"oauthProviderArn,omitempty"`
 
 	// OauthScopes are the OAuth scopes to request
 	// At least one scope is required for OAuth2 authentication
-	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
-	OauthScopes []string `json:"oauthScopes"`
+	// +optional
+	OauthScopes []string `json:"oauthScopes,omitempty"`
+
+	// CredentialProviders are the credential providers of the target, in order of preference.
+	// When set, they replace AuthType, OauthProviderArn and OauthScopes.
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	CredentialProviders []CredentialProvider `json:"credentialProviders,omitempty"`
+
+	// CredentialProviderExtensions are merged into the credential provider configurations sent to
+	// AWS, after they were built from the other fields, so that AWS options without a spec field
+	// can be used. Entry i is a JSON merge patch of the i-th configuration in the form of the AWS
+	// API; further entries are added as configurations of their own. Requires the operator's
+	// CredentialProviderExtensions feature gate.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	CredentialProviderExtensions []runtime.RawExtension `json:"credentialProviderExtensions,omitempty"`
+
+	// AllowedRequestHeaders are the allowed request headers for metadata propagation.
+	// An omitted list keeps the setting of the gateway target, an empty list clears it.
+	// +optional
+	AllowedRequestHeaders []string `json:"allowedRequestHeaders"`
+
+	// AllowedQueryParameters are the allowed query parameters for metadata propagation.
+	// An omitted list keeps the setting of the gateway target, an empty list clears it.
+	// +optional
+	AllowedQueryParameters []string `json:"allowedQueryParameters"`
+
+	// AllowedResponseHeaders are the allowed response headers for metadata propagation.
+	// An omitted list keeps the setting of the gateway target, an empty list clears it.
+	// +optional
+	AllowedResponseHeaders []string `json:"allowedResponseHeaders"`
+
+	// DisableMetadataPropagation clears all metadata allowlists of the gateway target, so that
+	// no headers or query parameters are propagated. It cannot be combined with the allowlists.
+	// +optional
+	DisableMetadataPropagation bool `json:"disableMetadataPropagation,omitempty"`
+
+	// EndpointRef references the workload serving the endpoint
+	// +optional
+	EndpointRef *WorkloadReference `json:"endpointRef,omitempty"`
+
+	// ServiceRef references the Kubernetes Service exposing the MCP server, as an alternative to
+	// endpoint. The endpoint is resolved from the load balancer address of the Service, or the
+	// URL in its mcpgateway.bedrock.aws/url annotation, and follows changes of the address.
+	// The Service must carry the mcpgateway.bedrock.aws/watch=true label.
+	// +optional
+	ServiceRef *ServiceReference `json:"serviceRef,omitempty"`
 
-	// AllowedRequestHeaders are the allowed request headers for metadata propagation
+	// HTTPRouteRef references the Gateway API HTTPRoute exposing the MCP server, as an alternative
+	// to endpoint. The endpoint is resolved from the hostnames of the route and the HTTPS listeners
+	// of its parent Gateways, and follows changes of the route's hostnames. The HTTPRoute must carry
+	// the mcpgateway.bedrock.aws/watch=true label. Requires the GatewayAPI feature gate.
 	// +optional
-	AllowedRequestHeaders []string `json:"allowedRequestHeaders,omitempty"`
+	HTTPRouteRef *HTTPRouteReference `json:"httpRouteRef,omitempty"`
 
-	// AllowedQueryParameters are the allowed query parameters for metadata propagation
+	// Autoscaling scales the workload referenced by EndpointRef on gateway traffic.
+	// Requires KEDA in the cluster and the operator running with --keda-prometheus-address.
 	// +optional
-	AllowedQueryParameters []string `json:"allowedQueryParameters,omitempty"`
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
 
-	// AllowedResponseHeaders are the allowed response headers for metadata propagation
+	// Probe configures how the operator itself connects to the endpoint for its checks.
+	// It is never sent to AWS and does not affect how the gateway reaches the endpoint.
 	// +optional
-	AllowedResponseHeaders []string `json:"allowedResponseHeaders,omitempty"`
+	Probe *ProbeSpec `json:"probe,omitempty"`
+
+	// DrainPeriod keeps the gateway target registered for this long after the MCPServer is deleted,
+	// so that in-flight agent sessions relying on its tools are not cut off instantly.
+	// A TargetDraining event is emitted when the drain starts.
+	// +optional
+	DrainPeriod *metav1.Duration `json:"drainPeriod,omitempty"`
+
+	// TTL expires the MCPServer this long after it was created, e.g. for the MCP servers of
+	// per-pull-request preview environments. Cannot be combined with expiresAt.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// ExpiresAt expires the MCPServer at this time. Cannot be combined with ttl.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// ExpirationPolicy is what happens once the MCPServer expires: DeleteTarget (the default)
+	// deletes the gateway target and keeps the MCPServer, DeleteResource deletes the MCPServer.
+	// +kubebuilder:validation:Enum=DeleteTarget;DeleteResource
+	// +optional
+	ExpirationPolicy string `json:"expirationPolicy,omitempty"`
+
+	// DependentDeletion controls how objects owned by the MCPServer, such as its ScaledObject,
+	// are deleted with it. Background (the default) leaves them to the garbage collector;
+	// Foreground deletes them, and waits for them to be gone, before the gateway target.
+	// +kubebuilder:validation:Enum=Background;Foreground
+	// +optional
+	DependentDeletion string `json:"dependentDeletion,omitempty"`
+
+	// DeletionPolicy is what happens to the gateway target when the MCPServer is deleted: Delete
+	// (the default) deletes it, Retain leaves it in AWS, e.g. to hand it over to another tool or to
+	// an MCPServer adopting it.
+	// +kubebuilder:validation:Enum=Delete;Retain
+	// +optional
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// Priority orders the reconciles of MCPServers waiting in the operator's queue, e.g. after an
+	// operator restart or gateway recovery, so that production targets are handled before
+	// development ones. Defaults to Normal.
+	// +kubebuilder:validation:Enum=High;Normal;Low
+	// +optional
+	Priority string `json:"priority,omitempty"`
+}
+
+// ToolSchemaSpec is the tool schema of a Lambda target. Exactly one of s3Uri and inline must be set.
+type ToolSchemaSpec struct {
+	// S3URI is the Amazon S3 URI of a JSON file with the tool definitions
+	// +kubebuilder:validation:Pattern=`^s3://.*`
+	// +optional
+	S3URI string `json:"s3Uri,omitempty"`
+
+	// S3BucketOwnerAccountID is the account ID of the owner of the bucket of s3Uri, for buckets
+	// in another account
+	// +optional
+	S3BucketOwnerAccountID string `json:"s3BucketOwnerAccountId,omitempty"`
+
+	// Inline is a JSON array of tool definitions, each with a name, a description, an inputSchema
+	// and an optional outputSchema
+	// +optional
+	Inline string `json:"inline,omitempty"`
+}
+
+// OpenAPISchemaSpec is the OpenAPI specification of an OpenApiSchema target. Exactly one of
+// inline, configMapRef and s3Uri must be set.
+type OpenAPISchemaSpec struct {
+	// Inline is the OpenAPI specification as JSON or YAML
+	// +optional
+	Inline string `json:"inline,omitempty"`
+
+	// ConfigMapRef selects the key of a ConfigMap in the MCPServer's namespace holding the
+	// OpenAPI specification. The ConfigMap must be labelled mcpgateway.bedrock.aws/watch=true.
+	// +optional
+	ConfigMapRef *corev1.ConfigMapKeySelector `json:"configMapRef,omitempty"`
+
+	// S3URI is the Amazon S3 URI of the OpenAPI specification
+	// +kubebuilder:validation:Pattern=`^s3://.*`
+	// +optional
+	S3URI string `json:"s3Uri,omitempty"`
+
+	// S3BucketOwnerAccountID is the account ID of the owner of the bucket of s3Uri, for buckets
+	// in another account
+	// +optional
+	S3BucketOwnerAccountID string `json:"s3BucketOwnerAccountId,omitempty"`
+}
+
+// ProbeSpec configures the operator's own connections to the MCP server endpoint
+type ProbeSpec struct {
+	// TLS configures certificate verification for endpoint probes
+	// +optional
+	TLS *ProbeTLSSpec `json:"tls,omitempty"`
+}
+
+// ProbeTLSSpec configures certificate verification for endpoint probes
+type ProbeTLSSpec struct {
+	// CASecretRef selects the key of a Secret holding PEM-encoded CA certificates that are
+	// trusted in addition to the system roots, for endpoints served with a private CA.
+	// The Secret must carry the mcpgateway.bedrock.aws/watch=true label.
+	// +optional
+	CASecretRef *corev1.SecretKeySelector `json:"caSecretRef,omitempty"`
+
+	// InsecureSkipVerify disables certificate verification for endpoint probes.
+	// Intended for development only.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// CredentialProvider configures one credential provider of the gateway target
+type CredentialProvider struct {
+	// Type is the credential provider type
+	// +kubebuilder:validation:Enum=OAuth2;ApiKey;GatewayIamRole
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// ProviderArn is the ARN of the OAuth2 or API key credential provider
+	// +optional
+	ProviderArn string `json:"providerArn,omitempty"`
+
+	// Scopes are the OAuth scopes to request (OAuth2 only)
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// CredentialLocation is where the API key is sent (ApiKey only)
+	// +kubebuilder:validation:Enum=HEADER;QUERY_PARAMETER
+	// +optional
+	CredentialLocation string `json:"credentialLocation,omitempty"`
+
+	// CredentialParameterName is the header or query parameter carrying the API key (ApiKey only)
+	// +optional
+	CredentialParameterName string `json:"credentialParameterName,omitempty"`
+
+	// CredentialPrefix is prepended to the API key, e.g. "Bearer" (ApiKey only)
+	// +optional
+	CredentialPrefix string `json:"credentialPrefix,omitempty"`
+}
+
+// AppliedCredentialProvider summarizes a credential provider configuration of a gateway target
+type AppliedCredentialProvider struct {
+	// Type is the credential provider type: OAuth2, ApiKey or GatewayIamRole
+	Type string `json:"type"`
+
+	// ProviderArn is the ARN of the OAuth2 or API key credential provider
+	// +optional
+	ProviderArn string `json:"providerArn,omitempty"`
+
+	// GrantType is the OAuth grant type, e.g. CLIENT_CREDENTIALS (OAuth2 only)
+	// +optional
+	GrantType string `json:"grantType,omitempty"`
+
+	// ScopeCount is the number of OAuth scopes requested (OAuth2 only)
+	// +optional
+	ScopeCount int32 `json:"scopeCount,omitempty"`
+
+	// CredentialLocation is where the API key is sent (ApiKey only)
+	// +optional
+	CredentialLocation string `json:"credentialLocation,omitempty"`
+}
+
+// WorkloadReference identifies a workload in the namespace of the MCPServer
+type WorkloadReference struct {
+	// Kind is the workload kind
+	// +kubebuilder:validation:Enum=Deployment;StatefulSet
+	// +kubebuilder:default="Deployment"
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the workload name
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Readiness gates the gateway target on the workload, so that the gateway never routes to a
+	// backend without available replicas. WaitForAvailable creates the target only once the
+	// workload has an available replica; RemoveWhenScaledToZero also deletes the target while the
+	// workload is scaled to zero and creates it again once it is available. Defaults to None.
+	// Gating requires the workload to carry the mcpgateway.bedrock.aws/watch=true label.
+	// +kubebuilder:validation:Enum=None;WaitForAvailable;RemoveWhenScaledToZero
+	// +optional
+	Readiness string `json:"readiness,omitempty"`
+}
+
+// ServiceReference references the Kubernetes Service exposing an MCP server
+type ServiceReference struct {
+	// Name is the Service name
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace is the Service namespace (defaults to the namespace of the MCPServer)
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Port is the Service port the gateway connects to. Defaults to the only port of the Service.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Path is the path of the MCP endpoint, e.g. /mcp
+	// +kubebuilder:validation:Pattern=`^/.*`
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// HTTPRouteReference references the Gateway API HTTPRoute exposing an MCP server
+type HTTPRouteReference struct {
+	// Name is the HTTPRoute name
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace is the HTTPRoute namespace (defaults to the namespace of the MCPServer)
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Path is the path of the MCP endpoint, e.g. /mcp
+	// +kubebuilder:validation:Pattern=`^/.*`
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// ProviderConfigReference references an AWSProviderConfig
+type ProviderConfigReference struct {
+	// Name is the AWSProviderConfig name
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// WorkloadMetadata is gateway target metadata taken from workload annotations
+type WorkloadMetadata struct {
+	// Description is the gateway target description
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// TargetName is the gateway target name, which prefixes the names of its tools
+	// +optional
+	TargetName string `json:"targetName,omitempty"`
+}
+
+// FieldProvenance records the source of the gateway target values that do not come from the
+// MCPServer spec alone. Each field holds one of: spec, environment/<name>, existingTarget,
+// defaultGateway, workload/<kind>/<name>, spokeCluster/<name> or resourceName.
+type FieldProvenance struct {
+	// GatewayID is the source of the gateway of the target
+	// +optional
+	GatewayID string `json:"gatewayId,omitempty"`
+
+	// TargetName is the source of the gateway target name
+	// +optional
+	TargetName string `json:"targetName,omitempty"`
+
+	// Description is the source of the gateway target description, unset without a description
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// AutoscalingSpec configures traffic-based scaling of the workload behind an MCPServer
+type AutoscalingSpec struct {
+	// MinReplicas is the lower replica bound (defaults to 1)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper replica bound
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetRequestsPerSecond is the gateway request rate each replica should serve
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	TargetRequestsPerSecond int32 `json:"targetRequestsPerSecond"`
 }
 
 // MCPServerStatus defines the observed state of MCPServer.
@@ -101,10 +528,20 @@ type MCPServerStatus struct {
 	// +optional
 	TargetID string `json:"targetId,omitempty"`
 
+	// TargetRemovals counts the gateway targets removed while the MCPServer remained, e.g. on
+	// expiration. It is part of the client token of creates, so a recreated target is not
+	// deduplicated against the removed one.
+	// +optional
+	TargetRemovals int64 `json:"targetRemovals,omitempty"`
+
 	// GatewayArn is the gateway ARN
 	// +optional
 	GatewayArn string `json:"gatewayArn,omitempty"`
 
+	// GatewayID is the canonical ID of the gateway the target was created on
+	// +optional
+	GatewayID string `json:"gatewayId,omitempty"`
+
 	// TargetStatus is the current target status (CREATING, READY, FAILED, etc.)
 	// +optional
 	TargetStatus string `json:"targetStatus,omitempty"`
@@ -113,10 +550,64 @@ type MCPServerStatus struct {
 	// +optional
 	StatusReasons []string `json:"statusReasons,omitempty"`
 
-	// LastSynchronized is the last synchronization timestamp
+	// LastSynchronized is the last time the observed state of the gateway target changed.
+	// Use lastAttemptedSync and lastSuccessfulSync to tell when the operator last synchronized.
 	// +optional
 	LastSynchronized *metav1.Time `json:"lastSynchronized,omitempty"`
 
+	// LastAttemptedSync is the last time the operator called AWS to create, update or read the
+	// gateway target. It is recorded at most once a minute unless the outcome changes.
+	// +optional
+	LastAttemptedSync *metav1.Time `json:"lastAttemptedSync,omitempty"`
+
+	// LastSuccessfulSync is the last time a synchronization with the gateway target succeeded
+	// +optional
+	LastSuccessfulSync *metav1.Time `json:"lastSuccessfulSync,omitempty"`
+
+	// LastSyncOutcome is the outcome of the last attempted synchronization
+	// +kubebuilder:validation:Enum=Succeeded;Failed
+	// +optional
+	LastSyncOutcome string `json:"lastSyncOutcome,omitempty"`
+
+	// TargetUpdatedAt is the last modification time of the gateway target as observed by the operator.
+	// Updates are refused with a ConcurrentModification condition if the target was modified since.
+	// +optional
+	TargetUpdatedAt *metav1.Time `json:"targetUpdatedAt,omitempty"`
+
+	// WorkloadMetadata is the gateway target description and name last applied from the
+	// annotations of the workload referenced by spec.endpointRef
+	// +optional
+	WorkloadMetadata *WorkloadMetadata `json:"workloadMetadata,omitempty"`
+
+	// ResolvedEndpoint is the endpoint last applied to the gateway target after substituting the
+	// values of the namespace's endpoint values ConfigMap into spec.endpoint, or resolving
+	// spec.serviceRef or spec.httpRouteRef. It is empty if spec.endpoint has no variables.
+	// +optional
+	ResolvedEndpoint string `json:"resolvedEndpoint,omitempty"`
+
+	// AppliedCredentialProviders are the credential provider configurations of the gateway target
+	// as AWS last returned them, in order, after defaults, environment profiles and credential
+	// provider extensions were applied. Secret values are never included.
+	// +optional
+	AppliedCredentialProviders []AppliedCredentialProvider `json:"appliedCredentialProviders,omitempty"`
+
+	// OpenAPISchemaHash is the SHA-256 hash of the OpenAPI specification last applied to the
+	// gateway target from the ConfigMap of spec.openApiSchema.configMapRef. It is empty if the
+	// specification is not read from a ConfigMap.
+	// +optional
+	OpenAPISchemaHash string `json:"openApiSchemaHash,omitempty"`
+
+	// AppliedSpecHash is the SHA-256 hash of the normalized spec last applied to the gateway
+	// target. A new generation whose normalized spec hashes the same, e.g. after reordering
+	// OAuth scopes, is observed without updating the gateway target.
+	// +optional
+	AppliedSpecHash string `json:"appliedSpecHash,omitempty"`
+
+	// Provenance records where the gateway ID, target name and description of the gateway target
+	// came from when they were last resolved
+	// +optional
+	Provenance *FieldProvenance `json:"provenance,omitempty"`
+
 	// conditions represent the current state of the MCPServer resource.
 	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
 	//
@@ -132,6 +623,7 @@ type MCPServerStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced,shortName=mcps
@@ -0,0 +1,104 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MCPServerGroupSpec defines the desired state of MCPServerGroup
+type MCPServerGroupSpec struct {
+	// Selector selects the MCPServers of the group in the namespace of the group.
+	// An empty selector selects every MCPServer in the namespace.
+	// +kubebuilder:validation:Required
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// MinMembers is the number of MCPServers the group must select to become ready, so that a
+	// group is not reported ready before its MCPServers are created
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	// +optional
+	MinMembers int32 `json:"minMembers,omitempty"`
+}
+
+// MCPServerGroupStatus defines the observed state of MCPServerGroup
+type MCPServerGroupStatus struct {
+	// ObservedGeneration is the generation observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Members is the number of MCPServers selected by the group
+	// +optional
+	Members int32 `json:"members,omitempty"`
+
+	// ReadyMembers is the number of selected MCPServers whose gateway target is READY for
+	// their current generation
+	// +optional
+	ReadyMembers int32 `json:"readyMembers,omitempty"`
+
+	// NotReady lists the selected MCPServers that are not ready, in alphabetical order
+	// +optional
+	NotReady []string `json:"notReady,omitempty"`
+
+	// conditions represent the current state of the MCPServerGroup resource.
+	// The Ready condition is True once every selected MCPServer is ready.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=mcpsg
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Members",type=integer,JSONPath=`.status.members`
+// +kubebuilder:printcolumn:name="Ready Members",type=integer,JSONPath=`.status.readyMembers`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// MCPServerGroup is the Schema for the mcpservergroups API.
+// It aggregates the readiness of the gateway targets of a labelled set of MCPServers into a
+// single Ready condition, so that applications can wait for all of their tools with one object.
+type MCPServerGroup struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of MCPServerGroup
+	// +required
+	Spec MCPServerGroupSpec `json:"spec"`
+
+	// status defines the observed state of MCPServerGroup
+	// +optional
+	Status MCPServerGroupStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// MCPServerGroupList contains a list of MCPServerGroup
+type MCPServerGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []MCPServerGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MCPServerGroup{}, &MCPServerGroupList{})
+}
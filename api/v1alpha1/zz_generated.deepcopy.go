@@ -21,35 +21,502 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSProviderConfig) DeepCopyInto(out *AWSProviderConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSProviderConfig.
+func (in *AWSProviderConfig) DeepCopy() *AWSProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSProviderConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSProviderConfigList) DeepCopyInto(out *AWSProviderConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSProviderConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSProviderConfigList.
+func (in *AWSProviderConfigList) DeepCopy() *AWSProviderConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSProviderConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSProviderConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSProviderConfigSpec) DeepCopyInto(out *AWSProviderConfigSpec) {
+	*out = *in
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(AWSRetrySpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSProviderConfigSpec.
+func (in *AWSProviderConfigSpec) DeepCopy() *AWSProviderConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSProviderConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSRetrySpec) DeepCopyInto(out *AWSRetrySpec) {
+	*out = *in
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSRetrySpec.
+func (in *AWSRetrySpec) DeepCopy() *AWSRetrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSRetrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentCoreStack) DeepCopyInto(out *AgentCoreStack) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentCoreStack.
+func (in *AgentCoreStack) DeepCopy() *AgentCoreStack {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentCoreStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentCoreStack) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentCoreStackList) DeepCopyInto(out *AgentCoreStackList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AgentCoreStack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentCoreStackList.
+func (in *AgentCoreStackList) DeepCopy() *AgentCoreStackList {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentCoreStackList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentCoreStackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentCoreStackSpec) DeepCopyInto(out *AgentCoreStackSpec) {
+	*out = *in
+	in.Gateway.DeepCopyInto(&out.Gateway)
+	if in.CredentialProviders != nil {
+		in, out := &in.CredentialProviders, &out.CredentialProviders
+		*out = make([]StackCredentialProviderSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TokenVault != nil {
+		in, out := &in.TokenVault, &out.TokenVault
+		*out = new(TokenVaultSpec)
+		**out = **in
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]StackTargetSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentCoreStackSpec.
+func (in *AgentCoreStackSpec) DeepCopy() *AgentCoreStackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentCoreStackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentCoreStackStatus) DeepCopyInto(out *AgentCoreStackStatus) {
+	*out = *in
+	if in.CredentialProviders != nil {
+		in, out := &in.CredentialProviders, &out.CredentialProviders
+		*out = make([]StackCredentialProviderStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]StackTargetStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.MCPServers != nil {
+		in, out := &in.MCPServers, &out.MCPServers
+		*out = make([]GatewayMCPServerReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentCoreStackStatus.
+func (in *AgentCoreStackStatus) DeepCopy() *AgentCoreStackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentCoreStackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedCredentialProvider) DeepCopyInto(out *AppliedCredentialProvider) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedCredentialProvider.
+func (in *AppliedCredentialProvider) DeepCopy() *AppliedCredentialProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedCredentialProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingSpec) DeepCopyInto(out *AutoscalingSpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingSpec.
+func (in *AutoscalingSpec) DeepCopy() *AutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialProvider) DeepCopyInto(out *CredentialProvider) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialProvider.
+func (in *CredentialProvider) DeepCopy() *CredentialProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldProvenance) DeepCopyInto(out *FieldProvenance) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldProvenance.
+func (in *FieldProvenance) DeepCopy() *FieldProvenance {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayMCPServerReference) DeepCopyInto(out *GatewayMCPServerReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayMCPServerReference.
+func (in *GatewayMCPServerReference) DeepCopy() *GatewayMCPServerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayMCPServerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteReference) DeepCopyInto(out *HTTPRouteReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteReference.
+func (in *HTTPRouteReference) DeepCopy() *HTTPRouteReference {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthorizerSpec) DeepCopyInto(out *JWTAuthorizerSpec) {
+	*out = *in
+	if in.AllowedAudience != nil {
+		in, out := &in.AllowedAudience, &out.AllowedAudience
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedClients != nil {
+		in, out := &in.AllowedClients, &out.AllowedClients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthorizerSpec.
+func (in *JWTAuthorizerSpec) DeepCopy() *JWTAuthorizerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthorizerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPServer) DeepCopyInto(out *MCPServer) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
+func (in *MCPServer) DeepCopy() *MCPServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerGroup) DeepCopyInto(out *MCPServerGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerGroup.
+func (in *MCPServerGroup) DeepCopy() *MCPServerGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerGroupList) DeepCopyInto(out *MCPServerGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerGroupList.
+func (in *MCPServerGroupList) DeepCopy() *MCPServerGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerGroupSpec) DeepCopyInto(out *MCPServerGroupSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
-func (in *MCPServer) DeepCopy() *MCPServer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerGroupSpec.
+func (in *MCPServerGroupSpec) DeepCopy() *MCPServerGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServer)
+	out := new(MCPServerGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPServer) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerGroupStatus) DeepCopyInto(out *MCPServerGroupStatus) {
+	*out = *in
+	if in.NotReady != nil {
+		in, out := &in.NotReady, &out.NotReady
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	return nil
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerGroupStatus.
+func (in *MCPServerGroupStatus) DeepCopy() *MCPServerGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerGroupStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -87,16 +554,45 @@ func (in *MCPServerList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPServerSpec) DeepCopyInto(out *MCPServerSpec) {
 	*out = *in
+	if in.ToolSchema != nil {
+		in, out := &in.ToolSchema, &out.ToolSchema
+		*out = new(ToolSchemaSpec)
+		**out = **in
+	}
+	if in.OpenAPISchema != nil {
+		in, out := &in.OpenAPISchema, &out.OpenAPISchema
+		*out = new(OpenAPISchemaSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Capabilities != nil {
 		in, out := &in.Capabilities, &out.Capabilities
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProviderConfigRef != nil {
+		in, out := &in.ProviderConfigRef, &out.ProviderConfigRef
+		*out = new(ProviderConfigReference)
+		**out = **in
+	}
 	if in.OauthScopes != nil {
 		in, out := &in.OauthScopes, &out.OauthScopes
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CredentialProviders != nil {
+		in, out := &in.CredentialProviders, &out.CredentialProviders
+		*out = make([]CredentialProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CredentialProviderExtensions != nil {
+		in, out := &in.CredentialProviderExtensions, &out.CredentialProviderExtensions
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AllowedRequestHeaders != nil {
 		in, out := &in.AllowedRequestHeaders, &out.AllowedRequestHeaders
 		*out = make([]string, len(*in))
@@ -112,6 +608,45 @@ func (in *MCPServerSpec) DeepCopyInto(out *MCPServerSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.EndpointRef != nil {
+		in, out := &in.EndpointRef, &out.EndpointRef
+		*out = new(WorkloadReference)
+		**out = **in
+	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
+		**out = **in
+	}
+	if in.HTTPRouteRef != nil {
+		in, out := &in.HTTPRouteRef, &out.HTTPRouteRef
+		*out = new(HTTPRouteReference)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Probe != nil {
+		in, out := &in.Probe, &out.Probe
+		*out = new(ProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DrainPeriod != nil {
+		in, out := &in.DrainPeriod, &out.DrainPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerSpec.
@@ -136,9 +671,36 @@ func (in *MCPServerStatus) DeepCopyInto(out *MCPServerStatus) {
 		in, out := &in.LastSynchronized, &out.LastSynchronized
 		*out = (*in).DeepCopy()
 	}
+	if in.LastAttemptedSync != nil {
+		in, out := &in.LastAttemptedSync, &out.LastAttemptedSync
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulSync != nil {
+		in, out := &in.LastSuccessfulSync, &out.LastSuccessfulSync
+		*out = (*in).DeepCopy()
+	}
+	if in.TargetUpdatedAt != nil {
+		in, out := &in.TargetUpdatedAt, &out.TargetUpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.WorkloadMetadata != nil {
+		in, out := &in.WorkloadMetadata, &out.WorkloadMetadata
+		*out = new(WorkloadMetadata)
+		**out = **in
+	}
+	if in.AppliedCredentialProviders != nil {
+		in, out := &in.AppliedCredentialProviders, &out.AppliedCredentialProviders
+		*out = make([]AppliedCredentialProvider, len(*in))
+		copy(*out, *in)
+	}
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(FieldProvenance)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -154,3 +716,284 @@ func (in *MCPServerStatus) DeepCopy() *MCPServerStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataAllowlists) DeepCopyInto(out *MetadataAllowlists) {
+	*out = *in
+	if in.AllowedRequestHeaders != nil {
+		in, out := &in.AllowedRequestHeaders, &out.AllowedRequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedQueryParameters != nil {
+		in, out := &in.AllowedQueryParameters, &out.AllowedQueryParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedResponseHeaders != nil {
+		in, out := &in.AllowedResponseHeaders, &out.AllowedResponseHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataAllowlists.
+func (in *MetadataAllowlists) DeepCopy() *MetadataAllowlists {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataAllowlists)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenAPISchemaSpec) DeepCopyInto(out *OpenAPISchemaSpec) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenAPISchemaSpec.
+func (in *OpenAPISchemaSpec) DeepCopy() *OpenAPISchemaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenAPISchemaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeSpec) DeepCopyInto(out *ProbeSpec) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ProbeTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeSpec.
+func (in *ProbeSpec) DeepCopy() *ProbeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeTLSSpec) DeepCopyInto(out *ProbeTLSSpec) {
+	*out = *in
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeTLSSpec.
+func (in *ProbeTLSSpec) DeepCopy() *ProbeTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigReference) DeepCopyInto(out *ProviderConfigReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigReference.
+func (in *ProviderConfigReference) DeepCopy() *ProviderConfigReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
+func (in *ServiceReference) DeepCopy() *ServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackCredentialProviderSpec) DeepCopyInto(out *StackCredentialProviderSpec) {
+	*out = *in
+	in.ClientSecretRef.DeepCopyInto(&out.ClientSecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackCredentialProviderSpec.
+func (in *StackCredentialProviderSpec) DeepCopy() *StackCredentialProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StackCredentialProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackCredentialProviderStatus) DeepCopyInto(out *StackCredentialProviderStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackCredentialProviderStatus.
+func (in *StackCredentialProviderStatus) DeepCopy() *StackCredentialProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StackCredentialProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackGatewaySpec) DeepCopyInto(out *StackGatewaySpec) {
+	*out = *in
+	if in.JWTAuthorizer != nil {
+		in, out := &in.JWTAuthorizer, &out.JWTAuthorizer
+		*out = new(JWTAuthorizerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetadataDefaults != nil {
+		in, out := &in.MetadataDefaults, &out.MetadataDefaults
+		*out = new(MetadataAllowlists)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackGatewaySpec.
+func (in *StackGatewaySpec) DeepCopy() *StackGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StackGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackTargetSpec) DeepCopyInto(out *StackTargetSpec) {
+	*out = *in
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(MetadataAllowlists)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackTargetSpec.
+func (in *StackTargetSpec) DeepCopy() *StackTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StackTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackTargetStatus) DeepCopyInto(out *StackTargetStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackTargetStatus.
+func (in *StackTargetStatus) DeepCopy() *StackTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StackTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenVaultSpec) DeepCopyInto(out *TokenVaultSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenVaultSpec.
+func (in *TokenVaultSpec) DeepCopy() *TokenVaultSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenVaultSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolSchemaSpec) DeepCopyInto(out *ToolSchemaSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolSchemaSpec.
+func (in *ToolSchemaSpec) DeepCopy() *ToolSchemaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolSchemaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadMetadata) DeepCopyInto(out *WorkloadMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadMetadata.
+func (in *WorkloadMetadata) DeepCopy() *WorkloadMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadReference) DeepCopyInto(out *WorkloadReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadReference.
+func (in *WorkloadReference) DeepCopy() *WorkloadReference {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadReference)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// iam-policy prints the least-privilege IAM policy the operator's role needs. Its flags mirror the
+// operator's, so the policy of a deployment is generated by passing it the same flags, e.g.
+//
+//	go run ./cmd/iam-policy --controllers=mcpserver,agentcorestack --target-stats-interval=1m
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/mcp-gateway-operator/internal/controller"
+	"github.com/aws/mcp-gateway-operator/pkg/iampolicy"
+)
+
+func main() {
+	var controllers string
+	var enableStackController bool
+	var targetStatsInterval time.Duration
+	var enableTargetNameWebhook bool
+	var gatewayRoleARNs string
+	features := iampolicy.Features{}
+
+	flag.StringVar(&controllers, "controllers", strings.Join(controller.DefaultControllers, ","),
+		"Comma-separated list of controllers the operator runs, as in the operator's --controllers.")
+	flag.BoolVar(&enableStackController, "enable-agentcorestack-controller", false,
+		"Deprecated: add agentcorestack to --controllers instead.")
+	flag.DurationVar(&targetStatsInterval, "target-stats-interval", 0,
+		"The operator's --target-stats-interval. Non-zero grants cloudwatch:GetMetricData.")
+	flag.BoolVar(&enableTargetNameWebhook, "enable-target-name-webhook", false,
+		"The operator's --enable-target-name-webhook. Grants bedrock-agentcore:ListGatewayTargets.")
+	flag.StringVar(&features.TokenVaultKMSKeyARN, "token-vault-kms-key-arn", "",
+		"The customer managed key AgentCoreStacks configure for the token vault, if any.")
+	flag.StringVar(&features.ActivityLogGroup, "activity-log-group", "",
+		"The operator's --activity-log-group. Grants writing log streams of the log group.")
+	flag.StringVar(&gatewayRoleARNs, "gateway-role-arns", "",
+		"Comma-separated execution roles of AgentCoreStack gateways. Defaults to every role of the account.")
+	flag.StringVar(&features.Partition, "partition", "",
+		"AWS partition of the resources. Defaults to the partition of --aws-region, or aws.")
+	flag.StringVar(&features.Region, "aws-region", "", "Restrict the resources to this region.")
+	flag.StringVar(&features.AccountID, "account-id", "", "Restrict the resources to this account.")
+	flag.Parse()
+
+	enabled, err := controller.ParseControllers(controllers)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: invalid --controllers: %v\n", err)
+		os.Exit(2)
+	}
+	features.MCPServers = enabled[controller.MCPServerControllerName]
+	features.AgentCoreStacks = enabled[controller.AgentCoreStackControllerName] || enableStackController
+	// Target statistics and the webhook only run alongside the mcpserver controller
+	features.TargetStats = targetStatsInterval > 0 && features.MCPServers
+	features.TargetNameWebhook = enableTargetNameWebhook && features.MCPServers
+	if gatewayRoleARNs != "" {
+		features.GatewayRoleARNs = strings.Split(gatewayRoleARNs, ",")
+	}
+
+	doc, err := iampolicy.Generate(features)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	data, err := doc.Marshal()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(data))
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/apply"
+	"github.com/aws/mcp-gateway-operator/pkg/bulkimport"
+)
+
+// importFieldManager owns the fields of the MCPServers written by the import command
+const importFieldManager = "kubectl-mcpgateway-import"
+
+// importOptions are the flags of the import command
+type importOptions struct {
+	kubeconfig   string
+	kubeContext  string
+	namespace    string
+	inventory    string
+	format       string
+	template     string
+	nameTemplate string
+	dryRun       bool
+	timeout      time.Duration
+}
+
+// importFlags registers the flags of the import command
+func importFlags(opts *importOptions) *flag.FlagSet {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	fs.StringVar(&opts.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file (defaults to the KUBECONFIG rules).")
+	fs.StringVar(&opts.kubeContext, "context", "", "The kubeconfig context to use.")
+	fs.StringVar(&opts.namespace, "namespace", "",
+		"Namespace of the MCPServers (defaults to the namespace of the template, then of the context).")
+	fs.StringVar(&opts.namespace, "n", "", "Shorthand for --namespace.")
+	fs.StringVar(&opts.inventory, "filename", "", "The JSON or CSV inventory of MCP servers to import.")
+	fs.StringVar(&opts.inventory, "f", "", "Shorthand for --filename.")
+	fs.StringVar(&opts.format, "format", "",
+		"Format of the inventory, json or csv (defaults to the extension of the file).")
+	fs.StringVar(&opts.template, "template", "",
+		"MCPServer manifest whose metadata and spec are shared by all imported MCPServers.")
+	fs.StringVar(&opts.nameTemplate, "name-template", bulkimport.DefaultNameTemplate,
+		"Go template naming each MCPServer, with the inventory fields, .Index and .Host of the endpoint.")
+	fs.BoolVar(&opts.dryRun, "dry-run", false, "Print the MCPServers as YAML instead of applying them.")
+	fs.DurationVar(&opts.timeout, "request-timeout", 5*time.Minute, "Timeout for applying all MCPServers.")
+	return fs
+}
+
+// runImport generates the MCPServers of an inventory and applies or prints them
+func runImport(ctx context.Context, opts *importOptions) error {
+	if opts.inventory == "" {
+		return errors.New("--filename is required")
+	}
+	format := opts.format
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(opts.inventory)), ".")
+	}
+	inventory, err := os.Open(opts.inventory)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = inventory.Close() }()
+	entries, err := bulkimport.ParseInventory(inventory, format)
+	if err != nil {
+		return err
+	}
+
+	mcpServerTemplate := &mcpgatewayv1alpha1.MCPServer{}
+	if opts.template != "" {
+		file, err := os.Open(opts.template)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = file.Close() }()
+		if mcpServerTemplate, err = bulkimport.LoadTemplate(file); err != nil {
+			return err
+		}
+	}
+
+	kubeConfig := loadKubeConfig(opts.kubeconfig, opts.kubeContext)
+	namespace := opts.namespace
+	if namespace == "" && mcpServerTemplate.Namespace == "" {
+		if namespace, _, err = kubeConfig.Namespace(); err != nil {
+			return fmt.Errorf("failed to determine namespace: %w", err)
+		}
+	}
+	generator, err := bulkimport.NewGenerator(mcpServerTemplate, opts.nameTemplate, namespace)
+	if err != nil {
+		return err
+	}
+	mcpServers, err := generator.Generate(entries)
+	if err != nil {
+		return err
+	}
+
+	if opts.dryRun {
+		for _, mcpServer := range mcpServers {
+			data, err := yaml.Marshal(&mcpServer)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("---\n%s", data)
+		}
+		return nil
+	}
+
+	c, err := newClient(kubeConfig)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
+
+	// Every MCPServer is attempted, so that one conflict does not hold up the rest of the batch
+	applier := apply.NewApplier(c, importFieldManager)
+	failed := 0
+	for i := range mcpServers {
+		mcpServer := &mcpServers[i]
+		if err := applier.Apply(ctx, mcpServer); err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "mcpserver/%s: %v\n", mcpServer.Name, err)
+			continue
+		}
+		fmt.Printf("mcpserver/%s applied\n", mcpServer.Name)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to apply %d of %d MCPServers", failed, len(mcpServers))
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// kubectl-mcpgateway is a kubectl plugin for triaging the MCPServers managed by the operator.
+// Installed on the PATH it is run as "kubectl mcpgateway status" or "kubectl mcpgateway import".
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/fleet"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+const usage = `Usage:
+  kubectl mcpgateway status [flags]
+      Lists MCPServers with their target status, time since the last sync and a summary of errors.
+  kubectl mcpgateway import -f <inventory> [flags]
+      Generates an MCPServer for every MCP server of a JSON or CSV inventory and applies them.
+
+Status flags:
+`
+
+func main() {
+	var err error
+	switch {
+	case len(os.Args) >= 2 && os.Args[1] == "status":
+		opts := &statusOptions{}
+		if statusFlags(opts).Parse(os.Args[2:]) != nil {
+			os.Exit(2)
+		}
+		err = runStatus(context.Background(), opts)
+	case len(os.Args) >= 2 && os.Args[1] == "import":
+		opts := &importOptions{}
+		if importFlags(opts).Parse(os.Args[2:]) != nil {
+			os.Exit(2)
+		}
+		err = runImport(context.Background(), opts)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		statusFlags(&statusOptions{}).PrintDefaults()
+		fmt.Fprint(os.Stderr, "\nImport flags:\n")
+		importFlags(&importOptions{}).PrintDefaults()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// statusOptions are the flags of the status command
+type statusOptions struct {
+	kubeconfig    string
+	kubeContext   string
+	namespace     string
+	allNamespaces bool
+	gateway       string
+	timeout       time.Duration
+}
+
+// statusFlags registers the flags of the status command
+func statusFlags(opts *statusOptions) *flag.FlagSet {
+	fs := flag.NewFlagSet("status", flag.ContinueOnError)
+	fs.StringVar(&opts.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file (defaults to the KUBECONFIG rules).")
+	fs.StringVar(&opts.kubeContext, "context", "", "The kubeconfig context to use.")
+	fs.StringVar(&opts.namespace, "namespace", "", "Namespace to list (defaults to the namespace of the context).")
+	fs.StringVar(&opts.namespace, "n", "", "Shorthand for --namespace.")
+	fs.BoolVar(&opts.allNamespaces, "all-namespaces", false, "List MCPServers in all namespaces.")
+	fs.BoolVar(&opts.allNamespaces, "A", false, "Shorthand for --all-namespaces.")
+	fs.StringVar(&opts.gateway, "gateway", "", "Only list MCPServers on this gateway (ID or ARN).")
+	fs.DurationVar(&opts.timeout, "request-timeout", 30*time.Second, "Timeout for listing MCPServers.")
+	return fs
+}
+
+// runStatus lists the MCPServers and prints their summary
+func runStatus(ctx context.Context, opts *statusOptions) error {
+	kubeConfig := loadKubeConfig(opts.kubeconfig, opts.kubeContext)
+	c, err := newClient(kubeConfig)
+	if err != nil {
+		return err
+	}
+
+	var listOpts []client.ListOption
+	if !opts.allNamespaces {
+		namespace := opts.namespace
+		if namespace == "" {
+			if namespace, _, err = kubeConfig.Namespace(); err != nil {
+				return fmt.Errorf("failed to determine namespace: %w", err)
+			}
+		}
+		listOpts = append(listOpts, client.InNamespace(namespace))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := c.List(ctx, mcpServers, listOpts...); err != nil {
+		return fmt.Errorf("failed to list MCPServers: %w", err)
+	}
+
+	return fleet.Write(os.Stdout, fleet.Summarize(mcpServers.Items, opts.gateway, time.Now()))
+}
+
+// loadKubeConfig returns the kubeconfig selected by the --kubeconfig and --context flags
+func loadKubeConfig(kubeconfig, kubeContext string) clientcmd.ClientConfig {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfig
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: kubeContext})
+}
+
+// newClient creates a client for MCPServers from the kubeconfig. Status details stored in an
+// annotation by installations in conditions-only status mode are restored on read.
+func newClient(kubeConfig clientcmd.ClientConfig) (client.Client, error) {
+	restConfig, err := kubeConfig.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := mcpgatewayv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	c, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	return status.NewClient(c, status.ModeFull), nil
+}
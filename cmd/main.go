@@ -17,38 +17,76 @@ limitations under the License.
 package main
 
 import (
+	"bufio"
 	"context"
 	"crypto/tls"
+	"errors"
 	"flag"
+	"fmt"
+	"io"
+	"maps"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"go.opentelemetry.io/otel"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
 	"github.com/aws/mcp-gateway-operator/internal/controller"
+	webhookv1alpha1 "github.com/aws/mcp-gateway-operator/internal/webhook/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/activitylog"
+	"github.com/aws/mcp-gateway-operator/pkg/audit"
+	"github.com/aws/mcp-gateway-operator/pkg/backup"
 	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/canary"
 	pkgconfig "github.com/aws/mcp-gateway-operator/pkg/config"
+	"github.com/aws/mcp-gateway-operator/pkg/environment"
+	"github.com/aws/mcp-gateway-operator/pkg/journal"
+	"github.com/aws/mcp-gateway-operator/pkg/metriclabels"
+	"github.com/aws/mcp-gateway-operator/pkg/oneshot"
+	"github.com/aws/mcp-gateway-operator/pkg/preview"
+	"github.com/aws/mcp-gateway-operator/pkg/probe"
+	"github.com/aws/mcp-gateway-operator/pkg/rollout"
+	"github.com/aws/mcp-gateway-operator/pkg/sharding"
+	"github.com/aws/mcp-gateway-operator/pkg/simulator"
+	"github.com/aws/mcp-gateway-operator/pkg/stats"
 	"github.com/aws/mcp-gateway-operator/pkg/status"
+	"github.com/aws/mcp-gateway-operator/pkg/storageversion"
+	"github.com/aws/mcp-gateway-operator/pkg/tracing"
 	// +kubebuilder:scaffold:imports
 )
 
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
+
+	// version is the operator version, set at build time via -ldflags "-X main.version=<version>"
+	version = "dev"
 )
 
 func init() {
@@ -68,7 +106,64 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var gatewayID string
+	var defaultGatewayConfigMap string
+	var environmentsConfigMap string
 	var awsRegion string
+	var awsProfile string
+	var agentCoreEndpointURL string
+	var useFIPSEndpoints bool
+	var devMode bool
+	var dryRun bool
+	var awsSimulator bool
+	var clusterID string
+	var credentialsExpiryThreshold time.Duration
+	var shardCount, shardIndex int
+	var journalNamespace, journalName string
+	var targetStatsInterval time.Duration
+	var metricLabelKeys string
+	var kedaPrometheusAddress string
+	var catalogConfigMaps bool
+	var enableTargetNameWebhook bool
+	var backupPath string
+	var backupInterval time.Duration
+	var restoreFrom string
+	var endpointPattern string
+	var targetNameWebhookTimeout time.Duration
+	var enableStackController bool
+	var controllers string
+	var featureGates string
+	var migrateStorage bool
+	var callBudgetLimit int
+	var callBudgetWindow time.Duration
+	var awsCallTimeout time.Duration
+	var fairShareCapacity int
+	var fairSharePartition string
+	var rateLimit float64
+	var rateLimits string
+	var circuitBreakerThreshold int
+	var circuitBreakerCooldown time.Duration
+	var backpressureThreshold int
+	var backpressureMaxFactor int
+	var spokeClusterNamespace string
+	var auditLogSink string
+	var activityLogGroup, activityLogStream string
+	var rolloutMaxUnavailable, rolloutMaxFailures int
+	var maxConcurrentCreatesPerGateway int
+	var rolloutMinReady time.Duration
+	var gatewayDeletedPolicy string
+	var driftCheckInterval time.Duration
+	var driftPolicy string
+	var statusMode string
+	var canaryInterval, canaryTimeout time.Duration
+	var canaryNamespace, canaryEndpoint, canaryOAuthProviderArn, canaryOAuthScopes string
+	var previewTargetNameTemplate, previewRegistryNamespace, previewRegistryName string
+	var previewCleanupInterval time.Duration
+	var gatewayCacheTTL time.Duration
+	var once bool
+	var onceTimeout time.Duration
+	var otlpEndpoint string
+	var otlpInsecure bool
+	var traceSampleRatio float64
 	var tlsOpts []func(*tls.Config)
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
@@ -89,7 +184,204 @@ func main() {
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 	flag.StringVar(&gatewayID, "gateway-id", os.Getenv("GATEWAY_ID"), "AWS Bedrock gateway identifier (can also be set via GATEWAY_ID env var)")
+	flag.StringVar(&defaultGatewayConfigMap, "default-gateway-configmap", "",
+		"ConfigMap key holding the default gateway ID, as namespace/name#key. Changes are picked up without "+
+			"a restart; --gateway-id is used while the key is missing. The ConfigMap must be labelled "+
+			"mcpgateway.bedrock.aws/watch=true.")
+	flag.StringVar(&environmentsConfigMap, "environments-configmap", "",
+		"ConfigMap (namespace/name) holding one environment profile per key. Namespaces labelled "+
+			controller.EnvironmentLabel+"=<key> get the gateway, retry policy and approval requirement of the profile. "+
+			"The ConfigMap must be labelled "+controller.WatchLabel+"=true.")
 	flag.StringVar(&awsRegion, "aws-region", os.Getenv("AWS_REGION"), "AWS region (can also be set via AWS_REGION env var)")
+	flag.StringVar(&awsProfile, "aws-profile", "",
+		"Shared config profile to load AWS credentials and region from, e.g. an SSO profile. "+
+			"Defaults to the AWS_PROFILE env var or the default profile.")
+	flag.StringVar(&agentCoreEndpointURL, "agentcore-endpoint-url", "",
+		"Endpoint URL of the AgentCore control plane in --aws-region, e.g. an interface VPC endpoint for clusters "+
+			"without internet egress. AWSProviderConfigs in the same region without spec.endpointUrl use it too. "+
+			"Leave empty to use the regional endpoint.")
+	flag.BoolVar(&useFIPSEndpoints, "aws-use-fips-endpoints", false,
+		"If set, send all AWS calls, including those of AWSProviderConfigs, to the FIPS endpoints of their region. "+
+			"Only the aws and aws-us-gov partitions have FIPS endpoints. Endpoint URLs that are set explicitly "+
+			"are used as they are.")
+	flag.BoolVar(&devMode, "dev-mode", false,
+		"Run from a developer machine against a sandbox account: log at debug level unless --zap-log-level "+
+			"is set, resolve AWS credentials before starting so expired SSO sessions fail fast, and prompt "+
+			"for the AWS region on the terminal if none is configured.")
+	flag.BoolVar(&dryRun, "dry-run", false,
+		"Log every mutating AWS call with its full input instead of sending it; read-only calls are still made. "+
+			"Resources report the calls that were held back in their status. Requires --dev-mode.")
+	flag.BoolVar(&awsSimulator, "aws-simulator", false,
+		"Serve AgentCore calls from an in-memory simulator instead of AWS, for local end-to-end runs without "+
+			"AWS credentials. The gateway of --gateway-id exists from the start. Requires --dev-mode.")
+	flag.StringVar(&clusterID, "cluster-id", os.Getenv("CLUSTER_ID"),
+		"Cluster identifier added to the AWS SDK user-agent for CloudTrail attribution and recorded as the owner of "+
+			"the gateway targets the operator writes (can also be set via CLUSTER_ID env var)")
+	flag.DurationVar(&credentialsExpiryThreshold, "credentials-expiry-threshold", 14*24*time.Hour,
+		"Raise the CredentialsExpiring condition when the endpoint certificate or OAuth credentials expire "+
+			"within this duration. Set to 0 to disable expiry checks.")
+	flag.IntVar(&shardCount, "shard-count", 1,
+		"Number of operator replicas splitting the MCPServer fleet between them. 1 disables sharding.")
+	flag.IntVar(&shardIndex, "shard-index", -1,
+		"Shard handled by this replica (0-based). Defaults to the ordinal suffix of the pod hostname, "+
+			"as assigned by a StatefulSet.")
+	flag.StringVar(&journalNamespace, "journal-namespace", os.Getenv("POD_NAMESPACE"),
+		"Namespace of the ConfigMap journaling mutating AWS operations for crash recovery "+
+			"(defaults to the POD_NAMESPACE env var). Leave empty to disable the journal.")
+	flag.StringVar(&journalName, "journal-name", "mcp-gateway-operator-journal",
+		"Name of the ConfigMap journaling mutating AWS operations.")
+	flag.DurationVar(&targetStatsInterval, "target-stats-interval", 0,
+		"How often to export per-target request and error rates from CloudWatch as Prometheus metrics. "+
+			"Set to 0 to disable target statistics.")
+	flag.StringVar(&metricLabelKeys, "metric-labels", "",
+		"Comma-separated MCPServer label keys added to the per-target gauges as label_<key>, e.g. tool-domain "+
+			"becomes label_tool_domain. Every key adds a label to each series, so only allowlist low-cardinality labels.")
+	flag.StringVar(&kedaPrometheusAddress, "keda-prometheus-address", "",
+		"Address of the Prometheus server scraping the operator metrics. When set, MCPServers with "+
+			"spec.autoscaling get a KEDA ScaledObject scaling spec.endpointRef on gateway traffic.")
+	flag.BoolVar(&catalogConfigMaps, "catalog-configmaps", false,
+		"If set, every MCPServer gets a <name>-catalog ConfigMap with a Backstage catalog entity "+
+			"describing its endpoint, gateway and tools.")
+	flag.StringVar(&backupPath, "backup-path", "",
+		"File to periodically write the AWS identifiers of all MCPServers and AgentCoreStacks to, e.g. on a "+
+			"persistent volume, for --restore-from after a cluster rebuild. Leave empty to disable.")
+	flag.DurationVar(&backupInterval, "backup-interval", 10*time.Minute,
+		"How often --backup-path is written.")
+	flag.StringVar(&restoreFrom, "restore-from", "",
+		"Snapshot written by --backup-path to restore at startup: recreated MCPServers and AgentCoreStacks "+
+			"without AWS identifiers adopt the AWS resources recorded for them instead of creating new ones.")
+	flag.StringVar(&endpointPattern, "endpoint-pattern", "",
+		"Regular expression that MCPServer endpoints must match in addition to ^https://, "+
+			"e.g. to only allow the egress hosts of a service mesh. Leave empty to allow any HTTPS endpoint.")
+	flag.BoolVar(&enableTargetNameWebhook, "enable-target-name-webhook", false,
+		"If set, serve a validating webhook that warns when the gateway of a new MCPServer already has a "+
+			"target with its name, or another MCPServer registers its endpoint on the same gateway. "+
+			"Requires the webhook certificate; lookups that fail admit the MCPServer.")
+	flag.DurationVar(&targetNameWebhookTimeout, "target-name-webhook-timeout", webhookv1alpha1.DefaultLookupTimeout,
+		"Timeout of the AWS lookup made for a single admission request by --enable-target-name-webhook.")
+	flag.DurationVar(&awsCallTimeout, "aws-call-timeout", bedrock.DefaultCallTimeout,
+		"Timeout of a single attempt of an AWS call. Attempts that time out are retried, while calls abandoned "+
+			"because the operator shuts down are requeued without counting as failures.")
+	flag.IntVar(&callBudgetLimit, "aws-call-budget", 0,
+		"Maximum number of AWS calls made for a single MCPServer within --aws-call-budget-window. "+
+			"Resources exceeding it back off with the Throttled condition. Set to 0 to disable the budget.")
+	flag.DurationVar(&callBudgetWindow, "aws-call-budget-window", time.Hour,
+		"Sliding window over which --aws-call-budget is enforced.")
+	flag.IntVar(&fairShareCapacity, "aws-call-fair-share", 0,
+		"Maximum number of AWS calls within --aws-call-budget-window, divided evenly among the tenants making "+
+			"calls so that one tenant's churn cannot starve the others. Set to 0 to disable fair sharing.")
+	flag.StringVar(&fairSharePartition, "aws-call-fair-share-by", controller.FairSharePartitionNamespace,
+		"Tenants of --aws-call-fair-share: namespace or gateway.")
+	flag.Float64Var(&rateLimit, "aws-rate-limit", 0,
+		"Calls per second the operator makes of every AgentCore operation, shared by all reconciles, so that "+
+			"many resources reconciling at once stay below the control plane's TPS quotas. Set to 0 to only "+
+			"limit the operations of --aws-rate-limits.")
+	flag.StringVar(&rateLimits, "aws-rate-limits", "",
+		"Comma-separated <operation>=<calls per second> pairs overriding --aws-rate-limit for single "+
+			"operations, e.g. CreateGatewayTarget=5,ListGatewayTargets=10. 0 does not limit the operation.")
+	flag.IntVar(&circuitBreakerThreshold, "aws-circuit-breaker-threshold", 10,
+		"Number of consecutive AWS calls throttled, failed by AWS or timed out after which all AWS calls are "+
+			"failed right away, with the Degraded condition on MCPServers, until a probe call made every "+
+			"--aws-circuit-breaker-cooldown succeeds. The operator's own client and every AWSProviderConfig "+
+			"are counted separately. Set to 0 to disable the circuit breaker.")
+	flag.DurationVar(&circuitBreakerCooldown, "aws-circuit-breaker-cooldown", 30*time.Second,
+		"How long --aws-circuit-breaker-threshold fails AWS calls before probing AWS again.")
+	flag.IntVar(&backpressureThreshold, "aws-backpressure-threshold", 10,
+		"Number of AWS calls per minute throttled by AWS or held back by --aws-rate-limit at which the "+
+			"requeue intervals of all MCPServers, including --drift-check-interval, are doubled, up to "+
+			"--aws-backpressure-max-factor. Every minute with fewer halves them again. Set to 0 to disable "+
+			"backpressure.")
+	flag.IntVar(&backpressureMaxFactor, "aws-backpressure-max-factor", 8,
+		"Maximum factor by which --aws-backpressure-threshold stretches requeue intervals.")
+	flag.StringVar(&spokeClusterNamespace, "spoke-cluster-namespace", "",
+		"Run as a hub: also reconcile the MCPServers of the spoke clusters whose kubeconfig Secrets in this "+
+			"namespace are labelled mcpgateway.bedrock.aws/spoke-cluster=<cluster-name>. Spokes are loaded at "+
+			"startup. Leave empty to only reconcile this cluster.")
+	flag.StringVar(&auditLogSink, "audit-log", "",
+		"Write a JSON audit record for every mutating AWS call to stdout, stderr, or the given file path. "+
+			"Leave empty to disable audit records.")
+	flag.StringVar(&activityLogGroup, "activity-log-group", "",
+		"Mirror the operator's events and audit records as JSON into this existing CloudWatch Logs log group. "+
+			"Leave empty to disable the activity log.")
+	flag.StringVar(&activityLogStream, "activity-log-stream", "",
+		"Log stream of --activity-log-group written by this replica. Defaults to the pod hostname.")
+	flag.IntVar(&rolloutMaxUnavailable, "rollout-max-unavailable", 0,
+		"Number of gateway targets per gateway that may be updating or not yet available at once. "+
+			"Further updates wait for the next wave. 0 applies every update right away.")
+	flag.DurationVar(&rolloutMinReady, "rollout-min-ready", 30*time.Second,
+		"How long an updated gateway target must be READY before it counts as available to the rollout.")
+	flag.IntVar(&rolloutMaxFailures, "rollout-max-failures", 1,
+		"Number of failed gateway targets per gateway that pauses the rollout. 0 never pauses.")
+	flag.IntVar(&maxConcurrentCreatesPerGateway, "max-concurrent-creates-per-gateway", 0,
+		"Number of gateway targets per gateway that may be created at once. Further MCPServers wait for a "+
+			"create slot. 0 does not cap creates.")
+	flag.StringVar(&gatewayDeletedPolicy, "gateway-deleted-policy", controller.GatewayDeletedPolicyOrphan,
+		"What happens to the gateway targets of a gateway deleted outside of the operator: orphan stops calling "+
+			"AWS for them, recreate also creates the targets of MCPServers without spec.gatewayId on the gateway "+
+			"of their environment or the default gateway.")
+	flag.DurationVar(&driftCheckInterval, "drift-check-interval", 10*time.Minute,
+		"How often the gateway target of a ready MCPServer is compared with its spec, to detect changes made "+
+			"outside of the operator. Set to 0 to disable drift detection.")
+	flag.StringVar(&driftPolicy, "drift-policy", controller.DriftPolicyReport,
+		"What happens to gateway targets that differ from their MCPServer spec: report sets the ConfigDrift "+
+			"condition, correct also updates the target to match the spec.")
+	flag.StringVar(&statusMode, "status-mode", status.ModeFull,
+		"Which MCPServer status fields the operator writes: full writes all of them, conditions-only only writes "+
+			"conditions and stores the other fields, e.g. the target ID, in the "+status.DetailsAnnotation+" annotation.")
+	flag.DurationVar(&canaryInterval, "canary-interval", 0,
+		"How often to create, update and delete a synthetic canary MCPServer, exporting whether the operator "+
+			"completed every step as Prometheus metrics. Set to 0 to disable the canary.")
+	flag.DurationVar(&canaryTimeout, "canary-timeout", 5*time.Minute,
+		"How long the operator may take to complete a single step of the canary.")
+	flag.StringVar(&canaryNamespace, "canary-namespace", os.Getenv("POD_NAMESPACE"),
+		"Namespace of the canary MCPServer (defaults to the POD_NAMESPACE env var).")
+	flag.StringVar(&canaryEndpoint, "canary-endpoint", "",
+		"HTTPS endpoint of the MCP server backing the canary, e.g. a self-hosted echo server.")
+	flag.StringVar(&canaryOAuthProviderArn, "canary-oauth-provider-arn", "",
+		"OAuth credential provider ARN of the canary gateway target.")
+	flag.StringVar(&canaryOAuthScopes, "canary-oauth-scopes", "",
+		"Comma-separated OAuth scopes of the canary gateway target.")
+	flag.StringVar(&previewTargetNameTemplate, "preview-target-name-template", preview.DefaultTargetNameTemplate,
+		"Go template of the default gateway target names of MCPServers labelled with "+preview.Label+
+			". Fields: .Name, .Namespace, .Preview (the label value) and .Cluster.")
+	flag.DurationVar(&previewCleanupInterval, "preview-cleanup-interval", 0,
+		"How often to delete the gateway targets of preview MCPServers whose namespace no longer exists. "+
+			"Set to 0 to disable preview cleanup.")
+	flag.StringVar(&previewRegistryNamespace, "preview-registry-namespace", os.Getenv("POD_NAMESPACE"),
+		"Namespace of the ConfigMap recording the gateway targets of preview MCPServers "+
+			"(defaults to the POD_NAMESPACE env var).")
+	flag.StringVar(&previewRegistryName, "preview-registry-name", "mcp-gateway-operator-preview-targets",
+		"Name of the ConfigMap recording the gateway targets of preview MCPServers.")
+	flag.DurationVar(&gatewayCacheTTL, "gateway-cache-ttl", 5*time.Minute,
+		"How long GetGateway results are reused for all MCPServers of a gateway. Changes of AgentCoreStacks "+
+			"invalidate the cached gateway right away. Set to 0 to disable the cache.")
+	flag.StringVar(&otlpEndpoint, "otlp-endpoint", "",
+		"host:port of an OTLP gRPC collector to export OpenTelemetry spans of reconciles and AWS calls to, "+
+			"e.g. otel-collector.observability:4317. Leave empty to disable tracing.")
+	flag.BoolVar(&otlpInsecure, "otlp-insecure", false,
+		"If set, spans are exported to --otlp-endpoint without TLS.")
+	flag.Float64Var(&traceSampleRatio, "trace-sample-ratio", 1,
+		"Fraction of reconciles traced, between 0 and 1. AWS calls are traced along with their reconcile.")
+	flag.BoolVar(&migrateStorage, "migrate-storage", false,
+		"Rewrite every custom resource in its CRD's current storage version, then exit. "+
+			"Run as a Job after upgrading to an operator version with a new storage version.")
+	flag.BoolVar(&once, "once", false,
+		"Reconcile every resource, then exit as soon as all of them are in sync, or with a non-zero code "+
+			"if any is not in sync within --once-timeout. For CI/CD pipelines and batch environments "+
+			"that cannot run a long-lived operator. Disables leader election.")
+	flag.DurationVar(&onceTimeout, "once-timeout", 10*time.Minute,
+		"How long --once waits for the resources to be in sync.")
+	flag.StringVar(&controllers, "controllers", strings.Join(controller.DefaultControllers, ","),
+		"Comma-separated list of controllers to run: "+strings.Join(controller.KnownControllers, ", ")+
+			". '*' runs all controllers and '-<name>' excludes one, e.g. '*,-agentcorestack'. "+
+			"Only the CRDs and RBAC of the enabled controllers are required.")
+	flag.StringVar(&featureGates, "feature-gates", "",
+		"Comma-separated list of <feature>=true|false pairs enabling optional features: "+
+			strings.Join(controller.KnownFeatureGates, ", ")+". Features are disabled by default, except "+
+			controller.FeatureSecretReferences+".")
+	flag.BoolVar(&enableStackController, "enable-agentcorestack-controller", false,
+		"Deprecated: add agentcorestack to --controllers instead. If set, reconcile AgentCoreStack resources, "+
+			"which create gateways and credential providers. Requires the AgentCoreStack CRD to be installed.")
 
 	opts := zap.Options{
 		Development: true,
@@ -97,32 +389,227 @@ func main() {
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
+	if devMode && !flagSet("zap-log-level") {
+		_ = flag.Set("zap-log-level", "debug")
+	}
+
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if dryRun && !devMode {
+		setupLog.Error(nil, "--dry-run requires --dev-mode")
+		os.Exit(1)
+	}
+	if awsSimulator && !devMode {
+		setupLog.Error(nil, "--aws-simulator requires --dev-mode")
+		os.Exit(1)
+	}
+	if awsSimulator && dryRun {
+		setupLog.Error(nil, "--dry-run cannot be combined with --aws-simulator")
+		os.Exit(1)
+	}
+	if agentCoreEndpointURL != "" {
+		if err := bedrock.ValidateEndpointURL(agentCoreEndpointURL); err != nil {
+			setupLog.Error(err, "invalid --agentcore-endpoint-url")
+			os.Exit(1)
+		}
+	}
+
+	enabledControllers, err := controller.ParseControllers(controllers)
+	if err != nil {
+		setupLog.Error(err, "invalid --controllers")
+		os.Exit(1)
+	}
+	if enableStackController {
+		enabledControllers[controller.AgentCoreStackControllerName] = true
+	}
+	gates, err := controller.ParseFeatureGates(featureGates)
+	if err != nil {
+		setupLog.Error(err, "invalid --feature-gates")
+		os.Exit(1)
+	}
+	runMCPServers := enabledControllers[controller.MCPServerControllerName]
+
+	// CRDs are read without a cache, before the manager starts
+	directClient, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		setupLog.Error(err, "unable to create API client")
+		os.Exit(1)
+	}
+	storageMigrator := storageversion.NewMigrator(directClient, ctrl.Log.WithName("storageversion"))
+	if migrateStorage {
+		if err := runStorageMigration(context.Background(), storageMigrator); err != nil {
+			setupLog.Error(err, "storage migration failed")
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Refuse to start on objects stored in a version this operator cannot read, e.g. after a downgrade
+	if err := checkStorageVersions(context.Background(), storageMigrator); err != nil {
+		setupLog.Error(err, "unsupported CRD storage versions")
+		os.Exit(1)
+	}
+
+	// Link recreated resources to their AWS resources before any controller reconciles them
+	if restoreFrom != "" {
+		if err := runRestore(context.Background(), directClient, restoreFrom); err != nil {
+			setupLog.Error(err, "restore failed", "snapshot", restoreFrom)
+			os.Exit(1)
+		}
+	}
+
 	// Validate required configuration
-	if gatewayID == "" {
-		setupLog.Error(nil, "gateway-id is required (set via --gateway-id flag or GATEWAY_ID environment variable)")
+	if runMCPServers && gatewayID == "" && defaultGatewayConfigMap == "" {
+		setupLog.Error(nil, "gateway-id is required (set via --gateway-id flag or GATEWAY_ID environment variable, "+
+			"or --default-gateway-configmap)")
 		os.Exit(1)
 	}
+	var defaultGatewayNamespace, defaultGatewayName, defaultGatewayKey string
+	if defaultGatewayConfigMap != "" {
+		defaultGatewayNamespace, defaultGatewayName, defaultGatewayKey, err =
+			pkgconfig.ParseConfigMapKeyRef(defaultGatewayConfigMap)
+		if err != nil {
+			setupLog.Error(err, "invalid default-gateway-configmap")
+			os.Exit(1)
+		}
+	}
+
+	var environmentsNamespace, environmentsName string
+	if environmentsConfigMap != "" {
+		environmentsNamespace, environmentsName, err = pkgconfig.ParseConfigMapRef(environmentsConfigMap)
+		if err != nil {
+			setupLog.Error(err, "invalid environments-configmap")
+			os.Exit(1)
+		}
+	}
 
 	// Initialize AWS Bedrock client
 	ctx := context.Background()
+	stopTracing, err := tracing.Setup(ctx, tracing.Options{
+		Endpoint:    otlpEndpoint,
+		Insecure:    otlpInsecure,
+		SampleRatio: traceSampleRatio,
+		Version:     version,
+		ClusterID:   clusterID,
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing", "endpoint", otlpEndpoint)
+		os.Exit(1)
+	}
+	if otlpEndpoint != "" {
+		setupLog.Info("tracing enabled", "endpoint", otlpEndpoint, "sampleRatio", traceSampleRatio)
+	}
 	awsCfg, err := config.LoadDefaultConfig(ctx, func(opts *config.LoadOptions) error {
 		if awsRegion != "" {
 			opts.Region = awsRegion
 		}
+		if awsProfile != "" {
+			opts.SharedConfigProfile = awsProfile
+		}
+		if useFIPSEndpoints {
+			opts.UseFIPSEndpoint = aws.FIPSEndpointStateEnabled
+		}
 		return nil
 	})
 	if err != nil {
-		setupLog.Error(err, "unable to load AWS SDK config")
+		setupLog.Error(err, "unable to load AWS SDK config", "profile", awsProfile)
+		os.Exit(1)
+	}
+	if awsSimulator && awsCfg.Region == "" {
+		awsCfg.Region = "us-east-1"
+	}
+	if devMode && !awsSimulator {
+		if awsCfg.Region == "" {
+			if awsCfg.Region, err = promptRegion(os.Stdin, os.Stderr); err != nil {
+				setupLog.Error(err, "unable to determine AWS region")
+				os.Exit(1)
+			}
+		}
+		// SSO sessions expire; resolving the credentials now reports that before any reconcile
+		if _, err := awsCfg.Credentials.Retrieve(ctx); err != nil {
+			setupLog.Error(err, "unable to resolve AWS credentials, run 'aws sso login' for SSO profiles",
+				"profile", awsProfile)
+			os.Exit(1)
+		}
+		setupLog.Info("dev mode enabled", "region", awsCfg.Region, "profile", awsProfile, "dryRun", dryRun)
+	}
+	if useFIPSEndpoints && !awsSimulator {
+		if err := pkgconfig.ValidateFIPSRegion(awsCfg.Region); err != nil {
+			setupLog.Error(err, "--aws-use-fips-endpoints is not supported in this region")
+			os.Exit(1)
+		}
+	}
+
+	bedrockOptions := []func(*bedrockagentcorecontrol.Options){
+		bedrock.WithUserAgent(version, clusterID),
+		bedrock.WithTracing(otel.GetTracerProvider()),
+	}
+	if dryRun {
+		bedrockOptions = append(bedrockOptions, bedrock.WithDryRun(ctrl.Log.WithName("dry-run")))
+	}
+	// The endpoint is regional, so it is not part of the options AWSProviderConfig clients derive from
+	var bedrockClient bedrock.GatewayTargetAPI = bedrockagentcorecontrol.NewFromConfig(awsCfg,
+		append(slices.Clone(bedrockOptions), bedrock.WithEndpointURL(agentCoreEndpointURL))...)
+	if awsSimulator {
+		var gateways []string
+		if gatewayID != "" {
+			gateways = append(gateways, gatewayID)
+		}
+		bedrockClient = simulator.New(simulator.Options{Region: awsCfg.Region, Gateways: gateways})
+		setupLog.Info("serving AgentCore calls from the in-memory simulator, nothing is sent to AWS")
+	}
+	setupLog.Info("initialized AWS Bedrock client", "region", awsCfg.Region, "gatewayID", gatewayID,
+		"endpointURL", agentCoreEndpointURL, "fips", useFIPSEndpoints, "version", version, "clusterID", clusterID)
+
+	// The clients of AWSProviderConfigs are derived from the operator's AWS configuration
+	var providerClients *bedrock.ProviderClients
+	if !awsSimulator {
+		providerClients = bedrock.NewProviderClients(awsCfg, bedrockOptions...).WithDefaultEndpointURL(agentCoreEndpointURL)
+	}
+
+	// Determine the shard handled by this replica
+	if shardCount > 1 && shardIndex < 0 {
+		hostname, err := os.Hostname()
+		if err != nil {
+			setupLog.Error(err, "unable to determine hostname for shard index")
+			os.Exit(1)
+		}
+		if shardIndex, err = sharding.IndexFromHostname(hostname); err != nil {
+			setupLog.Error(err, "unable to derive shard index, set --shard-index explicitly")
+			os.Exit(1)
+		}
+	}
+	sharder, err := sharding.NewSharder(shardCount, max(shardIndex, 0))
+	if err != nil {
+		setupLog.Error(err, "invalid sharding configuration")
 		os.Exit(1)
 	}
+	leaderElectionID := "b89ac0a6.bedrock.aws"
+	if sharder.Enabled() {
+		// Every shard elects its own leader so that one replica per shard is active
+		leaderElectionID = fmt.Sprintf("shard-%d.%s", sharder.Index(), leaderElectionID)
+		setupLog.Info("sharding enabled", "shard", sharder.Index(), "shards", sharder.Count())
+	}
 
-	bedrockClient := bedrockagentcorecontrol.NewFromConfig(awsCfg)
-	setupLog.Info("initialized AWS Bedrock client", "region", awsCfg.Region, "gatewayID", gatewayID)
+	// Label the per-target gauges with the allowlisted MCPServer labels
+	metricLabels, err := metriclabels.ParseAllowlist(metricLabelKeys)
+	if err != nil {
+		setupLog.Error(err, "invalid --metric-labels")
+		os.Exit(1)
+	}
+	if !metricLabels.Empty() {
+		controller.SetMetricLabels(metricLabels)
+		stats.SetMetricLabels(metricLabels)
+		setupLog.Info("MCPServer labels added to metrics", "labels", metricLabels.Names())
+	}
 
 	// Initialize helper components
 	configParser := pkgconfig.NewConfigParser(gatewayID)
+	configParser.SetRegion(awsCfg.Region)
+	if err := configParser.SetEndpointPattern(endpointPattern); err != nil {
+		setupLog.Error(err, "invalid --endpoint-pattern")
+		os.Exit(1)
+	}
 	targetConfigBuilder := bedrock.NewTargetConfigBuilder()
 	// statusManager will be initialized with the manager's client after manager creation
 
@@ -193,13 +680,38 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
+	// Only cache Secrets, ConfigMaps and workloads explicitly labelled for the operator, so that
+	// the operator never holds every Secret in the cluster in memory
+	cacheOptions := cache.Options{
+		ByObject: controller.ReferenceCacheOptions(),
+	}
+	if gates.Enabled(controller.FeatureGatewayAPI) {
+		maps.Copy(cacheOptions.ByObject, controller.GatewayAPICacheOptions())
+	}
+
+	// A one-shot run must not wait for a lease held by a long-lived operator
+	if once && enableLeaderElection {
+		setupLog.Info("leader election is disabled with --once")
+		enableLeaderElection = false
+	}
+
+	if awsCallTimeout <= 0 {
+		setupLog.Error(nil, "invalid --aws-call-timeout, must be positive", "value", awsCallTimeout)
+		os.Exit(1)
+	}
+	if statusMode != status.ModeFull && statusMode != status.ModeConditionsOnly {
+		setupLog.Error(nil, "invalid --status-mode, must be full or conditions-only", "value", statusMode)
+		os.Exit(1)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
+		Cache:                  cacheOptions,
 		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "b89ac0a6.bedrock.aws",
+		LeaderElectionID:       leaderElectionID,
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -211,32 +723,447 @@ func main() {
 		// if you are doing or is intended to do any operation such as perform cleanups
 		// after the manager stops then its usage might be unsafe.
 		// LeaderElectionReleaseOnCancel: true,
+
+		// Every component reads and writes the status of MCPServers through the status mode
+		NewClient: func(config *rest.Config, options client.Options) (client.Client, error) {
+			c, err := client.New(config, options)
+			if err != nil {
+				return nil, err
+			}
+			return status.NewClient(c, statusMode), nil
+		},
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
-	// Initialize status manager with the manager's client
-	statusManager := status.NewManager(mgr.GetClient())
+	// Initialize status manager with the manager's client. It reports transitions of the target
+	// status and of the Ready condition as events of the MCPServer controller.
+	var mcpServerRecorder events.EventRecorder = mgr.GetEventRecorder("mcpserver-controller")
+
+	// Mirror events and audit records into CloudWatch Logs. Every replica writes its own stream.
+	var activityLog *activitylog.Sink
+	if activityLogGroup != "" {
+		if activityLogStream == "" {
+			if activityLogStream, err = os.Hostname(); err != nil {
+				setupLog.Error(err, "unable to determine hostname for --activity-log-stream")
+				os.Exit(1)
+			}
+		}
+		activityLog = activitylog.NewSink(activitylog.NewClient(awsCfg), activityLogGroup, activityLogStream,
+			activitylog.DefaultFlushInterval, version, clusterID, ctrl.Log.WithName("activity-log"))
+		if err := mgr.Add(activityLog); err != nil {
+			setupLog.Error(err, "unable to set up activity log")
+			os.Exit(1)
+		}
+		mcpServerRecorder = activityLog.Recorder(mcpServerRecorder, mgr.GetScheme())
+		setupLog.Info("Activity log enabled", "logGroup", activityLogGroup, "logStream", activityLogStream)
+	}
+	statusManager := status.NewManager(mgr.GetClient()).WithEventRecorder(mcpServerRecorder)
+
+	// Initialize the operation journal; reads bypass the cache, which only holds labelled ConfigMaps
+	var operationJournal *journal.Journal
+	if journalNamespace != "" {
+		if sharder.Enabled() {
+			journalName = fmt.Sprintf("%s-shard-%d", journalName, sharder.Index())
+		}
+		operationJournal = journal.NewJournal(mgr.GetClient(), mgr.GetAPIReader(), journalNamespace, journalName)
+		setupLog.Info("operation journal enabled", "namespace", journalNamespace, "name", journalName)
+	}
+
+	// Name preview targets after their preview and record them for cleanup; the registry is only
+	// kept if the cleaner runs
+	previewTargetNames, err := preview.ParseTargetNameTemplate(previewTargetNameTemplate)
+	if err != nil {
+		setupLog.Error(err, "invalid --preview-target-name-template")
+		os.Exit(1)
+	}
+	var previewRegistry *preview.Registry
+	if previewCleanupInterval > 0 {
+		if previewRegistryNamespace == "" {
+			setupLog.Error(nil, "--preview-cleanup-interval requires --preview-registry-namespace")
+			os.Exit(1)
+		}
+		previewRegistry = preview.NewRegistry(mgr.GetClient(), mgr.GetAPIReader(),
+			previewRegistryNamespace, previewRegistryName)
+	}
+
+	// Resolve the default gateway from its ConfigMap before any MCPServer is reconciled
+	if runMCPServers && defaultGatewayConfigMap != "" {
+		defaultGatewayReconciler := &controller.DefaultGatewayReconciler{
+			Client:       mgr.GetClient(),
+			ConfigParser: configParser,
+			ConfigMap:    types.NamespacedName{Namespace: defaultGatewayNamespace, Name: defaultGatewayName},
+			Key:          defaultGatewayKey,
+			Fallback:     gatewayID,
+		}
+		if err := defaultGatewayReconciler.Load(ctx, mgr.GetAPIReader()); err != nil {
+			setupLog.Error(err, "unable to read default gateway ConfigMap")
+			os.Exit(1)
+		}
+		if err := defaultGatewayReconciler.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DefaultGateway")
+			os.Exit(1)
+		}
+		setupLog.Info("default gateway read from ConfigMap", "configMap", defaultGatewayConfigMap,
+			"gatewayID", configParser.DefaultGatewayID())
+	}
+
+	// Load the environment profiles before any MCPServer is reconciled
+	var environments *environment.Registry
+	if runMCPServers && environmentsConfigMap != "" {
+		environments = environment.NewRegistry()
+		environmentReconciler := &controller.EnvironmentReconciler{
+			Client:       mgr.GetClient(),
+			Environments: environments,
+			ConfigMap:    types.NamespacedName{Namespace: environmentsNamespace, Name: environmentsName},
+		}
+		if err := environmentReconciler.Load(ctx, mgr.GetAPIReader()); err != nil {
+			setupLog.Error(err, "unable to read environments ConfigMap")
+			os.Exit(1)
+		}
+		if err := environmentReconciler.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Environment")
+			os.Exit(1)
+		}
+		setupLog.Info("environment profiles read from ConfigMap", "configMap", environmentsConfigMap)
+	}
+
+	// Bound the AWS calls a single misbehaving MCPServer can make
+	var callBudget *bedrock.CallBudget
+	if callBudgetLimit > 0 {
+		callBudget = bedrock.NewCallBudget(callBudgetLimit, callBudgetWindow)
+		setupLog.Info("AWS call budget enabled", "limit", callBudgetLimit, "window", callBudgetWindow)
+	}
+
+	// Share gateway metadata between reconciles instead of describing the gateway every time
+	var gatewayCache *bedrock.GatewayCache
+	if gatewayCacheTTL > 0 {
+		gatewayCache = bedrock.NewGatewayCache(gatewayCacheTTL)
+		setupLog.Info("gateway cache enabled", "ttl", gatewayCacheTTL)
+	}
+
+	// Keep one tenant from consuming the AWS call capacity of all others
+	var fairShare *bedrock.FairShare
+	if fairShareCapacity > 0 {
+		if fairSharePartition != controller.FairSharePartitionNamespace &&
+			fairSharePartition != controller.FairSharePartitionGateway {
+			setupLog.Error(nil, "invalid --aws-call-fair-share-by, must be namespace or gateway",
+				"value", fairSharePartition)
+			os.Exit(1)
+		}
+		fairShare = bedrock.NewFairShare(fairShareCapacity, callBudgetWindow)
+		setupLog.Info("AWS call fair share enabled", "capacity", fairShareCapacity, "window", callBudgetWindow,
+			"partition", fairSharePartition)
+	}
+
+	// Pace the AWS calls of all reconciles below the AgentCore quotas
+	var rateLimiter *bedrock.RateLimiter
+	if rateLimit < 0 {
+		setupLog.Error(nil, "invalid --aws-rate-limit, must not be negative", "value", rateLimit)
+		os.Exit(1)
+	}
+	operationRates, err := bedrock.ParseRateLimits(rateLimits)
+	if err != nil {
+		setupLog.Error(err, "invalid --aws-rate-limits")
+		os.Exit(1)
+	}
+	if rateLimit > 0 || len(operationRates) > 0 {
+		rateLimiter = bedrock.NewRateLimiter(rateLimit, operationRates)
+		setupLog.Info("AWS rate limit enabled", "callsPerSecond", rateLimit, "operations", operationRates)
+	}
+
+	// Stop calling AWS while it keeps failing
+	var circuitBreaker *bedrock.CircuitBreaker
+	if circuitBreakerThreshold > 0 {
+		if circuitBreakerCooldown <= 0 {
+			setupLog.Error(nil, "invalid --aws-circuit-breaker-cooldown, must be positive", "value", circuitBreakerCooldown)
+			os.Exit(1)
+		}
+		circuitBreaker = bedrock.NewCircuitBreaker(circuitBreakerThreshold, circuitBreakerCooldown)
+		if providerClients != nil {
+			// Every AWSProviderConfig gets a breaker of its own
+			providerClients.WithCircuitBreakers(circuitBreakerThreshold, circuitBreakerCooldown)
+		}
+		setupLog.Info("AWS circuit breaker enabled", "threshold", circuitBreakerThreshold,
+			"cooldown", circuitBreakerCooldown)
+	}
+
+	// Back off fleet-wide while AWS throttles the operator
+	var backpressure *bedrock.Backpressure
+	if backpressureThreshold > 0 {
+		if backpressureMaxFactor < 1 {
+			setupLog.Error(nil, "invalid --aws-backpressure-max-factor, must be at least 1", "value", backpressureMaxFactor)
+			os.Exit(1)
+		}
+		backpressure = bedrock.NewBackpressure(backpressureThreshold, bedrock.DefaultBackpressureWindow,
+			backpressureMaxFactor)
+		setupLog.Info("AWS backpressure enabled", "threshold", backpressureThreshold,
+			"window", bedrock.DefaultBackpressureWindow, "maxFactor", backpressureMaxFactor)
+	}
+
+	if gatewayDeletedPolicy != controller.GatewayDeletedPolicyOrphan &&
+		gatewayDeletedPolicy != controller.GatewayDeletedPolicyRecreate {
+		setupLog.Error(nil, "invalid --gateway-deleted-policy, must be orphan or recreate", "value", gatewayDeletedPolicy)
+		os.Exit(1)
+	}
+
+	if driftPolicy != controller.DriftPolicyReport && driftPolicy != controller.DriftPolicyCorrect {
+		setupLog.Error(nil, "invalid --drift-policy, must be report or correct", "value", driftPolicy)
+		os.Exit(1)
+	}
+
+	// Apply updates to many targets of a gateway in waves
+	var rolloutGate *rollout.Gate
+	if rolloutMaxUnavailable > 0 {
+		rolloutGate = rollout.NewGate(rollout.Policy{
+			MaxUnavailable: rolloutMaxUnavailable,
+			MinReady:       rolloutMinReady,
+			MaxFailures:    rolloutMaxFailures,
+		})
+		setupLog.Info("Rollout waves enabled", "maxUnavailable", rolloutMaxUnavailable,
+			"minReady", rolloutMinReady, "maxFailures", rolloutMaxFailures)
+	}
+
+	if maxConcurrentCreatesPerGateway < 0 {
+		setupLog.Error(nil, "invalid --max-concurrent-creates-per-gateway, must not be negative",
+			"value", maxConcurrentCreatesPerGateway)
+		os.Exit(1)
+	}
+
+	var auditLogger *audit.Logger
+	var auditWriters []io.Writer
+	if auditLogSink != "" {
+		sink, err := audit.OpenSink(auditLogSink)
+		if err != nil {
+			setupLog.Error(err, "unable to open audit log")
+			os.Exit(1)
+		}
+		defer sink.Close()
+		auditWriters = append(auditWriters, sink)
+		setupLog.Info("Audit records enabled", "sink", auditLogSink)
+	}
+	if activityLog != nil {
+		auditWriters = append(auditWriters, activityLog)
+	}
+	if len(auditWriters) > 0 {
+		auditLogger = audit.NewLogger(io.MultiWriter(auditWriters...), version, clusterID)
+	}
 
 	// Register MCPServer controller
-	if err = (&controller.MCPServerReconciler{
-		Client:              mgr.GetClient(),
-		Scheme:              mgr.GetScheme(),
-		BedrockClient:       bedrockClient,
-		DefaultGatewayID:    gatewayID,
-		ConfigParser:        configParser,
-		TargetConfigBuilder: targetConfigBuilder,
-		StatusManager:       statusManager,
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "MCPServer")
+	var mcpServerReconciler *controller.MCPServerReconciler
+	if runMCPServers {
+		mcpServerReconciler = &controller.MCPServerReconciler{
+			Client:              mgr.GetClient(),
+			Scheme:              mgr.GetScheme(),
+			BedrockClient:       bedrockClient,
+			ProviderClients:     providerClients,
+			DefaultGatewayID:    gatewayID,
+			ConfigParser:        configParser,
+			TargetConfigBuilder: targetConfigBuilder,
+			StatusManager:       statusManager,
+
+			EndpointProber:                 probe.NewProber(10 * time.Second),
+			CredentialsExpiryThreshold:     credentialsExpiryThreshold,
+			Sharder:                        sharder,
+			Journal:                        operationJournal,
+			KEDAPrometheusAddress:          kedaPrometheusAddress,
+			CatalogConfigMaps:              catalogConfigMaps,
+			CallBudget:                     callBudget,
+			AWSCallTimeout:                 awsCallTimeout,
+			FairShare:                      fairShare,
+			FairSharePartition:             fairSharePartition,
+			AuditLogger:                    auditLogger,
+			GatewayCache:                   gatewayCache,
+			Environments:                   environments,
+			Recorder:                       mcpServerRecorder,
+			Rollout:                        rolloutGate,
+			MaxConcurrentCreatesPerGateway: maxConcurrentCreatesPerGateway,
+			FeatureGates:                   gates,
+			DriftCheckInterval:             driftCheckInterval,
+			Backpressure:                   backpressure,
+			RateLimiter:                    rateLimiter,
+			CircuitBreaker:                 circuitBreaker,
+			DriftPolicy:                    driftPolicy,
+			StatusMode:                     statusMode,
+			GatewayDeletedPolicy:           gatewayDeletedPolicy,
+			ClusterID:                      clusterID,
+			PreviewTargetNames:             previewTargetNames,
+			PreviewRegistry:                previewRegistry,
+		}
+		if err = mcpServerReconciler.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "MCPServer")
+			os.Exit(1)
+		}
+		setupLog.Info("registered MCPServer controller")
+
+		// Warn about duplicate target names and endpoints at admission rather than at the first reconcile
+		if enableTargetNameWebhook {
+			if err = webhookv1alpha1.SetupMCPServerWebhookWithManager(mgr, &webhookv1alpha1.MCPServerCustomValidator{
+				Finder: bedrock.NewBedrockClientWrapper(bedrockClient, ctrl.Log.WithName("mcpserver-webhook"),
+					bedrock.WithRateLimiter(rateLimiter), bedrock.WithCircuitBreaker(circuitBreaker)),
+				Endpoints:    mcpServerReconciler,
+				ConfigParser: configParser,
+				Timeout:      targetNameWebhookTimeout,
+			}); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "MCPServer")
+				os.Exit(1)
+			}
+			setupLog.Info("registered MCPServer target name webhook")
+		}
+
+		// In hub mode the MCPServers of spoke clusters are reconciled with this operator's AWS credentials
+		if spokeClusterNamespace != "" {
+			spokes, errs := controller.LoadSpokeClusters(context.Background(), directClient, spokeClusterNamespace)
+			for _, err := range errs {
+				setupLog.Error(err, "skipping spoke cluster")
+			}
+			for _, spoke := range spokes {
+				if err := mcpServerReconciler.SetupSpokeWithManager(mgr, spoke); err != nil {
+					setupLog.Error(err, "unable to create controller", "controller", "MCPServer", "spokeCluster", spoke.Name)
+					os.Exit(1)
+				}
+				setupLog.Info("registered MCPServer controller for spoke cluster", "spokeCluster", spoke.Name)
+			}
+		}
+	}
+
+	if enabledControllers[controller.AgentCoreStackControllerName] {
+		if err = (&controller.AgentCoreStackReconciler{
+			Client:         mgr.GetClient(),
+			Scheme:         mgr.GetScheme(),
+			BedrockClient:  bedrockClient,
+			AuditLogger:    auditLogger,
+			GatewayCache:   gatewayCache,
+			AWSCallTimeout: awsCallTimeout,
+			RateLimiter:    rateLimiter,
+			CircuitBreaker: circuitBreaker,
+			Sharder:        sharder,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AgentCoreStack")
+			os.Exit(1)
+		}
+		setupLog.Info("registered AgentCoreStack controller")
+	}
+
+	// Hold back the readiness of Pods until the gateway targets they require are READY
+	if enabledControllers[controller.TargetReadinessControllerName] {
+		if err = (&controller.TargetReadinessReconciler{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "TargetReadiness")
+			os.Exit(1)
+		}
+		setupLog.Info("registered target readiness controller")
+	}
+
+	// Aggregate the readiness of labelled sets of MCPServers into MCPServerGroups
+	if enabledControllers[controller.MCPServerGroupControllerName] {
+		if err = (&controller.MCPServerGroupReconciler{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "MCPServerGroup")
+			os.Exit(1)
+		}
+		setupLog.Info("registered MCPServer group controller")
+	}
+
+	// Fleet-wide tasks run once, on the leader of shard 0, rather than on the leader of every shard
+	fleetTasks := targetStatsInterval > 0 || canaryInterval > 0 || previewRegistry != nil || backupPath != ""
+	if fleetTasks && !sharder.Primary() {
+		setupLog.Info("target statistics, canary, preview cleanup and backup export run on shard 0 only")
+	}
+
+	// Export gateway target traffic from CloudWatch for autoscaling signals
+	if runMCPServers && targetStatsInterval > 0 && sharder.Primary() {
+		collector := stats.NewCollector(mgr.GetClient(), cloudwatch.NewFromConfig(awsCfg), configParser,
+			targetStatsInterval, targetStatsWindow(targetStatsInterval), ctrl.Log.WithName("stats"))
+		if err := mgr.Add(collector); err != nil {
+			setupLog.Error(err, "unable to set up target statistics collector")
+			os.Exit(1)
+		}
+		setupLog.Info("target statistics enabled", "interval", targetStatsInterval)
+	}
+
+	// Exercise the create, update and delete path of a synthetic MCPServer
+	if runMCPServers && canaryInterval > 0 && (canaryEndpoint == "" || canaryNamespace == "") {
+		setupLog.Error(nil, "--canary-interval requires --canary-endpoint and --canary-namespace")
+		os.Exit(1)
+	}
+	if runMCPServers && canaryInterval > 0 && sharder.Primary() {
+		spec := mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:         canaryEndpoint,
+			Capabilities:     []string{"tools"},
+			OauthProviderArn: canaryOAuthProviderArn,
+		}
+		if canaryOAuthScopes != "" {
+			spec.OauthScopes = strings.Split(canaryOAuthScopes, ",")
+		}
+		key := types.NamespacedName{Namespace: canaryNamespace, Name: "mcp-gateway-canary"}
+		if err := mgr.Add(canary.NewCanary(mgr.GetClient(), key, spec, canaryInterval, canaryTimeout,
+			ctrl.Log.WithName("canary"))); err != nil {
+			setupLog.Error(err, "unable to set up canary")
+			os.Exit(1)
+		}
+		setupLog.Info("canary enabled", "interval", canaryInterval, "mcpServer", key)
+	}
+
+	// Delete the gateway targets left behind by deleted preview namespaces
+	if runMCPServers && previewRegistry != nil && sharder.Primary() {
+		deleter := bedrock.NewBedrockClientWrapper(bedrockClient, ctrl.Log.WithName("preview"),
+			bedrock.WithAuditLogger(auditLogger), bedrock.WithCallTimeout(awsCallTimeout),
+			bedrock.WithRateLimiter(rateLimiter), bedrock.WithCircuitBreaker(circuitBreaker))
+		// Targets managed with an AWSProviderConfig are deleted in its account
+		targetContext := preview.WithTargetContext(func(ctx context.Context, entry preview.Entry) (context.Context, error) {
+			return mcpServerReconciler.ProviderContext(ctx, entry.ProviderConfig)
+		})
+		if err := mgr.Add(preview.NewCleaner(mgr.GetAPIReader(), previewRegistry, deleter, previewCleanupInterval,
+			ctrl.Log.WithName("preview"), targetContext)); err != nil {
+			setupLog.Error(err, "unable to set up preview cleanup")
+			os.Exit(1)
+		}
+		setupLog.Info("preview cleanup enabled", "interval", previewCleanupInterval,
+			"registry", types.NamespacedName{Namespace: previewRegistryNamespace, Name: previewRegistryName})
+	}
+
+	// Export the AWS identifiers of the managed resources for disaster recovery
+	if backupPath != "" && sharder.Primary() {
+		exporter := backup.NewExporter(directClient, backupPath, backupInterval, clusterID, ctrl.Log.WithName("backup"))
+		if err := mgr.Add(exporter); err != nil {
+			setupLog.Error(err, "unable to set up backup exporter")
+			os.Exit(1)
+		}
+		setupLog.Info("backup export enabled", "path", backupPath, "interval", backupInterval)
+	}
+
+	// Report the stored versions of the CRDs so the progress of storage migrations can be tracked
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		storageMigrator.Report(ctx, storedVersionsReportInterval)
+		return nil
+	})); err != nil {
+		setupLog.Error(err, "unable to set up stored versions report")
 		os.Exit(1)
 	}
-	setupLog.Info("registered MCPServer controller")
 
 	// +kubebuilder:scaffold:builder
 
+	// In one-shot mode the manager is stopped once every resource is in sync
+	mgrCtx, stopManager := context.WithCancel(ctrl.SetupSignalHandler())
+	defer stopManager()
+	var onceRunner *oneshot.Runner
+	if once {
+		onceRunner = oneshot.NewRunner(mgr.GetClient(), onceTimeout, stopManager, ctrl.Log.WithName("once"))
+		onceRunner.MCPServers = runMCPServers
+		onceRunner.Owns = sharder.Owns
+		onceRunner.Stacks = enabledControllers[controller.AgentCoreStackControllerName]
+		if err := mgr.Add(onceRunner); err != nil {
+			setupLog.Error(err, "unable to set up one-shot run")
+			os.Exit(1)
+		}
+		setupLog.Info("one-shot mode enabled", "timeout", onceTimeout)
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -247,8 +1174,119 @@ func main() {
 	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	err = mgr.Start(mgrCtx)
+	// Export the spans still buffered, which would be lost on exit
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := stopTracing(flushCtx); err != nil {
+		setupLog.Error(err, "unable to flush traces")
+	}
+	cancelFlush()
+	if err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+
+	if onceRunner != nil {
+		unsynced, done := onceRunner.Result()
+		if !done {
+			for _, resource := range unsynced {
+				setupLog.Info("resource not in sync", "resource", resource)
+			}
+			setupLog.Error(nil, "one-shot run did not complete", "unsynced", len(unsynced))
+			os.Exit(1)
+		}
+		setupLog.Info("one-shot run complete, all resources are in sync")
+	}
+}
+
+// storedVersionsReportInterval is how often the stored versions of the CRDs are reported
+const storedVersionsReportInterval = 10 * time.Minute
+
+// runStorageMigration migrates every installed operator CRD to its storage version
+func runStorageMigration(ctx context.Context, migrator *storageversion.Migrator) error {
+	for _, crdName := range storageversion.ManagedCRDs {
+		if _, err := migrator.Migrate(ctx, crdName); err != nil {
+			if apierrors.IsNotFound(err) {
+				setupLog.Info("CRD not installed, skipping storage migration", "crd", crdName)
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// runRestore restores the AWS identifiers in a snapshot to the resources in the cluster
+func runRestore(ctx context.Context, c client.Client, path string) error {
+	snapshot, err := backup.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	setupLog.Info("restoring snapshot", "snapshot", path, "clusterID", snapshot.ClusterID,
+		"exportedAt", snapshot.ExportedAt)
+
+	restored, err := backup.Restore(ctx, c, snapshot, ctrl.Log.WithName("backup"))
+	if err != nil {
+		return err
+	}
+	outcomes := map[backup.Outcome]int{}
+	for _, resource := range restored {
+		outcomes[resource.Outcome]++
+	}
+	setupLog.Info("restored snapshot", "restored", outcomes[backup.OutcomeRestored],
+		"specChanged", outcomes[backup.OutcomeSpecChanged], "alreadyLinked", outcomes[backup.OutcomeAlreadyLinked],
+		"notFound", outcomes[backup.OutcomeNotFound])
+	return nil
+}
+
+// checkStorageVersions fails if an operator CRD has objects stored in a version this operator
+// does not know. CRDs that are not installed or cannot be read are skipped.
+func checkStorageVersions(ctx context.Context, migrator *storageversion.Migrator) error {
+	known := []string{mcpgatewayv1alpha1.GroupVersion.Version}
+	for _, crdName := range storageversion.ManagedCRDs {
+		_, err := migrator.Check(ctx, crdName, known)
+		var unknownVersion *storageversion.UnknownVersionError
+		if errors.As(err, &unknownVersion) {
+			return err
+		}
+		if err != nil {
+			setupLog.Info("unable to check CRD storage versions", "crd", crdName, "error", err.Error())
+		}
+	}
+	return nil
+}
+
+// flagSet reports whether the named flag was set on the command line
+func flagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// promptRegion asks for the AWS region on the terminal, for dev mode runs without a configured
+// region. It fails if in is not a terminal, e.g. when running in a cluster.
+func promptRegion(in *os.File, out io.Writer) (string, error) {
+	if info, err := in.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return "", errors.New("no AWS region configured, set --aws-region, AWS_REGION or the region of the profile")
+	}
+	_, _ = fmt.Fprint(out, "AWS region: ")
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read AWS region: %w", err)
+	}
+	region := strings.TrimSpace(line)
+	if region == "" {
+		return "", errors.New("no AWS region entered")
+	}
+	return region, nil
+}
+
+// targetStatsWindow returns the CloudWatch aggregation window for the given collection interval.
+// CloudWatch periods are whole minutes, so the window is the interval rounded up to a minute.
+func targetStatsWindow(interval time.Duration) time.Duration {
+	return max(interval.Round(time.Minute), time.Minute)
 }
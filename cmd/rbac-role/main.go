@@ -0,0 +1,93 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// rbac-role prints the ClusterRole the operator needs. Its flags mirror the operator's, so the role
+// of a deployment is generated by passing it the same flags, e.g.
+//
+//	go run ./cmd/rbac-role --controllers=mcpserver --feature-gates=SecretReferences=false
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/mcp-gateway-operator/internal/controller"
+	"github.com/aws/mcp-gateway-operator/pkg/rbacrole"
+)
+
+func main() {
+	var roleName string
+	var controllers string
+	var enableStackController bool
+	var featureGates string
+	var environmentsConfigMap string
+	var previewCleanupInterval time.Duration
+	var kedaPrometheusAddress string
+	var spokeClusterNamespace string
+
+	flag.StringVar(&roleName, "name", rbacrole.DefaultRoleName, "Name of the ClusterRole.")
+	flag.StringVar(&controllers, "controllers", strings.Join(controller.DefaultControllers, ","),
+		"Comma-separated list of controllers the operator runs, as in the operator's --controllers.")
+	flag.BoolVar(&enableStackController, "enable-agentcorestack-controller", false,
+		"Deprecated: add agentcorestack to --controllers instead.")
+	flag.StringVar(&featureGates, "feature-gates", "",
+		"The operator's --feature-gates. SecretReferences=false drops the Secret permissions of MCPServers.")
+	flag.StringVar(&environmentsConfigMap, "environments-configmap", "",
+		"The operator's --environments-configmap. Grants reading namespaces.")
+	flag.DurationVar(&previewCleanupInterval, "preview-cleanup-interval", 0,
+		"The operator's --preview-cleanup-interval. Non-zero grants reading namespaces.")
+	flag.StringVar(&kedaPrometheusAddress, "keda-prometheus-address", "",
+		"The operator's --keda-prometheus-address. Grants managing KEDA ScaledObjects.")
+	flag.StringVar(&spokeClusterNamespace, "spoke-cluster-namespace", "",
+		"The operator's --spoke-cluster-namespace. Grants listing spoke kubeconfig Secrets.")
+	flag.Parse()
+
+	enabled, err := controller.ParseControllers(controllers)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: invalid --controllers: %v\n", err)
+		os.Exit(2)
+	}
+	gates, err := controller.ParseFeatureGates(featureGates)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: invalid --feature-gates: %v\n", err)
+		os.Exit(2)
+	}
+
+	role, err := rbacrole.Generate(roleName, rbacrole.Features{
+		MCPServers:       enabled[controller.MCPServerControllerName],
+		AgentCoreStacks:  enabled[controller.AgentCoreStackControllerName] || enableStackController,
+		TargetReadiness:  enabled[controller.TargetReadinessControllerName],
+		MCPServerGroups:  enabled[controller.MCPServerGroupControllerName],
+		SecretReferences: gates.Enabled(controller.FeatureSecretReferences),
+		GatewayAPI:       gates.Enabled(controller.FeatureGatewayAPI),
+		Namespaces:       environmentsConfigMap != "" || previewCleanupInterval > 0,
+		Autoscaling:      kedaPrometheusAddress != "",
+		SpokeClusters:    spokeClusterNamespace != "",
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	data, err := rbacrole.Marshal(role)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Print(string(data))
+}
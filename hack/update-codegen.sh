@@ -0,0 +1,39 @@
+#!/usr/bin/env bash
+
+# Copyright 2026.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Regenerates the clientset, informers, listers and apply configurations in pkg/client from the
+# +genclient types in api/.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+MODULE="$(cd "${SCRIPT_ROOT}" && go list -m)"
+
+# Use the code-generator release that matches the client-go the module builds against.
+CLIENT_GO_VERSION="$(cd "${SCRIPT_ROOT}" && go list -m -f '{{.Version}}' k8s.io/client-go)"
+CODEGEN_PKG="${CODEGEN_PKG:-$(go mod download -json "k8s.io/code-generator@${CLIENT_GO_VERSION}" | sed -n 's/.*"Dir": "\(.*\)",/\1/p')}"
+
+source "${CODEGEN_PKG}/kube_codegen.sh"
+
+kube::codegen::gen_client \
+    --with-watch \
+    --with-applyconfig \
+    --output-dir "${SCRIPT_ROOT}/pkg/client" \
+    --output-pkg "${MODULE}/pkg/client" \
+    --boilerplate "${SCRIPT_ROOT}/hack/boilerplate.go.txt" \
+    "${SCRIPT_ROOT}/api"
@@ -58,3 +58,18 @@ Create the name of the service account to use
 {{- default "default" .Values.serviceAccount.name }}
 {{- end }}
 {{- end }}
+
+{{/*
+Controllers run by the operator, as a list. The deprecated enableAgentCoreStackController value
+adds the agentcorestack controller.
+*/}}
+{{- define "mcp-gateway-operator.controllers" -}}
+{{- $controllers := .Values.operator.controllers -}}
+{{- if has "*" $controllers -}}
+{{- $controllers = list "mcpserver" "agentcorestack" "targetreadiness" "mcpservergroup" -}}
+{{- end -}}
+{{- if and .Values.operator.enableAgentCoreStackController (not (has "agentcorestack" $controllers)) -}}
+{{- $controllers = append $controllers "agentcorestack" -}}
+{{- end -}}
+{{- join "," $controllers -}}
+{{- end }}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// AdoptTargetAnnotation binds a new MCPServer to the gateway target with the given ID, which
+// already exists in AWS, instead of creating a duplicate. The target must have the name and
+// endpoint of the MCPServer. Once adopted, the target is owned by the MCPServer like one it
+// created, and deleted with it.
+const AdoptTargetAnnotation = "mcpgateway.bedrock.aws/adopt-target-id"
+
+// reasonAdoptionError is the Ready reason of MCPServers whose AdoptTargetAnnotation names a
+// target that cannot be adopted
+const reasonAdoptionError = "AdoptionError"
+
+// adoptExistingTarget binds the MCPServer to the target named by its AdoptTargetAnnotation. It
+// reports false if the MCPServer has no such annotation, in which case a new target is created.
+// Targets that do not match the MCPServer are never adopted; the Ready condition explains why,
+// and nothing is created until the annotation or the spec is fixed.
+func (r *MCPServerReconciler) adoptExistingTarget(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) (bool, ctrl.Result, error) {
+	targetID := mcpServer.Annotations[AdoptTargetAnnotation]
+	if targetID == "" {
+		return false, ctrl.Result{}, nil
+	}
+
+	gatewayID, err := r.ConfigParser.GetGatewayID(mcpServer)
+	if err != nil {
+		log.Error(err, "Failed to get gateway ID")
+		return true, ctrl.Result{}, err
+	}
+
+	bedrockWrapper := r.newBedrockWrapper(log)
+	output, err := bedrockWrapper.GetGatewayTarget(ctx, gatewayID, targetID)
+	if err != nil {
+		if bedrock.IsResourceNotFoundError(err) {
+			return true, ctrl.Result{}, r.rejectAdoption(ctx, mcpServer, log,
+				fmt.Sprintf("Gateway target %s to adopt does not exist on gateway %s", targetID, gatewayID))
+		}
+		return true, ctrl.Result{}, err
+	}
+
+	if message := adoptionMismatch(mcpServer, r.targetName(mcpServer), output); message != "" {
+		return true, ctrl.Result{}, r.rejectAdoption(ctx, mcpServer, log, message)
+	}
+
+	// Two MCPServers must not own the same target, or deleting one would remove the other's target
+	owner, err := r.targetOwnedBy(ctx, mcpServer, gatewayID, targetID)
+	if err != nil {
+		return true, ctrl.Result{}, err
+	}
+	if owner != "" {
+		return true, ctrl.Result{}, r.rejectAdoption(ctx, mcpServer, log,
+			fmt.Sprintf("Gateway target %s is already owned by MCPServer %s", targetID, owner))
+	}
+
+	// Leave the target alone if another cluster manages it
+	if conflict, err := r.checkOwnershipConflict(ctx, mcpServer, output, log); conflict || err != nil {
+		return true, ctrl.Result{}, err
+	}
+
+	// Re-fetch the resource to get the latest version before updating status
+	latestMCPServer := &mcpgatewayv1alpha1.MCPServer{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(mcpServer), latestMCPServer); err != nil {
+		log.Error(err, "Failed to re-fetch MCPServer before status update")
+		return true, ctrl.Result{}, err
+	}
+
+	recordResolvedEndpoint(latestMCPServer, mcpServer)
+	recordAppliedCredentials(latestMCPServer, output.CredentialProviderConfigurations)
+	if err := r.StatusManager.UpdateTargetCreated(ctx, latestMCPServer, targetID, aws.ToString(output.GatewayArn),
+		string(output.Status), output.UpdatedAt); err != nil {
+		if apierrors.IsConflict(err) {
+			return true, ctrl.Result{Requeue: true}, nil
+		}
+		return true, ctrl.Result{}, err
+	}
+
+	// From here on the target is deleted with the MCPServer, and re-adopted if its status is lost
+	if err := r.recordTargetOwnership(ctx, latestMCPServer, gatewayID, targetID); err != nil {
+		log.Error(err, "Failed to record gateway target ownership")
+	}
+
+	log.Info("Adopted existing gateway target", "gatewayId", gatewayID, "targetId", targetID)
+	return true, ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+}
+
+// rejectAdoption reports a target that cannot be adopted in the Ready condition. The MCPServer is
+// reconciled again when its annotations or spec change.
+func (r *MCPServerReconciler) rejectAdoption(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+	message string,
+) error {
+	log.Info("Refusing to adopt gateway target", "reason", message)
+	return r.StatusManager.SetError(ctx, mcpServer, reasonAdoptionError, message)
+}
+
+// adoptionMismatch returns why the existing target does not match the MCPServer, or an empty
+// string if it does. The endpoint is only compared for MCP server targets.
+func adoptionMismatch(
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	targetName string,
+	output *bedrockagentcorecontrol.GetGatewayTargetOutput,
+) string {
+	if name := aws.ToString(output.Name); name != targetName {
+		return fmt.Sprintf("Gateway target %s is named %q, not %q", aws.ToString(output.TargetId), name, targetName)
+	}
+
+	targetType := mcpServer.Spec.TargetType
+	if targetType != "" && targetType != mcpgatewayv1alpha1.TargetTypeMcpServer {
+		return ""
+	}
+	endpoint := bedrock.MCPServerEndpoint(output.TargetConfiguration)
+	if bedrock.NormalizeEndpoint(endpoint) != bedrock.NormalizeEndpoint(mcpServer.Spec.Endpoint) {
+		return fmt.Sprintf("Gateway target %s has endpoint %q, not %q", aws.ToString(output.TargetId), endpoint,
+			mcpServer.Spec.Endpoint)
+	}
+	return ""
+}
+
+// targetOwnedBy returns the namespaced name of another MCPServer that owns the gateway target,
+// or an empty string if there is none
+func (r *MCPServerReconciler) targetOwnedBy(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	gatewayID, targetID string,
+) (string, error) {
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := r.List(ctx, mcpServers); err != nil {
+		return "", err
+	}
+	value := targetOwnerValue(gatewayID, targetID)
+	for i := range mcpServers.Items {
+		other := &mcpServers.Items[i]
+		if other.UID == mcpServer.UID {
+			continue
+		}
+		if other.Annotations[targetOwnerAnnotation] == value || other.Status.TargetID == targetID {
+			return other.Namespace + "/" + other.Name, nil
+		}
+	}
+	return "", nil
+}
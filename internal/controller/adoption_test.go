@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Target adoption", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	adopting := func(targetID string) *mcpgatewayv1alpha1.MCPServer {
+		return &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        key.Name,
+				Namespace:   key.Namespace,
+				UID:         types.UID("4b8e2a61-7c3d-4f19-9a5e-0d6c1b2e3f47"),
+				Generation:  1,
+				Annotations: map[string]string{AdoptTargetAnnotation: targetID},
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://weather.example.com/mcp",
+				Capabilities: []string{"tools"},
+			},
+		}
+	}
+
+	readyReason := func(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+		condition := meta.FindStatusCondition(mcpServer.Status.Conditions, "Ready")
+		Expect(condition).NotTo(BeNil())
+		return condition.Reason
+	}
+
+	It("should adopt a matching target and delete it with the MCPServer", func() {
+		h := newReconcileHarness(adopting("TARGET1"))
+		Expect(h.agentCore.addTarget("gw-1", "weather", "https://weather.example.com/mcp")).To(Equal("TARGET1"))
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("CreateGatewayTarget")).To(BeZero())
+		adopted := h.get(ctx, key)
+		Expect(adopted.Status.TargetID).To(Equal("TARGET1"))
+		Expect(adopted.Annotations).To(HaveKeyWithValue(targetOwnerAnnotation, "gw-1/TARGET1"))
+
+		Expect(h.client.Delete(ctx, adopted)).To(Succeed())
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.targetIDs()).To(BeEmpty())
+		Expect(apierrors.IsNotFound(h.client.Get(ctx, key, &mcpgatewayv1alpha1.MCPServer{}))).To(BeTrue())
+	})
+
+	DescribeTable("should refuse targets that do not match",
+		func(name, endpoint string) {
+			h := newReconcileHarness(adopting("TARGET1"))
+			h.agentCore.addTarget("gw-1", name, endpoint)
+
+			_, err := h.reconcile(ctx, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.agentCore.callCount("CreateGatewayTarget")).To(BeZero())
+			refused := h.get(ctx, key)
+			Expect(refused.Status.TargetID).To(BeEmpty())
+			Expect(readyReason(refused)).To(Equal(reasonAdoptionError))
+		},
+		Entry("another name", "news", "https://weather.example.com/mcp"),
+		Entry("another endpoint", "weather", "https://news.example.com/mcp"),
+	)
+
+	It("should refuse targets that do not exist", func() {
+		h := newReconcileHarness(adopting("TARGET9"))
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("CreateGatewayTarget")).To(BeZero())
+		Expect(readyReason(h.get(ctx, key))).To(Equal(reasonAdoptionError))
+	})
+
+	It("should refuse targets owned by another MCPServer", func() {
+		owner := adopting("")
+		owner.Name = "weather-owner"
+		owner.UID = types.UID("0f3a9c2e-5d71-4b6a-8e4f-7a1b2c3d4e5f")
+		owner.Annotations = map[string]string{targetOwnerAnnotation: "gw-1/TARGET1"}
+		h := newReconcileHarness(adopting("TARGET1"), owner)
+		h.agentCore.addTarget("gw-1", "weather", "https://weather.example.com/mcp")
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.get(ctx, key).Status.TargetID).To(BeEmpty())
+		Expect(readyReason(h.get(ctx, key))).To(Equal(reasonAdoptionError))
+	})
+})
@@ -0,0 +1,815 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	bedrocktypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/audit"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/sharding"
+)
+
+const (
+	stackFinalizer = "mcpgateway.bedrock.aws/stack-finalizer"
+
+	// StackLabel is set on MCPServers managed by an AgentCoreStack to the name of the stack
+	StackLabel = "mcpgateway.bedrock.aws/stack"
+
+	// stackRequeueInterval is how often a stack waiting on AWS or its MCPServers is checked again
+	stackRequeueInterval = 10 * time.Second
+
+	// defaultTokenVault is the token vault AgentCore creates credential providers in
+	defaultTokenVault = "default"
+)
+
+// targetFailureReasons are the MCPServer Ready condition reasons that retrying cannot fix
+var targetFailureReasons = map[string]bool{
+	"ValidationError":                           true,
+	"ConfigurationError":                        true,
+	"CreationError":                             true,
+	bedrock.ReasonIncompatibleGatewayProtocol:   true,
+	bedrock.ReasonIncompatibleGatewayAuthorizer: true,
+}
+
+// AgentCoreStackReconciler reconciles an AgentCoreStack object
+type AgentCoreStackReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	BedrockClient bedrock.GatewayTargetAPI
+
+	// AuditLogger records every mutating AWS call. Nil disables audit records.
+	AuditLogger *audit.Logger
+
+	// GatewayCache is shared with the MCPServer controller. The cached gateway of a stack is
+	// invalidated whenever the stack is reconciled. Nil disables caching.
+	GatewayCache *bedrock.GatewayCache
+
+	// AWSCallTimeout bounds every attempt of an AWS call, independently of the reconcile.
+	// Zero uses bedrock.DefaultCallTimeout.
+	AWSCallTimeout time.Duration
+
+	// RateLimiter is shared with the MCPServer controller. Nil does not limit calls.
+	RateLimiter *bedrock.RateLimiter
+
+	// CircuitBreaker is shared with the MCPServer controller. Nil never fails calls.
+	CircuitBreaker *bedrock.CircuitBreaker
+
+	// Sharder restricts the reconciler to the AgentCoreStacks assigned to this replica, so that
+	// every stack is provisioned by a single shard leader. Nil reconciles every stack.
+	Sharder *sharding.Sharder
+}
+
+// stackFailure is a provisioning failure that retrying cannot fix
+type stackFailure struct {
+	reason string
+	err    error
+}
+
+func (f *stackFailure) Error() string {
+	return f.err.Error()
+}
+
+func (f *stackFailure) Unwrap() error {
+	return f.err
+}
+
+// awsStackError classifies an AWS error as a stackFailure unless it is transient. Calls abandoned
+// because the reconcile was canceled, e.g. on shutdown, or failed by the open circuit breaker are
+// not failures of the stack and must not roll it back.
+func awsStackError(reason string, err error) error {
+	if bedrock.IsRetryableError(err) || bedrock.IsCanceledError(err) || bedrock.IsCircuitOpenError(err) {
+		return err
+	}
+	return &stackFailure{reason: reason, err: err}
+}
+
+// +kubebuilder:rbac:groups=mcpgateway.bedrock.aws,resources=agentcorestacks,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=mcpgateway.bedrock.aws,resources=agentcorestacks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mcpgateway.bedrock.aws,resources=agentcorestacks/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile provisions the gateway, credential providers and targets of an AgentCoreStack in
+// that order. If provisioning fails before the stack first becomes ready, every component
+// created so far is deleted in reverse order and the stack is marked Failed until its spec changes.
+func (r *AgentCoreStackReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	log := logf.FromContext(ctx)
+	ctx, span := startReconcileSpan(ctx, "AgentCoreStack", req)
+	defer func() { endReconcileSpan(span, "", result, err) }()
+
+	// Wait for the next probe of the circuit breaker instead of the controller's backoff
+	defer func() {
+		var openErr *bedrock.CircuitOpenError
+		if errors.As(err, &openErr) {
+			log.Info("AWS calls are failing, requeueing for the next probe", "retryAfter", openErr.RetryAfter)
+			result, err = ctrl.Result{RequeueAfter: openErr.RetryAfter}, nil
+		}
+	}()
+
+	// Tag all AWS calls made during this reconcile with the resource they belong to
+	ctx = bedrock.WithStackAttribution(ctx, req.Namespace, req.Name)
+
+	stack := &mcpgatewayv1alpha1.AgentCoreStack{}
+	if err := r.Get(ctx, req.NamespacedName, stack); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("AgentCoreStack resource not found, likely deleted")
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to get AgentCoreStack resource")
+		return ctrl.Result{}, err
+	}
+
+	// Requests for stacks of other shards are still enqueued through their MCPServers
+	if !ownedBy(r.Sharder, stack) {
+		log.V(1).Info("AgentCoreStack is assigned to another shard, skipping", "shard", r.Sharder.ShardFor(stack))
+		return ctrl.Result{}, nil
+	}
+
+	opts := []bedrock.Option{bedrock.WithAuditLogger(r.AuditLogger), bedrock.WithGatewayCache(r.GatewayCache),
+		bedrock.WithRateLimiter(r.RateLimiter), bedrock.WithCircuitBreaker(r.CircuitBreaker)}
+	if r.AWSCallTimeout > 0 {
+		opts = append(opts, bedrock.WithCallTimeout(r.AWSCallTimeout))
+	}
+	bedrockWrapper := bedrock.NewBedrockClientWrapper(r.BedrockClient, log, opts...)
+
+	// Every event of the stack may follow a change of its gateway, so the MCPServers of the
+	// gateway must not keep using what was cached before
+	bedrockWrapper.InvalidateGateway(stack.Status.GatewayID)
+
+	if !stack.DeletionTimestamp.IsZero() {
+		return r.handleDeletion(ctx, stack, bedrockWrapper, log)
+	}
+
+	if !controllerutil.ContainsFinalizer(stack, stackFinalizer) {
+		patch := client.MergeFrom(stack.DeepCopy())
+		controllerutil.AddFinalizer(stack, stackFinalizer)
+		if err := r.Patch(ctx, stack, patch); err != nil {
+			log.Error(err, "Failed to add finalizer")
+			return ctrl.Result{}, err
+		}
+		log.Info("Added finalizer to AgentCoreStack")
+	}
+
+	switch stack.Status.Phase {
+	case mcpgatewayv1alpha1.StackPhaseRollingBack:
+		return r.rollBack(ctx, stack, bedrockWrapper, log)
+	case mcpgatewayv1alpha1.StackPhaseFailed:
+		// A rolled back stack is only retried once its spec changes
+		if stack.Generation == stack.Status.ObservedGeneration {
+			return ctrl.Result{}, nil
+		}
+	}
+
+	return r.provision(ctx, stack, bedrockWrapper, log)
+}
+
+// provision creates or updates every component of the stack and aggregates their state
+func (r *AgentCoreStackReconciler) provision(
+	ctx context.Context,
+	stack *mcpgatewayv1alpha1.AgentCoreStack,
+	bedrockWrapper *bedrock.BedrockClientWrapper,
+	log logr.Logger,
+) (ctrl.Result, error) {
+	// Only the initial bring-up is transactional; a stack that has been ready keeps its
+	// components when a later change fails
+	transactional := stack.Status.Phase != mcpgatewayv1alpha1.StackPhaseReady &&
+		stack.Status.Phase != mcpgatewayv1alpha1.StackPhaseDegraded
+	if transactional {
+		stack.Status.Phase = mcpgatewayv1alpha1.StackPhaseProvisioning
+	}
+
+	// The token vault is checked first so that an unusable vault fails before anything is created
+	ready := false
+	err := r.ensureTokenVault(ctx, stack, bedrockWrapper, log)
+	if err == nil {
+		ready, err = r.ensureGateway(ctx, stack, bedrockWrapper, log)
+	}
+	if err == nil && ready {
+		ready, err = r.ensureCredentialProviders(ctx, stack, bedrockWrapper, log)
+	}
+	if err == nil && ready {
+		ready, err = r.ensureTargets(ctx, stack, log)
+	}
+	if err == nil {
+		err = r.setBoundMCPServers(ctx, stack)
+	}
+
+	if err != nil {
+		var failure *stackFailure
+		if !errors.As(err, &failure) {
+			log.Error(err, "Failed to provision AgentCoreStack")
+			return ctrl.Result{}, err
+		}
+
+		log.Error(failure.err, "AgentCoreStack provisioning failed", "reason", failure.reason)
+		setStackReadyCondition(stack, metav1.ConditionFalse, failure.reason, failure.Error())
+		if transactional {
+			log.Info("Rolling back AgentCoreStack")
+			stack.Status.Phase = mcpgatewayv1alpha1.StackPhaseRollingBack
+		} else {
+			stack.Status.Phase = mcpgatewayv1alpha1.StackPhaseDegraded
+			stack.Status.ObservedGeneration = stack.Generation
+		}
+		if err := r.Status().Update(ctx, stack); err != nil {
+			return r.statusUpdateResult(err, log)
+		}
+		if transactional {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !ready {
+		setStackReadyCondition(stack, metav1.ConditionFalse, "Provisioning", "Waiting for stack components to become ready")
+		if err := r.Status().Update(ctx, stack); err != nil {
+			return r.statusUpdateResult(err, log)
+		}
+		return ctrl.Result{RequeueAfter: stackRequeueInterval}, nil
+	}
+
+	stack.Status.Phase = mcpgatewayv1alpha1.StackPhaseReady
+	stack.Status.ObservedGeneration = stack.Generation
+	setStackReadyCondition(stack, metav1.ConditionTrue, "StackReady", "Gateway, credential providers and targets are ready")
+	if err := r.Status().Update(ctx, stack); err != nil {
+		return r.statusUpdateResult(err, log)
+	}
+	log.Info("AgentCoreStack is ready", "gatewayId", stack.Status.GatewayID)
+	return ctrl.Result{}, nil
+}
+
+// ensureGateway creates the stack gateway if needed and reports whether it is ready
+func (r *AgentCoreStackReconciler) ensureGateway(
+	ctx context.Context,
+	stack *mcpgatewayv1alpha1.AgentCoreStack,
+	bedrockWrapper *bedrock.BedrockClientWrapper,
+	log logr.Logger,
+) (bool, error) {
+	if stack.Status.GatewayID == "" {
+		spec := stack.Spec.Gateway
+		authorizerType := spec.AuthorizerType
+		if authorizerType == "" {
+			authorizerType = string(bedrocktypes.AuthorizerTypeAwsIam)
+		}
+
+		input := &bedrockagentcorecontrol.CreateGatewayInput{
+			Name:           aws.String(spec.Name),
+			RoleArn:        aws.String(spec.RoleArn),
+			ProtocolType:   bedrocktypes.GatewayProtocolTypeMcp,
+			AuthorizerType: bedrocktypes.AuthorizerType(authorizerType),
+			// The token is scoped to the generation so a retry after rollback creates a new gateway
+			ClientToken: aws.String(string(stack.UID) + "-" + strconv.FormatInt(stack.Generation, 10)),
+		}
+		if spec.Description != "" {
+			input.Description = aws.String(spec.Description)
+		}
+		if spec.JWTAuthorizer != nil {
+			input.AuthorizerConfiguration = &bedrocktypes.AuthorizerConfigurationMemberCustomJWTAuthorizer{
+				Value: bedrocktypes.CustomJWTAuthorizerConfiguration{
+					DiscoveryUrl:    aws.String(spec.JWTAuthorizer.DiscoveryURL),
+					AllowedAudience: spec.JWTAuthorizer.AllowedAudience,
+					AllowedClients:  spec.JWTAuthorizer.AllowedClients,
+				},
+			}
+		}
+
+		log.Info("Creating gateway", "name", spec.Name)
+		output, err := bedrockWrapper.CreateGateway(ctx, input)
+		if err != nil {
+			return false, awsStackError("GatewayCreationFailed", err)
+		}
+
+		// Persist the ID right away so that the gateway is never orphaned
+		stack.Status.GatewayID = aws.ToString(output.GatewayId)
+		stack.Status.GatewayArn = aws.ToString(output.GatewayArn)
+		stack.Status.GatewayURL = aws.ToString(output.GatewayUrl)
+		if err := r.Status().Update(ctx, stack); err != nil {
+			return false, err
+		}
+	}
+
+	gateway, err := bedrockWrapper.GetGateway(ctx, stack.Status.GatewayID)
+	if err != nil {
+		return false, awsStackError("GatewayUnavailable", err)
+	}
+	stack.Status.GatewayURL = aws.ToString(gateway.GatewayUrl)
+
+	switch gateway.Status {
+	case bedrocktypes.GatewayStatusReady:
+		return true, nil
+	case bedrocktypes.GatewayStatusFailed, bedrocktypes.GatewayStatusUpdateUnsuccessful, bedrocktypes.GatewayStatusDeleting:
+		return false, &stackFailure{
+			reason: "GatewayFailed",
+			err:    fmt.Errorf("gateway %s is %s: %v", stack.Status.GatewayID, gateway.Status, gateway.StatusReasons),
+		}
+	default:
+		log.V(1).Info("Gateway not ready yet", "gatewayId", stack.Status.GatewayID, "status", gateway.Status)
+		return false, nil
+	}
+}
+
+// ensureTokenVault checks that the stack credential providers can be created in the configured
+// token vault and switches the vault to the configured KMS key. The vault is shared by the whole
+// account, so its key is never reverted on rollback or deletion.
+func (r *AgentCoreStackReconciler) ensureTokenVault(
+	ctx context.Context,
+	stack *mcpgatewayv1alpha1.AgentCoreStack,
+	bedrockWrapper *bedrock.BedrockClientWrapper,
+	log logr.Logger,
+) error {
+	spec := stack.Spec.TokenVault
+	if spec == nil {
+		return nil
+	}
+	vault := stackTokenVault(stack)
+
+	if vault != defaultTokenVault && len(stack.Spec.CredentialProviders) > 0 {
+		return &stackFailure{
+			reason: "UnsupportedTokenVault",
+			err: fmt.Errorf("credential providers can only be created in the %q token vault, not %q",
+				defaultTokenVault, vault),
+		}
+	}
+	if spec.KMSKeyArn == "" {
+		return nil
+	}
+
+	output, err := bedrockWrapper.GetTokenVault(ctx, vault)
+	if err != nil {
+		return awsStackError("TokenVaultUnavailable", err)
+	}
+	if kms := output.KmsConfiguration; kms != nil && kms.KeyType == bedrocktypes.KeyTypeCustomerManagedKey &&
+		aws.ToString(kms.KmsKeyArn) == spec.KMSKeyArn {
+		return nil
+	}
+
+	log.Info("Setting token vault KMS key", "tokenVault", vault, "kmsKeyArn", spec.KMSKeyArn)
+	if _, err := bedrockWrapper.SetTokenVaultCMK(ctx, vault, spec.KMSKeyArn); err != nil {
+		return awsStackError("TokenVaultConfigurationFailed", err)
+	}
+	return nil
+}
+
+// ensureCredentialProviders creates the stack credential providers that do not exist yet.
+// It reports false while a referenced client secret is missing.
+func (r *AgentCoreStackReconciler) ensureCredentialProviders(
+	ctx context.Context,
+	stack *mcpgatewayv1alpha1.AgentCoreStack,
+	bedrockWrapper *bedrock.BedrockClientWrapper,
+	log logr.Logger,
+) (bool, error) {
+	for _, spec := range stack.Spec.CredentialProviders {
+		if stackProviderArn(stack, spec.Name) != "" {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		key := types.NamespacedName{Namespace: stack.Namespace, Name: spec.ClientSecretRef.Name}
+		if err := r.Get(ctx, key, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("Waiting for client secret; it must carry the watch label",
+					"secret", spec.ClientSecretRef.Name, "label", WatchLabel)
+				return false, nil
+			}
+			return false, err
+		}
+		clientSecret, ok := secret.Data[spec.ClientSecretRef.Key]
+		if !ok {
+			return false, &stackFailure{
+				reason: "CredentialProviderSecretInvalid",
+				err:    fmt.Errorf("secret %s has no key %s", spec.ClientSecretRef.Name, spec.ClientSecretRef.Key),
+			}
+		}
+
+		log.Info("Creating OAuth2 credential provider", "name", spec.Name)
+		output, err := bedrockWrapper.CreateOauth2CredentialProvider(ctx, &bedrockagentcorecontrol.CreateOauth2CredentialProviderInput{
+			Name:                     aws.String(spec.Name),
+			CredentialProviderVendor: bedrocktypes.CredentialProviderVendorTypeCustomOauth2,
+			Oauth2ProviderConfigInput: &bedrocktypes.Oauth2ProviderConfigInputMemberCustomOauth2ProviderConfig{
+				Value: bedrocktypes.CustomOauth2ProviderConfigInput{
+					ClientId:       aws.String(spec.ClientID),
+					ClientSecret:   aws.String(string(clientSecret)),
+					OauthDiscovery: &bedrocktypes.Oauth2DiscoveryMemberDiscoveryUrl{Value: spec.DiscoveryURL},
+				},
+			},
+		})
+		var arn string
+		switch {
+		case err == nil:
+			arn = aws.ToString(output.CredentialProviderArn)
+		case providerExists(err):
+			// An earlier attempt created the provider but failed to record it in the status
+			arn, err = adoptCredentialProvider(ctx, bedrockWrapper, spec.Name, log)
+			if err != nil {
+				return false, awsStackError("CredentialProviderCreationFailed", err)
+			}
+		default:
+			return false, awsStackError("CredentialProviderCreationFailed", err)
+		}
+
+		// Persist the ARN right away so that the provider is never orphaned
+		stack.Status.CredentialProviders = append(stack.Status.CredentialProviders,
+			mcpgatewayv1alpha1.StackCredentialProviderStatus{
+				Name: spec.Name,
+				Arn:  arn,
+			})
+		if err := r.Status().Update(ctx, stack); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// providerExists reports whether a credential provider create failed because a provider of
+// the same name already exists
+func providerExists(err error) bool {
+	return bedrock.IsConflictError(err) ||
+		(bedrock.IsValidationError(err) && strings.Contains(strings.ToLower(err.Error()), "already exists"))
+}
+
+// adoptCredentialProvider returns the ARN of the existing credential provider with the given name,
+// so that a stack whose status update was lost after the create does not fail on the retry
+func adoptCredentialProvider(
+	ctx context.Context,
+	bedrockWrapper *bedrock.BedrockClientWrapper,
+	name string,
+	log logr.Logger,
+) (string, error) {
+	output, err := bedrockWrapper.GetOauth2CredentialProvider(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	log.Info("Adopting existing OAuth2 credential provider", "name", name,
+		"arn", aws.ToString(output.CredentialProviderArn))
+	return aws.ToString(output.CredentialProviderArn), nil
+}
+
+// ensureTargets creates or updates the MCPServer of every stack target, deletes the MCPServers
+// of removed targets and reports whether every target is ready
+func (r *AgentCoreStackReconciler) ensureTargets(
+	ctx context.Context,
+	stack *mcpgatewayv1alpha1.AgentCoreStack,
+	log logr.Logger,
+) (bool, error) {
+	desired := make(map[string]bool, len(stack.Spec.Targets))
+	for _, target := range stack.Spec.Targets {
+		providerArn := stackProviderArn(stack, target.CredentialProvider)
+		if providerArn == "" {
+			return false, &stackFailure{
+				reason: "UnknownCredentialProvider",
+				err:    fmt.Errorf("target %s references unknown credential provider %s", target.Name, target.CredentialProvider),
+			}
+		}
+
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: stackTargetName(stack, target.Name), Namespace: stack.Namespace},
+		}
+		desired[mcpServer.Name] = true
+		result, err := controllerutil.CreateOrUpdate(ctx, r.Client, mcpServer, func() error {
+			if mcpServer.Labels == nil {
+				mcpServer.Labels = map[string]string{}
+			}
+			mcpServer.Labels[StackLabel] = stack.Name
+			mcpServer.Spec.Endpoint = target.Endpoint
+			mcpServer.Spec.Capabilities = target.Capabilities
+			mcpServer.Spec.Description = target.Description
+			mcpServer.Spec.GatewayID = stack.Status.GatewayID
+			mcpServer.Spec.TargetName = target.Name
+			mcpServer.Spec.AuthType = "OAuth2"
+			mcpServer.Spec.CredentialProviders = []mcpgatewayv1alpha1.CredentialProvider{
+				{Type: "OAuth2", ProviderArn: providerArn, Scopes: target.Scopes},
+			}
+			metadata := stackTargetMetadata(stack, target)
+			mcpServer.Spec.AllowedRequestHeaders = metadata.AllowedRequestHeaders
+			mcpServer.Spec.AllowedQueryParameters = metadata.AllowedQueryParameters
+			mcpServer.Spec.AllowedResponseHeaders = metadata.AllowedResponseHeaders
+			return controllerutil.SetControllerReference(stack, mcpServer, r.Scheme)
+		})
+		if err != nil {
+			return false, err
+		}
+		if result != controllerutil.OperationResultNone {
+			log.Info("Reconciled stack target", "mcpServer", mcpServer.Name, "operation", result)
+		}
+	}
+
+	children, err := r.stackMCPServers(ctx, stack)
+	if err != nil {
+		return false, err
+	}
+
+	observed := make(map[string]*mcpgatewayv1alpha1.MCPServer, len(children))
+	for i := range children {
+		child := &children[i]
+		if !desired[child.Name] {
+			if err := r.Delete(ctx, child); client.IgnoreNotFound(err) != nil {
+				return false, err
+			}
+			log.Info("Deleted MCPServer of removed stack target", "mcpServer", child.Name)
+			continue
+		}
+		observed[child.Name] = child
+	}
+
+	ready := true
+	stack.Status.Targets = make([]mcpgatewayv1alpha1.StackTargetStatus, 0, len(stack.Spec.Targets))
+	for _, target := range stack.Spec.Targets {
+		name := stackTargetName(stack, target.Name)
+		targetStatus := mcpgatewayv1alpha1.StackTargetStatus{Name: target.Name, MCPServer: name}
+
+		child, ok := observed[name]
+		if !ok {
+			// Not in the cache yet
+			ready = false
+			stack.Status.Targets = append(stack.Status.Targets, targetStatus)
+			continue
+		}
+		targetStatus.TargetStatus = child.Status.TargetStatus
+		stack.Status.Targets = append(stack.Status.Targets, targetStatus)
+
+		condition := meta.FindStatusCondition(child.Status.Conditions, "Ready")
+		switch {
+		case child.Status.TargetStatus == "FAILED":
+			return false, &stackFailure{
+				reason: "TargetFailed",
+				err:    fmt.Errorf("target %s failed: %v", target.Name, child.Status.StatusReasons),
+			}
+		case condition != nil && condition.Status == metav1.ConditionFalse && targetFailureReasons[condition.Reason]:
+			return false, &stackFailure{
+				reason: "TargetFailed",
+				err:    fmt.Errorf("target %s: %s: %s", target.Name, condition.Reason, condition.Message),
+			}
+		case condition == nil || condition.Status != metav1.ConditionTrue:
+			ready = false
+		}
+	}
+	return ready, nil
+}
+
+// rollBack deletes the components created by a failed provisioning attempt
+func (r *AgentCoreStackReconciler) rollBack(
+	ctx context.Context,
+	stack *mcpgatewayv1alpha1.AgentCoreStack,
+	bedrockWrapper *bedrock.BedrockClientWrapper,
+	log logr.Logger,
+) (ctrl.Result, error) {
+	done, err := r.teardown(ctx, stack, bedrockWrapper, log)
+	if statusErr := r.Status().Update(ctx, stack); statusErr != nil {
+		return r.statusUpdateResult(statusErr, log)
+	}
+	if err != nil {
+		log.Error(err, "Failed to roll back AgentCoreStack")
+		return ctrl.Result{}, err
+	}
+	if !done {
+		return ctrl.Result{RequeueAfter: stackRequeueInterval}, nil
+	}
+
+	// Keep the reason of the original failure on the Ready condition
+	reason, message := "RolledBack", "Provisioning failed"
+	if condition := meta.FindStatusCondition(stack.Status.Conditions, "Ready"); condition != nil {
+		reason, message = condition.Reason, condition.Message
+	}
+	stack.Status.Phase = mcpgatewayv1alpha1.StackPhaseFailed
+	stack.Status.ObservedGeneration = stack.Generation
+	setStackReadyCondition(stack, metav1.ConditionFalse, reason, "Rolled back: "+message)
+	if err := r.Status().Update(ctx, stack); err != nil {
+		return r.statusUpdateResult(err, log)
+	}
+	log.Info("AgentCoreStack rolled back", "reason", reason)
+	return ctrl.Result{}, nil
+}
+
+// handleDeletion deletes every component of the stack before removing its finalizer
+func (r *AgentCoreStackReconciler) handleDeletion(
+	ctx context.Context,
+	stack *mcpgatewayv1alpha1.AgentCoreStack,
+	bedrockWrapper *bedrock.BedrockClientWrapper,
+	log logr.Logger,
+) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(stack, stackFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	stack.Status.Phase = mcpgatewayv1alpha1.StackPhaseDeleting
+	done, err := r.teardown(ctx, stack, bedrockWrapper, log)
+	if statusErr := r.Status().Update(ctx, stack); statusErr != nil {
+		return r.statusUpdateResult(statusErr, log)
+	}
+	if err != nil {
+		log.Error(err, "Failed to delete AgentCoreStack components")
+		return ctrl.Result{}, err
+	}
+	if !done {
+		return ctrl.Result{RequeueAfter: stackRequeueInterval}, nil
+	}
+
+	patch := client.MergeFrom(stack.DeepCopy())
+	controllerutil.RemoveFinalizer(stack, stackFinalizer)
+	if err := r.Patch(ctx, stack, patch); err != nil {
+		log.Error(err, "Failed to remove finalizer")
+		return ctrl.Result{}, err
+	}
+	log.Info("Removed finalizer from AgentCoreStack after deleting its components")
+	return ctrl.Result{}, nil
+}
+
+// teardown deletes the stack components in reverse creation order: the MCPServers, whose own
+// finalizers delete the gateway targets, then the credential providers, then the gateway.
+// It reports false while MCPServers are still being deleted.
+func (r *AgentCoreStackReconciler) teardown(
+	ctx context.Context,
+	stack *mcpgatewayv1alpha1.AgentCoreStack,
+	bedrockWrapper *bedrock.BedrockClientWrapper,
+	log logr.Logger,
+) (bool, error) {
+	children, err := r.stackMCPServers(ctx, stack)
+	if err != nil {
+		return false, err
+	}
+	if len(children) > 0 {
+		for i := range children {
+			if !children[i].DeletionTimestamp.IsZero() {
+				continue
+			}
+			if err := r.Delete(ctx, &children[i]); client.IgnoreNotFound(err) != nil {
+				return false, err
+			}
+		}
+		log.Info("Waiting for stack MCPServers to be deleted", "remaining", len(children))
+		return false, nil
+	}
+	stack.Status.Targets = nil
+
+	for len(stack.Status.CredentialProviders) > 0 {
+		last := stack.Status.CredentialProviders[len(stack.Status.CredentialProviders)-1]
+		if err := bedrockWrapper.DeleteOauth2CredentialProvider(ctx, last.Name); err != nil {
+			return false, err
+		}
+		stack.Status.CredentialProviders = stack.Status.CredentialProviders[:len(stack.Status.CredentialProviders)-1]
+	}
+
+	if stack.Status.GatewayID != "" {
+		if err := bedrockWrapper.DeleteGateway(ctx, stack.Status.GatewayID); err != nil {
+			return false, err
+		}
+		stack.Status.GatewayID = ""
+		stack.Status.GatewayArn = ""
+		stack.Status.GatewayURL = ""
+	}
+
+	return true, nil
+}
+
+// stackMCPServers lists the MCPServers controlled by the stack
+func (r *AgentCoreStackReconciler) stackMCPServers(
+	ctx context.Context,
+	stack *mcpgatewayv1alpha1.AgentCoreStack,
+) ([]mcpgatewayv1alpha1.MCPServer, error) {
+	list := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := r.List(ctx, list, client.InNamespace(stack.Namespace), client.MatchingLabels{StackLabel: stack.Name}); err != nil {
+		return nil, err
+	}
+
+	children := make([]mcpgatewayv1alpha1.MCPServer, 0, len(list.Items))
+	for _, item := range list.Items {
+		if metav1.IsControlledBy(&item, stack) {
+			children = append(children, item)
+		}
+	}
+	return children, nil
+}
+
+// statusUpdateResult maps a failed stack status update to a reconcile result
+func (r *AgentCoreStackReconciler) statusUpdateResult(err error, log logr.Logger) (ctrl.Result, error) {
+	if apierrors.IsConflict(err) {
+		log.V(1).Info("Conflict updating AgentCoreStack status, will retry")
+		return ctrl.Result{Requeue: true}, nil
+	}
+	log.Error(err, "Failed to update AgentCoreStack status")
+	return ctrl.Result{}, err
+}
+
+// setStackReadyCondition sets the Ready condition of the stack
+func setStackReadyCondition(stack *mcpgatewayv1alpha1.AgentCoreStack, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&stack.Status.Conditions, metav1.Condition{
+		Type:               "Ready",
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: stack.Generation,
+	})
+}
+
+// stackTokenVault returns the token vault of the stack
+func stackTokenVault(stack *mcpgatewayv1alpha1.AgentCoreStack) string {
+	if stack.Spec.TokenVault == nil || stack.Spec.TokenVault.Name == "" {
+		return defaultTokenVault
+	}
+	return stack.Spec.TokenVault.Name
+}
+
+// stackProviderArn returns the ARN of the named stack credential provider, or "" if it was not created yet
+func stackProviderArn(stack *mcpgatewayv1alpha1.AgentCoreStack, name string) string {
+	for _, provider := range stack.Status.CredentialProviders {
+		if provider.Name == name {
+			return provider.Arn
+		}
+	}
+	return ""
+}
+
+// stackTargetName returns the name of the MCPServer managing a stack target
+func stackTargetName(stack *mcpgatewayv1alpha1.AgentCoreStack, target string) string {
+	return stack.Name + "-" + target
+}
+
+// stackTargetMetadata merges the metadata defaults of the stack gateway into the allowlists of a
+// target. The defaults come first and duplicates are dropped, comparing header names
+// case-insensitively. Allowlists that neither set stay nil, which keeps the allowlist of the
+// gateway target.
+func stackTargetMetadata(stack *mcpgatewayv1alpha1.AgentCoreStack, target mcpgatewayv1alpha1.StackTargetSpec) mcpgatewayv1alpha1.MetadataAllowlists {
+	defaults := stack.Spec.Gateway.MetadataDefaults
+	if defaults == nil {
+		defaults = &mcpgatewayv1alpha1.MetadataAllowlists{}
+	}
+	own := target.Metadata
+	if own == nil {
+		own = &mcpgatewayv1alpha1.MetadataAllowlists{}
+	}
+	return mcpgatewayv1alpha1.MetadataAllowlists{
+		AllowedRequestHeaders:  mergeAllowlists(defaults.AllowedRequestHeaders, own.AllowedRequestHeaders, strings.ToLower),
+		AllowedQueryParameters: mergeAllowlists(defaults.AllowedQueryParameters, own.AllowedQueryParameters, nil),
+		AllowedResponseHeaders: mergeAllowlists(defaults.AllowedResponseHeaders, own.AllowedResponseHeaders, strings.ToLower),
+	}
+}
+
+// mergeAllowlists concatenates defaults and own, dropping duplicate entries. normalize, if set,
+// maps entries to the form they are compared in. Returns nil if both lists are empty.
+func mergeAllowlists(defaults, own []string, normalize func(string) string) []string {
+	if normalize == nil {
+		normalize = func(s string) string { return s }
+	}
+	var merged []string
+	seen := make(map[string]bool, len(defaults)+len(own))
+	for _, entry := range append(append([]string{}, defaults...), own...) {
+		if seen[normalize(entry)] {
+			continue
+		}
+		seen[normalize(entry)] = true
+		merged = append(merged, entry)
+	}
+	return merged
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AgentCoreStackReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &mcpgatewayv1alpha1.MCPServer{},
+		gatewayIndexField, indexGateway); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mcpgatewayv1alpha1.AgentCoreStack{}, builder.WithPredicates(shardPredicate(r.Sharder))).
+		Owns(&mcpgatewayv1alpha1.MCPServer{}).
+		// MCPServers outside the stack are listed in its status too
+		Watches(&mcpgatewayv1alpha1.MCPServer{}, handler.EnqueueRequestsFromMapFunc(r.mapMCPServerToStacks)).
+		Named("agentcorestack").
+		Complete(r)
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/sharding"
+	"github.com/aws/mcp-gateway-operator/pkg/simulator"
+)
+
+var _ = Describe("AgentCoreStack Controller", func() {
+	Context("When deleting a stack without provisioned components", func() {
+		const resourceName = "test-stack"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind AgentCoreStack")
+			resource := &mcpgatewayv1alpha1.AgentCoreStack{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       resourceName,
+					Namespace:  "default",
+					Finalizers: []string{stackFinalizer},
+				},
+				Spec: mcpgatewayv1alpha1.AgentCoreStackSpec{
+					Gateway: mcpgatewayv1alpha1.StackGatewaySpec{
+						Name:    "test-gateway",
+						RoleArn: "arn:aws:iam::123456789012:role/agentcore-gateway-role",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		It("should remove the finalizer without calling AWS", func() {
+			resource := &mcpgatewayv1alpha1.AgentCoreStack{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Spec.Gateway.AuthorizerType).To(Equal("AWS_IAM"))
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+
+			By("Reconciling the deleted resource")
+			reconciler := &AgentCoreStackReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When the stack is assigned to another shard", func() {
+		ctx := context.Background()
+		key := types.NamespacedName{Name: "sharded-stack", Namespace: "default"}
+
+		BeforeEach(func() {
+			resource := &mcpgatewayv1alpha1.AgentCoreStack{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       key.Name,
+					Namespace:  key.Namespace,
+					Labels:     map[string]string{sharding.ShardLabel: "1"},
+					Finalizers: []string{stackFinalizer},
+				},
+				Spec: mcpgatewayv1alpha1.AgentCoreStackSpec{
+					Gateway: mcpgatewayv1alpha1.StackGatewaySpec{
+						Name:    "sharded-gateway",
+						RoleArn: "arn:aws:iam::123456789012:role/agentcore-gateway-role",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should only be reconciled by the replica of its shard", func() {
+			other, err := sharding.NewSharder(2, 0)
+			Expect(err).NotTo(HaveOccurred())
+			reconciler := &AgentCoreStackReconciler{Client: k8sClient, Scheme: k8sClient.Scheme(), Sharder: other}
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("keeping the finalizer on the other shard")
+			resource := &mcpgatewayv1alpha1.AgentCoreStack{}
+			Expect(k8sClient.Get(ctx, key, resource)).To(Succeed())
+			Expect(resource.Finalizers).To(ContainElement(stackFinalizer))
+			Expect(shardPredicate(other).Delete(event.DeleteEvent{Object: resource})).To(BeFalse())
+
+			owner, err := sharding.NewSharder(2, 1)
+			Expect(err).NotTo(HaveOccurred())
+			reconciler.Sharder = owner
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, key, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When a credential provider already exists", func() {
+		ctx := context.Background()
+		key := types.NamespacedName{Name: "adopting-stack", Namespace: "default"}
+
+		var stack *mcpgatewayv1alpha1.AgentCoreStack
+
+		BeforeEach(func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "adopting-stack-oauth", Namespace: key.Namespace},
+				Data:       map[string][]byte{"clientSecret": []byte("s3cr3t")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, secret)).To(Succeed()) })
+
+			stack = &mcpgatewayv1alpha1.AgentCoreStack{
+				ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+				Spec: mcpgatewayv1alpha1.AgentCoreStackSpec{
+					Gateway: mcpgatewayv1alpha1.StackGatewaySpec{
+						Name:    "adopting-gateway",
+						RoleArn: "arn:aws:iam::123456789012:role/agentcore-gateway-role",
+					},
+					CredentialProviders: []mcpgatewayv1alpha1.StackCredentialProviderSpec{{
+						Name:         "tools-oauth",
+						DiscoveryURL: "https://idp.example.com/.well-known/openid-configuration",
+						ClientID:     "tools",
+						ClientSecretRef: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+							Key:                  "clientSecret",
+						},
+					}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, stack)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, stack)).To(Succeed()) })
+		})
+
+		It("should record the existing provider instead of failing the stack", func() {
+			api := simulator.New(simulator.Options{TransitionDelay: -1})
+			wrapper := bedrock.NewBedrockClientWrapper(api, logf.Log)
+
+			By("creating the provider in an earlier attempt whose status update was lost")
+			created, err := wrapper.CreateOauth2CredentialProvider(ctx, &bedrockagentcorecontrol.CreateOauth2CredentialProviderInput{
+				Name: aws.String("tools-oauth"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			reconciler := &AgentCoreStackReconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+			ready, err := reconciler.ensureCredentialProviders(ctx, stack, wrapper, logf.Log)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ready).To(BeTrue())
+
+			Expect(k8sClient.Get(ctx, key, stack)).To(Succeed())
+			Expect(stack.Status.CredentialProviders).To(ConsistOf(mcpgatewayv1alpha1.StackCredentialProviderStatus{
+				Name: "tools-oauth",
+				Arn:  aws.ToString(created.CredentialProviderArn),
+			}))
+		})
+	})
+
+	Context("When configuring the token vault", func() {
+		ctx := context.Background()
+
+		It("should reject credential providers in a vault other than the default one", func() {
+			stack := &mcpgatewayv1alpha1.AgentCoreStack{
+				Spec: mcpgatewayv1alpha1.AgentCoreStackSpec{
+					TokenVault: &mcpgatewayv1alpha1.TokenVaultSpec{Name: "team-vault"},
+					CredentialProviders: []mcpgatewayv1alpha1.StackCredentialProviderSpec{
+						{Name: "tools-oauth"},
+					},
+				},
+			}
+			Expect(stackTokenVault(stack)).To(Equal("team-vault"))
+
+			reconciler := &AgentCoreStackReconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+			err := reconciler.ensureTokenVault(ctx, stack, nil, logf.Log)
+
+			var failure *stackFailure
+			Expect(stderrors.As(err, &failure)).To(BeTrue())
+			Expect(failure.reason).To(Equal("UnsupportedTokenVault"))
+		})
+
+		It("should leave the vault alone without a KMS key", func() {
+			stack := &mcpgatewayv1alpha1.AgentCoreStack{
+				Spec: mcpgatewayv1alpha1.AgentCoreStackSpec{
+					TokenVault: &mcpgatewayv1alpha1.TokenVaultSpec{},
+					CredentialProviders: []mcpgatewayv1alpha1.StackCredentialProviderSpec{
+						{Name: "tools-oauth"},
+					},
+				},
+			}
+			Expect(stackTokenVault(stack)).To(Equal(defaultTokenVault))
+
+			reconciler := &AgentCoreStackReconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+			Expect(reconciler.ensureTokenVault(ctx, stack, nil, logf.Log)).To(Succeed())
+		})
+	})
+
+	Context("When merging gateway metadata defaults", func() {
+		It("should add the gateway defaults to the allowlists of every target", func() {
+			stack := &mcpgatewayv1alpha1.AgentCoreStack{
+				Spec: mcpgatewayv1alpha1.AgentCoreStackSpec{
+					Gateway: mcpgatewayv1alpha1.StackGatewaySpec{
+						MetadataDefaults: &mcpgatewayv1alpha1.MetadataAllowlists{
+							AllowedRequestHeaders: []string{"X-Trace-Id", "X-Tenant-Id"},
+						},
+					},
+				},
+			}
+			target := mcpgatewayv1alpha1.StackTargetSpec{
+				Metadata: &mcpgatewayv1alpha1.MetadataAllowlists{
+					AllowedRequestHeaders:  []string{"x-tenant-id", "X-User-Id"},
+					AllowedQueryParameters: []string{"version"},
+				},
+			}
+
+			metadata := stackTargetMetadata(stack, target)
+			Expect(metadata.AllowedRequestHeaders).To(Equal([]string{"X-Trace-Id", "X-Tenant-Id", "X-User-Id"}))
+			Expect(metadata.AllowedQueryParameters).To(Equal([]string{"version"}))
+			Expect(metadata.AllowedResponseHeaders).To(BeNil())
+		})
+
+		It("should leave the allowlists alone without defaults or target metadata", func() {
+			metadata := stackTargetMetadata(&mcpgatewayv1alpha1.AgentCoreStack{}, mcpgatewayv1alpha1.StackTargetSpec{})
+			Expect(metadata).To(Equal(mcpgatewayv1alpha1.MetadataAllowlists{}))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Applied spec", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	// readyHarness returns a harness whose MCPServer with OAuth scopes has a ready gateway target
+	readyHarness := func() *reconcileHarness {
+		h := newReconcileHarness(&mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       key.Name,
+				Namespace:  key.Namespace,
+				UID:        types.UID("5b8e2d4f-1c3a-4e6b-9d7f-2a4c6e8b0d13"),
+				Generation: 1,
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:         "https://weather.example.com/mcp",
+				Capabilities:     []string{"tools"},
+				AuthType:         "OAuth2",
+				OauthProviderArn: "arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/oauth2credentialprovider/weather",
+				OauthScopes:      []string{"read", "write"},
+			},
+		})
+		for range 3 {
+			_, err := h.reconcile(ctx, key)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		mcpServer := h.get(ctx, key)
+		Expect(mcpServer.Status.TargetStatus).To(Equal("READY"))
+		Expect(mcpServer.Status.AppliedSpecHash).NotTo(BeEmpty())
+		return h
+	}
+
+	// editSpec changes the spec of the stored MCPServer and bumps its generation
+	editSpec := func(h *reconcileHarness, edit func(spec *mcpgatewayv1alpha1.MCPServerSpec)) {
+		mcpServer := h.get(ctx, key)
+		edit(&mcpServer.Spec)
+		mcpServer.Generation++
+		Expect(h.client.Update(ctx, mcpServer)).To(Succeed())
+	}
+
+	It("should observe reordered scopes without updating the gateway target", func() {
+		h := readyHarness()
+		editSpec(h, func(spec *mcpgatewayv1alpha1.MCPServerSpec) {
+			spec.OauthScopes = []string{"write", " read"}
+		})
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("UpdateGatewayTarget")).To(BeZero())
+		mcpServer := h.get(ctx, key)
+		Expect(mcpServer.Status.ObservedGeneration).To(Equal(mcpServer.Generation))
+	})
+
+	It("should update the gateway target when the scopes change", func() {
+		h := readyHarness()
+		editSpec(h, func(spec *mcpgatewayv1alpha1.MCPServerSpec) {
+			spec.OauthScopes = []string{"read"}
+		})
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("UpdateGatewayTarget")).To(Equal(1))
+	})
+
+	It("should reject space-separated scopes without calling AWS", func() {
+		h := readyHarness()
+		editSpec(h, func(spec *mcpgatewayv1alpha1.MCPServerSpec) {
+			spec.OauthScopes = []string{"read write"}
+		})
+
+		_, _ = h.reconcile(ctx, key)
+		Expect(h.agentCore.callCount("UpdateGatewayTarget")).To(BeZero())
+		mcpServer := h.get(ctx, key)
+		Expect(mcpServer.Status.Conditions).To(ContainElement(And(
+			HaveField("Status", metav1.ConditionFalse),
+			HaveField("Message", ContainSubstring("list each of the 2 scopes as a separate entry")),
+		)))
+	})
+})
@@ -0,0 +1,81 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/autoscaling"
+)
+
+// +kubebuilder:rbac:groups=keda.sh,resources=scaledobjects,verbs=get;list;watch;create;update;delete
+
+// reconcileScaledObject keeps the KEDA ScaledObject of the MCPServer in line with spec.autoscaling.
+// The ScaledObject is owned by the MCPServer, so it is garbage collected with it, and is deleted
+// when autoscaling is removed from the spec. Failures are logged and never fail the reconcile,
+// since scaling the backend is independent of registering it with the gateway.
+func (r *MCPServerReconciler) reconcileScaledObject(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) {
+	if r.KEDAPrometheusAddress == "" {
+		return
+	}
+
+	scaledObject := autoscaling.NewScaledObject(mcpServer)
+
+	if !autoscaling.Enabled(mcpServer) {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(scaledObject), scaledObject); err != nil {
+			if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				log.Error(err, "Failed to get ScaledObject")
+			}
+			return
+		}
+		if !metav1.IsControlledBy(scaledObject, mcpServer) {
+			return
+		}
+		if err := r.Delete(ctx, scaledObject); client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to delete ScaledObject")
+			return
+		}
+		log.Info("Deleted ScaledObject after autoscaling was disabled")
+		return
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, scaledObject, func() error {
+		if err := controllerutil.SetControllerReference(mcpServer, scaledObject, r.Scheme); err != nil {
+			return err
+		}
+		return autoscaling.SetScaledObjectSpec(scaledObject, mcpServer, r.KEDAPrometheusAddress)
+	})
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			log.Info("KEDA is not installed in the cluster, skipping ScaledObject")
+			return
+		}
+		log.Error(err, "Failed to reconcile ScaledObject")
+		return
+	}
+	if result != controllerutil.OperationResultNone {
+		log.Info("Reconciled ScaledObject", "operation", result)
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch
+
+// backendUnavailableCondition is the condition reporting a gateway target held back or removed
+// because its workload is unavailable
+const backendUnavailableCondition = "BackendUnavailable"
+
+// Reasons of the BackendUnavailable condition
+const (
+	reasonWorkloadNotFound     = "WorkloadNotFound"
+	reasonWorkloadUnavailable  = "WorkloadUnavailable"
+	reasonWorkloadScaledToZero = "WorkloadScaledToZero"
+)
+
+// workloadState is the availability of the workload referenced by an MCPServer
+type workloadState struct {
+	found        bool
+	available    bool
+	scaledToZero bool
+}
+
+// readinessPolicy returns the readiness policy of the workload of the MCPServer
+func readinessPolicy(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	ref := mcpServer.Spec.EndpointRef
+	if ref == nil || ref.Readiness == "" {
+		return mcpgatewayv1alpha1.WorkloadReadinessNone
+	}
+	return ref.Readiness
+}
+
+// workloadKind returns the kind of the workload of the MCPServer
+func workloadKind(ref *mcpgatewayv1alpha1.WorkloadReference) string {
+	if ref.Kind == "" {
+		return "Deployment"
+	}
+	return ref.Kind
+}
+
+// getWorkload reads the workload referenced by the MCPServer from the label-restricted cache.
+// It returns nil if the workload does not exist or does not carry the WatchLabel.
+func (r *MCPServerReconciler) getWorkload(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) (client.Object, error) {
+	ref := mcpServer.Spec.EndpointRef
+	key := types.NamespacedName{Namespace: mcpServer.Namespace, Name: ref.Name}
+
+	var workload client.Object = &appsv1.Deployment{}
+	if workloadKind(ref) == "StatefulSet" {
+		workload = &appsv1.StatefulSet{}
+	}
+	if err := r.Get(ctx, key, workload); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return workload, nil
+}
+
+// getWorkloadState returns the availability of the workload referenced by the MCPServer
+func (r *MCPServerReconciler) getWorkloadState(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) (workloadState, error) {
+	workload, err := r.getWorkload(ctx, mcpServer)
+	if err != nil || workload == nil {
+		return workloadState{}, err
+	}
+
+	var desired *int32
+	var replicas, available int32
+	switch w := workload.(type) {
+	case *appsv1.StatefulSet:
+		desired, replicas, available = w.Spec.Replicas, w.Status.Replicas, w.Status.AvailableReplicas
+	case *appsv1.Deployment:
+		desired, replicas, available = w.Spec.Replicas, w.Status.Replicas, w.Status.AvailableReplicas
+	}
+
+	return workloadState{
+		found:     true,
+		available: available > 0,
+		// Wait for the last replica to be gone, so that in-flight requests can finish
+		scaledToZero: desired != nil && *desired == 0 && replicas == 0,
+	}, nil
+}
+
+// checkBackend gates the gateway target on the workload behind the endpoint according to
+// spec.endpointRef.readiness. Targets are only created once the workload has an available replica,
+// and with RemoveWhenScaledToZero deleted again while it is scaled to zero. Existing targets are
+// kept while a workload that still has replicas is briefly unavailable, e.g. during a rollout.
+// It reports true if the reconcile must stop; changes of the workload trigger a new reconcile.
+func (r *MCPServerReconciler) checkBackend(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) (bool, ctrl.Result, error) {
+	policy := readinessPolicy(mcpServer)
+	if policy == mcpgatewayv1alpha1.WorkloadReadinessNone {
+		return false, ctrl.Result{}, r.clearBackendUnavailable(ctx, mcpServer, "Workload readiness is not checked", log)
+	}
+
+	ref := mcpServer.Spec.EndpointRef
+	kind := workloadKind(ref)
+	state, err := r.getWorkloadState(ctx, mcpServer)
+	if err != nil {
+		return true, ctrl.Result{}, err
+	}
+
+	hasTarget := mcpServer.Status.TargetID != ""
+	switch {
+	case hasTarget && state.scaledToZero && policy == mcpgatewayv1alpha1.WorkloadReadinessRemoveWhenScaledToZero:
+		return true, ctrl.Result{}, r.removeTargetOfScaledDownWorkload(ctx, mcpServer, kind, log)
+	case hasTarget || state.available:
+		return false, ctrl.Result{}, r.clearBackendUnavailable(ctx, mcpServer,
+			fmt.Sprintf("%s %s is available", kind, ref.Name), log)
+	}
+
+	reason := reasonWorkloadUnavailable
+	message := fmt.Sprintf("%s %s has no available replicas, the gateway target is created once it has one", kind, ref.Name)
+	switch {
+	case !state.found:
+		reason = reasonWorkloadNotFound
+		message = fmt.Sprintf("%s %s not found; it must carry the %s=true label to be seen by the operator",
+			kind, ref.Name, WatchLabel)
+	case state.scaledToZero:
+		reason = reasonWorkloadScaledToZero
+		message = fmt.Sprintf("%s %s is scaled to zero, the gateway target is created once it has an available replica",
+			kind, ref.Name)
+	}
+	log.V(1).Info("Waiting for workload before creating gateway target", "kind", kind, "workload", ref.Name, "reason", reason)
+	return true, ctrl.Result{}, r.setBackendUnavailable(ctx, mcpServer, reason, message)
+}
+
+// removeTargetOfScaledDownWorkload deletes the gateway target of an MCPServer whose workload was
+// scaled to zero and clears it from the status, so that it is created again on scale up
+func (r *MCPServerReconciler) removeTargetOfScaledDownWorkload(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	kind string,
+	log logr.Logger,
+) error {
+	name := mcpServer.Spec.EndpointRef.Name
+	log.Info("Workload scaled to zero, removing gateway target", "kind", kind, "workload", name)
+	if err := r.deleteGatewayTarget(ctx, mcpServer, log); err != nil {
+		return err
+	}
+	if err := r.StatusManager.UpdateTargetRemoved(ctx, mcpServer); err != nil {
+		return err
+	}
+	return r.setBackendUnavailable(ctx, mcpServer, reasonWorkloadScaledToZero,
+		fmt.Sprintf("%s %s is scaled to zero, the gateway target was removed and is created again once it has an available replica",
+			kind, name))
+}
+
+// setBackendUnavailable reports a gateway target held back by its workload in the
+// BackendUnavailable and Ready conditions
+func (r *MCPServerReconciler) setBackendUnavailable(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, reason, message string) error {
+	if err := r.StatusManager.SetBackendUnavailable(ctx, mcpServer, true, reason, message); err != nil {
+		return err
+	}
+	return r.StatusManager.SetError(ctx, mcpServer, reason, message)
+}
+
+// clearBackendUnavailable clears the BackendUnavailable condition if it is set
+func (r *MCPServerReconciler) clearBackendUnavailable(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	message string,
+	log logr.Logger,
+) error {
+	if !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, backendUnavailableCondition) {
+		return nil
+	}
+	log.Info("Workload available, no longer holding back the gateway target")
+	return r.StatusManager.SetBackendUnavailable(ctx, mcpServer, false, "", message)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Backend readiness", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "test-backend", Namespace: "default"}
+
+	var reconciler *MCPServerReconciler
+
+	BeforeEach(func() {
+		reconciler = &MCPServerReconciler{
+			Client:        k8sClient,
+			Scheme:        k8sClient.Scheme(),
+			StatusManager: status.NewManager(k8sClient),
+		}
+
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+				EndpointRef: &mcpgatewayv1alpha1.WorkloadReference{
+					Name:      "test-backend",
+					Readiness: mcpgatewayv1alpha1.WorkloadReadinessWaitForAvailable,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, mcpServer)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, mcpServer)).To(Succeed())
+
+		deployment := &appsv1.Deployment{}
+		if err := k8sClient.Get(ctx, key, deployment); err == nil {
+			Expect(k8sClient.Delete(ctx, deployment)).To(Succeed())
+		}
+	})
+
+	createDeployment := func(availableReplicas int32) {
+		labels := map[string]string{"app": "test-backend"}
+		replicas := int32(1)
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "server", Image: "mcp-server"}},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+		deployment.Status.Replicas = 1
+		deployment.Status.AvailableReplicas = availableReplicas
+		Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
+	}
+
+	It("should hold back the gateway target until the workload is available", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+
+		By("reporting a missing workload")
+		gated, _, err := reconciler.checkBackend(ctx, mcpServer, logf.Log)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gated).To(BeTrue())
+		condition := meta.FindStatusCondition(mcpServer.Status.Conditions, backendUnavailableCondition)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(reasonWorkloadNotFound))
+		Expect(meta.IsStatusConditionFalse(mcpServer.Status.Conditions, "Ready")).To(BeTrue())
+
+		By("waiting while the workload has no available replica")
+		createDeployment(0)
+		gated, _, err = reconciler.checkBackend(ctx, mcpServer, logf.Log)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gated).To(BeTrue())
+		condition = meta.FindStatusCondition(mcpServer.Status.Conditions, backendUnavailableCondition)
+		Expect(condition.Reason).To(Equal(reasonWorkloadUnavailable))
+
+		By("admitting the target once a replica is available")
+		deployment := &appsv1.Deployment{}
+		Expect(k8sClient.Get(ctx, key, deployment)).To(Succeed())
+		deployment.Status.AvailableReplicas = 1
+		Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
+
+		gated, _, err = reconciler.checkBackend(ctx, mcpServer, logf.Log)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gated).To(BeFalse())
+		Expect(meta.IsStatusConditionFalse(mcpServer.Status.Conditions, backendUnavailableCondition)).To(BeTrue())
+	})
+
+	It("should keep an existing target while the workload is briefly unavailable", func() {
+		createDeployment(0)
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+		mcpServer.Status.TargetID = "target-123"
+
+		gated, _, err := reconciler.checkBackend(ctx, mcpServer, logf.Log)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gated).To(BeFalse())
+	})
+
+	It("should not gate MCPServers without a readiness policy", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+		mcpServer.Spec.EndpointRef.Readiness = mcpgatewayv1alpha1.WorkloadReadinessNone
+
+		gated, _, err := reconciler.checkBackend(ctx, mcpServer, logf.Log)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gated).To(BeFalse())
+	})
+})
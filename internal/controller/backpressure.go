@@ -0,0 +1,39 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// syncInterval returns the interval at which ready MCPServers are compared with their gateway
+// target, stretched while AWS throttles the operator. It is zero if drift detection is disabled.
+func (r *MCPServerReconciler) syncInterval() time.Duration {
+	return r.Backpressure.Stretch(r.DriftCheckInterval)
+}
+
+// applyBackpressure stretches the requeue of a reconcile by the backpressure factor, so that
+// every MCPServer backs off while AWS throttles the operator. Immediate requeues and the
+// controller's error backoff are left as they are.
+func (r *MCPServerReconciler) applyBackpressure(result ctrl.Result) ctrl.Result {
+	if result.RequeueAfter > 0 {
+		result.RequeueAfter = r.Backpressure.Stretch(result.RequeueAfter)
+	}
+	return result
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// throttledConditionType reports that an MCPServer exhausted its AWS call budget
+const throttledConditionType = "Throttled"
+
+// Fair share partitions, i.e. what makes up a tenant of the shared AWS call capacity
+const (
+	// FairSharePartitionNamespace makes every namespace a tenant
+	FairSharePartitionNamespace = "namespace"
+	// FairSharePartitionGateway makes every gateway a tenant
+	FairSharePartitionGateway = "gateway"
+)
+
+// newBedrockWrapper creates the AWS client wrapper for a reconcile, charging calls to the fair
+// share of the tenant and the call budget of the resource attributed in the context and auditing
+// mutating calls
+func (r *MCPServerReconciler) newBedrockWrapper(log logr.Logger) *bedrock.BedrockClientWrapper {
+	opts := []bedrock.Option{bedrock.WithCallBudget(r.CallBudget), bedrock.WithFairShare(r.FairShare),
+		bedrock.WithAuditLogger(r.AuditLogger), bedrock.WithGatewayCache(r.GatewayCache),
+		bedrock.WithBackpressure(r.Backpressure), bedrock.WithRateLimiter(r.RateLimiter),
+		bedrock.WithCircuitBreaker(r.CircuitBreaker)}
+	if r.AWSCallTimeout > 0 {
+		opts = append(opts, bedrock.WithCallTimeout(r.AWSCallTimeout))
+	}
+	return bedrock.NewBedrockClientWrapper(r.BedrockClient, log, opts...)
+}
+
+// tenant returns the tenant the AWS calls of the MCPServer are charged to in the fair share.
+// MCPServers whose gateway is not known yet are charged to their namespace. Namespaces of spoke
+// clusters are separate tenants from the namespaces of the same name in the hub cluster.
+func (r *MCPServerReconciler) tenant(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	if r.FairSharePartition == FairSharePartitionGateway {
+		if mcpServer.Status.GatewayID != "" {
+			return "gateway/" + mcpServer.Status.GatewayID
+		}
+		if gatewayID, err := r.ConfigParser.GetGatewayID(mcpServer); err == nil {
+			return "gateway/" + gatewayID
+		}
+	}
+	if r.ClusterName != "" {
+		return "namespace/" + r.ClusterName + "/" + mcpServer.Namespace
+	}
+	return "namespace/" + mcpServer.Namespace
+}
+
+// budgetExceeded returns the error describing why the MCPServer may not call AWS at the moment,
+// or nil if both its call budget and the fair share of its tenant have calls left
+func (r *MCPServerReconciler) budgetExceeded(mcpServer *mcpgatewayv1alpha1.MCPServer) *bedrock.BudgetExceededError {
+	if r.CallBudget != nil {
+		resource := bedrock.ResourceKey(mcpServer.Namespace, mcpServer.Name)
+		if retryAfter := r.CallBudget.RetryAfter(resource); retryAfter > 0 {
+			return &bedrock.BudgetExceededError{Resource: resource, Limit: r.CallBudget.Limit(),
+				Window: r.CallBudget.Window(), RetryAfter: retryAfter}
+		}
+	}
+	if r.FairShare != nil {
+		tenant := r.tenant(mcpServer)
+		if retryAfter := r.FairShare.RetryAfter(tenant); retryAfter > 0 {
+			return &bedrock.BudgetExceededError{Tenant: tenant, Limit: r.FairShare.Share(tenant),
+				Window: r.FairShare.Window(), RetryAfter: retryAfter}
+		}
+	}
+	return nil
+}
+
+// checkCallBudget puts an MCPServer that exhausted its AWS call budget into an extended backoff
+// with the Throttled condition before any AWS call is attempted, and clears the condition once
+// budget is available again. It reports whether the reconcile should stop with the given result.
+func (r *MCPServerReconciler) checkCallBudget(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) (bool, ctrl.Result, error) {
+	if r.CallBudget == nil && r.FairShare == nil {
+		return false, ctrl.Result{}, nil
+	}
+
+	if budgetErr := r.budgetExceeded(mcpServer); budgetErr != nil {
+		result, err := r.setThrottled(ctx, mcpServer, budgetErr, log)
+		return true, result, err
+	}
+
+	if meta.IsStatusConditionTrue(mcpServer.Status.Conditions, throttledConditionType) {
+		if err := r.StatusManager.SetThrottled(ctx, mcpServer, false, "AWS call budget available"); err != nil {
+			if apierrors.IsConflict(err) {
+				log.V(1).Info("Conflict clearing Throttled condition, will retry")
+				return true, ctrl.Result{Requeue: true}, nil
+			}
+			return true, ctrl.Result{}, err
+		}
+	}
+	return false, ctrl.Result{}, nil
+}
+
+// handleBudgetExceeded turns a reconcile that ran out of AWS call budget midway into an
+// extended backoff with the Throttled condition instead of an error retried at the
+// controller's rate
+func (r *MCPServerReconciler) handleBudgetExceeded(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	result ctrl.Result,
+	err error,
+	log logr.Logger,
+) (ctrl.Result, error) {
+	var budgetErr *bedrock.BudgetExceededError
+	if mcpServer == nil || !errors.As(err, &budgetErr) {
+		return result, err
+	}
+	return r.setThrottled(ctx, mcpServer, budgetErr, log)
+}
+
+// handleAWSThrottling requeues a reconcile that AWS throttled after the wait AWS advised,
+// instead of returning the error to be retried at the controller's backoff
+func handleAWSThrottling(result ctrl.Result, err error, log logr.Logger) (ctrl.Result, error) {
+	var throttledErr *bedrock.ThrottledError
+	if !errors.As(err, &throttledErr) {
+		return result, err
+	}
+	log.Info("AWS throttled the reconcile, requeueing as advised", "operation", throttledErr.Operation,
+		"retryAfter", throttledErr.RetryAfter)
+	return ctrl.Result{RequeueAfter: throttledErr.RetryAfter}, nil
+}
+
+// setThrottled sets the Throttled condition and requeues the MCPServer once budget is available
+func (r *MCPServerReconciler) setThrottled(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	budgetErr *bedrock.BudgetExceededError,
+	log logr.Logger,
+) (ctrl.Result, error) {
+	retryAfter := budgetErr.RetryAfter
+	message := fmt.Sprintf("AWS call budget of %d calls per %s exhausted, retrying in %s",
+		budgetErr.Limit, budgetErr.Window, retryAfter.Round(time.Second))
+	if budgetErr.Tenant != "" {
+		message = fmt.Sprintf("Fair share of %d AWS calls per %s for %s exhausted, retrying in %s",
+			budgetErr.Limit, budgetErr.Window, budgetErr.Tenant, retryAfter.Round(time.Second))
+		log.Info("Tenant of MCPServer exhausted its fair share of AWS calls", "tenant", budgetErr.Tenant,
+			"retryAfter", retryAfter)
+	} else {
+		log.Info("MCPServer exhausted its AWS call budget", "retryAfter", retryAfter)
+	}
+
+	if !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, throttledConditionType) {
+		if err := r.StatusManager.SetThrottled(ctx, mcpServer, true, message); err != nil {
+			// The condition is set again on the next reconcile
+			log.V(1).Info("Failed to set Throttled condition", "error", err.Error())
+		}
+	}
+	return ctrl.Result{RequeueAfter: retryAfter}, nil
+}
+
+// forgetCallBudget drops the call history of a deleted MCPServer
+func (r *MCPServerReconciler) forgetCallBudget(namespace, name string) {
+	if r.CallBudget != nil {
+		r.CallBudget.Forget(bedrock.ResourceKey(namespace, name))
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+)
+
+var _ = Describe("AWS call fair share", func() {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "team-a"},
+		Spec:       mcpgatewayv1alpha1.MCPServerSpec{GatewayID: "gw-abcdef1234"},
+	}
+
+	It("should partition tenants by namespace or gateway", func() {
+		reconciler := &MCPServerReconciler{ConfigParser: config.NewConfigParser("")}
+		Expect(reconciler.tenant(mcpServer)).To(Equal("namespace/team-a"))
+
+		reconciler.ClusterName = "spoke-1"
+		Expect(reconciler.tenant(mcpServer)).To(Equal("namespace/spoke-1/team-a"))
+
+		reconciler.FairSharePartition = FairSharePartitionGateway
+		Expect(reconciler.tenant(mcpServer)).To(Equal("gateway/gw-abcdef1234"))
+	})
+
+	It("should report a tenant that used up its share", func() {
+		reconciler := &MCPServerReconciler{FairShare: bedrock.NewFairShare(1, time.Hour)}
+		Expect(reconciler.budgetExceeded(mcpServer)).To(BeNil())
+
+		Expect(reconciler.FairShare.Spend("namespace/team-a")).To(Succeed())
+		budgetErr := reconciler.budgetExceeded(mcpServer)
+		Expect(budgetErr).NotTo(BeNil())
+		Expect(budgetErr.Tenant).To(Equal("namespace/team-a"))
+		Expect(budgetErr.RetryAfter).To(BeNumerically(">", 0))
+	})
+})
+
+var _ = Describe("AWS throttling", func() {
+	It("should requeue after the wait AWS advised", func() {
+		throttledErr := &bedrock.ThrottledError{
+			Operation:  "UpdateGatewayTarget",
+			RetryAfter: 40 * time.Second,
+			Err:        errors.New("ThrottlingException"),
+		}
+		result, err := handleAWSThrottling(ctrl.Result{}, fmt.Errorf("failed to update gateway target: %w", throttledErr), logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(40 * time.Second))
+	})
+
+	It("should leave other errors to the controller's backoff", func() {
+		otherErr := errors.New("boom")
+		result, err := handleAWSThrottling(ctrl.Result{}, otherErr, logr.Discard())
+		Expect(err).To(MatchError(otherErr))
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+})
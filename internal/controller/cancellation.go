@@ -0,0 +1,49 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// canceledRequeueDelay is how long a reconcile abandoned because its context ended waits before
+// it is picked up again
+const canceledRequeueDelay = 5 * time.Second
+
+// handleCanceled turns the error of a reconcile abandoned because its context ended, e.g. when the
+// operator shuts down or the reconcile ran past its deadline, into a plain requeue. Such errors say
+// nothing about AWS or the MCPServer, so they must neither grow the error backoff of the resource
+// nor be recorded as a failed sync. Timeouts of single AWS calls are retryable errors instead and
+// are left alone.
+func handleCanceled(ctx context.Context, result ctrl.Result, err error, log logr.Logger) (ctrl.Result, error) {
+	if !isCanceled(ctx, err) {
+		return result, err
+	}
+	log.Info("Reconcile abandoned, requeueing", "reason", err.Error())
+	return ctrl.Result{RequeueAfter: canceledRequeueDelay}, nil
+}
+
+// isCanceled reports whether err was caused by the end of the reconcile context ctx
+func isCanceled(ctx context.Context, err error) bool {
+	return ctx.Err() != nil && bedrock.IsCanceledError(err)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Reconcile cancellation", func() {
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	It("should requeue a canceled reconcile without recording a failure", func() {
+		h := newReconcileHarness(&mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       key.Name,
+				Namespace:  key.Namespace,
+				UID:        types.UID("2f6a8c1e-5d3b-4a7f-9e2c-8b1d4f6a3c5e"),
+				Generation: 1,
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://weather.example.com/mcp",
+				Capabilities: []string{"tools"},
+			},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		h.at(phaseFetched, func(context.Context, *mcpgatewayv1alpha1.MCPServer) error {
+			cancel()
+			return nil
+		})
+		result, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(canceledRequeueDelay))
+
+		mcpServer := h.get(context.Background(), key)
+		Expect(mcpServer.Status.LastSyncOutcome).To(BeEmpty())
+		Expect(meta.FindStatusCondition(mcpServer.Status.Conditions, "Ready")).To(BeNil())
+	})
+})
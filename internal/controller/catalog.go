@@ -0,0 +1,88 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/catalog"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+)
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=delete
+
+// reconcileCatalog keeps the Backstage catalog ConfigMap of the MCPServer in line with its spec and
+// gateway target. The ConfigMap is owned by the MCPServer, so it is garbage collected with it, and
+// is deleted when catalog output is disabled. Failures are logged and never fail the reconcile.
+func (r *MCPServerReconciler) reconcileCatalog(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) {
+	configMap := catalog.NewConfigMap(mcpServer)
+
+	if !r.CatalogConfigMaps {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+			if !apierrors.IsNotFound(err) {
+				log.Error(err, "Failed to get catalog ConfigMap")
+			}
+			return
+		}
+		if !metav1.IsControlledBy(configMap, mcpServer) {
+			return
+		}
+		if err := r.Delete(ctx, configMap); client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to delete catalog ConfigMap")
+			return
+		}
+		log.Info("Deleted catalog ConfigMap after catalog output was disabled")
+		return
+	}
+
+	target := r.catalogTarget(mcpServer)
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, configMap, func() error {
+		if err := controllerutil.SetControllerReference(mcpServer, configMap, r.Scheme); err != nil {
+			return err
+		}
+		return catalog.SetConfigMapData(configMap, mcpServer, target, WatchLabel)
+	})
+	if err != nil {
+		log.Error(err, "Failed to reconcile catalog ConfigMap")
+		return
+	}
+	if result != controllerutil.OperationResultNone {
+		log.Info("Reconciled catalog ConfigMap", "operation", result)
+	}
+}
+
+// catalogTarget describes the gateway target of the MCPServer for its catalog entity
+func (r *MCPServerReconciler) catalogTarget(mcpServer *mcpgatewayv1alpha1.MCPServer) catalog.Target {
+	gatewayID := mcpServer.Status.GatewayID
+	if gatewayID == "" {
+		// The gateway is not known yet if the spec is invalid; the entity is updated once it is
+		gatewayID, _ = r.ConfigParser.GetGatewayID(mcpServer)
+	}
+	return catalog.Target{
+		Name:       r.targetName(mcpServer),
+		ID:         mcpServer.Status.TargetID,
+		GatewayID:  gatewayID,
+		GatewayURL: config.GatewayURL(gatewayID, r.ConfigParser.Region()),
+	}
+}
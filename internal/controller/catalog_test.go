@@ -0,0 +1,94 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/catalog"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+)
+
+var _ = Describe("Catalog ConfigMap", func() {
+	ctx := context.Background()
+	configMapKey := types.NamespacedName{Name: "test-catalog-catalog", Namespace: "default"}
+
+	var mcpServer *mcpgatewayv1alpha1.MCPServer
+
+	BeforeEach(func() {
+		mcpServer = &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-catalog",
+				Namespace:   "default",
+				Annotations: map[string]string{catalog.OwnerAnnotation: "team-a"},
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+				GatewayID:    "gw-abcdef1234",
+			},
+		}
+		Expect(k8sClient.Create(ctx, mcpServer)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, mcpServer)).To(Succeed())
+		configMap := &corev1.ConfigMap{}
+		if err := k8sClient.Get(ctx, configMapKey, configMap); err == nil {
+			Expect(k8sClient.Delete(ctx, configMap)).To(Succeed())
+		}
+	})
+
+	It("should publish the catalog entity and delete it when disabled", func() {
+		parser := config.NewConfigParser("")
+		parser.SetRegion("us-east-1")
+		reconciler := &MCPServerReconciler{
+			Client:            k8sClient,
+			Scheme:            k8sClient.Scheme(),
+			ConfigParser:      parser,
+			CatalogConfigMaps: true,
+		}
+
+		By("enabling catalog output")
+		reconciler.reconcileCatalog(ctx, mcpServer, logf.Log)
+		configMap := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(ctx, configMapKey, configMap)).To(Succeed())
+		Expect(metav1.IsControlledBy(configMap, mcpServer)).To(BeTrue())
+		Expect(configMap.Labels).To(HaveKeyWithValue(catalog.ConfigMapLabel, "true"))
+		Expect(configMap.Labels).To(HaveKeyWithValue(WatchLabel, "true"))
+		entity := configMap.Data[catalog.EntityKey]
+		Expect(entity).To(ContainSubstring("owner: team-a"))
+		Expect(entity).To(ContainSubstring(
+			"https://gw-abcdef1234.gateway.bedrock-agentcore.us-east-1.amazonaws.com/mcp"))
+		Expect(entity).To(ContainSubstring("test-catalog___"))
+
+		By("disabling catalog output")
+		reconciler.CatalogConfigMaps = false
+		reconciler.reconcileCatalog(ctx, mcpServer, logf.Log)
+		err := k8sClient.Get(ctx, configMapKey, &corev1.ConfigMap{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// degradedConditionType reports that the AWS calls of an MCPServer are failed right away by the
+// open circuit breaker
+const degradedConditionType = "Degraded"
+
+// circuitBreaker returns the circuit breaker of the AWSProviderConfig applied to ctx, or the
+// circuit breaker of the operator's own client
+func (r *MCPServerReconciler) circuitBreaker(ctx context.Context) *bedrock.CircuitBreaker {
+	return bedrock.ContextCircuitBreaker(ctx, r.CircuitBreaker)
+}
+
+// checkCircuitBreaker puts an MCPServer into the Degraded condition and requeues it for the next
+// probe before any AWS call is attempted while the circuit breaker is open. It reports whether
+// the reconcile should stop with the given result.
+func (r *MCPServerReconciler) checkCircuitBreaker(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) (bool, ctrl.Result, error) {
+	var openErr *bedrock.CircuitOpenError
+	if !errors.As(r.circuitBreaker(ctx).Check(), &openErr) {
+		return false, ctrl.Result{}, nil
+	}
+	return true, r.setDegraded(ctx, mcpServer, openErr, log), nil
+}
+
+// handleCircuitBreaker turns a reconcile failed by the open circuit breaker midway into the
+// Degraded condition and a requeue at the next probe instead of an error retried at the
+// controller's rate. After any other outcome, the Degraded condition is cleared once the circuit
+// breaker has closed again.
+func (r *MCPServerReconciler) handleCircuitBreaker(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	result ctrl.Result,
+	err error,
+	log logr.Logger,
+) (ctrl.Result, error) {
+	if mcpServer == nil {
+		return result, err
+	}
+	var openErr *bedrock.CircuitOpenError
+	if errors.As(err, &openErr) {
+		return r.setDegraded(ctx, mcpServer, openErr, log), nil
+	}
+
+	if meta.IsStatusConditionTrue(mcpServer.Status.Conditions, degradedConditionType) && !r.circuitBreaker(ctx).Open() {
+		r.clearDegraded(ctx, mcpServer, log)
+	}
+	return result, err
+}
+
+// clearDegraded clears the Degraded condition. The status may have been written during the
+// reconcile, so the condition is cleared on the latest version of the MCPServer.
+func (r *MCPServerReconciler) clearDegraded(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) {
+	latest := &mcpgatewayv1alpha1.MCPServer{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(mcpServer), latest); err != nil {
+		return
+	}
+	if err := r.StatusManager.SetDegraded(ctx, latest, false, "AWS calls succeed again"); err != nil &&
+		!apierrors.IsNotFound(err) {
+		// The condition is cleared on the next reconcile
+		log.V(1).Info("Failed to clear Degraded condition", "error", err.Error())
+	}
+}
+
+// setDegraded sets the Degraded condition and requeues the MCPServer once the circuit breaker
+// lets a probe through
+func (r *MCPServerReconciler) setDegraded(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	openErr *bedrock.CircuitOpenError,
+	log logr.Logger,
+) ctrl.Result {
+	log.Info("AWS calls are failing, backing off until the circuit breaker probes AWS again",
+		"failures", openErr.Failures, "retryAfter", openErr.RetryAfter)
+	if err := r.StatusManager.SetDegraded(ctx, mcpServer, true, openErr.Error()); err != nil && !apierrors.IsNotFound(err) {
+		// The condition is set again on the next reconcile
+		log.V(1).Info("Failed to set Degraded condition", "error", err.Error())
+	}
+	return ctrl.Result{RequeueAfter: openErr.RetryAfter}
+}
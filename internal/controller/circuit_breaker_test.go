@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aws/mcp-gateway-operator/internal/testutil"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+var _ = Describe("Circuit breaker", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	It("should fail reconciles fast with the Degraded condition until a probe succeeds", func() {
+		h := newReconcileHarness(testutil.NewMCPServer(key.Name))
+		breaker := bedrock.NewCircuitBreaker(1, 100*time.Millisecond)
+		h.reconciler.CircuitBreaker = breaker
+		Expect(breaker.Allow("GetGateway")).To(Succeed())
+		breaker.Record(&smithy.GenericAPIError{Code: "ServiceUnavailableException"})
+
+		result, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(result.RequeueAfter).To(BeNumerically("<=", 100*time.Millisecond))
+		Expect(h.agentCore.callCount("CreateGatewayTarget")).To(BeZero())
+		condition := meta.FindStatusCondition(h.get(ctx, key).Status.Conditions, degradedConditionType)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("CircuitOpen"))
+		Expect(condition.Message).To(ContainSubstring("ServiceUnavailableException"))
+
+		By("probing AWS once the cooldown has passed")
+		Eventually(breaker.Check).Should(Succeed())
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(breaker.Open()).To(BeFalse())
+		Expect(h.get(ctx, key).Status.TargetID).NotTo(BeEmpty())
+		condition = meta.FindStatusCondition(h.get(ctx, key).Status.Conditions, degradedConditionType)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	})
+})
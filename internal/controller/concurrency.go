@@ -0,0 +1,103 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// overwriteTargetAnnotation tells the operator to overwrite a gateway target that was modified
+// outside of the operator. It is removed once the overwrite succeeded.
+const overwriteTargetAnnotation = "mcpgateway.bedrock.aws/overwrite-target"
+
+// concurrentModificationCondition is the condition reporting out-of-band target modifications
+const concurrentModificationCondition = "ConcurrentModification"
+
+// checkConcurrentModification compares the modification time of the current gateway target with
+// the one recorded in status.targetUpdatedAt. The gateway target API has no update token, so this
+// is the closest the operator gets to a conditional update: if another writer changed the target
+// since the operator last observed it, the update is refused and the ConcurrentModification
+// condition is set instead of silently reverting the other writer's change.
+func (r *MCPServerReconciler) checkConcurrentModification(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	current *bedrockagentcorecontrol.GetGatewayTargetOutput,
+	log logr.Logger,
+) (bool, error) {
+	recorded := mcpServer.Status.TargetUpdatedAt
+	if recorded == nil {
+		// Targets observed before the modification time was recorded cannot be checked
+		return false, nil
+	}
+	if r.DriftPolicy == DriftPolicyCorrect {
+		// The operator owns the target outright and reverts changes made outside of it
+		return false, nil
+	}
+	if mcpServer.Annotations[overwriteTargetAnnotation] == "true" {
+		log.Info("Overwriting gateway target regardless of concurrent modifications",
+			"targetId", mcpServer.Status.TargetID)
+		return false, nil
+	}
+
+	if current.UpdatedAt == nil || current.UpdatedAt.Truncate(time.Second).Equal(recorded.Time) {
+		return false, nil
+	}
+
+	message := fmt.Sprintf("Gateway target %s was modified at %s, after the operator last observed it at %s; "+
+		"reconcile the change into the MCPServer spec or set the %s=true annotation to overwrite it",
+		mcpServer.Status.TargetID, current.UpdatedAt.UTC().Format(time.RFC3339), recorded.UTC().Format(time.RFC3339),
+		overwriteTargetAnnotation)
+	log.Info("Gateway target was modified concurrently, refusing to update", "targetId", mcpServer.Status.TargetID,
+		"updatedAt", current.UpdatedAt, "observedUpdatedAt", recorded.Time)
+	if err := r.StatusManager.SetConcurrentModification(ctx, mcpServer, true, message); err != nil {
+		log.Error(err, "Failed to set concurrent modification condition")
+		return true, err
+	}
+	return true, nil
+}
+
+// clearConcurrentModification resets the ConcurrentModification condition and removes the
+// overwriteTargetAnnotation after the operator successfully wrote the gateway target
+func (r *MCPServerReconciler) clearConcurrentModification(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) {
+	if meta.IsStatusConditionTrue(mcpServer.Status.Conditions, concurrentModificationCondition) {
+		if err := r.StatusManager.SetConcurrentModification(ctx, mcpServer, false,
+			"Gateway target matches the MCPServer spec"); err != nil {
+			log.Error(err, "Failed to clear concurrent modification condition")
+		}
+	}
+
+	if _, ok := mcpServer.Annotations[overwriteTargetAnnotation]; ok {
+		patch := client.MergeFrom(mcpServer.DeepCopy())
+		delete(mcpServer.Annotations, overwriteTargetAnnotation)
+		if err := r.Patch(ctx, mcpServer, patch); err != nil {
+			log.Error(err, "Failed to remove overwrite annotation")
+		}
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Concurrent modification detection", func() {
+	const resourceName = "test-concurrency"
+
+	ctx := context.Background()
+
+	typeNamespacedName := types.NamespacedName{
+		Name:      resourceName,
+		Namespace: "default",
+	}
+
+	BeforeEach(func() {
+		resource := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        resourceName,
+				Namespace:   "default",
+				Annotations: map[string]string{overwriteTargetAnnotation: "true"},
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		resource := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+	})
+
+	It("should not check targets without a recorded modification time or with the overwrite annotation", func() {
+		reconciler := &MCPServerReconciler{Client: k8sClient, StatusManager: status.NewManager(k8sClient)}
+
+		resource := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+		// Neither check may look at the current target, which is why none is passed
+		modified, err := reconciler.checkConcurrentModification(ctx, resource, nil, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeFalse())
+
+		now := metav1.Now()
+		resource.Status.TargetUpdatedAt = &now
+		modified, err = reconciler.checkConcurrentModification(ctx, resource, nil, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeFalse())
+	})
+
+	It("should clear the condition and the overwrite annotation after a successful update", func() {
+		reconciler := &MCPServerReconciler{Client: k8sClient, StatusManager: status.NewManager(k8sClient)}
+
+		resource := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+		Expect(reconciler.StatusManager.SetConcurrentModification(ctx, resource, true, "modified")).To(Succeed())
+
+		reconciler.clearConcurrentModification(ctx, resource, logr.Discard())
+
+		Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+		Expect(resource.Annotations).NotTo(HaveKey(overwriteTargetAnnotation))
+		Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, concurrentModificationCondition)).To(BeTrue())
+	})
+})
@@ -0,0 +1,153 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// Policies for gateway targets that drifted from their MCPServer, e.g. after an edit in the AWS
+// console
+const (
+	// DriftPolicyReport sets the ConfigDrift condition and leaves the target as it is
+	DriftPolicyReport = "report"
+	// DriftPolicyCorrect additionally updates the target to match the spec again. Since the
+	// operator then owns the target outright, spec updates also overwrite changes made outside of
+	// the operator instead of refusing them as concurrent modifications.
+	DriftPolicyCorrect = "correct"
+)
+
+// configDriftCondition is the condition reporting gateway targets that differ from the spec
+const configDriftCondition = "ConfigDrift"
+
+// driftCheckTracker records when the gateway target of each MCPServer was last compared with its
+// spec, so that targets are fetched at most once per check interval
+type driftCheckTracker struct {
+	mu   sync.Mutex
+	last map[types.NamespacedName]time.Time
+}
+
+// due reports whether the target of the MCPServer is to be compared again, and records the check
+// if it is
+func (t *driftCheckTracker) due(key types.NamespacedName, interval time.Duration, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.last == nil {
+		t.last = make(map[types.NamespacedName]time.Time)
+	}
+	if last, ok := t.last[key]; ok && now.Sub(last) < interval {
+		return false
+	}
+	t.last[key] = now
+	return true
+}
+
+// forget makes the next check of the MCPServer due right away
+func (t *driftCheckTracker) forget(key types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.last, key)
+}
+
+// checkConfigDrift compares the ready gateway target with the spec once per DriftCheckInterval.
+// Edits made to the target outside of the operator do not change the generation of the MCPServer,
+// so they are not noticed otherwise. Drift sets the ConfigDrift condition; it reports true if the
+// target is to be updated because the DriftPolicy is DriftPolicyCorrect. Failures to fetch the
+// target are logged and never fail the reconcile.
+func (r *MCPServerReconciler) checkConfigDrift(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) (bool, error) {
+	key := client.ObjectKeyFromObject(mcpServer)
+	if r.DriftCheckInterval <= 0 || !r.driftChecks.due(key, r.syncInterval(), time.Now()) {
+		return false, nil
+	}
+
+	gatewayID, err := r.ConfigParser.GetGatewayID(mcpServer)
+	if err != nil {
+		return false, nil
+	}
+	current, err := r.newBedrockWrapper(log).GetGatewayTarget(ctx, gatewayID, mcpServer.Status.TargetID)
+	if err != nil {
+		log.V(1).Info("Unable to fetch gateway target for drift detection", "targetId", mcpServer.Status.TargetID,
+			"error", err.Error())
+		return false, nil
+	}
+
+	drift := r.TargetConfigBuilder.TargetDrift(mcpServer, r.targetName(mcpServer), current)
+	if len(drift) == 0 {
+		if !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, configDriftCondition) {
+			return false, nil
+		}
+		return false, r.StatusManager.SetConfigDrift(ctx, mcpServer, false, "Gateway target matches the MCPServer spec")
+	}
+
+	targetDriftDetected.WithLabelValues(mcpServer.Namespace, mcpServer.Name).Inc()
+	log.Info("Gateway target differs from the spec", "targetId", mcpServer.Status.TargetID, "drift", drift,
+		"policy", r.DriftPolicy)
+	if err := r.StatusManager.SetConfigDrift(ctx, mcpServer, true, strings.Join(drift, "; ")); err != nil {
+		return false, err
+	}
+	if r.DriftPolicy != DriftPolicyCorrect {
+		return false, nil
+	}
+
+	r.recordEvent(mcpServer, corev1.EventTypeNormal, "DriftCorrected", "UpdateGatewayTarget",
+		"Updating gateway target "+mcpServer.Status.TargetID+" to match the MCPServer spec: "+strings.Join(drift, "; "))
+	return true, nil
+}
+
+// requeueForDriftCheck shortens the requeue of a ready MCPServer to the drift check interval, so
+// that its target is compared again even if nothing else happens
+func (r *MCPServerReconciler) requeueForDriftCheck(result ctrl.Result) ctrl.Result {
+	if r.DriftCheckInterval <= 0 || result.Requeue {
+		return result
+	}
+	if result.RequeueAfter == 0 || result.RequeueAfter > r.DriftCheckInterval {
+		result.RequeueAfter = r.DriftCheckInterval
+	}
+	return result
+}
+
+// clearConfigDrift resets the ConfigDrift condition after the operator successfully wrote the
+// gateway target, which then matches the spec
+func (r *MCPServerReconciler) clearConfigDrift(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) {
+	if !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, configDriftCondition) {
+		return
+	}
+	if err := r.StatusManager.SetConfigDrift(ctx, mcpServer, false, "Gateway target matches the MCPServer spec"); err != nil {
+		log.Error(err, "Failed to clear config drift condition")
+	}
+}
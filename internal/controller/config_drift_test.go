@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+var _ = Describe("Config drift", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	// readyHarness returns a harness whose MCPServer has a ready gateway target
+	readyHarness := func(policy string) *reconcileHarness {
+		h := newReconcileHarness(&mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       key.Name,
+				Namespace:  key.Namespace,
+				UID:        types.UID("9d1c3e5a-7b2f-4e8d-a6c0-3f5b7d9e1a24"),
+				Generation: 1,
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://weather.example.com/mcp",
+				Capabilities: []string{"tools"},
+				Description:  "Weather tools",
+			},
+		})
+		h.reconciler.DriftCheckInterval = time.Hour
+		h.reconciler.DriftPolicy = policy
+		for range 3 {
+			_, err := h.reconcile(ctx, key)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(h.get(ctx, key).Status.TargetStatus).To(Equal("READY"))
+		return h
+	}
+
+	It("should report a target edited outside of the operator", func() {
+		h := readyHarness(DriftPolicyReport)
+		h.reconciler.driftChecks.forget(key)
+		h.agentCore.editTarget("TARGET1", "https://elsewhere.example.com/mcp")
+
+		result, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("<=", time.Hour))
+		condition := meta.FindStatusCondition(h.get(ctx, key).Status.Conditions, configDriftCondition)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring("https://elsewhere.example.com/mcp"))
+		Expect(h.agentCore.callCount("UpdateGatewayTarget")).To(BeZero())
+		Expect(h.agentCore.endpointOf("TARGET1")).To(Equal("https://elsewhere.example.com/mcp"))
+	})
+
+	It("should correct a target edited outside of the operator", func() {
+		h := readyHarness(DriftPolicyCorrect)
+		h.reconciler.driftChecks.forget(key)
+		h.agentCore.editTarget("TARGET1", "https://elsewhere.example.com/mcp")
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("UpdateGatewayTarget")).To(Equal(1))
+		Expect(h.agentCore.endpointOf("TARGET1")).To(Equal("https://weather.example.com/mcp"))
+		condition := meta.FindStatusCondition(h.get(ctx, key).Status.Conditions, configDriftCondition)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	})
+
+	It("should leave matching targets alone and only check once per interval", func() {
+		h := readyHarness(DriftPolicyCorrect)
+		h.reconciler.driftChecks.forget(key)
+		gets := h.agentCore.callCount("GetGatewayTarget")
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("GetGatewayTarget")).To(Equal(gets + 1))
+		Expect(meta.FindStatusCondition(h.get(ctx, key).Status.Conditions, configDriftCondition)).To(BeNil())
+
+		h.agentCore.editTarget("TARGET1", "https://elsewhere.example.com/mcp")
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("GetGatewayTarget")).To(Equal(gets + 1))
+		Expect(h.agentCore.callCount("UpdateGatewayTarget")).To(BeZero())
+	})
+
+	It("should stretch the requeue of ready MCPServers while AWS throttles the operator", func() {
+		h := readyHarness(DriftPolicyReport)
+		h.reconciler.Backpressure = bedrock.NewBackpressure(2, time.Hour, 8)
+
+		result, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(time.Hour))
+
+		h.reconciler.Backpressure.RecordThrottle()
+		h.reconciler.Backpressure.RecordThrottle()
+		result, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(2 * time.Hour))
+		Expect(h.reconciler.syncInterval()).To(Equal(2 * time.Hour))
+	})
+})
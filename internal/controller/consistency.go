@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+const (
+	// awaitingConsistencyCondition is the condition reporting gateway targets that AWS does not
+	// return yet, shortly after they were written
+	awaitingConsistencyCondition = "AwaitingConsistency"
+
+	// targetConsistencyWindow is how long after the operator last wrote a gateway target a
+	// ResourceNotFoundException for it is put down to the eventual consistency of the AgentCore
+	// control plane rather than the target missing
+	targetConsistencyWindow = 30 * time.Second
+
+	// consistencyRetryInterval is how soon a gateway target that is not yet visible is read again
+	consistencyRetryInterval = 2 * time.Second
+)
+
+// awaitConsistency reports whether err, returned by a read of the gateway target of the
+// MCPServer, is a ResourceNotFoundException within targetConsistencyWindow of the last write of
+// the target, as recorded in status.targetUpdatedAt. AWS briefly returns not found for targets it
+// just created, so the read is to be retried after consistencyRetryInterval instead of reporting
+// an error. The wait is counted and reported in the AwaitingConsistency condition.
+func (r *MCPServerReconciler) awaitConsistency(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	err error,
+	log logr.Logger,
+) bool {
+	written := mcpServer.Status.TargetUpdatedAt
+	if !bedrock.IsResourceNotFoundError(err) || written == nil {
+		return false
+	}
+	// status.targetUpdatedAt is truncated to seconds
+	elapsed := time.Since(written.Time)
+	if elapsed >= targetConsistencyWindow+time.Second {
+		return false
+	}
+
+	targetConsistencyWaits.WithLabelValues(mcpServer.Namespace, mcpServer.Name).Inc()
+	log.Info("Gateway target not visible yet, retrying", "targetId", mcpServer.Status.TargetID,
+		"sinceWrite", elapsed.Truncate(time.Millisecond).String())
+	message := fmt.Sprintf("Gateway target %s was written %s ago and is not returned by AWS yet",
+		mcpServer.Status.TargetID, elapsed.Truncate(time.Second))
+	if statusErr := r.StatusManager.SetAwaitingConsistency(ctx, mcpServer, true, message); statusErr != nil {
+		log.V(1).Info("Failed to set awaiting consistency condition", "error", statusErr.Error())
+	}
+	return true
+}
+
+// clearAwaitingConsistency resets the AwaitingConsistency condition once the gateway target was read
+func (r *MCPServerReconciler) clearAwaitingConsistency(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) {
+	if !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, awaitingConsistencyCondition) {
+		return
+	}
+	if err := r.StatusManager.SetAwaitingConsistency(ctx, mcpServer, false, "Gateway target is visible"); err != nil {
+		log.Error(err, "Failed to clear awaiting consistency condition")
+	}
+}
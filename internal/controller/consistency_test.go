@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Eventual consistency", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	// createdHarness returns a harness whose MCPServer just created its gateway target, which is
+	// still being created
+	createdHarness := func() *reconcileHarness {
+		h := newReconcileHarness(&mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       key.Name,
+				Namespace:  key.Namespace,
+				UID:        types.UID("2c7e9a1b-4d6f-4b3a-8e5c-1a9d7f3b5e60"),
+				Generation: 1,
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://weather.example.com/mcp",
+				Capabilities: []string{"tools"},
+			},
+		})
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		created := h.get(ctx, key)
+		created.Status.TargetStatus = "CREATING"
+		Expect(h.client.Status().Update(ctx, created)).To(Succeed())
+		return h
+	}
+
+	awaitingCondition := func(h *reconcileHarness) *metav1.Condition {
+		return meta.FindStatusCondition(h.get(ctx, key).Status.Conditions, awaitingConsistencyCondition)
+	}
+
+	It("should retry a target that is not returned yet right after it was created", func() {
+		h := createdHarness()
+		h.agentCore.hideTarget("TARGET1", 1)
+
+		result, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(consistencyRetryInterval))
+		Expect(awaitingCondition(h).Status).To(Equal(metav1.ConditionTrue))
+
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.get(ctx, key).Status.TargetStatus).To(Equal("READY"))
+		Expect(awaitingCondition(h).Status).To(Equal(metav1.ConditionFalse))
+	})
+
+	It("should report a target missing long after it was written", func() {
+		h := createdHarness()
+		created := h.get(ctx, key)
+		created.Status.TargetUpdatedAt = &metav1.Time{Time: time.Now().Add(-time.Hour).Truncate(time.Second)}
+		Expect(h.client.Status().Update(ctx, created)).To(Succeed())
+		h.agentCore.hideTarget("TARGET1", 1)
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).To(HaveOccurred())
+		Expect(awaitingCondition(h)).To(BeNil())
+	})
+})
@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Names of the controllers that can be enabled with the --controllers flag
+const (
+	MCPServerControllerName       = "mcpserver"
+	AgentCoreStackControllerName  = "agentcorestack"
+	TargetReadinessControllerName = "targetreadiness"
+	MCPServerGroupControllerName  = "mcpservergroup"
+)
+
+// KnownControllers lists every controller of the operator
+var KnownControllers = []string{
+	MCPServerControllerName, AgentCoreStackControllerName, TargetReadinessControllerName, MCPServerGroupControllerName,
+}
+
+// DefaultControllers are the controllers enabled when --controllers is not set
+var DefaultControllers = []string{MCPServerControllerName}
+
+// ParseControllers parses a --controllers value into the set of enabled controllers.
+// The value is a comma-separated list of controller names. "*" enables every known controller
+// and "-<name>" disables a controller enabled by an earlier entry, e.g. "*,-agentcorestack".
+// Each controller only needs the CRD and RBAC of the resources it reconciles, so a minimal
+// install enables just the controllers it uses.
+func ParseControllers(value string) (map[string]bool, error) {
+	enabled := map[string]bool{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case entry == "":
+			continue
+		case entry == "*":
+			for _, name := range KnownControllers {
+				enabled[name] = true
+			}
+		default:
+			name, disable := strings.CutPrefix(entry, "-")
+			if !slices.Contains(KnownControllers, name) {
+				return nil, fmt.Errorf("unknown controller %q, known controllers are %s",
+					name, strings.Join(KnownControllers, ", "))
+			}
+			if disable {
+				delete(enabled, name)
+			} else {
+				enabled[name] = true
+			}
+		}
+	}
+	if len(enabled) == 0 {
+		return nil, fmt.Errorf("no controller enabled")
+	}
+	return enabled, nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Controller selection", func() {
+	DescribeTable("parsing --controllers",
+		func(value string, want map[string]bool) {
+			enabled, err := ParseControllers(value)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(enabled).To(Equal(want))
+		},
+		Entry("default", "mcpserver", map[string]bool{MCPServerControllerName: true}),
+		Entry("list", "mcpserver, agentcorestack",
+			map[string]bool{MCPServerControllerName: true, AgentCoreStackControllerName: true}),
+		Entry("all", "*", map[string]bool{
+			MCPServerControllerName:       true,
+			AgentCoreStackControllerName:  true,
+			TargetReadinessControllerName: true,
+			MCPServerGroupControllerName:  true,
+		}),
+		Entry("all but one", "*,-mcpserver", map[string]bool{
+			AgentCoreStackControllerName:  true,
+			TargetReadinessControllerName: true,
+			MCPServerGroupControllerName:  true,
+		}),
+	)
+
+	It("should reject unknown controllers and empty selections", func() {
+		_, err := ParseControllers("mcpserver,gateway")
+		Expect(err).To(MatchError(ContainSubstring(`unknown controller "gateway"`)))
+
+		_, err = ParseControllers("*,-mcpserver,-agentcorestack,-targetreadiness,-mcpservergroup")
+		Expect(err).To(MatchError(ContainSubstring("no controller enabled")))
+	})
+})
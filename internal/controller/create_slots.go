@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// createSlotRecheckInterval is how often an MCPServer waiting for a create slot asks again
+const createSlotRecheckInterval = 10 * time.Second
+
+// createSlotTracker records the MCPServers holding one of the create slots of their gateway
+type createSlotTracker struct {
+	mu   sync.Mutex
+	held map[string]map[types.NamespacedName]bool
+}
+
+// acquire takes a create slot of the gateway for the MCPServer unless all limit slots are in use.
+// creating are the other MCPServers of the gateway whose targets are CREATING according to the
+// cache; they occupy a slot whether they took it from this tracker or not, e.g. before a restart.
+// It returns whether the slot was taken and how many slots are in use.
+func (t *createSlotTracker) acquire(gatewayID string, key types.NamespacedName, creating []types.NamespacedName, limit int) (bool, int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.held == nil {
+		t.held = make(map[string]map[types.NamespacedName]bool)
+	}
+	held := t.held[gatewayID]
+	if held == nil {
+		held = make(map[types.NamespacedName]bool)
+		t.held[gatewayID] = held
+	}
+	inUse := len(held)
+	for _, other := range creating {
+		if !held[other] {
+			inUse++
+		}
+	}
+	if held[key] {
+		return true, inUse
+	}
+	if inUse >= limit {
+		return false, inUse
+	}
+	held[key] = true
+	return true, inUse + 1
+}
+
+// release frees the create slot held by the MCPServer, if any
+func (t *createSlotTracker) release(key types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for gatewayID, held := range t.held {
+		delete(held, key)
+		if len(held) == 0 {
+			delete(t.held, gatewayID)
+		}
+	}
+}
+
+// releaseCreateSlot frees the create slot of the MCPServer once its gateway target is no longer
+// being created
+func (r *MCPServerReconciler) releaseCreateSlot(mcpServer *mcpgatewayv1alpha1.MCPServer) {
+	if mcpServer.Status.TargetID != "" && mcpServer.Status.TargetStatus != "CREATING" {
+		r.createSlots.release(client.ObjectKeyFromObject(mcpServer))
+	}
+}
+
+// checkCreateSlot takes one of the MaxConcurrentCreatesPerGateway create slots of the gateway of
+// the MCPServer before its gateway target is created, which spreads the creates of a large apply
+// over time. Without a free slot the Progressing condition is set with reason WaitingForSlot and
+// the check is retried after createSlotRecheckInterval. The slot is held until the target left
+// CREATING, see releaseCreateSlot, or the create failed.
+func (r *MCPServerReconciler) checkCreateSlot(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) (bool, ctrl.Result, error) {
+	if r.MaxConcurrentCreatesPerGateway <= 0 {
+		return false, ctrl.Result{}, nil
+	}
+
+	gatewayID, err := r.ConfigParser.GetGatewayID(mcpServer)
+	if err != nil {
+		return true, ctrl.Result{}, err
+	}
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := r.List(ctx, mcpServers); err != nil {
+		return true, ctrl.Result{}, err
+	}
+	key := client.ObjectKeyFromObject(mcpServer)
+	var creating []types.NamespacedName
+	for _, other := range mcpServers.Items {
+		otherKey := client.ObjectKeyFromObject(&other)
+		if otherKey == key || other.Status.TargetStatus != "CREATING" {
+			continue
+		}
+		if otherGatewayID, err := r.ConfigParser.GetGatewayID(&other); err == nil && otherGatewayID == gatewayID {
+			creating = append(creating, otherKey)
+		}
+	}
+
+	acquired, inUse := r.createSlots.acquire(gatewayID, key, creating, r.MaxConcurrentCreatesPerGateway)
+	if acquired {
+		if err := r.StatusManager.SetWaitingForSlot(ctx, mcpServer, false, ""); err != nil {
+			log.Error(err, "Failed to clear waiting for slot condition")
+		}
+		return false, ctrl.Result{}, nil
+	}
+
+	log.Info("Gateway target creation waits for a free create slot", "gatewayId", gatewayID, "inUse", inUse)
+	message := fmt.Sprintf("Waiting for a create slot: %d of %d gateway targets of gateway %s are being created",
+		inUse, r.MaxConcurrentCreatesPerGateway, gatewayID)
+	if err := r.StatusManager.SetWaitingForSlot(ctx, mcpServer, true, message); err != nil {
+		if apierrors.IsConflict(err) {
+			return true, ctrl.Result{Requeue: true}, nil
+		}
+		return true, ctrl.Result{}, err
+	}
+	return true, ctrl.Result{RequeueAfter: createSlotRecheckInterval}, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	bedrocktypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/internal/testutil"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Create slots", func() {
+	ctx := context.Background()
+	weather := types.NamespacedName{Namespace: testutil.DefaultNamespace, Name: "weather"}
+	tickets := types.NamespacedName{Namespace: testutil.DefaultNamespace, Name: "tickets"}
+
+	var (
+		aws        *testutil.FakeAWS
+		k8s        client.Client
+		reconciler *MCPServerReconciler
+	)
+
+	BeforeEach(func() {
+		aws = testutil.NewFakeAWS()
+		k8s = testutil.NewFakeClientBuilder(
+			testutil.NewMCPServer(weather.Name, testutil.WithEndpoint("https://weather.example.com/mcp")),
+			testutil.NewMCPServer(tickets.Name, testutil.WithEndpoint("https://tickets.example.com/mcp")),
+		).
+			WithIndex(&mcpgatewayv1alpha1.MCPServer{}, endpointIndexField, indexEndpoint).
+			WithIndex(&mcpgatewayv1alpha1.MCPServer{}, referenceIndexField, indexReferences).
+			Build()
+		reconciler = &MCPServerReconciler{
+			Client:                         k8s,
+			Scheme:                         k8s.Scheme(),
+			BedrockClient:                  aws,
+			ConfigParser:                   config.NewConfigParser(testutil.DefaultGatewayID),
+			TargetConfigBuilder:            bedrock.NewTargetConfigBuilder(),
+			StatusManager:                  status.NewManager(k8s),
+			MaxConcurrentCreatesPerGateway: 1,
+		}
+	})
+
+	reconcileMCPServer := func(key types.NamespacedName) (reconcile.Result, *mcpgatewayv1alpha1.MCPServer) {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8s.Get(ctx, key, mcpServer)).To(Succeed())
+		return result, mcpServer
+	}
+
+	It("should queue creates beyond the limit until a slot is free", func() {
+		By("creating the first target")
+		_, mcpServer := reconcileMCPServer(weather)
+		Expect(mcpServer.Status.TargetID).NotTo(BeEmpty())
+
+		By("holding back the second create while the first target is CREATING")
+		result, waiting := reconcileMCPServer(tickets)
+		Expect(result.RequeueAfter).To(Equal(createSlotRecheckInterval))
+		Expect(waiting.Status.TargetID).To(BeEmpty())
+		condition := meta.FindStatusCondition(waiting.Status.Conditions, "Progressing")
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal("WaitingForSlot"))
+		Expect(aws.Calls("CreateGatewayTarget")).To(Equal(1))
+
+		By("creating the second target once the first one is READY")
+		aws.Settle()
+		_, mcpServer = reconcileMCPServer(weather)
+		Expect(mcpServer.Status.TargetStatus).To(Equal("READY"))
+		// The slot is freed by the next reconcile that finds the target READY
+		reconcileMCPServer(weather)
+		_, created := reconcileMCPServer(tickets)
+		Expect(created.Status.TargetID).NotTo(BeEmpty())
+		Expect(meta.FindStatusCondition(created.Status.Conditions, "Progressing")).To(BeNil())
+		Expect(aws.Calls("CreateGatewayTarget")).To(Equal(2))
+	})
+
+	It("should free the slot of a failed create", func() {
+		aws.Fail("CreateGatewayTarget", 1, &bedrocktypes.ValidationException{Message: awssdk.String("invalid target")})
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: weather})
+		Expect(err).To(HaveOccurred())
+
+		_, created := reconcileMCPServer(tickets)
+		Expect(created.Status.TargetID).NotTo(BeEmpty())
+	})
+
+	It("should not cap creates without a limit", func() {
+		reconciler.MaxConcurrentCreatesPerGateway = 0
+		_, first := reconcileMCPServer(weather)
+		_, second := reconcileMCPServer(tickets)
+		Expect(first.Status.TargetID).NotTo(BeEmpty())
+		Expect(second.Status.TargetID).NotTo(BeEmpty())
+	})
+})
@@ -0,0 +1,61 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// validateCredentialProviderExtensions refuses spec.credentialProviderExtensions unless the
+// feature gate is enabled, as they are sent to AWS without the validation of the typed fields
+func (r *MCPServerReconciler) validateCredentialProviderExtensions(mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	if len(mcpServer.Spec.CredentialProviderExtensions) == 0 {
+		return nil
+	}
+	if !r.FeatureGates.Enabled(FeatureCredentialProviderExtensions) {
+		return fmt.Errorf("credentialProviderExtensions requires the operator to run with --feature-gates=%s=true",
+			FeatureCredentialProviderExtensions)
+	}
+	for i, extension := range mcpServer.Spec.CredentialProviderExtensions {
+		var object map[string]any
+		if err := json.Unmarshal(extension.Raw, &object); err != nil || object == nil {
+			return fmt.Errorf("credentialProviderExtensions[%d] must be a JSON object", i)
+		}
+	}
+	return nil
+}
+
+// withCredentialProviderExtensions returns a context whose gateway target creates and updates
+// merge the credential provider extensions of the MCPServer into the request
+func (r *MCPServerReconciler) withCredentialProviderExtensions(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+) context.Context {
+	if !r.FeatureGates.Enabled(FeatureCredentialProviderExtensions) {
+		return ctx
+	}
+	extensions := make([]json.RawMessage, 0, len(mcpServer.Spec.CredentialProviderExtensions))
+	for _, extension := range mcpServer.Spec.CredentialProviderExtensions {
+		extensions = append(extensions, json.RawMessage(extension.Raw))
+	}
+	return bedrock.WithCredentialProviderExtensions(ctx, extensions)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bedrocktypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/probe"
+)
+
+const (
+	// credentialsExpireAtAnnotation records when the OAuth client secret behind the
+	// credential provider expires, as an RFC 3339 timestamp
+	credentialsExpireAtAnnotation = "mcpgateway.bedrock.aws/credentials-expire-at"
+
+	// credentialsExpiryCheckInterval is how often credential expiry is re-checked once a target is ready
+	credentialsExpiryCheckInterval = 1 * time.Hour
+
+	expirySourceEndpointCertificate = "endpoint-certificate"
+	expirySourceAnnotation          = "annotation"
+)
+
+// checkCredentialsExpiry probes the endpoint certificate and the credentials expiry annotation and
+// sets the CredentialsExpiring condition when either expires within CredentialsExpiryThreshold.
+// Failures to determine expiry are logged and never fail the reconcile.
+func (r *MCPServerReconciler) checkCredentialsExpiry(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) (ctrl.Result, error) {
+	if r.CredentialsExpiryThreshold <= 0 || r.EndpointProber == nil {
+		return ctrl.Result{}, nil
+	}
+
+	var earliest time.Time
+	var earliestSource string
+	record := func(expiry time.Time, source string) {
+		credentialsExpiryTimestamp.For(mcpServer.Labels,
+			prometheus.Labels{"namespace": mcpServer.Namespace, "name": mcpServer.Name, "source": source}).
+			Set(float64(expiry.Unix()))
+		if earliest.IsZero() || expiry.Before(earliest) {
+			earliest = expiry
+			earliestSource = source
+		}
+	}
+
+	// Lambda and OpenAPI schema targets have no endpoint certificate
+	if mcpServer.Spec.Endpoint != "" {
+		if tlsOptions, err := r.probeTLSOptions(ctx, mcpServer); err != nil {
+			log.Info("Unable to load probe TLS configuration", "error", err.Error())
+		} else if expiry, err := r.EndpointProber.CertificateExpiry(ctx, mcpServer.Spec.Endpoint, tlsOptions); err != nil {
+			log.V(1).Info("Unable to determine endpoint certificate expiry", "endpoint", mcpServer.Spec.Endpoint, "error", err.Error())
+		} else {
+			record(expiry, expirySourceEndpointCertificate)
+		}
+	}
+
+	if value, ok := mcpServer.Annotations[credentialsExpireAtAnnotation]; ok {
+		if expiry, err := time.Parse(time.RFC3339, value); err != nil {
+			log.Info("Ignoring invalid credentials expiry annotation", "annotation", credentialsExpireAtAnnotation, "value", value)
+		} else {
+			record(expiry, expirySourceAnnotation)
+		}
+	}
+
+	if earliest.IsZero() {
+		return ctrl.Result{RequeueAfter: credentialsExpiryCheckInterval}, nil
+	}
+
+	remaining := time.Until(earliest)
+	expiring := remaining < r.CredentialsExpiryThreshold
+	var message string
+	if expiring {
+		message = fmt.Sprintf("%s expires at %s (in %s)", earliestSource, earliest.UTC().Format(time.RFC3339), remaining.Truncate(time.Minute))
+		log.Info("Credentials expiring soon", "source", earliestSource, "expiresAt", earliest)
+	} else {
+		message = fmt.Sprintf("Earliest expiry is %s at %s", earliestSource, earliest.UTC().Format(time.RFC3339))
+	}
+
+	if err := r.StatusManager.SetCredentialsExpiring(ctx, mcpServer, expiring, message); err != nil {
+		if apierrors.IsConflict(err) {
+			log.V(1).Info("Conflict setting credentials expiring condition, will retry")
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: credentialsExpiryCheckInterval}, nil
+}
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// probeTLSOptions builds the TLS options for probing the endpoint from spec.probe.tls.
+// The CA Secret is read from the label-restricted cache, so it must carry the WatchLabel.
+// Reading it requires the SecretReferences feature gate.
+func (r *MCPServerReconciler) probeTLSOptions(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) (probe.TLSOptions, error) {
+	if mcpServer.Spec.Probe == nil || mcpServer.Spec.Probe.TLS == nil {
+		return probe.TLSOptions{}, nil
+	}
+	tlsSpec := mcpServer.Spec.Probe.TLS
+
+	opts := probe.TLSOptions{InsecureSkipVerify: tlsSpec.InsecureSkipVerify}
+	if tlsSpec.CASecretRef == nil || tlsSpec.InsecureSkipVerify {
+		return opts, nil
+	}
+
+	if !r.FeatureGates.Enabled(FeatureSecretReferences) {
+		return probe.TLSOptions{}, fmt.Errorf("caSecretRef requires the operator to run with --feature-gates=%s=true",
+			FeatureSecretReferences)
+	}
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: mcpServer.Namespace, Name: tlsSpec.CASecretRef.Name}
+	if err := r.Get(ctx, key, secret); err != nil {
+		return probe.TLSOptions{}, fmt.Errorf("failed to get CA Secret %s: %w", key, err)
+	}
+	caPEM, ok := secret.Data[tlsSpec.CASecretRef.Key]
+	if !ok {
+		return probe.TLSOptions{}, fmt.Errorf("CA Secret %s has no key %q", key, tlsSpec.CASecretRef.Key)
+	}
+	pool, err := probe.NewCertPool(caPEM)
+	if err != nil {
+		return probe.TLSOptions{}, fmt.Errorf("invalid CA certificates in Secret %s: %w", key, err)
+	}
+	opts.RootCAs = pool
+	return opts, nil
+}
+
+// recordAppliedCredentials records the credential providers AWS reports for the gateway target
+// in status.appliedCredentialProviders. Responses without credential configurations, e.g. for
+// Lambda targets, leave the recorded providers untouched.
+func recordAppliedCredentials(mcpServer *mcpgatewayv1alpha1.MCPServer, configs []bedrocktypes.CredentialProviderConfiguration) {
+	if applied := bedrock.SummarizeCredentialProviders(configs); applied != nil {
+		mcpServer.Status.AppliedCredentialProviders = applied
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Reconcile actions, i.e. the branch of the reconcile loop that was taken
+const (
+	actionNotFound       = "notFound"
+	actionOtherShard     = "otherShard"
+	actionDelete         = "delete"
+	actionInvalidSpec    = "invalidSpec"
+	actionCreate         = "create"
+	actionUpdate         = "update"
+	actionSkip           = "skip"
+	actionSyncStatus     = "syncStatus"
+	actionThrottled      = "throttled"
+	actionRolloutPending = "rolloutPending"
+
+	actionBackendUnavailable = "backendUnavailable"
+	actionApprovalPending    = "approvalPending"
+	actionGatewayDeleted     = "gatewayDeleted"
+	actionExpired            = "expired"
+	actionCanceled           = "canceled"
+	actionWaitingForSlot     = "waitingForSlot"
+)
+
+// Reconcile decisions reported in the decision trace
+const (
+	decisionCreated         = "created"
+	decisionUpdated         = "updated"
+	decisionDeleted         = "deleted"
+	decisionStatusSynced    = "statusSynced"
+	decisionSkippedNoChange = "skippedNoChange"
+	decisionWaitingReady    = "waitingReady"
+	decisionBackoff         = "backoff"
+	decisionIgnored         = "ignored"
+	decisionInvalidSpec     = "invalidSpec"
+	decisionThrottled       = "throttled"
+	decisionDraining        = "draining"
+	decisionRolloutPending  = "rolloutPending"
+
+	decisionBackendUnavailable = "backendUnavailable"
+	decisionApprovalPending    = "approvalPending"
+	decisionGatewayDeleted     = "gatewayDeleted"
+	decisionExpired            = "expired"
+	decisionCanceled           = "canceled"
+	decisionWaitingForSlot     = "waitingForSlot"
+)
+
+// decisionTraceLevel is the log verbosity of the decision trace
+const decisionTraceLevel = 1
+
+// reconcileTrace records the path taken by a reconcile so that a single structured
+// "Reconcile decision" line explains why the operator did or did not call AWS
+type reconcileTrace struct {
+	start  time.Time
+	action string
+}
+
+// newReconcileTrace starts the trace of a reconcile
+func newReconcileTrace() *reconcileTrace {
+	return &reconcileTrace{start: time.Now()}
+}
+
+// decision derives the outcome of the reconcile from the action taken and its result.
+// Errors and immediate requeues are reported as backoff; actions that requeue after a delay
+// while the target is not yet READY are reported as waitingReady, and deletions that wait for the
+// drain period or for dependent objects as draining.
+func (t *reconcileTrace) decision(result ctrl.Result, err error) string {
+	if t.action == actionThrottled {
+		return decisionThrottled
+	}
+	if t.action == actionCanceled {
+		return decisionCanceled
+	}
+	if err != nil || result.Requeue {
+		return decisionBackoff
+	}
+
+	switch t.action {
+	case actionCreate, actionUpdate, actionSyncStatus:
+		if result.RequeueAfter > 0 {
+			return decisionWaitingReady
+		}
+	case actionDelete:
+		if result.RequeueAfter > 0 {
+			return decisionDraining
+		}
+	}
+
+	switch t.action {
+	case actionCreate:
+		return decisionCreated
+	case actionUpdate:
+		return decisionUpdated
+	case actionSyncStatus:
+		return decisionStatusSynced
+	case actionDelete:
+		return decisionDeleted
+	case actionSkip:
+		return decisionSkippedNoChange
+	case actionInvalidSpec:
+		return decisionInvalidSpec
+	case actionRolloutPending:
+		return decisionRolloutPending
+	case actionBackendUnavailable:
+		return decisionBackendUnavailable
+	case actionApprovalPending:
+		return decisionApprovalPending
+	case actionGatewayDeleted:
+		return decisionGatewayDeleted
+	case actionExpired:
+		return decisionExpired
+	case actionWaitingForSlot:
+		return decisionWaitingForSlot
+	default:
+		return decisionIgnored
+	}
+}
+
+// log writes the decision trace of the finished reconcile
+func (t *reconcileTrace) log(log logr.Logger, result ctrl.Result, err error) {
+	keysAndValues := []any{
+		"decision", t.decision(result, err),
+		"action", t.action,
+		"duration", time.Since(t.start).String(),
+	}
+	if result.RequeueAfter > 0 {
+		keysAndValues = append(keysAndValues, "requeueAfter", result.RequeueAfter.String())
+	}
+	if err != nil {
+		keysAndValues = append(keysAndValues, "error", err.Error())
+	}
+	log.V(decisionTraceLevel).Info("Reconcile decision", keysAndValues...)
+}
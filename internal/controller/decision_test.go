@@ -0,0 +1,48 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("Reconcile decision trace", func() {
+	DescribeTable("derives the decision from the action and result",
+		func(action string, result ctrl.Result, err error, expected string) {
+			trace := &reconcileTrace{action: action}
+			Expect(trace.decision(result, err)).To(Equal(expected))
+		},
+		Entry("created", actionCreate, ctrl.Result{}, nil, decisionCreated),
+		Entry("created and waiting", actionCreate, ctrl.Result{RequeueAfter: 10 * time.Second}, nil, decisionWaitingReady),
+		Entry("update failed", actionUpdate, ctrl.Result{}, errors.New("throttled"), decisionBackoff),
+		Entry("status conflict", actionSyncStatus, ctrl.Result{Requeue: true}, nil, decisionBackoff),
+		Entry("ready and unchanged", actionSkip, ctrl.Result{RequeueAfter: time.Hour}, nil, decisionSkippedNoChange),
+		Entry("deleted", actionDelete, ctrl.Result{}, nil, decisionDeleted),
+		Entry("draining", actionDelete, ctrl.Result{RequeueAfter: time.Minute}, nil, decisionDraining),
+		Entry("budget exhausted", actionThrottled, ctrl.Result{RequeueAfter: time.Minute}, nil, decisionThrottled),
+		Entry("reconcile canceled", actionCanceled, ctrl.Result{RequeueAfter: canceledRequeueDelay}, nil, decisionCanceled),
+		Entry("held back by rollout", actionRolloutPending, ctrl.Result{RequeueAfter: 15 * time.Second}, nil, decisionRolloutPending),
+		Entry("awaiting approval", actionApprovalPending, ctrl.Result{}, nil, decisionApprovalPending),
+		Entry("gateway deleted", actionGatewayDeleted, ctrl.Result{}, nil, decisionGatewayDeleted),
+		Entry("other shard", actionOtherShard, ctrl.Result{}, nil, decisionIgnored),
+	)
+})
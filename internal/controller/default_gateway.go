@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+)
+
+// DefaultGatewayReconciler keeps the default gateway ID of the ConfigParser in line with a
+// ConfigMap key, so that the default gateway can be rotated without redeploying the operator.
+// The ConfigMap must carry the WatchLabel to be visible through the operator's cache.
+type DefaultGatewayReconciler struct {
+	client.Client
+	ConfigParser *config.ConfigParser
+
+	// ConfigMap is the ConfigMap holding the default gateway ID
+	ConfigMap types.NamespacedName
+	// Key is the ConfigMap data key holding the default gateway ID
+	Key string
+	// Fallback is used while the ConfigMap or its key is missing
+	Fallback string
+}
+
+// Reconcile updates the default gateway ID from the ConfigMap
+func (r *DefaultGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return ctrl.Result{}, r.Load(ctx, r.Client)
+}
+
+// Load reads the default gateway ID from the ConfigMap through the given reader.
+// It is called once with an uncached reader at startup, before MCPServers are reconciled.
+func (r *DefaultGatewayReconciler) Load(ctx context.Context, reader client.Reader) error {
+	log := logf.FromContext(ctx)
+
+	gatewayID := r.Fallback
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, r.ConfigMap, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed to get default gateway ConfigMap", "configMap", r.ConfigMap)
+			return err
+		}
+		log.Info("Default gateway ConfigMap not found, using fallback", "configMap", r.ConfigMap)
+	} else if value := strings.TrimSpace(configMap.Data[r.Key]); value != "" {
+		gatewayID = value
+	} else {
+		log.Info("Default gateway ConfigMap has no gateway ID, using fallback", "configMap", r.ConfigMap, "key", r.Key)
+	}
+
+	if previous := r.ConfigParser.DefaultGatewayID(); previous != gatewayID {
+		r.ConfigParser.SetDefaultGatewayID(gatewayID)
+		log.Info("Default gateway changed", "previous", previous, "gatewayId", gatewayID)
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *DefaultGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	isDefaultGatewayConfigMap := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetNamespace() == r.ConfigMap.Namespace && obj.GetName() == r.ConfigMap.Name
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.ConfigMap{}, builder.WithPredicates(isDefaultGatewayConfigMap)).
+		Named("defaultgateway").
+		Complete(r)
+}
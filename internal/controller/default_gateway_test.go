@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+)
+
+var _ = Describe("DefaultGateway Controller", func() {
+	Context("When the default gateway ConfigMap changes", func() {
+		const configMapName = "default-gateway"
+
+		ctx := context.Background()
+
+		configMapKey := types.NamespacedName{
+			Name:      configMapName,
+			Namespace: "default",
+		}
+
+		It("should follow the ConfigMap and fall back when it is deleted", func() {
+			parser := config.NewConfigParser("fallback-gateway")
+			reconciler := &DefaultGatewayReconciler{
+				Client:       k8sClient,
+				ConfigParser: parser,
+				ConfigMap:    configMapKey,
+				Key:          "gatewayId",
+				Fallback:     "fallback-gateway",
+			}
+
+			By("creating the ConfigMap")
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      configMapName,
+					Namespace: "default",
+					Labels:    map[string]string{WatchLabel: "true"},
+				},
+				Data: map[string]string{"gatewayId": "gateway-one"},
+			}
+			Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
+			Expect(reconciler.Load(ctx, k8sClient)).To(Succeed())
+			Expect(parser.DefaultGatewayID()).To(Equal("gateway-one"))
+
+			By("rotating the gateway")
+			configMap.Data["gatewayId"] = "gateway-two"
+			Expect(k8sClient.Update(ctx, configMap)).To(Succeed())
+			Expect(reconciler.Load(ctx, k8sClient)).To(Succeed())
+			Expect(parser.DefaultGatewayID()).To(Equal("gateway-two"))
+
+			By("deleting the ConfigMap")
+			Expect(k8sClient.Delete(ctx, configMap)).To(Succeed())
+			Expect(reconciler.Load(ctx, k8sClient)).To(Succeed())
+			Expect(parser.DefaultGatewayID()).To(Equal("fallback-gateway"))
+		})
+	})
+})
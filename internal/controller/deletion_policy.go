@@ -0,0 +1,51 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// retainsTarget reports whether the gateway target outlives the MCPServer
+func retainsTarget(mcpServer *mcpgatewayv1alpha1.MCPServer) bool {
+	return mcpServer.Spec.DeletionPolicy == mcpgatewayv1alpha1.DeletionPolicyRetain
+}
+
+// retainGatewayTarget releases the gateway target of an MCPServer with the Retain deletion policy.
+// The target is left in AWS as it is, and an event records its ID so that it can be found, and
+// adopted, later.
+func (r *MCPServerReconciler) retainGatewayTarget(mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) {
+	if mcpServer.Status.TargetID == "" {
+		log.Info("No target ID found, nothing to retain")
+		return
+	}
+
+	gatewayID := mcpServer.Status.GatewayID
+	if gatewayID == "" {
+		gatewayID, _ = r.ConfigParser.GetGatewayID(mcpServer)
+	}
+	log.Info("Retaining gateway target as requested by the deletion policy", "gatewayId", gatewayID,
+		"targetId", mcpServer.Status.TargetID)
+	r.recordEvent(mcpServer, corev1.EventTypeNormal, "TargetRetained", "Delete",
+		fmt.Sprintf("Gateway target %s on gateway %s was retained in AWS by deletion policy %s",
+			mcpServer.Status.TargetID, gatewayID, mcpgatewayv1alpha1.DeletionPolicyRetain))
+}
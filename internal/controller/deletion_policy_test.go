@@ -0,0 +1,82 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Deletion policy", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	newMCPServer := func(deletionPolicy string) *mcpgatewayv1alpha1.MCPServer {
+		return &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       key.Name,
+				Namespace:  key.Namespace,
+				UID:        types.UID("6e2d4c8a-1f3b-4a7e-9c5d-8b0a2e4f6c13"),
+				Generation: 1,
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:       "https://weather.example.com/mcp",
+				Capabilities:   []string{"tools"},
+				DeletionPolicy: deletionPolicy,
+				DrainPeriod:    &metav1.Duration{Duration: time.Hour},
+			},
+		}
+	}
+
+	It("should retain the gateway target without draining it", func() {
+		h := newReconcileHarness(newMCPServer(mcpgatewayv1alpha1.DeletionPolicyRetain))
+		recorder := events.NewFakeRecorder(10)
+		h.reconciler.Recorder = recorder
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(h.client.Delete(ctx, h.get(ctx, key))).To(Succeed())
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("DeleteGatewayTarget")).To(BeZero())
+		Expect(h.agentCore.targetIDs()).To(ConsistOf("TARGET1"))
+		Expect(apierrors.IsNotFound(h.client.Get(ctx, key, &mcpgatewayv1alpha1.MCPServer{}))).To(BeTrue())
+		Expect(recorder.Events).To(Receive(And(ContainSubstring("TargetRetained"), ContainSubstring("TARGET1"))))
+	})
+
+	It("should delete the gateway target by default", func() {
+		mcpServer := newMCPServer("")
+		mcpServer.Spec.DrainPeriod = nil
+		h := newReconcileHarness(mcpServer)
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(h.client.Delete(ctx, h.get(ctx, key))).To(Succeed())
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.targetIDs()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,84 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/autoscaling"
+)
+
+// dependentDeletionInterval is how often the deletion of dependent objects is checked
+const dependentDeletionInterval = 5 * time.Second
+
+// dependentKinds are the kinds of the objects an MCPServer owns, in the order they are deleted
+// with the Foreground dependent deletion policy
+var dependentKinds = []schema.GroupVersionKind{
+	autoscaling.ScaledObjectGVK,
+}
+
+// deleteDependents deletes the objects controlled by the MCPServer one kind at a time and
+// returns how many are left. Objects of a kind are only deleted once every object of the
+// previous kinds is gone. Dependents are deleted in the foreground, so their own dependents are
+// gone before they are. Kinds that are not installed in the cluster have no dependents.
+func (r *MCPServerReconciler) deleteDependents(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) (int, error) {
+	for _, gvk := range dependentKinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := r.List(ctx, list, client.InNamespace(mcpServer.Namespace)); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return 0, fmt.Errorf("failed to list %s dependents: %w", gvk.Kind, err)
+		}
+
+		remaining := 0
+		for i := range list.Items {
+			dependent := &list.Items[i]
+			if !metav1.IsControlledBy(dependent, mcpServer) {
+				continue
+			}
+			remaining++
+			if !dependent.GetDeletionTimestamp().IsZero() {
+				continue
+			}
+			if err := r.Delete(ctx, dependent, client.PropagationPolicy(metav1.DeletePropagationForeground)); client.IgnoreNotFound(err) != nil {
+				return 0, fmt.Errorf("failed to delete %s %s: %w", gvk.Kind, dependent.GetName(), err)
+			}
+			log.Info("Deleted dependent object", "kind", gvk.Kind, "name", dependent.GetName())
+		}
+		if remaining > 0 {
+			log.Info("Waiting for dependent objects to be deleted", "kind", gvk.Kind, "remaining", remaining)
+			return remaining, nil
+		}
+	}
+	return 0, nil
+}
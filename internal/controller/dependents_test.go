@@ -0,0 +1,47 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Dependent deletion", func() {
+	ctx := context.Background()
+
+	It("should treat dependent kinds that are not installed as deleted", func() {
+		// The test environment does not install the KEDA CRDs
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dependents", Namespace: "default", UID: "uid-123"},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				DependentDeletion: mcpgatewayv1alpha1.DependentDeletionForeground,
+			},
+		}
+		reconciler := &MCPServerReconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+
+		remaining, err := reconciler.deleteDependents(ctx, mcpServer, logf.Log)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(remaining).To(BeZero())
+	})
+})
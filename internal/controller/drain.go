@@ -0,0 +1,79 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// drainingCondition reports that a deleted MCPServer keeps its gateway target for the drain period
+const drainingCondition = "Draining"
+
+// drainRemaining returns how much longer the gateway target of a deleted MCPServer is kept.
+// The drain period starts at the deletion timestamp, so it survives operator restarts without
+// being recorded. AgentCore has no way to disable a target, so the target stays fully
+// registered while draining.
+func drainRemaining(mcpServer *mcpgatewayv1alpha1.MCPServer, now time.Time) time.Duration {
+	if mcpServer.Spec.DrainPeriod == nil || mcpServer.DeletionTimestamp == nil || mcpServer.Status.TargetID == "" {
+		return 0
+	}
+	return mcpServer.DeletionTimestamp.Add(mcpServer.Spec.DrainPeriod.Duration).Sub(now)
+}
+
+// drainTarget postpones the deletion of the gateway target until the drain period has passed.
+// A TargetDraining event announces the deletion once, when the drain starts.
+func (r *MCPServerReconciler) drainTarget(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	remaining time.Duration,
+	log logr.Logger,
+) (ctrl.Result, error) {
+	deadline := mcpServer.DeletionTimestamp.Add(mcpServer.Spec.DrainPeriod.Duration)
+	message := fmt.Sprintf("Gateway target %s will be deleted at %s", mcpServer.Status.TargetID, deadline.UTC().Format(time.RFC3339))
+
+	started := !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, drainingCondition)
+	if err := r.StatusManager.SetDraining(ctx, mcpServer, message); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		log.Error(err, "Failed to update status with draining condition")
+		return ctrl.Result{}, err
+	}
+	if started {
+		log.Info("Draining gateway target before deletion", "targetId", mcpServer.Status.TargetID, "remaining", remaining.String())
+		r.recordEvent(mcpServer, corev1.EventTypeWarning, "TargetDraining", "Drain", message)
+	}
+	return ctrl.Result{RequeueAfter: remaining}, nil
+}
+
+// recordEvent emits an event for the MCPServer if the reconciler has a recorder
+func (r *MCPServerReconciler) recordEvent(mcpServer *mcpgatewayv1alpha1.MCPServer, eventType, reason, action, note string) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Eventf(mcpServer, nil, eventType, reason, action, "%s", note)
+}
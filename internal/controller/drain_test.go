@@ -0,0 +1,113 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Gateway target draining", func() {
+	const resourceName = "test-drain"
+
+	ctx := context.Background()
+
+	typeNamespacedName := types.NamespacedName{
+		Name:      resourceName,
+		Namespace: "default",
+	}
+
+	It("should compute the remaining drain period from the deletion timestamp", func() {
+		deletedAt := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &deletedAt},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				DrainPeriod: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			Status: mcpgatewayv1alpha1.MCPServerStatus{TargetID: "target-123"},
+		}
+
+		Expect(drainRemaining(mcpServer, deletedAt.Add(time.Minute))).To(Equal(4 * time.Minute))
+		Expect(drainRemaining(mcpServer, deletedAt.Add(10*time.Minute))).To(BeNumerically("<", 0))
+
+		By("not draining targets that were never created")
+		mcpServer.Status.TargetID = ""
+		Expect(drainRemaining(mcpServer, deletedAt.Time)).To(BeZero())
+
+		By("not draining without a drain period")
+		mcpServer.Status.TargetID = "target-123"
+		mcpServer.Spec.DrainPeriod = nil
+		Expect(drainRemaining(mcpServer, deletedAt.Time)).To(BeZero())
+	})
+
+	It("should keep the gateway target and announce the drain once", func() {
+		resource := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       resourceName,
+				Namespace:  "default",
+				Finalizers: []string{gatewayTargetFinalizer},
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+				DrainPeriod:  &metav1.Duration{Duration: time.Hour},
+			},
+		}
+		Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		resource.Status.TargetID = "target-123"
+		Expect(k8sClient.Status().Update(ctx, resource)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+
+		recorder := events.NewFakeRecorder(10)
+		// Draining never reaches AWS, which is why no Bedrock client is needed
+		reconciler := &MCPServerReconciler{
+			Client:        k8sClient,
+			Scheme:        k8sClient.Scheme(),
+			StatusManager: status.NewManager(k8sClient),
+			Recorder:      recorder,
+		}
+
+		for range 2 {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 50*time.Minute))
+		}
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(ContainSubstring("TargetDraining"))
+
+		Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+		Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, drainingCondition)).To(BeTrue())
+		Expect(controllerutil.ContainsFinalizer(resource, gatewayTargetFinalizer)).To(BeTrue())
+
+		By("releasing the resource")
+		patch := resource.DeepCopy()
+		controllerutil.RemoveFinalizer(patch, gatewayTargetFinalizer)
+		Expect(k8sClient.Update(ctx, patch)).To(Succeed())
+	})
+})
@@ -0,0 +1,154 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// endpointIndexField indexes MCPServers by the endpoint in their spec
+const endpointIndexField = ".spec.endpoint"
+
+// duplicateEndpointCondition is the condition reporting MCPServers that register the same
+// endpoint on the same gateway
+const duplicateEndpointCondition = "DuplicateEndpoint"
+
+// indexEndpoint is the field indexer for endpointIndexField
+func indexEndpoint(obj client.Object) []string {
+	mcpServer, ok := obj.(*mcpgatewayv1alpha1.MCPServer)
+	if !ok || mcpServer.Spec.Endpoint == "" {
+		return nil
+	}
+	return []string{mcpServer.Spec.Endpoint}
+}
+
+// endpointGateway returns the gateway the target of the MCPServer was created on or, before it
+// was created, the gateway its spec resolves to, or "" if there is none
+func (r *MCPServerReconciler) endpointGateway(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	if mcpServer.Status.GatewayID != "" {
+		return mcpServer.Status.GatewayID
+	}
+	gatewayID, err := r.ConfigParser.GetGatewayID(mcpServer)
+	if err != nil {
+		return ""
+	}
+	return gatewayID
+}
+
+// FindDuplicateEndpoints returns the other MCPServers, as sorted namespace/name keys, that
+// register the endpoint of the MCPServer on the same gateway. MCPServers that are being deleted
+// are not counted.
+func (r *MCPServerReconciler) FindDuplicateEndpoints(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+) ([]string, error) {
+	if mcpServer.Spec.Endpoint == "" {
+		return nil, nil
+	}
+	gatewayID := r.endpointGateway(mcpServer)
+	if gatewayID == "" {
+		return nil, nil
+	}
+
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := r.List(ctx, mcpServers, client.MatchingFields{endpointIndexField: mcpServer.Spec.Endpoint}); err != nil {
+		return nil, err
+	}
+
+	var duplicates []string
+	for i := range mcpServers.Items {
+		other := &mcpServers.Items[i]
+		if other.Namespace == mcpServer.Namespace && other.Name == mcpServer.Name {
+			continue
+		}
+		if !other.DeletionTimestamp.IsZero() || r.endpointGateway(other) != gatewayID {
+			continue
+		}
+		duplicates = append(duplicates, other.Namespace+"/"+other.Name)
+	}
+	sort.Strings(duplicates)
+	return duplicates, nil
+}
+
+// checkDuplicateEndpoint sets the DuplicateEndpoint condition if other MCPServers register the
+// endpoint of the MCPServer on the same gateway, which usually is a copy-paste mistake that
+// exposes the same tools twice. The duplicate is reported rather than refused; each of the
+// MCPServers still gets its target.
+// The condition is only added once a duplicate was seen, and cleared when it is gone.
+func (r *MCPServerReconciler) checkDuplicateEndpoint(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) error {
+	duplicates, err := r.FindDuplicateEndpoints(ctx, mcpServer)
+	if err != nil {
+		return err
+	}
+	if len(duplicates) == 0 {
+		if !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, duplicateEndpointCondition) {
+			return nil
+		}
+		return r.StatusManager.SetDuplicateEndpoint(ctx, mcpServer, false,
+			"No other MCPServer registers the endpoint on the gateway")
+	}
+
+	log.Info("Endpoint is registered on the gateway by other MCPServers", "endpoint", mcpServer.Spec.Endpoint,
+		"mcpServers", duplicates)
+	return r.StatusManager.SetDuplicateEndpoint(ctx, mcpServer, true, fmt.Sprintf(
+		"Endpoint %s is also registered on gateway %s by %s", mcpServer.Spec.Endpoint,
+		r.endpointGateway(mcpServer), strings.Join(duplicates, ", ")))
+}
+
+// mapEndpointToMCPServers enqueues the other MCPServers registering the endpoint of the MCPServer,
+// and those reporting a duplicate endpoint, so that their condition follows MCPServers that are
+// created, change their endpoint or are deleted
+func (r *MCPServerReconciler) mapEndpointToMCPServers(ctx context.Context, obj client.Object) []reconcile.Request {
+	mcpServer, ok := obj.(*mcpgatewayv1alpha1.MCPServer)
+	if !ok {
+		return nil
+	}
+
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := r.List(ctx, mcpServers); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, other := range mcpServers.Items {
+		if other.Namespace == mcpServer.Namespace && other.Name == mcpServer.Name {
+			continue
+		}
+		sameEndpoint := mcpServer.Spec.Endpoint != "" && other.Spec.Endpoint == mcpServer.Spec.Endpoint
+		if sameEndpoint || meta.IsStatusConditionTrue(other.Status.Conditions, duplicateEndpointCondition) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: other.Namespace, Name: other.Name},
+			})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Duplicate endpoints", func() {
+	ctx := context.Background()
+	const endpoint = "https://weather.example.com/mcp"
+
+	newEndpointMCPServer := func(namespace, name, endpoint, gatewayID string) *mcpgatewayv1alpha1.MCPServer {
+		return &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     endpoint,
+				Capabilities: []string{"tools"},
+				GatewayID:    gatewayID,
+			},
+		}
+	}
+
+	newReconciler := func(objects ...client.Object) *MCPServerReconciler {
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithObjects(objects...).
+			WithStatusSubresource(&mcpgatewayv1alpha1.MCPServer{}).
+			WithIndex(&mcpgatewayv1alpha1.MCPServer{}, endpointIndexField, indexEndpoint).
+			Build()
+		return &MCPServerReconciler{
+			Client:        fakeClient,
+			Scheme:        fakeClient.Scheme(),
+			ConfigParser:  config.NewConfigParser(""),
+			StatusManager: status.NewManager(fakeClient),
+		}
+	}
+
+	It("should index MCPServers by endpoint", func() {
+		Expect(indexEndpoint(newEndpointMCPServer("default", "weather", endpoint, "gw-1"))).To(Equal([]string{endpoint}))
+		Expect(indexEndpoint(newEndpointMCPServer("default", "weather", "", "gw-1"))).To(BeEmpty())
+	})
+
+	It("should only count MCPServers with the endpoint on the same gateway", func() {
+		weather := newEndpointMCPServer("team-a", "weather", endpoint, "gw-1")
+		reconciler := newReconciler(
+			weather,
+			newEndpointMCPServer("team-b", "weather-copy", endpoint, "gw-1"),
+			newEndpointMCPServer("team-a", "weather-staging", endpoint, "gw-2"),
+			newEndpointMCPServer("team-a", "forecast", "https://forecast.example.com/mcp", "gw-1"),
+		)
+
+		duplicates, err := reconciler.FindDuplicateEndpoints(ctx, weather)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(duplicates).To(Equal([]string{"team-b/weather-copy"}))
+	})
+
+	It("should compare the gateway the target was created on", func() {
+		weather := newEndpointMCPServer("team-a", "weather", endpoint, "gw-1")
+		moved := newEndpointMCPServer("team-b", "weather-copy", endpoint, "")
+		moved.Status.GatewayID = "gw-1"
+		reconciler := newReconciler(weather, moved)
+
+		duplicates, err := reconciler.FindDuplicateEndpoints(ctx, weather)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(duplicates).To(Equal([]string{"team-b/weather-copy"}))
+	})
+
+	It("should set and clear the DuplicateEndpoint condition", func() {
+		weather := newEndpointMCPServer("team-a", "weather", endpoint, "gw-1")
+		weatherCopy := newEndpointMCPServer("team-b", "weather-copy", endpoint, "gw-1")
+		reconciler := newReconciler(weather, weatherCopy)
+
+		Expect(reconciler.checkDuplicateEndpoint(ctx, weather, logr.Discard())).To(Succeed())
+		condition := meta.FindStatusCondition(weather.Status.Conditions, duplicateEndpointCondition)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring("team-b/weather-copy"))
+
+		Expect(reconciler.Delete(ctx, weatherCopy)).To(Succeed())
+		Expect(reconciler.checkDuplicateEndpoint(ctx, weather, logr.Discard())).To(Succeed())
+		Expect(meta.IsStatusConditionFalse(weather.Status.Conditions, duplicateEndpointCondition)).To(BeTrue())
+	})
+
+	It("should not add the condition to unique endpoints", func() {
+		weather := newEndpointMCPServer("team-a", "weather", endpoint, "gw-1")
+		reconciler := newReconciler(weather)
+
+		Expect(reconciler.checkDuplicateEndpoint(ctx, weather, logr.Discard())).To(Succeed())
+		Expect(weather.Status.Conditions).To(BeEmpty())
+	})
+
+	It("should enqueue the MCPServers sharing the endpoint or reporting a duplicate", func() {
+		weather := newEndpointMCPServer("team-a", "weather", endpoint, "gw-1")
+		flagged := newEndpointMCPServer("team-c", "old-copy", "https://old.example.com/mcp", "gw-1")
+		flagged.Status.Conditions = []metav1.Condition{{
+			Type: duplicateEndpointCondition, Status: metav1.ConditionTrue, Reason: "EndpointShared",
+			LastTransitionTime: metav1.Now(),
+		}}
+		reconciler := newReconciler(
+			weather,
+			newEndpointMCPServer("team-b", "weather-copy", endpoint, "gw-2"),
+			flagged,
+			newEndpointMCPServer("team-a", "forecast", "https://forecast.example.com/mcp", "gw-1"),
+		)
+
+		requests := reconciler.mapEndpointToMCPServers(ctx, weather)
+		var keys []types.NamespacedName
+		for _, request := range requests {
+			keys = append(keys, request.NamespacedName)
+		}
+		Expect(keys).To(ConsistOf(
+			types.NamespacedName{Namespace: "team-b", Name: "weather-copy"},
+			types.NamespacedName{Namespace: "team-c", Name: "old-copy"},
+		))
+	})
+})
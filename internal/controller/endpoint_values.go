@@ -0,0 +1,105 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// EndpointValuesConfigMap is the name of the ConfigMap holding the values substituted into the
+// spec.endpoint of the MCPServers in its namespace, e.g. https://mcp.{{ .Values.domain }}/server.
+// Like every ConfigMap read by the operator it must carry WatchLabel.
+const EndpointValuesConfigMap = "mcpgateway-endpoint-values"
+
+// reasonEndpointSubstitutionError is the Ready reason of MCPServers whose endpoint variables
+// cannot be substituted
+const reasonEndpointSubstitutionError = "EndpointSubstitutionError"
+
+// endpointValuesError reports endpoint variables that cannot be substituted until the spec or the
+// ConfigMap is fixed
+type endpointValuesError struct {
+	err error
+}
+
+func (e *endpointValuesError) Error() string { return e.err.Error() }
+
+func (e *endpointValuesError) Unwrap() error { return e.err }
+
+// hasEndpointVariables reports whether the spec.endpoint of the MCPServer uses variables
+func hasEndpointVariables(mcpServer *mcpgatewayv1alpha1.MCPServer) bool {
+	return strings.Contains(mcpServer.Spec.Endpoint, "{{")
+}
+
+// substituteEndpointValues replaces the variables in the endpoint of the in-memory MCPServer with
+// the values of the namespace's EndpointValuesConfigMap, so that the gateway target is built with
+// the resolved endpoint. Like the workload metadata, the resolved endpoint is never written to
+// the spec.
+func (r *MCPServerReconciler) substituteEndpointValues(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	if !hasEndpointVariables(mcpServer) {
+		return nil
+	}
+
+	tmpl, err := template.New("endpoint").Option("missingkey=error").Parse(mcpServer.Spec.Endpoint)
+	if err != nil {
+		return &endpointValuesError{fmt.Errorf("invalid variables in spec.endpoint: %w", err)}
+	}
+
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: mcpServer.Namespace, Name: EndpointValuesConfigMap}
+	if err := r.Get(ctx, key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &endpointValuesError{fmt.Errorf(
+				"spec.endpoint uses variables but ConfigMap %s labelled %s=true was not found", key, WatchLabel)}
+		}
+		return err
+	}
+
+	var endpoint strings.Builder
+	if err := tmpl.Execute(&endpoint, map[string]any{"Values": configMap.Data}); err != nil {
+		return &endpointValuesError{fmt.Errorf(
+			"failed to substitute the values of ConfigMap %s into spec.endpoint: %w", key, err)}
+	}
+	mcpServer.Spec.Endpoint = endpoint.String()
+	return nil
+}
+
+// recordResolvedEndpoint records the endpoint applied to the gateway target in the status of
+// latest, the MCPServer as stored, if its spec.endpoint uses variables or it references a Service
+// or HTTPRoute
+func recordResolvedEndpoint(latest, applied *mcpgatewayv1alpha1.MCPServer) {
+	if !hasEndpointVariables(latest) && latest.Spec.ServiceRef == nil && latest.Spec.HTTPRouteRef == nil {
+		latest.Status.ResolvedEndpoint = ""
+		return
+	}
+	latest.Status.ResolvedEndpoint = applied.Spec.Endpoint
+}
+
+// resolvedEndpointChanged reports whether the values substituted into the endpoint of the
+// MCPServer, or the address of its Service or HTTPRoute, changed since the endpoint was last
+// applied to the gateway target. Changes of the spec itself are detected by its generation.
+func resolvedEndpointChanged(mcpServer *mcpgatewayv1alpha1.MCPServer) bool {
+	return mcpServer.Status.ResolvedEndpoint != "" && mcpServer.Status.ResolvedEndpoint != mcpServer.Spec.Endpoint
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Endpoint values", func() {
+	ctx := context.Background()
+
+	var reconciler *MCPServerReconciler
+	var configMap *corev1.ConfigMap
+
+	templated := func(endpoint string) *mcpgatewayv1alpha1.MCPServer {
+		return &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "default"},
+			Spec:       mcpgatewayv1alpha1.MCPServerSpec{Endpoint: endpoint},
+		}
+	}
+
+	BeforeEach(func() {
+		reconciler = &MCPServerReconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      EndpointValuesConfigMap,
+				Namespace: "default",
+				Labels:    map[string]string{WatchLabel: "true"},
+			},
+			Data: map[string]string{"domain": "stage.example.com"},
+		}
+		Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, configMap)).To(Succeed())
+	})
+
+	It("should substitute the values of the ConfigMap", func() {
+		mcpServer := templated("https://mcp.{{ .Values.domain }}/weather")
+		Expect(reconciler.substituteEndpointValues(ctx, mcpServer)).To(Succeed())
+		Expect(mcpServer.Spec.Endpoint).To(Equal("https://mcp.stage.example.com/weather"))
+	})
+
+	It("should leave endpoints without variables alone", func() {
+		mcpServer := templated("https://mcp.example.com/weather")
+		mcpServer.Namespace = "no-values"
+		Expect(reconciler.substituteEndpointValues(ctx, mcpServer)).To(Succeed())
+		Expect(mcpServer.Spec.Endpoint).To(Equal("https://mcp.example.com/weather"))
+	})
+
+	It("should report missing values", func() {
+		mcpServer := templated("https://mcp.{{ .Values.region }}/weather")
+		err := reconciler.substituteEndpointValues(ctx, mcpServer)
+		var valuesErr *endpointValuesError
+		Expect(errors.As(err, &valuesErr)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("region"))
+	})
+
+	It("should report a missing ConfigMap", func() {
+		mcpServer := templated("https://mcp.{{ .Values.domain }}/weather")
+		mcpServer.Namespace = "no-values"
+		err := reconciler.substituteEndpointValues(ctx, mcpServer)
+		var valuesErr *endpointValuesError
+		Expect(errors.As(err, &valuesErr)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(EndpointValuesConfigMap))
+	})
+
+	It("should report invalid variables", func() {
+		mcpServer := templated("https://mcp.{{ .Values.domain /weather")
+		var valuesErr *endpointValuesError
+		Expect(errors.As(reconciler.substituteEndpointValues(ctx, mcpServer), &valuesErr)).To(BeTrue())
+	})
+
+	It("should reference the ConfigMap from templated endpoints", func() {
+		Expect(referencedObjects(templated("https://mcp.{{ .Values.domain }}/weather"))).To(
+			ContainElement(referenceKey("ConfigMap", "default", EndpointValuesConfigMap)))
+		Expect(referencedObjects(templated("https://mcp.example.com/weather"))).To(BeEmpty())
+	})
+
+	It("should detect changed values through the recorded endpoint", func() {
+		stored := templated("https://mcp.{{ .Values.domain }}/weather")
+		applied := templated("https://mcp.stage.example.com/weather")
+		recordResolvedEndpoint(stored, applied)
+		Expect(stored.Status.ResolvedEndpoint).To(Equal("https://mcp.stage.example.com/weather"))
+
+		resolved := stored.DeepCopy()
+		resolved.Spec.Endpoint = "https://mcp.stage.example.com/weather"
+		Expect(resolvedEndpointChanged(resolved)).To(BeFalse())
+		resolved.Spec.Endpoint = "https://mcp.prod.example.com/weather"
+		Expect(resolvedEndpointChanged(resolved)).To(BeTrue())
+
+		plain := templated("https://mcp.example.com/weather")
+		recordResolvedEndpoint(plain, plain)
+		Expect(plain.Status.ResolvedEndpoint).To(BeEmpty())
+		Expect(resolvedEndpointChanged(plain)).To(BeFalse())
+	})
+})
@@ -0,0 +1,217 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/environment"
+)
+
+const (
+	// EnvironmentLabel on a Namespace selects the environment profile of the MCPServers in it
+	EnvironmentLabel = "mcpgateway.bedrock.aws/environment"
+
+	// ApprovedGenerationAnnotation approves a generation of an MCPServer whose environment
+	// requires approval, e.g. "mcpgateway.bedrock.aws/approved-generation: 4"
+	ApprovedGenerationAnnotation = "mcpgateway.bedrock.aws/approved-generation"
+
+	// approvalPendingCondition reports that a gateway target change awaits approval
+	approvalPendingCondition = "ApprovalPending"
+)
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// EnvironmentReconciler keeps the environment profiles in line with a ConfigMap holding one
+// profile per key. The ConfigMap must carry the WatchLabel to be visible through the operator's cache.
+type EnvironmentReconciler struct {
+	client.Client
+	Environments *environment.Registry
+
+	// ConfigMap is the ConfigMap holding the environment profiles
+	ConfigMap types.NamespacedName
+}
+
+// Reconcile updates the environment profiles from the ConfigMap
+func (r *EnvironmentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return ctrl.Result{}, r.Load(ctx, r.Client)
+}
+
+// Load reads the environment profiles from the ConfigMap through the given reader.
+// It is called once with an uncached reader at startup, before MCPServers are reconciled.
+// Invalid profiles are rejected as a whole, keeping the profiles loaded before.
+func (r *EnvironmentReconciler) Load(ctx context.Context, reader client.Reader) error {
+	log := logf.FromContext(ctx)
+
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, r.ConfigMap, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed to get environments ConfigMap", "configMap", r.ConfigMap)
+			return err
+		}
+		log.Info("Environments ConfigMap not found, no environment profiles apply", "configMap", r.ConfigMap)
+		r.Environments.Set(map[string]environment.Profile{})
+		return nil
+	}
+
+	profiles, err := environment.Parse(configMap.Data)
+	if err != nil {
+		log.Error(err, "Invalid environments ConfigMap, keeping the previous profiles", "configMap", r.ConfigMap)
+		return err
+	}
+	r.Environments.Set(profiles)
+	log.Info("Loaded environment profiles", "configMap", r.ConfigMap, "environments", len(profiles))
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *EnvironmentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	isEnvironmentsConfigMap := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetNamespace() == r.ConfigMap.Namespace && obj.GetName() == r.ConfigMap.Name
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.ConfigMap{}, builder.WithPredicates(isEnvironmentsConfigMap)).
+		Named("environment").
+		Complete(r)
+}
+
+// environmentProfile returns the environment of the namespace of the MCPServer and its profile.
+// The profile is nil if environments are disabled, the namespace has no environment label or the
+// environment has no profile.
+func (r *MCPServerReconciler) environmentProfile(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+) (string, *environment.Profile, error) {
+	if r.Environments == nil {
+		return "", nil, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, types.NamespacedName{Name: mcpServer.Namespace}, namespace); err != nil {
+		return "", nil, client.IgnoreNotFound(err)
+	}
+	name := namespace.Labels[EnvironmentLabel]
+	if name == "" {
+		return "", nil, nil
+	}
+	return name, r.Environments.Lookup(name), nil
+}
+
+// applyEnvironmentProfile applies the profile to the in-memory MCPServer and the reconcile
+// context. The gateway of the environment only applies to MCPServers that name no gateway and
+// have no target yet, so existing targets stay on the gateway they were created on.
+func applyEnvironmentProfile(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	profile *environment.Profile,
+) context.Context {
+	if profile == nil {
+		return ctx
+	}
+	if profile.GatewayID != "" && mcpServer.Spec.GatewayID == "" && mcpServer.Status.GatewayArn == "" {
+		mcpServer.Spec.GatewayID = profile.GatewayID
+	}
+	if profile.Retry != nil {
+		ctx = bedrock.WithContextRetryPolicy(ctx, profile.RetryPolicy(bedrock.DefaultRetryPolicy()))
+	}
+	return ctx
+}
+
+// approvedGeneration returns the generation approved by ApprovedGenerationAnnotation, or 0
+func approvedGeneration(mcpServer *mcpgatewayv1alpha1.MCPServer) int64 {
+	generation, err := strconv.ParseInt(mcpServer.Annotations[ApprovedGenerationAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return generation
+}
+
+// checkApproval holds back the creation of the gateway target, or its update after a spec change,
+// until the current generation is approved if the environment of the MCPServer requires approval.
+// It reports whether the reconcile should stop with the given result. Annotating the MCPServer
+// triggers the next reconcile, so a pending approval is not requeued.
+func (r *MCPServerReconciler) checkApproval(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	environmentName string,
+	profile *environment.Profile,
+	log logr.Logger,
+) (bool, ctrl.Result, error) {
+	changing := mcpServer.Status.TargetID == "" || mcpServer.Generation != mcpServer.Status.ObservedGeneration
+	required := profile != nil && profile.RequireApproval && changing
+
+	if !required || approvedGeneration(mcpServer) == mcpServer.Generation {
+		if meta.IsStatusConditionTrue(mcpServer.Status.Conditions, approvalPendingCondition) {
+			message := fmt.Sprintf("Generation %d approved", mcpServer.Generation)
+			if !required {
+				message = "Approval no longer required"
+			}
+			if err := r.StatusManager.SetApprovalPending(ctx, mcpServer, false, message); err != nil {
+				if apierrors.IsConflict(err) {
+					return true, ctrl.Result{Requeue: true}, nil
+				}
+				return true, ctrl.Result{}, err
+			}
+		}
+		return false, ctrl.Result{}, nil
+	}
+
+	message := fmt.Sprintf("Environment %s requires approval of generation %d: annotate the MCPServer with %s=%d",
+		environmentName, mcpServer.Generation, ApprovedGenerationAnnotation, mcpServer.Generation)
+	log.Info("Gateway target change awaits approval", "environment", environmentName, "generation", mcpServer.Generation)
+	if err := r.StatusManager.SetApprovalPending(ctx, mcpServer, true, message); err != nil {
+		if apierrors.IsConflict(err) {
+			return true, ctrl.Result{Requeue: true}, nil
+		}
+		return true, ctrl.Result{}, err
+	}
+	return true, ctrl.Result{}, nil
+}
+
+// mapNamespaceToMCPServers enqueues every MCPServer in the namespace, whose environment label may
+// have changed
+func (r *MCPServerReconciler) mapNamespaceToMCPServers(ctx context.Context, obj client.Object) []reconcile.Request {
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := r.List(ctx, mcpServers, client.InNamespace(obj.GetName())); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(mcpServers.Items))
+	for _, item := range mcpServers.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: item.Namespace, Name: item.Name},
+		})
+	}
+	return requests
+}
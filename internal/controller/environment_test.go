@@ -0,0 +1,154 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/environment"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Environment profiles", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "test-environment", Namespace: "env-prod"}
+	maxRetries := 5
+
+	var reconciler *MCPServerReconciler
+
+	BeforeEach(func() {
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   key.Namespace,
+			Labels: map[string]string{EnvironmentLabel: "prod"},
+		}}
+		if err := k8sClient.Create(ctx, namespace); !apierrors.IsAlreadyExists(err) {
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		environments := environment.NewRegistry()
+		environments.Set(map[string]environment.Profile{
+			"prod": {
+				GatewayID:       "gw-prod",
+				RequireApproval: true,
+				Retry:           &environment.RetrySpec{MaxRetries: &maxRetries},
+			},
+		})
+		reconciler = &MCPServerReconciler{
+			Client:        k8sClient,
+			Scheme:        k8sClient.Scheme(),
+			StatusManager: status.NewManager(k8sClient),
+			Environments:  environments,
+		}
+
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, mcpServer)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, mcpServer)).To(Succeed())
+	})
+
+	It("should select the profile by the namespace label", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+
+		name, profile, err := reconciler.environmentProfile(ctx, mcpServer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("prod"))
+		Expect(profile).NotTo(BeNil())
+
+		reconciler.Environments = nil
+		_, profile, err = reconciler.environmentProfile(ctx, mcpServer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile).To(BeNil())
+	})
+
+	It("should only default the gateway of MCPServers without one", func() {
+		profile := &environment.Profile{GatewayID: "gw-prod"}
+
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		applyEnvironmentProfile(ctx, mcpServer, profile)
+		Expect(mcpServer.Spec.GatewayID).To(Equal("gw-prod"))
+
+		mcpServer = &mcpgatewayv1alpha1.MCPServer{}
+		mcpServer.Spec.GatewayID = "gw-own"
+		applyEnvironmentProfile(ctx, mcpServer, profile)
+		Expect(mcpServer.Spec.GatewayID).To(Equal("gw-own"))
+
+		mcpServer = &mcpgatewayv1alpha1.MCPServer{}
+		mcpServer.Status.GatewayArn = "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-old"
+		applyEnvironmentProfile(ctx, mcpServer, profile)
+		Expect(mcpServer.Spec.GatewayID).To(BeEmpty())
+	})
+
+	It("should hold back the target until the generation is approved", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+		name, profile, err := reconciler.environmentProfile(ctx, mcpServer)
+		Expect(err).NotTo(HaveOccurred())
+
+		pending, result, err := reconciler.checkApproval(ctx, mcpServer, name, profile, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending).To(BeTrue())
+		Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+		condition := meta.FindStatusCondition(mcpServer.Status.Conditions, approvalPendingCondition)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal("AwaitingApproval"))
+		Expect(condition.Message).To(ContainSubstring(ApprovedGenerationAnnotation))
+
+		By("approving the current generation")
+		mcpServer.Annotations = map[string]string{
+			ApprovedGenerationAnnotation: strconv.FormatInt(mcpServer.Generation, 10),
+		}
+		pending, _, err = reconciler.checkApproval(ctx, mcpServer, name, profile, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending).To(BeFalse())
+		Expect(meta.IsStatusConditionFalse(mcpServer.Status.Conditions, approvalPendingCondition)).To(BeTrue())
+	})
+
+	It("should not require approval to sync an unchanged target", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+		mcpServer.Status.TargetID = "T1"
+		mcpServer.Status.ObservedGeneration = mcpServer.Generation
+
+		pending, _, err := reconciler.checkApproval(ctx, mcpServer, "prod",
+			&environment.Profile{RequireApproval: true}, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending).To(BeFalse())
+	})
+})
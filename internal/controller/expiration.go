@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// expiredCondition reports that the ttl or expiresAt of an MCPServer has passed
+const expiredCondition = "Expired"
+
+// reasonExpired is the Ready reason of MCPServers whose gateway target was deleted on expiration
+const reasonExpired = "Expired"
+
+// expirationDeadline returns when the MCPServer expires, from spec.expiresAt or spec.ttl counted
+// from its creation, and false if it never expires
+func expirationDeadline(mcpServer *mcpgatewayv1alpha1.MCPServer) (time.Time, bool) {
+	switch {
+	case mcpServer.Spec.ExpiresAt != nil:
+		return mcpServer.Spec.ExpiresAt.Time, true
+	case mcpServer.Spec.TTL != nil:
+		return mcpServer.CreationTimestamp.Add(mcpServer.Spec.TTL.Duration), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// validateExpiration validates the expiration fields of the spec
+func validateExpiration(mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	if mcpServer.Spec.TTL != nil && mcpServer.Spec.ExpiresAt != nil {
+		return fmt.Errorf("ttl and expiresAt cannot be combined")
+	}
+	if mcpServer.Spec.TTL != nil && mcpServer.Spec.TTL.Duration <= 0 {
+		return fmt.Errorf("ttl must be positive")
+	}
+	return nil
+}
+
+// checkExpiration deletes the gateway target of an expired MCPServer, or with the DeleteResource
+// policy the MCPServer itself, whose finalizer then deletes the target. It reports true if the
+// reconcile must stop. An MCPServer whose deadline is moved into the future again, e.g. by
+// extending its ttl, gets its gateway target back.
+func (r *MCPServerReconciler) checkExpiration(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) (bool, ctrl.Result, error) {
+	deadline, expires := expirationDeadline(mcpServer)
+	if !expires || time.Now().Before(deadline) {
+		if !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, expiredCondition) {
+			return false, ctrl.Result{}, nil
+		}
+		message := "MCPServer does not expire"
+		if expires {
+			message = fmt.Sprintf("MCPServer expires at %s", deadline.UTC().Format(time.RFC3339))
+		}
+		return false, ctrl.Result{}, r.StatusManager.SetExpired(ctx, mcpServer, false, message)
+	}
+
+	message := fmt.Sprintf("MCPServer expired at %s", deadline.UTC().Format(time.RFC3339))
+	if mcpServer.Spec.ExpirationPolicy == mcpgatewayv1alpha1.ExpirationPolicyDeleteResource {
+		log.Info("MCPServer expired, deleting it", "deadline", deadline)
+		r.recordEvent(mcpServer, corev1.EventTypeNormal, "MCPServerExpired", "Delete", message)
+		return true, ctrl.Result{}, client.IgnoreNotFound(r.Delete(ctx, mcpServer))
+	}
+
+	if mcpServer.Status.TargetID == "" && meta.IsStatusConditionTrue(mcpServer.Status.Conditions, expiredCondition) {
+		return true, ctrl.Result{}, nil
+	}
+
+	log.Info("MCPServer expired, removing gateway target", "deadline", deadline, "targetId", mcpServer.Status.TargetID)
+	if err := r.deleteGatewayTarget(ctx, mcpServer, log); err != nil {
+		return true, ctrl.Result{}, err
+	}
+	if err := r.StatusManager.UpdateTargetRemoved(ctx, mcpServer); err != nil {
+		return true, ctrl.Result{}, err
+	}
+	if err := r.StatusManager.SetExpired(ctx, mcpServer, true, message+", the gateway target was deleted"); err != nil {
+		return true, ctrl.Result{}, err
+	}
+	r.recordEvent(mcpServer, corev1.EventTypeNormal, "TargetExpired", "Delete", message)
+	return true, ctrl.Result{}, r.StatusManager.SetError(ctx, mcpServer, reasonExpired, message)
+}
+
+// requeueAtExpiration makes sure the MCPServer is reconciled again when it expires, unless the
+// reconcile already comes back earlier or failed
+func requeueAtExpiration(mcpServer *mcpgatewayv1alpha1.MCPServer, result ctrl.Result, err error) ctrl.Result {
+	if err != nil || result.Requeue || mcpServer == nil || !mcpServer.DeletionTimestamp.IsZero() {
+		return result
+	}
+	deadline, expires := expirationDeadline(mcpServer)
+	if !expires {
+		return result
+	}
+	remaining := time.Until(deadline)
+	if remaining > 0 && (result.RequeueAfter == 0 || remaining < result.RequeueAfter) {
+		result.RequeueAfter = remaining
+	}
+	return result
+}
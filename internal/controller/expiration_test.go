@@ -0,0 +1,113 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Expiration", func() {
+	ctx := context.Background()
+	log := logf.Log
+
+	var reconciler *MCPServerReconciler
+	var mcpServer *mcpgatewayv1alpha1.MCPServer
+
+	BeforeEach(func() {
+		reconciler = &MCPServerReconciler{
+			Client:        k8sClient,
+			Scheme:        k8sClient.Scheme(),
+			StatusManager: status.NewManager(k8sClient),
+		}
+		mcpServer = &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "preview-42", Namespace: "default"},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://pr-42.preview.example.com",
+				Capabilities: []string{"tools"},
+				ExpiresAt:    &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			},
+		}
+		Expect(k8sClient.Create(ctx, mcpServer)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, mcpServer))).To(Succeed())
+	})
+
+	It("should compute the deadline from expiresAt or the ttl", func() {
+		created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		m := &mcpgatewayv1alpha1.MCPServer{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}}
+		_, expires := expirationDeadline(m)
+		Expect(expires).To(BeFalse())
+
+		m.Spec.TTL = &metav1.Duration{Duration: 24 * time.Hour}
+		deadline, expires := expirationDeadline(m)
+		Expect(expires).To(BeTrue())
+		Expect(deadline).To(Equal(created.Add(24 * time.Hour)))
+		Expect(validateExpiration(m)).To(Succeed())
+
+		m.Spec.ExpiresAt = &metav1.Time{Time: created.Add(time.Hour)}
+		Expect(validateExpiration(m)).To(MatchError(ContainSubstring("cannot be combined")))
+	})
+
+	It("should requeue at the deadline unless the reconcile comes back earlier", func() {
+		m := &mcpgatewayv1alpha1.MCPServer{Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			ExpiresAt: &metav1.Time{Time: time.Now().Add(time.Hour)},
+		}}
+		Expect(requeueAtExpiration(m, ctrl.Result{}, nil).RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+		Expect(requeueAtExpiration(m, ctrl.Result{RequeueAfter: time.Minute}, nil).RequeueAfter).To(Equal(time.Minute))
+		Expect(requeueAtExpiration(m, ctrl.Result{Requeue: true}, nil).RequeueAfter).To(BeZero())
+	})
+
+	It("should remove the gateway target once expired and restore it when extended", func() {
+		expired, _, err := reconciler.checkExpiration(ctx, mcpServer, log)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expired).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(mcpServer.Status.Conditions, expiredCondition)).To(BeTrue())
+		Expect(meta.FindStatusCondition(mcpServer.Status.Conditions, "Ready").Reason).To(Equal(reasonExpired))
+
+		By("extending the deadline")
+		mcpServer.Spec.ExpiresAt = &metav1.Time{Time: time.Now().Add(time.Hour)}
+		expired, _, err = reconciler.checkExpiration(ctx, mcpServer, log)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expired).To(BeFalse())
+		Expect(meta.IsStatusConditionFalse(mcpServer.Status.Conditions, expiredCondition)).To(BeTrue())
+	})
+
+	It("should delete the MCPServer with the DeleteResource policy", func() {
+		mcpServer.Spec.ExpirationPolicy = mcpgatewayv1alpha1.ExpirationPolicyDeleteResource
+		expired, _, err := reconciler.checkExpiration(ctx, mcpServer, log)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expired).To(BeTrue())
+
+		err = k8sClient.Get(ctx, client.ObjectKeyFromObject(mcpServer), &mcpgatewayv1alpha1.MCPServer{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Feature gates that can be set with the --feature-gates flag
+const (
+	// FeatureCredentialProviderExtensions passes spec.credentialProviderExtensions through to AWS
+	FeatureCredentialProviderExtensions = "CredentialProviderExtensions"
+	// FeatureGatewayAPI resolves spec.httpRouteRef through Gateway API HTTPRoutes and Gateways.
+	// The Gateway API CRDs must be installed.
+	FeatureGatewayAPI = "GatewayAPI"
+	// FeatureSecretReferences lets MCPServers reference Secrets, e.g. spec.probe.tls.caSecretRef,
+	// and watches them. Disabling it drops the Secret permissions of the mcpserver controller.
+	FeatureSecretReferences = "SecretReferences"
+)
+
+// defaultFeatureGates are the known feature gates and whether they are enabled by default
+var defaultFeatureGates = map[string]bool{
+	FeatureCredentialProviderExtensions: false,
+	FeatureGatewayAPI:                   false,
+	FeatureSecretReferences:             true,
+}
+
+// KnownFeatureGates lists every feature gate of the operator
+var KnownFeatureGates = slices.Sorted(maps.Keys(defaultFeatureGates))
+
+// FeatureGates are the enabled and disabled features of the operator
+type FeatureGates map[string]bool
+
+// ParseFeatureGates parses a --feature-gates value, a comma-separated list of <feature>=<bool>
+// pairs such as "CredentialProviderExtensions=true". Features that are not listed keep their
+// default.
+func ParseFeatureGates(value string) (FeatureGates, error) {
+	gates := FeatureGates(maps.Clone(defaultFeatureGates))
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, setting, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, fmt.Errorf("feature gate %q must be set as <feature>=true|false", entry)
+		}
+		name = strings.TrimSpace(name)
+		if _, known := defaultFeatureGates[name]; !known {
+			return nil, fmt.Errorf("unknown feature gate %q, known feature gates are %s",
+				name, strings.Join(KnownFeatureGates, ", "))
+		}
+		enabled, err := strconv.ParseBool(strings.TrimSpace(setting))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of feature gate %s: %w", name, err)
+		}
+		gates[name] = enabled
+	}
+	return gates, nil
+}
+
+// Enabled reports whether the feature is enabled. A nil FeatureGates uses the defaults.
+func (g FeatureGates) Enabled(feature string) bool {
+	if g == nil {
+		return defaultFeatureGates[feature]
+	}
+	return g[feature]
+}
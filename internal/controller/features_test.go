@@ -0,0 +1,90 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Feature gates", func() {
+	DescribeTable("parsing --feature-gates",
+		func(value string, want bool) {
+			gates, err := ParseFeatureGates(value)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gates.Enabled(FeatureCredentialProviderExtensions)).To(Equal(want))
+		},
+		Entry("default", "", false),
+		Entry("enabled", "CredentialProviderExtensions=true", true),
+		Entry("disabled", " CredentialProviderExtensions = false ", false),
+	)
+
+	It("should reject unknown feature gates and malformed values", func() {
+		_, err := ParseFeatureGates("Teleport=true")
+		Expect(err).To(MatchError(ContainSubstring(`unknown feature gate "Teleport"`)))
+		_, err = ParseFeatureGates("CredentialProviderExtensions")
+		Expect(err).To(MatchError(ContainSubstring("<feature>=true|false")))
+		_, err = ParseFeatureGates("CredentialProviderExtensions=maybe")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should only accept credential provider extensions behind their feature gate", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		mcpServer.Spec.CredentialProviderExtensions = []runtime.RawExtension{
+			{Raw: []byte(`{"credentialProvider":{"oauthCredentialProvider":{"customParameters":{"audience":"mcp"}}}}`)},
+		}
+
+		reconciler := &MCPServerReconciler{}
+		Expect(reconciler.validateCredentialProviderExtensions(mcpServer)).To(
+			MatchError(ContainSubstring("--feature-gates=CredentialProviderExtensions=true")))
+
+		reconciler.FeatureGates = FeatureGates{FeatureCredentialProviderExtensions: true}
+		Expect(reconciler.validateCredentialProviderExtensions(mcpServer)).To(Succeed())
+
+		mcpServer.Spec.CredentialProviderExtensions = append(mcpServer.Spec.CredentialProviderExtensions,
+			runtime.RawExtension{Raw: []byte(`"GATEWAY_IAM_ROLE"`)})
+		Expect(reconciler.validateCredentialProviderExtensions(mcpServer)).To(
+			MatchError(ContainSubstring("credentialProviderExtensions[1] must be a JSON object")))
+	})
+
+	It("should only read CA Secrets behind their feature gate", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		mcpServer.Spec.Probe = &mcpgatewayv1alpha1.ProbeSpec{TLS: &mcpgatewayv1alpha1.ProbeTLSSpec{
+			CASecretRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "internal-ca"},
+				Key:                  "ca.crt",
+			},
+		}}
+
+		Expect(FeatureGates(nil).Enabled(FeatureSecretReferences)).To(BeTrue())
+		reconciler := &MCPServerReconciler{FeatureGates: FeatureGates{FeatureSecretReferences: false}}
+		_, err := reconciler.probeTLSOptions(context.Background(), mcpServer)
+		Expect(err).To(MatchError(ContainSubstring("--feature-gates=SecretReferences=true")))
+
+		// Skipping verification needs no Secret
+		mcpServer.Spec.Probe.TLS.InsecureSkipVerify = true
+		opts, err := reconciler.probeTLSOptions(context.Background(), mcpServer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opts.InsecureSkipVerify).To(BeTrue())
+	})
+})
@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+const (
+	// gatewayTargetFinalizer keeps an MCPServer until its gateway target was deleted
+	gatewayTargetFinalizer = "mcpgateway.bedrock.aws/gateway-target-finalizer"
+
+	// legacyGatewayTargetFinalizer is the finalizer written by operator versions before it moved
+	// into the domain of the CRD group. It is replaced by gatewayTargetFinalizer on the next
+	// reconcile and honoured until then, so MCPServers deleted during an upgrade still delete
+	// their gateway target. Those versions do not remove gatewayTargetFinalizer, so rolling back to
+	// them takes the manual step described in the upgrade notes of the README.
+	legacyGatewayTargetFinalizer = "bedrock.aws/gateway-target-finalizer"
+)
+
+// hasGatewayTargetFinalizer reports whether the MCPServer carries the finalizer or its legacy name
+func hasGatewayTargetFinalizer(mcpServer *mcpgatewayv1alpha1.MCPServer) bool {
+	return controllerutil.ContainsFinalizer(mcpServer, gatewayTargetFinalizer) ||
+		controllerutil.ContainsFinalizer(mcpServer, legacyGatewayTargetFinalizer)
+}
+
+// ensureGatewayTargetFinalizer adds the finalizer to the MCPServer, replacing the legacy finalizer
+// in the same patch. Finalizers are patched rather than updated so the operator never claims
+// ownership of spec fields written by users or generators through server-side apply.
+func (r *MCPServerReconciler) ensureGatewayTargetFinalizer(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) error {
+	legacy := controllerutil.ContainsFinalizer(mcpServer, legacyGatewayTargetFinalizer)
+	if controllerutil.ContainsFinalizer(mcpServer, gatewayTargetFinalizer) && !legacy {
+		return nil
+	}
+
+	patch := client.MergeFrom(mcpServer.DeepCopy())
+	controllerutil.RemoveFinalizer(mcpServer, legacyGatewayTargetFinalizer)
+	controllerutil.AddFinalizer(mcpServer, gatewayTargetFinalizer)
+	if err := r.Patch(ctx, mcpServer, patch); err != nil {
+		return err
+	}
+	if legacy {
+		log.Info("Replaced legacy finalizer of MCPServer", "legacy", legacyGatewayTargetFinalizer,
+			"finalizer", gatewayTargetFinalizer)
+	} else {
+		log.Info("Added finalizer to MCPServer")
+	}
+	return nil
+}
+
+// removeGatewayTargetFinalizer removes the finalizer and its legacy name from the MCPServer
+func removeGatewayTargetFinalizer(mcpServer *mcpgatewayv1alpha1.MCPServer) {
+	controllerutil.RemoveFinalizer(mcpServer, gatewayTargetFinalizer)
+	controllerutil.RemoveFinalizer(mcpServer, legacyGatewayTargetFinalizer)
+}
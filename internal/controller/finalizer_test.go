@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Legacy gateway target finalizer", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	newMCPServer := func(finalizers ...string) *mcpgatewayv1alpha1.MCPServer {
+		return &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       key.Name,
+				Namespace:  key.Namespace,
+				Finalizers: finalizers,
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://weather.example.com/mcp",
+				Capabilities: []string{"tools"},
+			},
+		}
+	}
+
+	It("should replace the legacy finalizer and keep foreign ones", func() {
+		h := newReconcileHarness(newMCPServer(legacyGatewayTargetFinalizer, "example.com/backup"))
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.get(ctx, key).Finalizers).To(Equal([]string{"example.com/backup", gatewayTargetFinalizer}))
+		Expect(h.get(ctx, key).Status.TargetID).To(Equal("TARGET1"))
+	})
+
+	It("should delete the gateway target of an MCPServer deleted with the legacy finalizer", func() {
+		h := newReconcileHarness(newMCPServer())
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("deleting an MCPServer finalized by an earlier operator version")
+		legacy := h.get(ctx, key)
+		legacy.Finalizers = []string{legacyGatewayTargetFinalizer}
+		Expect(h.client.Update(ctx, legacy)).To(Succeed())
+		Expect(h.client.Delete(ctx, legacy)).To(Succeed())
+
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("DeleteGatewayTarget")).To(Equal(1))
+		Expect(h.agentCore.targetIDs()).To(BeEmpty())
+		Expect(apierrors.IsNotFound(h.client.Get(ctx, key, &mcpgatewayv1alpha1.MCPServer{}))).To(BeTrue())
+	})
+})
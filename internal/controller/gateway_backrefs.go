@@ -0,0 +1,117 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// gatewayIndexField indexes MCPServers by the canonical ID of the gateway their target was created on
+const gatewayIndexField = ".status.gatewayId"
+
+// indexGateway is the field indexer for gatewayIndexField
+func indexGateway(obj client.Object) []string {
+	mcpServer, ok := obj.(*mcpgatewayv1alpha1.MCPServer)
+	if !ok || mcpServer.Status.GatewayID == "" {
+		return nil
+	}
+	return []string{mcpServer.Status.GatewayID}
+}
+
+// setBoundMCPServers records every MCPServer bound to the stack gateway in the stack status
+func (r *AgentCoreStackReconciler) setBoundMCPServers(ctx context.Context, stack *mcpgatewayv1alpha1.AgentCoreStack) error {
+	if stack.Status.GatewayID == "" {
+		stack.Status.MCPServers = nil
+		return nil
+	}
+
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := r.List(ctx, mcpServers, client.MatchingFields{gatewayIndexField: stack.Status.GatewayID}); err != nil {
+		return err
+	}
+	stack.Status.MCPServers = gatewayMCPServerReferences(mcpServers.Items)
+	return nil
+}
+
+// gatewayMCPServerReferences returns references to the MCPServers, sorted by namespace and name
+func gatewayMCPServerReferences(mcpServers []mcpgatewayv1alpha1.MCPServer) []mcpgatewayv1alpha1.GatewayMCPServerReference {
+	if len(mcpServers) == 0 {
+		return nil
+	}
+
+	refs := make([]mcpgatewayv1alpha1.GatewayMCPServerReference, 0, len(mcpServers))
+	for _, mcpServer := range mcpServers {
+		refs = append(refs, mcpgatewayv1alpha1.GatewayMCPServerReference{
+			Namespace: mcpServer.Namespace,
+			Name:      mcpServer.Name,
+			TargetID:  mcpServer.Status.TargetID,
+			Ready:     meta.IsStatusConditionTrue(mcpServer.Status.Conditions, "Ready"),
+		})
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Namespace != refs[j].Namespace {
+			return refs[i].Namespace < refs[j].Namespace
+		}
+		return refs[i].Name < refs[j].Name
+	})
+	return refs
+}
+
+// mapMCPServerToStacks enqueues the stacks whose gateway the MCPServer is bound to, and the stacks
+// still listing it, so that MCPServers moving to another gateway or being deleted are dropped
+func (r *AgentCoreStackReconciler) mapMCPServerToStacks(ctx context.Context, obj client.Object) []reconcile.Request {
+	mcpServer, ok := obj.(*mcpgatewayv1alpha1.MCPServer)
+	if !ok {
+		return nil
+	}
+
+	stacks := &mcpgatewayv1alpha1.AgentCoreStackList{}
+	if err := r.List(ctx, stacks); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, stack := range stacks.Items {
+		if stack.Status.GatewayID == "" {
+			continue
+		}
+		if stack.Status.GatewayID == mcpServer.Status.GatewayID || listsMCPServer(&stack, mcpServer) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: stack.Namespace, Name: stack.Name},
+			})
+		}
+	}
+	return requests
+}
+
+// listsMCPServer reports whether the stack status refers to the MCPServer
+func listsMCPServer(stack *mcpgatewayv1alpha1.AgentCoreStack, mcpServer *mcpgatewayv1alpha1.MCPServer) bool {
+	for _, ref := range stack.Status.MCPServers {
+		if ref.Namespace == mcpServer.Namespace && ref.Name == mcpServer.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Gateway backreferences", func() {
+	boundMCPServer := func(namespace, name, targetID string, ready bool) mcpgatewayv1alpha1.MCPServer {
+		status := metav1.ConditionFalse
+		if ready {
+			status = metav1.ConditionTrue
+		}
+		return mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status: mcpgatewayv1alpha1.MCPServerStatus{
+				GatewayID: "gw-1",
+				TargetID:  targetID,
+				Conditions: []metav1.Condition{
+					{Type: "Ready", Status: status, Reason: "Test"},
+				},
+			},
+		}
+	}
+
+	It("should index MCPServers by the gateway of their target", func() {
+		mcpServer := boundMCPServer("default", "weather", "T1", true)
+		Expect(indexGateway(&mcpServer)).To(Equal([]string{"gw-1"}))
+
+		mcpServer.Status.GatewayID = ""
+		Expect(indexGateway(&mcpServer)).To(BeEmpty())
+	})
+
+	It("should sort the references by namespace and name", func() {
+		refs := gatewayMCPServerReferences([]mcpgatewayv1alpha1.MCPServer{
+			boundMCPServer("team-b", "search", "T3", true),
+			boundMCPServer("team-a", "weather", "T2", false),
+			boundMCPServer("team-a", "maps", "", false),
+		})
+		Expect(refs).To(Equal([]mcpgatewayv1alpha1.GatewayMCPServerReference{
+			{Namespace: "team-a", Name: "maps"},
+			{Namespace: "team-a", Name: "weather", TargetID: "T2"},
+			{Namespace: "team-b", Name: "search", TargetID: "T3", Ready: true},
+		}))
+		Expect(gatewayMCPServerReferences(nil)).To(BeNil())
+	})
+
+	Context("When an MCPServer changes", func() {
+		ctx := context.Background()
+		key := types.NamespacedName{Name: "test-backrefs", Namespace: "default"}
+
+		BeforeEach(func() {
+			stack := &mcpgatewayv1alpha1.AgentCoreStack{
+				ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+				Spec: mcpgatewayv1alpha1.AgentCoreStackSpec{
+					Gateway: mcpgatewayv1alpha1.StackGatewaySpec{
+						Name:    "test-gateway",
+						RoleArn: "arn:aws:iam::123456789012:role/agentcore-gateway-role",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, stack)).To(Succeed())
+			stack.Status.GatewayID = "gw-1"
+			stack.Status.MCPServers = []mcpgatewayv1alpha1.GatewayMCPServerReference{
+				{Namespace: "team-a", Name: "moved"},
+			}
+			Expect(k8sClient.Status().Update(ctx, stack)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			stack := &mcpgatewayv1alpha1.AgentCoreStack{}
+			Expect(k8sClient.Get(ctx, key, stack)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, stack)).To(Succeed())
+		})
+
+		It("should enqueue the stacks of its gateway and the stacks listing it", func() {
+			reconciler := &AgentCoreStackReconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+			expected := []reconcile.Request{{NamespacedName: key}}
+
+			bound := boundMCPServer("team-b", "search", "T3", true)
+			Expect(reconciler.mapMCPServerToStacks(ctx, &bound)).To(Equal(expected))
+
+			moved := boundMCPServer("team-a", "moved", "T4", true)
+			moved.Status.GatewayID = "gw-2"
+			Expect(reconciler.mapMCPServerToStacks(ctx, &moved)).To(Equal(expected))
+
+			unrelated := boundMCPServer("team-a", "other", "T5", true)
+			unrelated.Status.GatewayID = "gw-2"
+			Expect(reconciler.mapMCPServerToStacks(ctx, &unrelated)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/environment"
+)
+
+// gatewayDeletedCondition is the condition reporting that the gateway of the gateway target was
+// deleted from AWS outside of the operator
+const gatewayDeletedCondition = "GatewayDeleted"
+
+// reasonGatewayDeleted is the reason of the Ready condition of an MCPServer whose gateway was deleted
+const reasonGatewayDeleted = "GatewayDeleted"
+
+// Policies for MCPServers whose gateway was deleted
+const (
+	// GatewayDeletedPolicyOrphan stops calling AWS for the targets of the deleted gateway. They are
+	// only created again if the spec of an MCPServer names another gateway.
+	GatewayDeletedPolicyOrphan = "orphan"
+	// GatewayDeletedPolicyRecreate additionally creates the targets of MCPServers that name no
+	// gateway on the gateway of their environment or the default gateway, once it is another one
+	GatewayDeletedPolicyRecreate = "recreate"
+)
+
+// isGatewayDeleted reports whether err, returned by a call for a gateway target on gatewayID, was
+// caused by the deletion of the gateway. A missing target is confirmed to be a missing gateway with
+// GetGateway, as targets are also deleted on their own. A cached gateway is not proof that it
+// still exists, so the cache is bypassed.
+func isGatewayDeleted(ctx context.Context, bedrockWrapper *bedrock.BedrockClientWrapper, gatewayID string, err error) bool {
+	if !bedrock.IsResourceNotFoundError(err) {
+		return false
+	}
+	bedrockWrapper.InvalidateGateway(gatewayID)
+	_, err = bedrockWrapper.GetGateway(ctx, gatewayID)
+	return bedrock.IsResourceNotFoundError(err)
+}
+
+// handleGatewayDeleted marks the MCPServer and every other MCPServer with a target on the deleted
+// gateway GatewayDeleted, so that none of them calls AWS for its target again
+func (r *MCPServerReconciler) handleGatewayDeleted(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	gatewayID string,
+	log logr.Logger,
+) (ctrl.Result, error) {
+	log.Info("Gateway was deleted from AWS, no longer calling AWS for its targets", "gatewayId", gatewayID)
+	if err := r.markGatewayDeleted(ctx, mcpServer, gatewayID); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := r.List(ctx, mcpServers); err != nil {
+		return ctrl.Result{}, err
+	}
+	for i := range mcpServers.Items {
+		dependent := &mcpServers.Items[i]
+		if dependent.UID == mcpServer.UID || dependent.Status.GatewayID != gatewayID ||
+			meta.IsStatusConditionTrue(dependent.Status.Conditions, gatewayDeletedCondition) {
+			continue
+		}
+		// Dependents that fail to be marked find out on their own next call to AWS
+		if err := r.markGatewayDeleted(ctx, dependent, gatewayID); err != nil {
+			log.Error(err, "Failed to mark MCPServer GatewayDeleted", "namespace", dependent.Namespace,
+				"name", dependent.Name)
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+// markGatewayDeleted reports the deleted gateway in the GatewayDeleted and Ready conditions
+func (r *MCPServerReconciler) markGatewayDeleted(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, gatewayID string) error {
+	message := fmt.Sprintf("Gateway %s was deleted from AWS; the gateway target is no longer synchronized "+
+		"and the MCPServer can be deleted without deleting it", gatewayID)
+	if err := r.StatusManager.SetGatewayDeleted(ctx, mcpServer, true, message); err != nil {
+		return err
+	}
+	return r.StatusManager.SetError(ctx, mcpServer, reasonGatewayDeleted, message)
+}
+
+// checkGatewayDeleted keeps an MCPServer whose gateway was deleted from calling AWS. If the MCPServer
+// resolves to a replacement gateway, its target and gateway are cleared from the status so that the
+// next reconcile creates the target there. A spec naming another gateway always counts as a
+// replacement; the gateway of the environment or the default gateway only with the recreate policy.
+// It reports true if the reconcile must stop.
+func (r *MCPServerReconciler) checkGatewayDeleted(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	profile *environment.Profile,
+	log logr.Logger,
+) (bool, ctrl.Result, error) {
+	if !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, gatewayDeletedCondition) {
+		return false, ctrl.Result{}, nil
+	}
+
+	deleted := mcpServer.Status.GatewayID
+	replacement := r.replacementGateway(mcpServer, profile)
+	if replacement == "" || replacement == deleted ||
+		(mcpServer.Spec.GatewayID == "" && r.GatewayDeletedPolicy != GatewayDeletedPolicyRecreate) {
+		log.V(1).Info("Gateway was deleted, not calling AWS", "gatewayId", deleted)
+		return true, ctrl.Result{}, nil
+	}
+
+	log.Info("Moving gateway target to replacement gateway", "deletedGatewayId", deleted, "gatewayId", replacement)
+	if err := r.StatusManager.UpdateGatewayRemoved(ctx, mcpServer); err != nil {
+		return true, ctrl.Result{}, err
+	}
+	if err := r.StatusManager.SetGatewayDeleted(ctx, mcpServer, false,
+		fmt.Sprintf("Gateway %s was deleted, the gateway target is created on gateway %s", deleted, replacement)); err != nil {
+		return true, ctrl.Result{}, err
+	}
+	return true, ctrl.Result{Requeue: true}, nil
+}
+
+// replacementGateway returns the gateway the MCPServer resolves to without the gateway recorded in
+// its status, or "" if it resolves to none
+func (r *MCPServerReconciler) replacementGateway(mcpServer *mcpgatewayv1alpha1.MCPServer, profile *environment.Profile) string {
+	candidate := mcpServer.DeepCopy()
+	candidate.Status.GatewayArn = ""
+	if candidate.Spec.GatewayID == "" && profile != nil {
+		candidate.Spec.GatewayID = profile.GatewayID
+	}
+	gatewayID, err := r.ConfigParser.GetGatewayID(candidate)
+	if err != nil {
+		return ""
+	}
+	return gatewayID
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+	"github.com/aws/mcp-gateway-operator/pkg/environment"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Deleted gateways", func() {
+	ctx := context.Background()
+	const deletedArn = "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-gone"
+	keys := []types.NamespacedName{
+		{Name: "test-gateway-deleted-a", Namespace: "default"},
+		{Name: "test-gateway-deleted-b", Namespace: "default"},
+	}
+
+	var reconciler *MCPServerReconciler
+
+	BeforeEach(func() {
+		reconciler = &MCPServerReconciler{
+			Client:        k8sClient,
+			Scheme:        k8sClient.Scheme(),
+			ConfigParser:  config.NewConfigParser("gw-gone"),
+			StatusManager: status.NewManager(k8sClient),
+		}
+
+		for _, key := range keys {
+			mcpServer := &mcpgatewayv1alpha1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+				Spec: mcpgatewayv1alpha1.MCPServerSpec{
+					Endpoint:     "https://mcp.example.com",
+					Capabilities: []string{"tools"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, mcpServer)).To(Succeed())
+			mcpServer.Status.TargetID = "T-" + key.Name
+			mcpServer.Status.GatewayArn = deletedArn
+			mcpServer.Status.GatewayID = "gw-gone"
+			mcpServer.Status.TargetStatus = "READY"
+			Expect(k8sClient.Status().Update(ctx, mcpServer)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		for _, key := range keys {
+			mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+			Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, mcpServer)).To(Succeed())
+		}
+	})
+
+	It("should mark every MCPServer with a target on the gateway", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, keys[0], mcpServer)).To(Succeed())
+
+		result, err := reconciler.handleGatewayDeleted(ctx, mcpServer, "gw-gone", logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Requeue).To(BeFalse())
+
+		for _, key := range keys {
+			marked := &mcpgatewayv1alpha1.MCPServer{}
+			Expect(k8sClient.Get(ctx, key, marked)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(marked.Status.Conditions, gatewayDeletedCondition)).To(BeTrue())
+			ready := meta.FindStatusCondition(marked.Status.Conditions, "Ready")
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Reason).To(Equal(reasonGatewayDeleted))
+		}
+	})
+
+	It("should not call AWS until there is a replacement gateway", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, keys[0], mcpServer)).To(Succeed())
+		Expect(reconciler.markGatewayDeleted(ctx, mcpServer, "gw-gone")).To(Succeed())
+
+		By("orphaning targets of MCPServers without spec.gatewayId")
+		reconciler.ConfigParser = config.NewConfigParser("gw-new")
+		deleted, result, err := reconciler.checkGatewayDeleted(ctx, mcpServer, nil, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+		Expect(result.Requeue).To(BeFalse())
+		Expect(mcpServer.Status.TargetID).NotTo(BeEmpty())
+
+		By("recreating them on the gateway of the environment with the recreate policy")
+		reconciler.GatewayDeletedPolicy = GatewayDeletedPolicyRecreate
+		deleted, result, err = reconciler.checkGatewayDeleted(ctx, mcpServer,
+			&environment.Profile{GatewayID: "gw-env"}, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+		Expect(result.Requeue).To(BeTrue())
+		Expect(mcpServer.Status.TargetID).To(BeEmpty())
+		Expect(mcpServer.Status.GatewayArn).To(BeEmpty())
+		Expect(meta.IsStatusConditionFalse(mcpServer.Status.Conditions, gatewayDeletedCondition)).To(BeTrue())
+
+		deleted, _, err = reconciler.checkGatewayDeleted(ctx, mcpServer, nil, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+	})
+
+	It("should wait while spec.gatewayId names the deleted gateway", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, keys[1], mcpServer)).To(Succeed())
+		Expect(reconciler.markGatewayDeleted(ctx, mcpServer, "gw-gone")).To(Succeed())
+		reconciler.GatewayDeletedPolicy = GatewayDeletedPolicyRecreate
+
+		mcpServer.Spec.GatewayID = "gw-gone"
+		deleted, result, err := reconciler.checkGatewayDeleted(ctx, mcpServer, nil, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+		Expect(result.Requeue).To(BeFalse())
+
+		By("moving to the gateway the spec names instead")
+		mcpServer.Spec.GatewayID = "gw-new"
+		reconciler.GatewayDeletedPolicy = GatewayDeletedPolicyOrphan
+		deleted, result, err = reconciler.checkGatewayDeleted(ctx, mcpServer, nil, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+		Expect(result.Requeue).To(BeTrue())
+		Expect(mcpServer.Status.TargetID).To(BeEmpty())
+	})
+})
@@ -0,0 +1,496 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+// errSimulatedCrash is returned by phase hooks that end a reconcile as if the operator crashed
+var errSimulatedCrash = errors.New("simulated crash")
+
+// fakeGatewayTarget is a gateway target held by fakeAgentCore
+type fakeGatewayTarget struct {
+	id          string
+	gatewayID   string
+	name        string
+	endpoint    string
+	description string
+	credentials json.RawMessage
+	updatedAt   time.Time
+
+	// status is the status of the target, READY if empty
+	status string
+}
+
+// fakeTargetInput is the body of the create and update requests of a gateway target
+type fakeTargetInput struct {
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	ClientToken         string `json:"clientToken"`
+	TargetConfiguration struct {
+		Mcp struct {
+			McpServer struct {
+				Endpoint string `json:"endpoint"`
+			} `json:"mcpServer"`
+		} `json:"mcp"`
+	} `json:"targetConfiguration"`
+	CredentialProviderConfigurations json.RawMessage `json:"credentialProviderConfigurations"`
+}
+
+// fakeAgentCore serves the gateway and gateway target API of the AgentCore control plane from
+// memory, so that reconciles run against the real SDK client. Like AWS, it returns the target
+// created earlier when a create is retried with the same client token.
+type fakeAgentCore struct {
+	server *httptest.Server
+
+	mu      sync.Mutex
+	targets map[string]*fakeGatewayTarget
+	tokens  map[string]string
+	calls   map[string]int
+	nextID  int
+
+	// hidden counts the reads for which a target is not found yet, like AWS right after a write
+	hidden map[string]int
+	// deleting counts the reads for which a deleted target is still DELETING
+	deleting map[string]int
+	// deleteFails holds the targets whose deletion fails once they are no longer DELETING
+	deleteFails map[string]bool
+	// denied holds the target operations that fail with AccessDeniedException
+	denied map[string]bool
+}
+
+// newFakeAgentCore starts a fakeAgentCore. It is stopped when the current spec ends.
+func newFakeAgentCore() *fakeAgentCore {
+	f := &fakeAgentCore{
+		targets: map[string]*fakeGatewayTarget{},
+		tokens:  map[string]string{},
+		calls:   map[string]int{},
+		hidden:  map[string]int{},
+
+		deleting:    map[string]int{},
+		deleteFails: map[string]bool{},
+		denied:      map[string]bool{},
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	DeferCleanup(f.server.Close)
+	return f
+}
+
+// client returns an SDK client calling the fake
+func (f *fakeAgentCore) client() *bedrockagentcorecontrol.Client {
+	return bedrockagentcorecontrol.New(bedrockagentcorecontrol.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(f.server.URL),
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+		}),
+	})
+}
+
+// addTarget adds a target created outside of the operator and returns its ID
+func (f *fakeAgentCore) addTarget(gatewayID, name, endpoint string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextID++
+	id := fmt.Sprintf("TARGET%d", f.nextID)
+	f.targets[id] = &fakeGatewayTarget{id: id, gatewayID: gatewayID, name: name, endpoint: endpoint, updatedAt: time.Now()}
+	return id
+}
+
+// editTarget changes the endpoint of a target outside of the operator, like an edit in the console
+func (f *fakeAgentCore) editTarget(id, endpoint string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.targets[id].endpoint = endpoint
+	f.targets[id].updatedAt = time.Now().Add(time.Second)
+}
+
+// hideTarget makes the next reads of a target return not found
+func (f *fakeAgentCore) hideTarget(id string, reads int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.hidden[id] = reads
+}
+
+// deleteAsync makes AWS delete a target asynchronously: it stays DELETING for the given number
+// of reads, and is then gone or, if fails is set, FAILED
+func (f *fakeAgentCore) deleteAsync(id string, reads int, fails bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleting[id] = reads
+	f.deleteFails[id] = fails
+}
+
+// deny makes calls of the target operation fail with AccessDeniedException, or succeed again
+func (f *fakeAgentCore) deny(operation string, denied bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.denied[operation] = denied
+}
+
+// endpointOf returns the endpoint of a target
+func (f *fakeAgentCore) endpointOf(id string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.targets[id].endpoint
+}
+
+// callCount returns how often the operation was called
+func (f *fakeAgentCore) callCount(operation string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[operation]
+}
+
+// targetIDs returns the IDs of the targets that exist
+func (f *fakeAgentCore) targetIDs() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ids := make([]string, 0, len(f.targets))
+	for id := range f.targets {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (f *fakeAgentCore) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Paths are /gateways/{gatewayIdentifier}/ and /gateways/{gatewayIdentifier}/targets/{targetId}/
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		f.calls["GetGateway"]++
+		f.write(w, http.StatusOK, map[string]any{
+			"gatewayId":    parts[1],
+			"gatewayArn":   f.gatewayArn(parts[1]),
+			"name":         parts[1],
+			"status":       "READY",
+			"protocolType": "MCP",
+			"roleArn":      "arn:aws:iam::123456789012:role/gateway",
+			"workloadIdentityDetails": map[string]any{
+				"workloadIdentityArn": "arn:aws:bedrock-agentcore:us-east-1:123456789012:workload-identity/gateway",
+			},
+		})
+	case len(parts) == 3 && r.Method == http.MethodPost:
+		f.calls["CreateGatewayTarget"]++
+		var input fakeTargetInput
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			f.writeError(w, http.StatusBadRequest, "ValidationException", err.Error())
+			return
+		}
+		id, ok := f.tokens[input.ClientToken]
+		if !ok {
+			f.nextID++
+			id = fmt.Sprintf("TARGET%d", f.nextID)
+			f.tokens[input.ClientToken] = id
+			f.targets[id] = &fakeGatewayTarget{id: id, gatewayID: parts[1], name: input.Name,
+				endpoint: input.TargetConfiguration.Mcp.McpServer.Endpoint, description: input.Description,
+				credentials: input.CredentialProviderConfigurations, updatedAt: time.Now()}
+		}
+		f.writeTarget(w, f.targets[id])
+	case len(parts) == 3 && r.Method == http.MethodGet:
+		f.calls["ListGatewayTargets"]++
+		items := []map[string]any{}
+		for _, target := range f.targets {
+			if target.gatewayID == parts[1] {
+				items = append(items, map[string]any{"targetId": target.id, "name": target.name, "status": "READY"})
+			}
+		}
+		f.write(w, http.StatusOK, map[string]any{"items": items})
+	case len(parts) == 4:
+		target, ok := f.targets[parts[3]]
+		operation := map[string]string{
+			http.MethodGet:    "GetGatewayTarget",
+			http.MethodPut:    "UpdateGatewayTarget",
+			http.MethodDelete: "DeleteGatewayTarget",
+		}[r.Method]
+		f.calls[operation]++
+		if f.denied[operation] {
+			f.writeError(w, http.StatusForbidden, "AccessDeniedException", "not authorized to perform "+operation)
+			return
+		}
+		if r.Method == http.MethodGet && f.hidden[parts[3]] > 0 {
+			f.hidden[parts[3]]--
+			ok = false
+		}
+		if ok && r.Method == http.MethodGet && target.status == "DELETING" {
+			if f.deleting[target.id]--; f.deleting[target.id] < 0 {
+				if f.deleteFails[target.id] {
+					target.status = "FAILED"
+				} else {
+					delete(f.targets, target.id)
+					ok = false
+				}
+			}
+		}
+		if !ok || target.gatewayID != parts[1] {
+			f.writeError(w, http.StatusNotFound, "ResourceNotFoundException", "target not found")
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			var input fakeTargetInput
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				f.writeError(w, http.StatusBadRequest, "ValidationException", err.Error())
+				return
+			}
+			target.name = input.Name
+			target.endpoint = input.TargetConfiguration.Mcp.McpServer.Endpoint
+			target.description = input.Description
+			target.credentials = input.CredentialProviderConfigurations
+			target.updatedAt = time.Now()
+		case http.MethodDelete:
+			if _, async := f.deleting[target.id]; async {
+				target.status = "DELETING"
+			} else {
+				delete(f.targets, target.id)
+			}
+		}
+		f.writeTarget(w, target)
+	default:
+		f.writeError(w, http.StatusBadRequest, "ValidationException", "unsupported request "+r.Method+" "+r.URL.Path)
+	}
+}
+
+func (f *fakeAgentCore) gatewayArn(gatewayID string) string {
+	return "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/" + gatewayID
+}
+
+func (f *fakeAgentCore) writeTarget(w http.ResponseWriter, target *fakeGatewayTarget) {
+	body := map[string]any{
+		"targetId":   target.id,
+		"gatewayArn": f.gatewayArn(target.gatewayID),
+		"name":       target.name,
+		"status":     cmp.Or(target.status, "READY"),
+		"updatedAt":  target.updatedAt.UTC().Format(time.RFC3339),
+		"targetConfiguration": map[string]any{
+			"mcp": map[string]any{"mcpServer": map[string]any{"endpoint": target.endpoint}},
+		},
+	}
+	if target.description != "" {
+		body["description"] = target.description
+	}
+	if target.credentials != nil {
+		body["credentialProviderConfigurations"] = target.credentials
+	}
+	f.write(w, http.StatusOK, body)
+}
+
+func (f *fakeAgentCore) writeError(w http.ResponseWriter, code int, errorType, message string) {
+	w.Header().Set("X-Amzn-Errortype", errorType)
+	f.write(w, code, map[string]any{"message": message})
+}
+
+func (f *fakeAgentCore) write(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// reconcileHarness drives MCPServer reconciles one at a time against a fake Kubernetes client
+// and a fake AgentCore control plane. Changes can be injected at the phase boundaries of a
+// reconcile, and the phases each reconcile went through are recorded.
+type reconcileHarness struct {
+	client     client.Client
+	agentCore  *fakeAgentCore
+	reconciler *MCPServerReconciler
+
+	// phases are the phase boundaries reached by the last reconcile
+	phases     []reconcilePhase
+	injections map[reconcilePhase]func(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) error
+}
+
+// newReconcileHarness returns a harness whose fake Kubernetes client holds the given objects
+func newReconcileHarness(objects ...client.Object) *reconcileHarness {
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&mcpgatewayv1alpha1.MCPServer{}).
+		WithIndex(&mcpgatewayv1alpha1.MCPServer{}, endpointIndexField, indexEndpoint).
+		WithIndex(&mcpgatewayv1alpha1.MCPServer{}, referenceIndexField, indexReferences).
+		Build()
+
+	h := &reconcileHarness{
+		client:     fakeClient,
+		agentCore:  newFakeAgentCore(),
+		injections: map[reconcilePhase]func(context.Context, *mcpgatewayv1alpha1.MCPServer) error{},
+	}
+	h.reconciler = &MCPServerReconciler{
+		Client:              fakeClient,
+		Scheme:              fakeClient.Scheme(),
+		BedrockClient:       h.agentCore.client(),
+		ConfigParser:        config.NewConfigParser("gw-1"),
+		TargetConfigBuilder: bedrock.NewTargetConfigBuilder(),
+		StatusManager:       status.NewManager(fakeClient),
+		phaseHook:           h.enterPhase,
+	}
+	return h
+}
+
+func (h *reconcileHarness) enterPhase(ctx context.Context, phase reconcilePhase, mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	h.phases = append(h.phases, phase)
+	inject, ok := h.injections[phase]
+	if !ok {
+		return nil
+	}
+	delete(h.injections, phase)
+	return inject(ctx, mcpServer)
+}
+
+// at runs inject when the next reconcile reaches the phase
+func (h *reconcileHarness) at(phase reconcilePhase, inject func(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) error) {
+	h.injections[phase] = inject
+}
+
+// crashAt ends the next reconcile that reaches the phase with errSimulatedCrash
+func (h *reconcileHarness) crashAt(phase reconcilePhase) {
+	h.at(phase, func(context.Context, *mcpgatewayv1alpha1.MCPServer) error {
+		return errSimulatedCrash
+	})
+}
+
+// reconcile runs a single reconcile of the MCPServer
+func (h *reconcileHarness) reconcile(ctx context.Context, key types.NamespacedName) (ctrl.Result, error) {
+	h.phases = nil
+	return h.reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+}
+
+// get returns the MCPServer as stored by the fake Kubernetes client
+func (h *reconcileHarness) get(ctx context.Context, key types.NamespacedName) *mcpgatewayv1alpha1.MCPServer {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+	Expect(h.client.Get(ctx, key, mcpServer)).To(Succeed())
+	return mcpServer
+}
+
+var _ = Describe("Reconcile phases", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	newMCPServer := func() *mcpgatewayv1alpha1.MCPServer {
+		return &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       key.Name,
+				Namespace:  key.Namespace,
+				UID:        types.UID("9d1c5e7a-3b2f-4e8d-a6c4-2f7b9e0d1a35"),
+				Generation: 1,
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://weather.example.com/mcp",
+				Capabilities: []string{"tools"},
+			},
+		}
+	}
+
+	It("should step through the phases of a create", func() {
+		h := newReconcileHarness(newMCPServer())
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.phases).To(Equal([]reconcilePhase{
+			phaseFetched, phaseValidated, phaseFinalizerAdded, phaseBeforeCreate, phaseAfterCreate,
+		}))
+		Expect(h.agentCore.targetIDs()).To(ConsistOf("TARGET1"))
+		Expect(h.get(ctx, key).Status.TargetID).To(Equal("TARGET1"))
+	})
+
+	It("should resume a create interrupted before the target was recorded", func() {
+		h := newReconcileHarness(newMCPServer())
+
+		By("crashing once AWS created the target")
+		h.crashAt(phaseAfterCreate)
+		_, err := h.reconcile(ctx, key)
+		Expect(err).To(MatchError(errSimulatedCrash))
+		Expect(h.agentCore.targetIDs()).To(ConsistOf("TARGET1"))
+		Expect(h.get(ctx, key).Status.TargetID).To(BeEmpty())
+
+		By("reusing the client token of the interrupted create")
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("CreateGatewayTarget")).To(Equal(2))
+		Expect(h.agentCore.targetIDs()).To(ConsistOf("TARGET1"))
+		Expect(h.get(ctx, key).Status.TargetID).To(Equal("TARGET1"))
+	})
+
+	It("should delete a target created while the MCPServer was being deleted", func() {
+		h := newReconcileHarness(newMCPServer())
+
+		By("deleting the MCPServer while its target is created")
+		h.at(phaseBeforeCreate, func(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+			return h.client.Delete(ctx, mcpServer.DeepCopy())
+		})
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		deleting := h.get(ctx, key)
+		Expect(deleting.DeletionTimestamp).NotTo(BeNil())
+		Expect(deleting.Status.TargetID).To(Equal("TARGET1"))
+
+		By("deleting the target recorded by the create")
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.phases).To(Equal([]reconcilePhase{phaseFetched, phaseBeforeDelete, phaseAfterDelete}))
+		Expect(h.agentCore.targetIDs()).To(BeEmpty())
+		Expect(apierrors.IsNotFound(h.client.Get(ctx, key, &mcpgatewayv1alpha1.MCPServer{}))).To(BeTrue())
+	})
+
+	It("should keep the finalizer when the operator crashes after deleting the target", func() {
+		h := newReconcileHarness(newMCPServer())
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.client.Delete(ctx, h.get(ctx, key))).To(Succeed())
+
+		h.crashAt(phaseAfterDelete)
+		_, err = h.reconcile(ctx, key)
+		Expect(err).To(MatchError(errSimulatedCrash))
+		Expect(h.agentCore.targetIDs()).To(BeEmpty())
+		Expect(h.get(ctx, key).Finalizers).To(ContainElement(gatewayTargetFinalizer))
+
+		By("treating the target that is already gone as deleted")
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("DeleteGatewayTarget")).To(Equal(2))
+		Expect(apierrors.IsNotFound(h.client.Get(ctx, key, &mcpgatewayv1alpha1.MCPServer{}))).To(BeTrue())
+	})
+})
@@ -0,0 +1,247 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;gateways,verbs=get;list;watch
+
+// gatewayAPIGroup is the API group of the Gateway API
+const gatewayAPIGroup = "gateway.networking.k8s.io"
+
+var (
+	// HTTPRouteGVK is the GroupVersionKind of Gateway API HTTPRoutes
+	HTTPRouteGVK = schema.GroupVersionKind{Group: gatewayAPIGroup, Version: "v1", Kind: "HTTPRoute"}
+	// GatewayGVK is the GroupVersionKind of Gateway API Gateways
+	GatewayGVK = schema.GroupVersionKind{Group: gatewayAPIGroup, Version: "v1", Kind: "Gateway"}
+)
+
+// reasonHTTPRouteRefError is the Ready reason of MCPServers whose spec.httpRouteRef cannot be
+// resolved to an endpoint
+const reasonHTTPRouteRefError = "HTTPRouteRefError"
+
+// httpRouteRefError reports an HTTPRoute that cannot be resolved to an endpoint until the spec,
+// the route or its Gateways are fixed, e.g. because no Gateway has an HTTPS listener for it
+type httpRouteRefError struct {
+	err error
+}
+
+func (e *httpRouteRefError) Error() string { return e.err.Error() }
+
+func (e *httpRouteRefError) Unwrap() error { return e.err }
+
+// newHTTPRoute returns an empty HTTPRoute
+func newHTTPRoute() *unstructured.Unstructured {
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(HTTPRouteGVK)
+	return route
+}
+
+// GatewayAPICacheOptions returns the cache configuration for HTTPRoutes. Like the other referenced
+// objects, only HTTPRoutes labelled with WatchLabel=true are cached. The options must only be used
+// with the GatewayAPI feature gate, since the cache cannot start without the Gateway API CRDs.
+func GatewayAPICacheOptions() map[client.Object]cache.ByObject {
+	return map[client.Object]cache.ByObject{
+		newHTTPRoute(): {
+			Label:     labels.SelectorFromSet(labels.Set{WatchLabel: "true"}),
+			Transform: stripReferenceMetadata,
+		},
+	}
+}
+
+// httpRouteRefKey returns the namespaced name of the HTTPRoute referenced by the MCPServer
+func httpRouteRefKey(mcpServer *mcpgatewayv1alpha1.MCPServer) types.NamespacedName {
+	ref := mcpServer.Spec.HTTPRouteRef
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = mcpServer.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: ref.Name}
+}
+
+// resolveHTTPRouteRef sets the endpoint of the in-memory MCPServer to the URL at which the
+// Gateways of the HTTPRoute referenced by spec.httpRouteRef expose it. Like the endpoint of a
+// referenced Service, it is never written to the spec but recorded in the status, so a changed
+// hostname of the route updates the gateway target.
+func (r *MCPServerReconciler) resolveHTTPRouteRef(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	// Lambda and OpenApiSchema targets reject httpRouteRef during validation
+	targetType := mcpServer.Spec.TargetType
+	if mcpServer.Spec.HTTPRouteRef == nil || (targetType != "" && targetType != mcpgatewayv1alpha1.TargetTypeMcpServer) {
+		return nil
+	}
+	if !r.FeatureGates.Enabled(FeatureGatewayAPI) {
+		return &httpRouteRefError{fmt.Errorf("httpRouteRef requires the operator to run with --feature-gates=%s=true",
+			FeatureGatewayAPI)}
+	}
+	if mcpServer.Spec.ServiceRef != nil {
+		return &httpRouteRefError{fmt.Errorf("serviceRef and httpRouteRef cannot be combined")}
+	}
+	if mcpServer.Spec.Endpoint != "" {
+		return &httpRouteRefError{fmt.Errorf("endpoint and httpRouteRef cannot be combined")}
+	}
+
+	route := newHTTPRoute()
+	key := httpRouteRefKey(mcpServer)
+	if err := r.Get(ctx, key, route); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &httpRouteRefError{fmt.Errorf("httproute %s labelled %s=true was not found", key, WatchLabel)}
+		}
+		return err
+	}
+
+	endpoint, err := r.httpRouteURL(ctx, route, mcpServer.Spec.HTTPRouteRef.Path)
+	if err != nil {
+		return err
+	}
+	mcpServer.Spec.Endpoint = endpoint
+	return nil
+}
+
+// httpRouteURL returns the HTTPS URL of the MCP endpoint at path of the route, on the first
+// HTTPS listener of its parent Gateways that serves one of its hostnames
+func (r *MCPServerReconciler) httpRouteURL(ctx context.Context, route *unstructured.Unstructured, path string) (string, error) {
+	key := client.ObjectKeyFromObject(route)
+	hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+
+	for _, item := range parentRefs {
+		parentRef, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		group, _, _ := unstructured.NestedString(parentRef, "group")
+		kind, _, _ := unstructured.NestedString(parentRef, "kind")
+		if (group != "" && group != gatewayAPIGroup) || (kind != "" && kind != GatewayGVK.Kind) {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(parentRef, "name")
+		namespace, _, _ := unstructured.NestedString(parentRef, "namespace")
+		if namespace == "" {
+			namespace = route.GetNamespace()
+		}
+
+		gateway := &unstructured.Unstructured{}
+		gateway.SetGroupVersionKind(GatewayGVK)
+		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, gateway); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return "", err
+		}
+
+		sectionName, _, _ := unstructured.NestedString(parentRef, "sectionName")
+		port, _, _ := unstructured.NestedInt64(parentRef, "port")
+		if host, listenerPort, ok := gatewayHost(gateway, hostnames, sectionName, port); ok {
+			if listenerPort != 443 {
+				host = net.JoinHostPort(host, strconv.FormatInt(listenerPort, 10))
+			}
+			return "https://" + host + path, nil
+		}
+	}
+	return "", &httpRouteRefError{fmt.Errorf("httproute %s has no parent Gateway with an HTTPS listener "+
+		"serving a hostname of it", key)}
+}
+
+// gatewayHost returns the host and port at which an HTTPS listener of the gateway serves a route
+// with the given hostnames. Listeners are restricted to the section name and port of the parent
+// reference, if set. A route without hostnames is served on the hostname of the listener, or the
+// address of the gateway for listeners without hostname. Wildcard hostnames cannot be called, so
+// they only match specific hostnames of the other side.
+func gatewayHost(gateway *unstructured.Unstructured, hostnames []string, sectionName string, port int64) (string, int64, bool) {
+	listeners, _, _ := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	for _, item := range listeners {
+		listener, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(listener, "name")
+		protocol, _, _ := unstructured.NestedString(listener, "protocol")
+		listenerPort, _, _ := unstructured.NestedInt64(listener, "port")
+		if protocol != "HTTPS" || (sectionName != "" && name != sectionName) || (port != 0 && listenerPort != port) {
+			continue
+		}
+		listenerHostname, _, _ := unstructured.NestedString(listener, "hostname")
+
+		if len(hostnames) == 0 {
+			if listenerHostname == "" {
+				if address := gatewayAddress(gateway); address != "" {
+					return address, listenerPort, true
+				}
+				continue
+			}
+			if !strings.HasPrefix(listenerHostname, "*") {
+				return listenerHostname, listenerPort, true
+			}
+			continue
+		}
+		for _, hostname := range hostnames {
+			if host, ok := intersectHostname(listenerHostname, hostname); ok {
+				return host, listenerPort, true
+			}
+		}
+	}
+	return "", 0, false
+}
+
+// intersectHostname returns the specific hostname matched by both a listener and a route
+// hostname, either of which may be a wildcard. An empty listener hostname matches any hostname.
+func intersectHostname(listener, route string) (string, bool) {
+	routeWildcard := strings.HasPrefix(route, "*.")
+	listenerWildcard := strings.HasPrefix(listener, "*.")
+	switch {
+	case routeWildcard && listener != "" && !listenerWildcard && strings.HasSuffix(listener, route[1:]):
+		return listener, true
+	case routeWildcard:
+		return "", false
+	case listener == "" || listener == route:
+		return route, true
+	case listenerWildcard && strings.HasSuffix(route, listener[1:]):
+		return route, true
+	}
+	return "", false
+}
+
+// gatewayAddress returns the first address of the gateway in its status
+func gatewayAddress(gateway *unstructured.Unstructured) string {
+	addresses, _, _ := unstructured.NestedSlice(gateway.Object, "status", "addresses")
+	for _, item := range addresses {
+		address, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		if value, _, _ := unstructured.NestedString(address, "value"); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("HTTPRoute references", func() {
+	ctx := context.Background()
+
+	newGateway := func(listeners ...any) *unstructured.Unstructured {
+		gateway := &unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": "public", "namespace": "infra"},
+			"spec":     map[string]any{"listeners": listeners},
+			"status": map[string]any{"addresses": []any{
+				map[string]any{"type": "Hostname", "value": "public.elb.amazonaws.com"},
+			}},
+		}}
+		gateway.SetGroupVersionKind(GatewayGVK)
+		return gateway
+	}
+	listener := func(name, hostname string, port int64) map[string]any {
+		l := map[string]any{"name": name, "protocol": "HTTPS", "port": port}
+		if hostname != "" {
+			l["hostname"] = hostname
+		}
+		return l
+	}
+
+	It("should intersect listener and route hostnames", func() {
+		for _, c := range []struct{ listener, route, want string }{
+			{"", "weather.example.com", "weather.example.com"},
+			{"weather.example.com", "weather.example.com", "weather.example.com"},
+			{"*.example.com", "weather.example.com", "weather.example.com"},
+			{"weather.example.com", "*.example.com", "weather.example.com"},
+			{"weather.example.com", "news.example.com", ""},
+			{"*.example.com", "*.example.com", ""},
+			{"", "*.example.com", ""},
+		} {
+			host, ok := intersectHostname(c.listener, c.route)
+			Expect(ok).To(Equal(c.want != ""), "%s and %s", c.listener, c.route)
+			Expect(host).To(Equal(c.want))
+		}
+	})
+
+	It("should pick the HTTPS listener serving the route", func() {
+		gateway := newGateway(
+			map[string]any{"name": "http", "protocol": "HTTP", "port": int64(80)},
+			listener("news", "news.example.com", 443),
+			listener("tools", "*.tools.example.com", 8443),
+		)
+
+		host, port, ok := gatewayHost(gateway, []string{"weather.tools.example.com"}, "", 0)
+		Expect(ok).To(BeTrue())
+		Expect(host).To(Equal("weather.tools.example.com"))
+		Expect(port).To(BeEquivalentTo(8443))
+
+		_, _, ok = gatewayHost(gateway, []string{"weather.tools.example.com"}, "news", 0)
+		Expect(ok).To(BeFalse())
+
+		host, _, ok = gatewayHost(gateway, nil, "", 443)
+		Expect(ok).To(BeTrue())
+		Expect(host).To(Equal("news.example.com"))
+
+		host, _, ok = gatewayHost(newGateway(listener("any", "", 443)), nil, "", 0)
+		Expect(ok).To(BeTrue())
+		Expect(host).To(Equal("public.elb.amazonaws.com"))
+	})
+
+	Context("with an HTTPRoute in the cluster", func() {
+		var reconciler *MCPServerReconciler
+
+		referencing := func() *mcpgatewayv1alpha1.MCPServer {
+			return &mcpgatewayv1alpha1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "tools"},
+				Spec: mcpgatewayv1alpha1.MCPServerSpec{
+					HTTPRouteRef: &mcpgatewayv1alpha1.HTTPRouteReference{Name: "weather-mcp", Path: "/mcp"},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(mcpgatewayv1alpha1.AddToScheme(scheme)).To(Succeed())
+			for _, gvk := range []schema.GroupVersionKind{HTTPRouteGVK, GatewayGVK} {
+				scheme.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+				scheme.AddKnownTypeWithName(gvk.GroupVersion().WithKind(gvk.Kind+"List"), &unstructured.UnstructuredList{})
+			}
+
+			route := newHTTPRoute()
+			route.SetName("weather-mcp")
+			route.SetNamespace("tools")
+			route.SetLabels(map[string]string{WatchLabel: "true"})
+			Expect(unstructured.SetNestedStringSlice(route.Object, []string{"weather.tools.example.com"},
+				"spec", "hostnames")).To(Succeed())
+			Expect(unstructured.SetNestedSlice(route.Object, []any{
+				map[string]any{"name": "missing"},
+				map[string]any{"name": "public", "namespace": "infra", "sectionName": "tools"},
+			}, "spec", "parentRefs")).To(Succeed())
+
+			gateway := newGateway(listener("tools", "*.tools.example.com", 443))
+			reconciler = &MCPServerReconciler{
+				Client:       fake.NewClientBuilder().WithScheme(scheme).WithObjects(route, gateway).Build(),
+				Scheme:       scheme,
+				FeatureGates: FeatureGates{FeatureGatewayAPI: true},
+			}
+		})
+
+		It("should set the endpoint of the in-memory MCPServer", func() {
+			mcpServer := referencing()
+			Expect(reconciler.resolveHTTPRouteRef(ctx, mcpServer)).To(Succeed())
+			Expect(mcpServer.Spec.Endpoint).To(Equal("https://weather.tools.example.com/mcp"))
+		})
+
+		It("should report unresolvable references", func() {
+			var routeErr *httpRouteRefError
+
+			mcpServer := referencing()
+			mcpServer.Spec.HTTPRouteRef.Name = "missing"
+			Expect(errors.As(reconciler.resolveHTTPRouteRef(ctx, mcpServer), &routeErr)).To(BeTrue())
+
+			mcpServer = referencing()
+			mcpServer.Spec.ServiceRef = &mcpgatewayv1alpha1.ServiceReference{Name: "weather-mcp"}
+			Expect(errors.As(reconciler.resolveHTTPRouteRef(ctx, mcpServer), &routeErr)).To(BeTrue())
+
+			reconciler.FeatureGates = nil
+			err := reconciler.resolveHTTPRouteRef(ctx, referencing())
+			Expect(errors.As(err, &routeErr)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(FeatureGatewayAPI)))
+		})
+
+		It("should reference the HTTPRoute", func() {
+			Expect(referencedObjects(referencing())).To(
+				ContainElement(referenceKey(HTTPRouteGVK.Kind, "tools", "weather-mcp")))
+		})
+	})
+})
@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/journal"
+)
+
+// beginOperation records the intent to perform a mutating AWS call.
+// It returns a nil entry when the journal is disabled.
+func (r *MCPServerReconciler) beginOperation(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	operation journal.Operation,
+	gatewayID, clientToken string,
+) (*journal.Entry, error) {
+	if r.Journal == nil {
+		return nil, nil
+	}
+
+	entry := &journal.Entry{
+		Operation:      operation,
+		Namespace:      mcpServer.Namespace,
+		Name:           mcpServer.Name,
+		UID:            mcpServer.UID,
+		Generation:     mcpServer.Generation,
+		GatewayID:      gatewayID,
+		TargetID:       mcpServer.Status.TargetID,
+		ClientToken:    clientToken,
+		ProviderConfig: providerConfigName(mcpServer),
+	}
+	if err := r.Journal.Begin(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// completeOperation removes a journal entry once the outcome of the call has been persisted.
+// Failures are only logged: a stale entry is harmless and cleaned up on the next replay.
+func (r *MCPServerReconciler) completeOperation(ctx context.Context, entry *journal.Entry, log logr.Logger) {
+	if r.Journal == nil || entry == nil {
+		return
+	}
+	if err := r.Journal.Complete(ctx, entry); err != nil {
+		log.Error(err, "Failed to complete journal entry", "operation", entry.Operation)
+	}
+}
+
+// createClientToken returns the client token for a CreateGatewayTarget call. It is derived from
+// the UID and generation of the MCPServer and the number of targets removed from it, so that a
+// create retried after a transient error or an operator restart returns the target AWS already
+// created instead of a duplicate, while a changed spec or a recreated target gets a new token.
+// It returns "" for MCPServers without UID, which leaves the token to the client wrapper.
+func (r *MCPServerReconciler) createClientToken(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) string {
+	if mcpServer.UID == "" {
+		return ""
+	}
+	clientToken := fmt.Sprintf("%s-%d-%d", mcpServer.UID, mcpServer.Generation, mcpServer.Status.TargetRemovals)
+
+	if r.Journal != nil {
+		entry, err := r.Journal.Lookup(ctx, mcpServer.UID, journal.OperationCreate)
+		if err != nil {
+			log.Error(err, "Failed to look up journal entry")
+		} else if entry != nil && entry.ClientToken == clientToken {
+			log.Info("Resuming interrupted gateway target creation", "clientToken", clientToken, "startedAt", entry.StartedAt)
+		}
+	}
+	return clientToken
+}
+
+// ReplayJournal inspects the operations left pending by a previous run of the operator.
+// Entries of resources that still exist are left in place: their next reconcile resumes the
+// operation. Entries of resources that no longer exist are dropped, and interrupted creates
+// are reported since they may have left an untracked target behind in AWS.
+func (r *MCPServerReconciler) ReplayJournal(ctx context.Context) error {
+	if r.Journal == nil {
+		return nil
+	}
+	log := logf.FromContext(ctx).WithName("journal")
+
+	entries, err := r.Journal.Pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: entry.Namespace, Name: entry.Name}, mcpServer)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		if err == nil && mcpServer.UID == entry.UID {
+			log.Info("Found interrupted operation, it will be resumed by the next reconcile",
+				"operation", entry.Operation, "namespace", entry.Namespace, "name", entry.Name, "startedAt", entry.StartedAt)
+			continue
+		}
+
+		if entry.Operation == journal.OperationCreate {
+			log.Info("Resource of an interrupted create no longer exists, the gateway target may need manual cleanup",
+				"namespace", entry.Namespace, "name", entry.Name, "gatewayId", entry.GatewayID, "clientToken", entry.ClientToken,
+				"providerConfig", entry.ProviderConfig)
+		}
+		r.completeOperation(ctx, entry, log)
+	}
+
+	return nil
+}
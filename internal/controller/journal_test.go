@@ -0,0 +1,69 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Client tokens", func() {
+	ctx := context.Background()
+	reconciler := &MCPServerReconciler{}
+
+	mcpServer := func() *mcpgatewayv1alpha1.MCPServer {
+		return &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "weather",
+				Namespace:  "default",
+				UID:        types.UID("0b4a3c1e-7f4d-4c2a-9a61-1f3d2e5b8c90"),
+				Generation: 3,
+			},
+		}
+	}
+
+	It("should derive the create token from the identity of the MCPServer", func() {
+		token := reconciler.createClientToken(ctx, mcpServer(), logr.Discard())
+		Expect(token).To(Equal("0b4a3c1e-7f4d-4c2a-9a61-1f3d2e5b8c90-3-0"))
+		Expect(reconciler.createClientToken(ctx, mcpServer(), logr.Discard())).To(Equal(token))
+	})
+
+	It("should use a new token for a new generation or a recreated target", func() {
+		token := reconciler.createClientToken(ctx, mcpServer(), logr.Discard())
+
+		updated := mcpServer()
+		updated.Generation = 4
+		Expect(reconciler.createClientToken(ctx, updated, logr.Discard())).NotTo(Equal(token))
+
+		recreated := mcpServer()
+		recreated.Status.TargetRemovals = 1
+		Expect(reconciler.createClientToken(ctx, recreated, logr.Discard())).NotTo(Equal(token))
+	})
+
+	It("should leave the token to the client wrapper without UID", func() {
+		unsaved := mcpServer()
+		unsaved.UID = ""
+		Expect(reconciler.createClientToken(ctx, unsaved, logr.Discard())).To(BeEmpty())
+	})
+})
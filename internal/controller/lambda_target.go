@@ -0,0 +1,52 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// validateLambdaTarget validates the spec of an MCPServer with targetType Lambda. Lambda targets
+// are invoked with the gateway's IAM role, so they cannot use OAuth or API key credentials, and
+// have no endpoint for the operator to register or probe.
+func validateLambdaTarget(mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	spec := mcpServer.Spec
+	if spec.LambdaArn == "" {
+		return fmt.Errorf("lambdaArn is required when targetType is Lambda")
+	}
+	if spec.Endpoint != "" || spec.EndpointRef != nil || spec.ServiceRef != nil || spec.HTTPRouteRef != nil {
+		return fmt.Errorf("endpoint, endpointRef, serviceRef and httpRouteRef cannot be combined with targetType Lambda")
+	}
+	if _, err := bedrock.BuildToolSchema(spec.ToolSchema); err != nil {
+		return fmt.Errorf("invalid toolSchema: %w", err)
+	}
+
+	// authType defaults to OAuth2 and is ignored, but an OAuth provider is a mistake
+	if spec.OauthProviderArn != "" {
+		return fmt.Errorf("oauthProviderArn is not supported for Lambda targets, which use the gateway IAM role")
+	}
+	for i, provider := range spec.CredentialProviders {
+		if provider.Type != "GatewayIamRole" {
+			return fmt.Errorf("credentialProviders[%d]: type %s is not supported for Lambda targets, "+
+				"which use the gateway IAM role", i, provider.Type)
+		}
+	}
+	return nil
+}
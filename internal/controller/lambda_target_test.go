@@ -0,0 +1,72 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Lambda targets", func() {
+	var mcpServer *mcpgatewayv1alpha1.MCPServer
+
+	BeforeEach(func() {
+		mcpServer = &mcpgatewayv1alpha1.MCPServer{
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				TargetType: mcpgatewayv1alpha1.TargetTypeLambda,
+				LambdaArn:  "arn:aws:lambda:us-east-1:123456789012:function:tools",
+				ToolSchema: &mcpgatewayv1alpha1.ToolSchemaSpec{S3URI: "s3://schemas/tools.json"},
+			},
+		}
+	})
+
+	It("accepts a function with a tool schema", func() {
+		Expect(validateLambdaTarget(mcpServer)).To(Succeed())
+	})
+
+	It("accepts the gateway IAM role credential provider", func() {
+		mcpServer.Spec.CredentialProviders = []mcpgatewayv1alpha1.CredentialProvider{{Type: "GatewayIamRole"}}
+		Expect(validateLambdaTarget(mcpServer)).To(Succeed())
+	})
+
+	It("requires the function ARN", func() {
+		mcpServer.Spec.LambdaArn = ""
+		Expect(validateLambdaTarget(mcpServer)).To(MatchError(ContainSubstring("lambdaArn is required")))
+	})
+
+	It("rejects an endpoint", func() {
+		mcpServer.Spec.Endpoint = "https://mcp.example.com"
+		Expect(validateLambdaTarget(mcpServer)).To(MatchError(ContainSubstring("cannot be combined")))
+	})
+
+	It("rejects an invalid tool schema", func() {
+		mcpServer.Spec.ToolSchema = nil
+		Expect(validateLambdaTarget(mcpServer)).To(MatchError(ContainSubstring("invalid toolSchema")))
+	})
+
+	It("ignores the defaulted authType", func() {
+		mcpServer.Spec.AuthType = "OAuth2"
+		Expect(validateLambdaTarget(mcpServer)).To(Succeed())
+	})
+
+	It("rejects OAuth credentials", func() {
+		mcpServer.Spec.OauthProviderArn = "arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/oauth2credentialprovider/p"
+		Expect(validateLambdaTarget(mcpServer)).To(MatchError(ContainSubstring("oauthProviderArn is not supported")))
+	})
+})
@@ -18,67 +18,371 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"text/template"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	bedrocktypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/audit"
 	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
 	"github.com/aws/mcp-gateway-operator/pkg/config"
+	"github.com/aws/mcp-gateway-operator/pkg/environment"
+	"github.com/aws/mcp-gateway-operator/pkg/journal"
+	"github.com/aws/mcp-gateway-operator/pkg/preview"
+	"github.com/aws/mcp-gateway-operator/pkg/probe"
+	"github.com/aws/mcp-gateway-operator/pkg/rollout"
+	"github.com/aws/mcp-gateway-operator/pkg/sharding"
 	"github.com/aws/mcp-gateway-operator/pkg/status"
 )
 
-const gatewayTargetFinalizer = "bedrock.aws/gateway-target-finalizer"
+const (
+	// gatewayCompatibilityRecheckInterval is how often an incompatible gateway is checked again
+	gatewayCompatibilityRecheckInterval = 5 * time.Minute
+)
 
 // MCPServerReconciler reconciles a MCPServer object
 type MCPServerReconciler struct {
 	client.Client
 	Scheme              *runtime.Scheme
-	BedrockClient       *bedrockagentcorecontrol.Client
+	BedrockClient       bedrock.GatewayTargetAPI
 	DefaultGatewayID    string
 	ConfigParser        *config.ConfigParser
 	TargetConfigBuilder *bedrock.TargetConfigBuilder
 	StatusManager       *status.Manager
+
+	// ProviderClients creates the AgentCore clients of the AWSProviderConfigs referenced by
+	// MCPServers. Nil rejects spec.providerConfigRef.
+	ProviderClients *bedrock.ProviderClients
+
+	// EndpointProber inspects the endpoint TLS certificate for expiry checks
+	EndpointProber *probe.Prober
+	// CredentialsExpiryThreshold is how far ahead of expiry the CredentialsExpiring condition is raised.
+	// Zero disables expiry checks.
+	CredentialsExpiryThreshold time.Duration
+
+	// Sharder restricts the reconciler to the MCPServers assigned to this replica.
+	// Nil reconciles every MCPServer.
+	Sharder *sharding.Sharder
+
+	// Journal records mutating AWS calls for crash recovery. Nil disables journaling.
+	Journal *journal.Journal
+
+	// KEDAPrometheusAddress is the Prometheus server KEDA queries for gateway traffic.
+	// Empty disables ScaledObject management.
+	KEDAPrometheusAddress string
+
+	// CatalogConfigMaps enables the Backstage catalog ConfigMap of each MCPServer
+	CatalogConfigMaps bool
+
+	// CallBudget limits the AWS calls made for each MCPServer. Nil disables the limit.
+	CallBudget *bedrock.CallBudget
+
+	// AWSCallTimeout bounds every attempt of an AWS call, independently of the reconcile.
+	// Zero uses bedrock.DefaultCallTimeout.
+	AWSCallTimeout time.Duration
+
+	// FairShare divides the AWS call capacity among tenants. Nil disables fair sharing.
+	FairShare *bedrock.FairShare
+	// FairSharePartition is what makes up a tenant, FairSharePartitionNamespace or
+	// FairSharePartitionGateway
+	FairSharePartition string
+
+	// AuditLogger records every mutating AWS call. Nil disables audit records.
+	AuditLogger *audit.Logger
+
+	// GatewayCache serves GetGateway results shared by all MCPServers of a gateway. Nil disables
+	// caching.
+	GatewayCache *bedrock.GatewayCache
+
+	// ClusterID identifies the operator's cluster in the owner marker of the gateway targets it
+	// writes. Empty disables ownership conflict detection.
+	ClusterID string
+
+	// ClusterName is the name of the spoke cluster whose MCPServers the reconciler serves in hub
+	// mode. It is empty for the operator's own cluster.
+	ClusterName string
+
+	// Environments are the profiles selected by the EnvironmentLabel of the namespace.
+	// Nil disables environment profiles.
+	Environments *environment.Registry
+
+	// Recorder emits Kubernetes events for the MCPServer. Nil disables events.
+	Recorder events.EventRecorder
+
+	// Rollout paces updates of the gateway targets of a gateway in waves. Nil applies every
+	// update right away.
+	Rollout *rollout.Gate
+
+	// MaxConcurrentCreatesPerGateway caps the gateway targets of a gateway being created at the
+	// same time; further MCPServers wait for a create slot. Zero does not cap creates.
+	MaxConcurrentCreatesPerGateway int
+
+	// FeatureGates enable optional features. Nil uses the default of every feature.
+	FeatureGates FeatureGates
+
+	// DriftCheckInterval is how often the gateway target of a ready MCPServer is compared with its
+	// spec. Zero disables drift detection.
+	DriftCheckInterval time.Duration
+	// RateLimiter paces the AWS calls of all MCPServers per operation. Nil does not limit calls.
+	RateLimiter *bedrock.RateLimiter
+	// CircuitBreaker fails the AWS calls of all MCPServers using the operator's own client right
+	// away while AWS keeps failing, which puts them into the Degraded condition. MCPServers with an
+	// AWSProviderConfig use the breaker of its settings, see bedrock.ProviderClients. Nil never
+	// fails calls.
+	CircuitBreaker *bedrock.CircuitBreaker
+	// Backpressure stretches the requeue intervals of all MCPServers, including the drift check
+	// interval, while AWS throttles the operator. Nil disables backpressure.
+	Backpressure *bedrock.Backpressure
+	// DriftPolicy is what happens to gateway targets that differ from their spec, DriftPolicyReport
+	// or DriftPolicyCorrect. Empty reports them.
+	DriftPolicy string
+
+	// StatusMode is the status mode of the installation, status.ModeFull or
+	// status.ModeConditionsOnly. It only applies to the clients of spoke clusters; the client of
+	// the reconciler is expected to honour it already, see status.NewClient.
+	StatusMode string
+
+	// GatewayDeletedPolicy is what happens to the targets of a gateway deleted from AWS,
+	// GatewayDeletedPolicyOrphan or GatewayDeletedPolicyRecreate. Empty orphans them.
+	GatewayDeletedPolicy string
+
+	// PreviewTargetNames is the template of the default target names of MCPServers labelled with
+	// preview.Label. Nil names them like any other MCPServer.
+	PreviewTargetNames *template.Template
+	// PreviewRegistry records the gateway targets of preview MCPServers for the preview cleaner.
+	// Nil disables the registry.
+	PreviewRegistry *preview.Registry
+
+	shards      shardTracker
+	driftChecks driftCheckTracker
+	createSlots createSlotTracker
+
+	// phaseHook is called at the phase boundaries of every reconcile. It is only set by tests.
+	phaseHook phaseHook
 }
 
 // +kubebuilder:rbac:groups=mcpgateway.bedrock.aws,resources=mcpservers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=mcpgateway.bedrock.aws,resources=mcpservers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=mcpgateway.bedrock.aws,resources=mcpservers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mcpgateway.bedrock.aws,resources=awsproviderconfigs,verbs=get;list;update;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;update
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-func (r *MCPServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *MCPServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
 	log := logf.FromContext(ctx)
+	ctx, span := startReconcileSpan(ctx, "MCPServer", req)
+
+	// Explain the outcome of every reconcile in a single structured log line
+	trace := newReconcileTrace()
+	var mcpServer *mcpgatewayv1alpha1.MCPServer
+	defer func() {
+		var throttledErr *bedrock.ThrottledError
+		if bedrock.IsBudgetExceededError(err) || errors.As(err, &throttledErr) || bedrock.IsCircuitOpenError(err) {
+			trace.action = actionThrottled
+		}
+		if isCanceled(ctx, err) {
+			trace.action = actionCanceled
+		}
+		result, err = handleCanceled(ctx, result, err, log)
+		result, err = r.handleCircuitBreaker(ctx, mcpServer, result, err, log)
+		result, err = r.handleBudgetExceeded(ctx, mcpServer, result, err, log)
+		result, err = handleAWSThrottling(result, err, log)
+		result = r.applyBackpressure(result)
+		r.recordSync(ctx, mcpServer, trace.action, result, err, log)
+		trace.log(log, result, err)
+		endReconcileSpan(span, trace.action, result, err)
+	}()
+
+	// Quarantine a resource that triggers a panic instead of crash-looping the operator
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result, err = ctrl.Result{}, r.quarantine(ctx, mcpServer, recovered, log)
+			return
+		}
+		r.clearQuarantine(ctx, mcpServer, log)
+	}()
+
+	// Tag all AWS calls made during this reconcile with the resource they belong to
+	ctx = bedrock.WithAttribution(ctx, req.Namespace, req.Name)
 
 	// Fetch the MCPServer resource
-	mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+	mcpServer = &mcpgatewayv1alpha1.MCPServer{}
 	if err := r.Get(ctx, req.NamespacedName, mcpServer); err != nil {
 		if apierrors.IsNotFound(err) {
 			// Resource not found, likely deleted
 			log.Info("MCPServer resource not found, likely deleted")
+			trace.action = actionNotFound
+			r.shards.track(req.NamespacedName, false)
+			r.driftChecks.forget(req.NamespacedName)
+			r.createSlots.release(req.NamespacedName)
+			r.forgetCallBudget(req.Namespace, req.Name)
 			return ctrl.Result{}, nil
 		}
 		log.Error(err, "Failed to get MCPServer resource")
 		return ctrl.Result{}, err
 	}
+	if err := r.enterPhase(ctx, phaseFetched, mcpServer); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Come back when the MCPServer expires
+	defer func() { result = requeueAtExpiration(mcpServer, result, err) }()
+
+	// Free the create slot of a gateway target that is no longer being created
+	r.releaseCreateSlot(mcpServer)
+
+	// Skip resources assigned to another replica
+	if !r.ownsResource(mcpServer) {
+		log.V(1).Info("MCPServer is assigned to another shard, skipping", "shard", r.Sharder.ShardFor(mcpServer))
+		r.shards.track(req.NamespacedName, false)
+		trace.action = actionOtherShard
+		return ctrl.Result{}, nil
+	}
+	r.shards.track(req.NamespacedName, true)
+
+	// Charge the AWS calls of this reconcile to the fair share of the resource's tenant
+	ctx = bedrock.WithTenant(ctx, r.tenant(mcpServer))
+
+	// Keep the spec as written to tell which values the environment and workload supply
+	written := mcpServer.Spec.DeepCopy()
+
+	// Apply the gateway and retry policy of the namespace's environment
+	environmentName, profile, err := r.environmentProfile(ctx, mcpServer)
+	if err != nil {
+		log.Error(err, "Failed to read the environment of the namespace")
+		return ctrl.Result{}, err
+	}
+	ctx = applyEnvironmentProfile(ctx, mcpServer, profile)
+
+	// Call AWS with the client of the referenced AWSProviderConfig
+	ctx, err = r.applyProviderConfig(ctx, mcpServer)
+	var providerErr *providerConfigError
+	if err != nil && !errors.As(err, &providerErr) {
+		log.Error(err, "Failed to read the AWSProviderConfig")
+		return ctrl.Result{}, err
+	}
 
-	// Check if the resource is being deleted
+	// Check if the resource is being deleted. Only the deletion of the gateway target needs the
+	// AWSProviderConfig, so err, which is a providerConfigError by now, is left to handleDeletion.
 	if !mcpServer.DeletionTimestamp.IsZero() {
-		return r.handleDeletion(ctx, mcpServer, log)
+		trace.action = actionDelete
+		return r.handleDeletion(ctx, mcpServer, err, log)
+	}
+
+	if err != nil {
+		log.Error(err, "AWSProviderConfig cannot be used")
+		trace.action = actionInvalidSpec
+		if statusErr := r.StatusManager.SetError(ctx, mcpServer, reasonProviderConfigError, err.Error()); statusErr != nil {
+			log.Error(statusErr, "Failed to update status with provider config error")
+			return ctrl.Result{}, statusErr
+		}
+		// The MCPServer is reconciled again when the AWSProviderConfig is created
+		return ctrl.Result{}, nil
+	}
+
+	// Take the description and target name from the workload annotations unless the spec sets them
+	workloadMetadata, err := r.resolveWorkloadMetadata(ctx, mcpServer)
+	if err != nil {
+		log.Error(err, "Failed to read workload metadata")
+		return ctrl.Result{}, err
+	}
+	applyWorkloadMetadata(mcpServer, workloadMetadata)
+
+	// Substitute the values of the namespace's endpoint values ConfigMap into the endpoint
+	if err := r.substituteEndpointValues(ctx, mcpServer); err != nil {
+		var valuesErr *endpointValuesError
+		if !errors.As(err, &valuesErr) {
+			log.Error(err, "Failed to read endpoint values")
+			return ctrl.Result{}, err
+		}
+		log.Error(err, "Endpoint substitution failed")
+		trace.action = actionInvalidSpec
+		if statusErr := r.StatusManager.SetError(ctx, mcpServer, reasonEndpointSubstitutionError, err.Error()); statusErr != nil {
+			log.Error(statusErr, "Failed to update status with endpoint substitution error")
+			return ctrl.Result{}, statusErr
+		}
+		// The MCPServer is reconciled again when the ConfigMap changes
+		return ctrl.Result{}, nil
+	}
+
+	// Resolve the endpoint of the Service referenced by spec.serviceRef
+	if err := r.resolveServiceRef(ctx, mcpServer); err != nil {
+		var serviceErr *serviceRefError
+		if !errors.As(err, &serviceErr) {
+			log.Error(err, "Failed to read referenced Service")
+			return ctrl.Result{}, err
+		}
+		log.Error(err, "Service reference resolution failed")
+		trace.action = actionInvalidSpec
+		if statusErr := r.StatusManager.SetError(ctx, mcpServer, reasonServiceRefError, err.Error()); statusErr != nil {
+			log.Error(statusErr, "Failed to update status with service reference error")
+			return ctrl.Result{}, statusErr
+		}
+		// The MCPServer is reconciled again when the Service changes
+		return ctrl.Result{}, nil
+	}
+
+	// Resolve the endpoint of the HTTPRoute referenced by spec.httpRouteRef
+	if err := r.resolveHTTPRouteRef(ctx, mcpServer); err != nil {
+		var routeErr *httpRouteRefError
+		if !errors.As(err, &routeErr) {
+			log.Error(err, "Failed to read referenced HTTPRoute")
+			return ctrl.Result{}, err
+		}
+		log.Error(err, "HTTPRoute reference resolution failed")
+		trace.action = actionInvalidSpec
+		if statusErr := r.StatusManager.SetError(ctx, mcpServer, reasonHTTPRouteRefError, err.Error()); statusErr != nil {
+			log.Error(statusErr, "Failed to update status with HTTPRoute reference error")
+			return ctrl.Result{}, statusErr
+		}
+		// The MCPServer is reconciled again when the HTTPRoute changes
+		return ctrl.Result{}, nil
+	}
+
+	// Read the OpenAPI schema of OpenApiSchema targets from its ConfigMap
+	if err := r.resolveOpenAPISchema(ctx, mcpServer); err != nil {
+		var schemaErr *openAPISchemaError
+		if !errors.As(err, &schemaErr) {
+			log.Error(err, "Failed to read OpenAPI schema")
+			return ctrl.Result{}, err
+		}
+		log.Error(err, "OpenAPI schema resolution failed")
+		trace.action = actionInvalidSpec
+		if statusErr := r.StatusManager.SetError(ctx, mcpServer, reasonOpenAPISchemaError, err.Error()); statusErr != nil {
+			log.Error(statusErr, "Failed to update status with OpenAPI schema error")
+			return ctrl.Result{}, statusErr
+		}
+		// The MCPServer is reconciled again when the ConfigMap changes
+		return ctrl.Result{}, nil
 	}
 
 	// Validate the spec
-	if err := r.validateSpec(mcpServer); err != nil {
+	if err := r.validateSpec(ctx, mcpServer); err != nil {
 		log.Error(err, "Spec validation failed")
+		trace.action = actionInvalidSpec
 		if statusErr := r.StatusManager.SetError(ctx, mcpServer, "ValidationError", err.Error()); statusErr != nil {
 			log.Error(statusErr, "Failed to update status with validation error")
 			return ctrl.Result{}, statusErr
@@ -86,44 +390,188 @@ func (r *MCPServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		// Don't requeue for validation errors
 		return ctrl.Result{}, nil
 	}
+	if err := r.enterPhase(ctx, phaseValidated, mcpServer); err != nil {
+		return ctrl.Result{}, err
+	}
 
-	// Add finalizer if not present
-	if !controllerutil.ContainsFinalizer(mcpServer, gatewayTargetFinalizer) {
-		controllerutil.AddFinalizer(mcpServer, gatewayTargetFinalizer)
-		if err := r.Update(ctx, mcpServer); err != nil {
-			log.Error(err, "Failed to add finalizer")
-			return ctrl.Result{}, err
+	// Pass the credential provider options without spec fields through to AWS
+	ctx = r.withCredentialProviderExtensions(ctx, mcpServer)
+
+	// Add finalizer if not present, migrating the legacy finalizer
+	if err := r.ensureGatewayTargetFinalizer(ctx, mcpServer, log); err != nil {
+		log.Error(err, "Failed to add finalizer")
+		return ctrl.Result{}, err
+	}
+	if err := r.enterPhase(ctx, phaseFinalizerAdded, mcpServer); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Remove the gateway target, or the whole MCPServer, once its ttl or expiresAt has passed
+	if expired, result, err := r.checkExpiration(ctx, mcpServer, log); expired || err != nil {
+		trace.action = actionExpired
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return result, err
+	}
+
+	// Record where the gateway target values came from
+	if err := r.recordProvenance(ctx, mcpServer, r.fieldProvenance(written, mcpServer, environmentName), log); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		log.Error(err, "Failed to record provenance")
+		return ctrl.Result{}, err
+	}
+
+	// Scale the backend workload on gateway traffic
+	r.reconcileScaledObject(ctx, mcpServer, log)
+
+	// Publish the MCPServer to the developer portal
+	r.reconcileCatalog(ctx, mcpServer, log)
+
+	// Report other MCPServers registering the same endpoint on the gateway
+	if err := r.checkDuplicateEndpoint(ctx, mcpServer, log); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		log.Error(err, "Failed to check for duplicate endpoints")
+		return ctrl.Result{}, err
+	}
+
+	// Stop calling AWS for the target of a deleted gateway until there is a replacement
+	if deleted, result, err := r.checkGatewayDeleted(ctx, mcpServer, profile, log); deleted || err != nil {
+		trace.action = actionGatewayDeleted
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
 		}
-		log.Info("Added finalizer to MCPServer")
+		return result, err
+	}
+
+	// Back off without calling AWS while AWS keeps failing
+	if open, result, err := r.checkCircuitBreaker(ctx, mcpServer, log); open {
+		trace.action = actionThrottled
+		return result, err
+	}
+
+	// Back off without calling AWS while the resource has no call budget left
+	if throttled, result, err := r.checkCallBudget(ctx, mcpServer, log); throttled {
+		trace.action = actionThrottled
+		return result, err
+	}
+
+	// Keep the gateway from routing to a workload without available replicas
+	if gated, result, err := r.checkBackend(ctx, mcpServer, log); gated || err != nil {
+		trace.action = actionBackendUnavailable
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return result, err
+	}
+
+	// Hold back changes to the gateway target until the environment's approval is given
+	if pending, result, err := r.checkApproval(ctx, mcpServer, environmentName, profile, log); pending || err != nil {
+		trace.action = actionApprovalPending
+		return result, err
 	}
 
 	// Check if gateway target already exists
 	if mcpServer.Status.TargetID == "" {
+		// Adopt the target recorded in the ownership annotation before creating a new one
+		trace.action = actionCreate
+		if adopted, result, err := r.adoptOwnedTarget(ctx, mcpServer, log); adopted || err != nil {
+			return result, err
+		}
+		// Bind to an existing target the MCPServer was told to adopt instead of creating a duplicate
+		if adopted, result, err := r.adoptExistingTarget(ctx, mcpServer, log); adopted || err != nil {
+			return result, err
+		}
+
+		// Wait for a create slot of the gateway
+		if waiting, result, err := r.checkCreateSlot(ctx, mcpServer, log); waiting {
+			trace.action = actionWaitingForSlot
+			return result, err
+		}
+
 		// Create gateway target
-		return r.createGatewayTarget(ctx, mcpServer, log)
+		return r.createGatewayTarget(ctx, mcpServer, workloadMetadata, log)
 	}
 
 	// Check for configuration changes
-	if r.detectConfigChanges(ctx, mcpServer, log) {
+	if r.detectConfigChanges(ctx, mcpServer, workloadMetadata, log) {
+		// Wait for the rollout of the gateway to admit the update
+		if pending, result, err := r.checkRollout(ctx, mcpServer, log); pending {
+			trace.action = actionRolloutPending
+			return result, err
+		}
+
 		// Update gateway target
-		return r.updateGatewayTarget(ctx, mcpServer, log)
+		trace.action = actionUpdate
+		return r.updateGatewayTarget(ctx, mcpServer, workloadMetadata, log)
 	}
 
 	// Idempotency check: if target is already READY and no changes, skip AWS calls
 	if mcpServer.Status.TargetStatus == "READY" && mcpServer.Generation == mcpServer.Status.ObservedGeneration {
+		// Except for the periodic comparison with the live target, which catches edits made outside of the operator
+		if correct, err := r.checkConfigDrift(ctx, mcpServer, log); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, err
+		} else if correct {
+			if pending, result, err := r.checkRollout(ctx, mcpServer, log); pending {
+				trace.action = actionRolloutPending
+				return result, err
+			}
+			trace.action = actionUpdate
+			return r.updateGatewayTarget(ctx, mcpServer, workloadMetadata, log)
+		}
+
 		log.V(1).Info("Gateway target is ready and no changes detected, skipping reconciliation")
-		return ctrl.Result{}, nil
+		trace.action = actionSkip
+		result, err := r.checkCredentialsExpiry(ctx, mcpServer, log)
+		return r.requeueForDriftCheck(result), err
 	}
 
 	// Sync gateway target status
+	trace.action = actionSyncStatus
 	return r.syncGatewayTargetStatus(ctx, mcpServer, log)
 }
 
+// targetName returns the gateway target name of the MCPServer: spec.targetName, the preview
+// target name template for preview MCPServers, or else the resource name. Resource names of spoke
+// cluster MCPServers are prefixed with the cluster name so that resources with the same name in
+// different clusters do not collide on a shared gateway. Preview names are not prefixed, since the
+// template places the cluster name itself; the default template starts with it.
+func (r *MCPServerReconciler) targetName(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	if mcpServer.Spec.TargetName != "" {
+		return mcpServer.Spec.TargetName
+	}
+	if name, ok := r.previewTargetName(mcpServer); ok {
+		return name
+	}
+	if r.ClusterName != "" {
+		return r.ClusterName + "-" + mcpServer.Name
+	}
+	return mcpServer.Name
+}
+
 // validateSpec validates all required fields in the MCPServer spec
-func (r *MCPServerReconciler) validateSpec(mcpServer *mcpgatewayv1alpha1.MCPServer) error {
-	// Validate endpoint
-	if _, err := r.ConfigParser.ParseEndpoint(mcpServer.Spec.Endpoint); err != nil {
-		return fmt.Errorf("invalid endpoint: %w", err)
+func (r *MCPServerReconciler) validateSpec(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	// Validate the endpoint, the function and tools of Lambda targets or the OpenAPI schema
+	switch mcpServer.Spec.TargetType {
+	case mcpgatewayv1alpha1.TargetTypeLambda:
+		if err := validateLambdaTarget(mcpServer); err != nil {
+			return err
+		}
+	case mcpgatewayv1alpha1.TargetTypeOpenAPISchema:
+		if err := validateOpenAPITarget(mcpServer); err != nil {
+			return err
+		}
+	default:
+		if _, err := r.ConfigParser.ParseEndpoint(mcpServer.Spec.Endpoint); err != nil {
+			return fmt.Errorf("invalid endpoint: %w", err)
+		}
 	}
 
 	// Validate capabilities
@@ -132,11 +580,51 @@ func (r *MCPServerReconciler) validateSpec(mcpServer *mcpgatewayv1alpha1.MCPServ
 	}
 
 	// Validate auth configuration
-	if mcpServer.Spec.AuthType == "OAuth2" {
+	// spec.credentialProviders takes precedence over the single-provider fields
+	if len(mcpServer.Spec.CredentialProviders) > 0 {
+		if _, err := r.TargetConfigBuilder.BuildCredentialConfig(mcpServer); err != nil {
+			return fmt.Errorf("invalid credentialProviders: %w", err)
+		}
+	} else if mcpServer.Spec.AuthType == "OAuth2" && mcpServer.Spec.TargetType != mcpgatewayv1alpha1.TargetTypeLambda {
 		if mcpServer.Spec.OauthProviderArn == "" {
 			return fmt.Errorf("oauthProviderArn is required when authType is OAuth2")
 		}
 	}
+	if err := r.validateCredentialProviderExtensions(mcpServer); err != nil {
+		return err
+	}
+	if err := validateExpiration(mcpServer); err != nil {
+		return err
+	}
+
+	// Disabling metadata propagation clears the allowlists, so it must not be combined with them
+	if mcpServer.Spec.DisableMetadataPropagation && (len(mcpServer.Spec.AllowedRequestHeaders) > 0 ||
+		len(mcpServer.Spec.AllowedQueryParameters) > 0 || len(mcpServer.Spec.AllowedResponseHeaders) > 0) {
+		return fmt.Errorf("disableMetadataPropagation cannot be combined with metadata allowlists")
+	}
+
+	// A space-separated scope string is a single scope to AWS, which the provider then rejects
+	if err := config.ScopesError(config.ValidateScopes(mcpServer)); err != nil {
+		return err
+	}
+
+	// Provider and function ARNs cannot be used across partitions, e.g. from aws-us-gov in aws
+	if err := config.PartitionsError(config.ValidatePartitions(mcpServer, r.targetRegion(ctx))); err != nil {
+		return err
+	}
+
+	// Enforce the AgentCore limits locally rather than waiting for a ValidationException
+	if err := config.LimitsError(config.ValidateLimits(mcpServer, r.targetName(mcpServer))); err != nil {
+		return err
+	}
+
+	// A target removed at zero replicas gets no traffic to scale the workload up again on
+	if readinessPolicy(mcpServer) == mcpgatewayv1alpha1.WorkloadReadinessRemoveWhenScaledToZero &&
+		mcpServer.Spec.Autoscaling != nil && mcpServer.Spec.Autoscaling.MinReplicas != nil &&
+		*mcpServer.Spec.Autoscaling.MinReplicas == 0 {
+		return fmt.Errorf("endpointRef.readiness %s cannot be combined with autoscaling.minReplicas 0, "+
+			"as the workload would never be scaled up again", mcpgatewayv1alpha1.WorkloadReadinessRemoveWhenScaledToZero)
+	}
 
 	// Validate gateway ID is available
 	if _, err := r.ConfigParser.GetGatewayID(mcpServer); err != nil {
@@ -146,21 +634,82 @@ func (r *MCPServerReconciler) validateSpec(mcpServer *mcpgatewayv1alpha1.MCPServ
 	return nil
 }
 
-// handleDeletion handles the deletion of an MCPServer resource
-func (r *MCPServerReconciler) handleDeletion(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) (ctrl.Result, error) {
-	if controllerutil.ContainsFinalizer(mcpServer, gatewayTargetFinalizer) {
-		// Delete gateway target from AWS
-		if err := r.deleteGatewayTarget(ctx, mcpServer, log); err != nil {
+// handleDeletion handles the deletion of an MCPServer resource. providerErr is the error of an
+// AWSProviderConfig that cannot be used, which blocks only the deletion of the gateway target.
+func (r *MCPServerReconciler) handleDeletion(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	providerErr error,
+	log logr.Logger,
+) (ctrl.Result, error) {
+	if hasGatewayTargetFinalizer(mcpServer) {
+		// Keep the target registered until in-flight sessions had the chance to finish
+		if remaining := drainRemaining(mcpServer, time.Now()); remaining > 0 && !retainsTarget(mcpServer) {
+			return r.drainTarget(ctx, mcpServer, remaining, log)
+		}
+
+		// Delete owned objects before the target if the MCPServer asks for strict ordering
+		if mcpServer.Spec.DependentDeletion == mcpgatewayv1alpha1.DependentDeletionForeground {
+			remaining, err := r.deleteDependents(ctx, mcpServer, log)
+			if err != nil {
+				log.Error(err, "Failed to delete dependent objects")
+				return ctrl.Result{}, err
+			}
+			if remaining > 0 {
+				return ctrl.Result{RequeueAfter: dependentDeletionInterval}, nil
+			}
+		}
+
+		// Delete gateway target from AWS, unless it went away with its gateway
+		if meta.IsStatusConditionTrue(mcpServer.Status.Conditions, gatewayDeletedCondition) {
+			log.Info("Gateway was deleted, releasing MCPServer without deleting its gateway target",
+				"gatewayId", mcpServer.Status.GatewayID)
+		} else if meta.IsStatusConditionTrue(mcpServer.Status.Conditions, ownershipConflictCondition) {
+			log.Info("Gateway target is managed by another cluster, releasing MCPServer without deleting it",
+				"targetId", mcpServer.Status.TargetID)
+		} else if retainsTarget(mcpServer) {
+			r.retainGatewayTarget(mcpServer, log)
+		} else if providerErr != nil && mcpServer.Status.TargetID != "" {
+			orphaned, statusErr := r.reportProviderConfigUnusable(ctx, mcpServer, providerErr, log)
+			if statusErr != nil {
+				log.Error(statusErr, "Failed to update status with provider config error")
+				return ctrl.Result{}, statusErr
+			}
+			if !orphaned {
+				// The MCPServer is reconciled again when the AWSProviderConfig is created or changed
+				return ctrl.Result{}, nil
+			}
+		} else if deleted, err := r.finalizeGatewayTarget(ctx, mcpServer, log); bedrock.IsAccessDeniedError(err) {
+			// Waiting for a permission that was never granted would block the deletion forever
+			orphaned, statusErr := r.reportDeleteDenied(ctx, mcpServer, err, log)
+			if statusErr != nil {
+				log.Error(statusErr, "Failed to update status with access denied on delete")
+				return ctrl.Result{}, statusErr
+			}
+			if !orphaned {
+				return ctrl.Result{RequeueAfter: permissionsRecheckInterval}, nil
+			}
+		} else if err != nil {
 			log.Error(err, "Failed to delete gateway target")
 			return ctrl.Result{}, err
+		} else if !deleted {
+			// Keep the finalizer until AWS finished deleting the target
+			return ctrl.Result{RequeueAfter: targetDeletionPollInterval}, nil
+		}
+		if err := r.enterPhase(ctx, phaseAfterDelete, mcpServer); err != nil {
+			return ctrl.Result{}, err
 		}
+		r.forgetPreviewTarget(ctx, mcpServer, log)
 
 		// Remove finalizer after successful deletion
-		controllerutil.RemoveFinalizer(mcpServer, gatewayTargetFinalizer)
-		if err := r.Update(ctx, mcpServer); err != nil {
+		patch := client.MergeFrom(mcpServer.DeepCopy())
+		removeGatewayTargetFinalizer(mcpServer)
+		if err := r.Patch(ctx, mcpServer, patch); err != nil {
 			log.Error(err, "Failed to remove finalizer")
 			return ctrl.Result{}, err
 		}
+		deleteMetrics(mcpServer.Namespace, mcpServer.Name)
+		r.forgetCallBudget(mcpServer.Namespace, mcpServer.Name)
 		log.Info("Removed finalizer from MCPServer after successful deletion")
 	}
 	return ctrl.Result{}, nil
@@ -182,11 +731,23 @@ func (r *MCPServerReconciler) deleteGatewayTarget(ctx context.Context, mcpServer
 	}
 
 	// Create Bedrock client wrapper
-	bedrockWrapper := bedrock.NewBedrockClientWrapper(r.BedrockClient, log)
+	bedrockWrapper := r.newBedrockWrapper(log)
+
+	// Record the intent before calling AWS
+	entry, err := r.beginOperation(ctx, mcpServer, journal.OperationDelete, gatewayID, "")
+	if err != nil {
+		log.Error(err, "Failed to record delete in journal")
+		return err
+	}
 
 	// Delete gateway target
+	if err := r.enterPhase(ctx, phaseBeforeDelete, mcpServer); err != nil {
+		return err
+	}
 	log.Info("Deleting gateway target", "gatewayId", gatewayID, "targetId", mcpServer.Status.TargetID)
-	if err := bedrockWrapper.DeleteGatewayTarget(ctx, gatewayID, mcpServer.Status.TargetID); err != nil {
+	err = bedrockWrapper.DeleteGatewayTarget(ctx, gatewayID, mcpServer.Status.TargetID)
+	r.completeOperation(ctx, entry, log)
+	if err != nil {
 		log.Error(err, "Failed to delete gateway target")
 		return err
 	}
@@ -195,8 +756,22 @@ func (r *MCPServerReconciler) deleteGatewayTarget(ctx context.Context, mcpServer
 	return nil
 }
 
-// createGatewayTarget creates a new gateway target in AWS Bedrock AgentCore
-func (r *MCPServerReconciler) createGatewayTarget(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) (ctrl.Result, error) {
+// createGatewayTarget creates a new gateway target in AWS Bedrock AgentCore.
+// The workload metadata applied to the MCPServer is recorded in its status.
+func (r *MCPServerReconciler) createGatewayTarget(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	workloadMetadata *mcpgatewayv1alpha1.WorkloadMetadata,
+	log logr.Logger,
+) (ctrl.Result, error) {
+	// Give the create slot back unless a target was created
+	created := false
+	defer func() {
+		if !created {
+			r.createSlots.release(client.ObjectKeyFromObject(mcpServer))
+		}
+	}()
+
 	// Extract gateway ID
 	gatewayID, err := r.ConfigParser.GetGatewayID(mcpServer)
 	if err != nil {
@@ -205,10 +780,7 @@ func (r *MCPServerReconciler) createGatewayTarget(ctx context.Context, mcpServer
 	}
 
 	// Determine target name (use spec.TargetName or default to resource name)
-	targetName := mcpServer.Spec.TargetName
-	if targetName == "" {
-		targetName = mcpServer.Name
-	}
+	targetName := r.targetName(mcpServer)
 
 	// Build target configuration
 	targetConfig, err := r.TargetConfigBuilder.Build(mcpServer)
@@ -231,7 +803,7 @@ func (r *MCPServerReconciler) createGatewayTarget(ctx context.Context, mcpServer
 	}
 
 	// Build metadata configuration
-	metadataConfig := r.TargetConfigBuilder.BuildMetadataConfig(mcpServer)
+	metadataConfig := r.TargetConfigBuilder.BuildMetadataConfig(mcpServer, nil)
 
 	// Build CreateGatewayTargetInput
 	input := &bedrockagentcorecontrol.CreateGatewayTargetInput{
@@ -241,10 +813,8 @@ func (r *MCPServerReconciler) createGatewayTarget(ctx context.Context, mcpServer
 		CredentialProviderConfigurations: credentialConfig,
 	}
 
-	// Add description if provided
-	if mcpServer.Spec.Description != "" {
-		input.Description = aws.String(mcpServer.Spec.Description)
-	}
+	// Add description if provided, followed by the owner marker of this cluster
+	input.Description = r.targetDescription(mcpServer)
 
 	// Add metadata configuration if present
 	if metadataConfig != nil {
@@ -252,18 +822,57 @@ func (r *MCPServerReconciler) createGatewayTarget(ctx context.Context, mcpServer
 	}
 
 	// Create Bedrock client wrapper
-	bedrockWrapper := bedrock.NewBedrockClientWrapper(r.BedrockClient, log)
+	bedrockWrapper := r.newBedrockWrapper(log)
+
+	// Check the target against the live gateway so incompatibilities surface as precise conditions
+	// instead of a vague validation error from CreateGatewayTarget
+	incompatible, err := r.checkGatewayCompatibility(ctx, bedrockWrapper, gatewayID, credentialConfig, log)
+	if err != nil {
+		// A target removed from a gateway that was deleted since cannot be created there again
+		if bedrock.IsResourceNotFoundError(err) && mcpServer.Status.GatewayID == gatewayID {
+			return r.handleGatewayDeleted(ctx, mcpServer, gatewayID, log)
+		}
+		return ctrl.Result{}, err
+	}
+	if incompatible != nil {
+		log.Info("Gateway is incompatible with target", "gatewayId", gatewayID, "reason", incompatible.Reason,
+			"message", incompatible.Message)
+		if statusErr := r.StatusManager.SetError(ctx, mcpServer, incompatible.Reason, incompatible.Message); statusErr != nil {
+			log.Error(statusErr, "Failed to update status with gateway incompatibility")
+			return ctrl.Result{}, statusErr
+		}
+		// The gateway may be reconfigured out of band, so check again later
+		return ctrl.Result{RequeueAfter: gatewayCompatibilityRecheckInterval}, nil
+	}
+
+	// Record the intent before calling AWS
+	if clientToken := r.createClientToken(ctx, mcpServer, log); clientToken != "" {
+		input.ClientToken = aws.String(clientToken)
+	}
+	entry, err := r.beginOperation(ctx, mcpServer, journal.OperationCreate, gatewayID, aws.ToString(input.ClientToken))
+	if err != nil {
+		log.Error(err, "Failed to record create in journal")
+		return ctrl.Result{}, err
+	}
 
 	// Create gateway target
+	if err := r.enterPhase(ctx, phaseBeforeCreate, mcpServer); err != nil {
+		return ctrl.Result{}, err
+	}
 	log.Info("Creating gateway target", "gatewayId", gatewayID, "targetName", targetName)
 	output, err := bedrockWrapper.CreateGatewayTarget(ctx, input)
 	if err != nil {
+		r.completeOperation(ctx, entry, log)
 		log.Error(err, "Failed to create gateway target")
 		if statusErr := r.StatusManager.SetError(ctx, mcpServer, "CreationError", err.Error()); statusErr != nil {
 			log.Error(statusErr, "Failed to update status with creation error")
 		}
 		return ctrl.Result{}, err
 	}
+	created = true
+	if err := r.enterPhase(ctx, phaseAfterCreate, mcpServer); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Re-fetch the resource to get the latest version before updating status
 	latestMCPServer := &mcpgatewayv1alpha1.MCPServer{}
@@ -273,15 +882,29 @@ func (r *MCPServerReconciler) createGatewayTarget(ctx context.Context, mcpServer
 	}
 
 	// Update status with target information
-	if err := r.StatusManager.UpdateTargetCreated(ctx, latestMCPServer, *output.TargetId, *output.GatewayArn, string(output.Status)); err != nil {
+	latestMCPServer.Status.WorkloadMetadata = workloadMetadata
+	recordResolvedEndpoint(latestMCPServer, mcpServer)
+	recordOpenAPISchemaHash(latestMCPServer, mcpServer)
+	recordAppliedSpecHash(latestMCPServer, mcpServer)
+	recordAppliedCredentials(latestMCPServer, output.CredentialProviderConfigurations)
+	if err := r.StatusManager.UpdateTargetCreated(ctx, latestMCPServer, *output.TargetId, *output.GatewayArn, string(output.Status),
+		output.UpdatedAt); err != nil {
 		log.Error(err, "Failed to update status after creation")
 		// If it's a conflict error, requeue to retry
+		// The journal entry is kept so the retried create reuses its client token
 		if apierrors.IsConflict(err) {
 			log.V(1).Info("Conflict updating status after creation, will retry")
 			return ctrl.Result{Requeue: true}, nil
 		}
 		return ctrl.Result{}, err
 	}
+	r.completeOperation(ctx, entry, log)
+
+	// Record ownership on the resource itself so that it can re-adopt the target if its status is lost
+	if err := r.recordTargetOwnership(ctx, latestMCPServer, gatewayID, *output.TargetId); err != nil {
+		log.Error(err, "Failed to record gateway target ownership")
+	}
+	r.recordPreviewTarget(ctx, latestMCPServer, gatewayID, *output.TargetId, log)
 
 	log.Info("Gateway target created successfully", "targetId", *output.TargetId, "status", output.Status)
 
@@ -289,16 +912,105 @@ func (r *MCPServerReconciler) createGatewayTarget(ctx context.Context, mcpServer
 	return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
 }
 
+// checkGatewayCompatibility fetches the gateway and validates that it can serve a target with the
+// given credential configuration. The check is skipped if the operator may not describe the gateway.
+func (r *MCPServerReconciler) checkGatewayCompatibility(
+	ctx context.Context,
+	bedrockWrapper *bedrock.BedrockClientWrapper,
+	gatewayID string,
+	credentialConfig []bedrocktypes.CredentialProviderConfiguration,
+	log logr.Logger,
+) (*bedrock.IncompatibilityError, error) {
+	gateway, err := bedrockWrapper.GetGateway(ctx, gatewayID)
+	if err != nil {
+		if bedrock.IsAccessDeniedError(err) {
+			log.Info("Not authorized to get gateway, skipping compatibility check", "gatewayId", gatewayID)
+			return nil, nil
+		}
+		log.Error(err, "Failed to get gateway", "gatewayId", gatewayID)
+		return nil, err
+	}
+
+	var incompatible *bedrock.IncompatibilityError
+	if errors.As(bedrock.ValidateGatewayCompatibility(gateway, credentialConfig), &incompatible) {
+		return incompatible, nil
+	}
+	return nil, nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
+// Secrets and ConfigMaps are only watched through the label-restricted cache configured by
+// ReferenceCacheOptions, so the manager must be created with those options, and with
+// GatewayAPICacheOptions if the GatewayAPI feature gate is enabled.
 func (r *MCPServerReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&mcpgatewayv1alpha1.MCPServer{}).
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &mcpgatewayv1alpha1.MCPServer{},
+		referenceIndexField, indexReferences); err != nil {
+		return fmt.Errorf("failed to index MCPServer references: %w", err)
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &mcpgatewayv1alpha1.MCPServer{},
+		endpointIndexField, indexEndpoint); err != nil {
+		return fmt.Errorf("failed to index MCPServer endpoints: %w", err)
+	}
+
+	r.recordShardInfo()
+	fleetSource.Store(r)
+
+	// Replay operations interrupted by a previous run once the cache is ready
+	if r.Journal != nil {
+		if err := mgr.Add(manager.RunnableFunc(r.ReplayJournal)); err != nil {
+			return fmt.Errorf("failed to register journal replay: %w", err)
+		}
+	}
+
+	// Take over targets created by operator versions without ownership markers
+	if err := mgr.Add(manager.RunnableFunc(r.MigrateLegacyTargets)); err != nil {
+		return fmt.Errorf("failed to register legacy target migration: %w", err)
+	}
+
+	// MCPServers are watched rather than registered with For so that they are enqueued with the
+	// priority of their class
+	usePriorityQueue := true
+	b := ctrl.NewControllerManagedBy(mgr).
+		Watches(&mcpgatewayv1alpha1.MCPServer{}, priorityEventHandler[client.Object]{},
+			builder.WithPredicates(r.shardPredicate())).
+		Watches(&mcpgatewayv1alpha1.MCPServer{}, handler.EnqueueRequestsFromMapFunc(r.mapEndpointToMCPServers),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.mapReferenceToMCPServers("ConfigMap"))).
+		Watches(&mcpgatewayv1alpha1.AWSProviderConfig{},
+			handler.EnqueueRequestsFromMapFunc(r.mapReferenceToMCPServers(providerConfigKind))).
+		Watches(&corev1.Service{}, handler.EnqueueRequestsFromMapFunc(r.mapReferenceToMCPServers("Service"))).
+		Watches(&appsv1.Deployment{}, handler.EnqueueRequestsFromMapFunc(r.mapReferenceToMCPServers("Deployment"))).
+		Watches(&appsv1.StatefulSet{}, handler.EnqueueRequestsFromMapFunc(r.mapReferenceToMCPServers("StatefulSet"))).
 		Named("mcpserver").
-		Complete(r)
+		WithOptions(controller.Options{UsePriorityQueue: &usePriorityQueue})
+
+	// Without Secret references the operator needs no permission to read Secrets
+	if r.FeatureGates.Enabled(FeatureSecretReferences) {
+		b = b.Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.mapReferenceToMCPServers("Secret")))
+	}
+
+	// Changing the hostnames of an HTTPRoute updates the endpoint of the MCPServers referencing it
+	if r.FeatureGates.Enabled(FeatureGatewayAPI) {
+		b = b.Watches(newHTTPRoute(), handler.EnqueueRequestsFromMapFunc(r.mapReferenceToMCPServers(HTTPRouteGVK.Kind)),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+	}
+
+	// Changing the environment of a namespace applies its profile to the MCPServers in it
+	if r.Environments != nil {
+		b = b.Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.mapNamespaceToMCPServers),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}))
+	}
+	return b.Complete(r)
 }
 
-// detectConfigChanges checks if the MCPServer spec has changed compared to what's in AWS
-func (r *MCPServerReconciler) detectConfigChanges(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) bool {
+// detectConfigChanges checks if the MCPServer spec, or the metadata it takes from its workload,
+// has changed compared to what's in AWS
+func (r *MCPServerReconciler) detectConfigChanges(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	workloadMetadata *mcpgatewayv1alpha1.WorkloadMetadata,
+	log logr.Logger,
+) bool {
 	// For now, we'll use annotations to track the last applied configuration
 	// In a production system, you might want to fetch the current AWS configuration and compare
 
@@ -311,15 +1023,52 @@ func (r *MCPServerReconciler) detectConfigChanges(ctx context.Context, mcpServer
 	// Check if the resource generation has changed (indicates spec update)
 	// The generation is incremented by Kubernetes whenever the spec changes
 	if mcpServer.Generation != mcpServer.Status.ObservedGeneration {
-		log.Info("Configuration change detected", "generation", mcpServer.Generation, "observedGeneration", mcpServer.Status.ObservedGeneration)
+		// Unless the spec only changed in ways that do not reach AWS, e.g. the order of scopes
+		if !appliedSpecUnchanged(mcpServer) {
+			log.Info("Configuration change detected", "generation", mcpServer.Generation, "observedGeneration", mcpServer.Status.ObservedGeneration)
+			return true
+		}
+		log.V(1).Info("Spec changed without changing the gateway target", "generation", mcpServer.Generation,
+			"observedGeneration", mcpServer.Status.ObservedGeneration)
+	}
+
+	// The values substituted into the endpoint change without a new generation
+	if resolvedEndpointChanged(mcpServer) {
+		log.Info("Endpoint values changed", "endpoint", mcpServer.Spec.Endpoint,
+			"appliedEndpoint", mcpServer.Status.ResolvedEndpoint)
 		return true
 	}
 
-	return false
+	// Neither does the OpenAPI schema read from a ConfigMap
+	if openAPISchemaChanged(mcpServer) {
+		log.Info("OpenAPI schema ConfigMap changed", "appliedHash", mcpServer.Status.OpenAPISchemaHash)
+		return true
+	}
+
+	return workloadMetadataChanged(mcpServer, workloadMetadata, log)
 }
 
-// updateGatewayTarget updates an existing gateway target in AWS Bedrock AgentCore
-func (r *MCPServerReconciler) updateGatewayTarget(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) (ctrl.Result, error) {
+// recordAppliedSpecHash records the hash of the spec applied to the gateway target, see
+// bedrock.HashSpec, in the status of latest, the MCPServer as stored
+func recordAppliedSpecHash(latest, applied *mcpgatewayv1alpha1.MCPServer) {
+	latest.Status.AppliedSpecHash = bedrock.HashSpec(applied.Spec)
+}
+
+// appliedSpecUnchanged reports whether the normalized spec of the MCPServer is the one last
+// applied to the gateway target. The spec is compared after the environment profile, workload
+// metadata and endpoint values were applied, as it was when it was recorded.
+func appliedSpecUnchanged(mcpServer *mcpgatewayv1alpha1.MCPServer) bool {
+	return mcpServer.Status.AppliedSpecHash != "" && mcpServer.Status.AppliedSpecHash == bedrock.HashSpec(mcpServer.Spec)
+}
+
+// updateGatewayTarget updates an existing gateway target in AWS Bedrock AgentCore.
+// The workload metadata applied to the MCPServer is recorded in its status.
+func (r *MCPServerReconciler) updateGatewayTarget(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	workloadMetadata *mcpgatewayv1alpha1.WorkloadMetadata,
+	log logr.Logger,
+) (ctrl.Result, error) {
 	// Extract gateway ID
 	gatewayID, err := r.ConfigParser.GetGatewayID(mcpServer)
 	if err != nil {
@@ -328,10 +1077,7 @@ func (r *MCPServerReconciler) updateGatewayTarget(ctx context.Context, mcpServer
 	}
 
 	// Determine target name (use spec.TargetName or default to resource name)
-	targetName := mcpServer.Spec.TargetName
-	if targetName == "" {
-		targetName = mcpServer.Name
-	}
+	targetName := r.targetName(mcpServer)
 
 	// Build target configuration
 	targetConfig, err := r.TargetConfigBuilder.Build(mcpServer)
@@ -353,8 +1099,31 @@ func (r *MCPServerReconciler) updateGatewayTarget(ctx context.Context, mcpServer
 		return ctrl.Result{}, err
 	}
 
+	// Create Bedrock client wrapper
+	bedrockWrapper := r.newBedrockWrapper(log)
+
+	// Fetch the current target, which the metadata configuration and the concurrency check build on.
+	// Without permission to read it the update goes ahead without them.
+	current, err := bedrockWrapper.GetGatewayTarget(ctx, gatewayID, mcpServer.Status.TargetID)
+	if err != nil {
+		if r.awaitConsistency(ctx, mcpServer, err, log) {
+			return ctrl.Result{RequeueAfter: consistencyRetryInterval}, nil
+		}
+		if isGatewayDeleted(ctx, bedrockWrapper, gatewayID, err) {
+			return r.handleGatewayDeleted(ctx, mcpServer, gatewayID, log)
+		}
+		if !bedrock.IsAccessDeniedError(err) {
+			log.Error(err, "Failed to get gateway target before update")
+			return ctrl.Result{}, err
+		}
+		if statusErr := r.reportReadDenied(ctx, mcpServer, err, log); statusErr != nil {
+			log.Error(statusErr, "Failed to update status with partial permissions")
+		}
+		current = &bedrockagentcorecontrol.GetGatewayTargetOutput{}
+	}
+
 	// Build metadata configuration
-	metadataConfig := r.TargetConfigBuilder.BuildMetadataConfig(mcpServer)
+	metadataConfig := r.TargetConfigBuilder.BuildMetadataConfig(mcpServer, current.MetadataConfiguration)
 
 	// Build UpdateGatewayTargetInput
 	input := &bedrockagentcorecontrol.UpdateGatewayTargetInput{
@@ -365,29 +1134,68 @@ func (r *MCPServerReconciler) updateGatewayTarget(ctx context.Context, mcpServer
 		CredentialProviderConfigurations: credentialConfig,
 	}
 
-	// Add description if provided
-	if mcpServer.Spec.Description != "" {
-		input.Description = aws.String(mcpServer.Spec.Description)
-	}
+	// Add description if provided, followed by the owner marker of this cluster
+	input.Description = r.targetDescription(mcpServer)
 
 	// Add metadata configuration if present
 	if metadataConfig != nil {
 		input.MetadataConfiguration = metadataConfig
 	}
 
-	// Create Bedrock client wrapper
-	bedrockWrapper := bedrock.NewBedrockClientWrapper(r.BedrockClient, log)
+	// Refuse to mutate a target managed by another cluster
+	if conflict, err := r.checkOwnershipConflict(ctx, mcpServer, current, log); conflict || err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Refuse to overwrite changes made to the target outside of the operator.
+	// The user has to resolve the conflict, which updates the resource and triggers a reconcile.
+	modified, err := r.checkConcurrentModification(ctx, mcpServer, current, log)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if modified {
+		return ctrl.Result{}, nil
+	}
+
+	// Record the intent before calling AWS
+	entry, err := r.beginOperation(ctx, mcpServer, journal.OperationUpdate, gatewayID, "")
+	if err != nil {
+		log.Error(err, "Failed to record update in journal")
+		return ctrl.Result{}, err
+	}
 
 	// Update gateway target
+	if err := r.enterPhase(ctx, phaseBeforeUpdate, mcpServer); err != nil {
+		return ctrl.Result{}, err
+	}
 	log.Info("Updating gateway target", "gatewayId", gatewayID, "targetId", mcpServer.Status.TargetID, "targetName", targetName)
 	output, err := bedrockWrapper.UpdateGatewayTarget(ctx, input)
 	if err != nil {
+		r.completeOperation(ctx, entry, log)
 		log.Error(err, "Failed to update gateway target")
+		if bedrock.IsConflictError(err) {
+			// Another writer is modifying the target right now
+			if statusErr := r.StatusManager.SetConcurrentModification(ctx, mcpServer, true, err.Error()); statusErr != nil {
+				log.Error(statusErr, "Failed to update status with concurrent modification")
+			}
+			return ctrl.Result{}, err
+		}
+		if bedrock.IsAccessDeniedError(err) && current.TargetId != nil {
+			if statusErr := r.reportWriteDenied(ctx, mcpServer, err, log); statusErr != nil {
+				log.Error(statusErr, "Failed to update status with partial permissions")
+			}
+		}
 		if statusErr := r.StatusManager.SetError(ctx, mcpServer, "UpdateError", err.Error()); statusErr != nil {
 			log.Error(statusErr, "Failed to update status with update error")
 		}
 		return ctrl.Result{}, err
 	}
+	if err := r.enterPhase(ctx, phaseAfterUpdate, mcpServer); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// Re-fetch the resource to get the latest version before updating status
 	latestMCPServer := &mcpgatewayv1alpha1.MCPServer{}
@@ -397,7 +1205,13 @@ func (r *MCPServerReconciler) updateGatewayTarget(ctx context.Context, mcpServer
 	}
 
 	// Update status with new information
-	if err := r.StatusManager.UpdateTargetStatus(ctx, latestMCPServer, string(output.Status), output.StatusReasons); err != nil {
+	latestMCPServer.Status.WorkloadMetadata = workloadMetadata
+	recordResolvedEndpoint(latestMCPServer, mcpServer)
+	recordOpenAPISchemaHash(latestMCPServer, mcpServer)
+	recordAppliedSpecHash(latestMCPServer, mcpServer)
+	recordAppliedCredentials(latestMCPServer, output.CredentialProviderConfigurations)
+	if err := r.StatusManager.UpdateTargetStatus(ctx, latestMCPServer, string(output.Status), output.StatusReasons,
+		output.UpdatedAt); err != nil {
 		log.Error(err, "Failed to update status after update")
 		// If it's a conflict error, requeue to retry
 		if apierrors.IsConflict(err) {
@@ -406,6 +1220,10 @@ func (r *MCPServerReconciler) updateGatewayTarget(ctx context.Context, mcpServer
 		}
 		return ctrl.Result{}, err
 	}
+	r.completeOperation(ctx, entry, log)
+	r.clearConcurrentModification(ctx, latestMCPServer, log)
+	r.clearConfigDrift(ctx, latestMCPServer, log)
+	r.clearPartialPermissions(ctx, latestMCPServer, reasonWriteAccessDenied, log)
 
 	log.Info("Gateway target updated successfully", "targetId", *output.TargetId, "status", output.Status)
 
@@ -423,16 +1241,39 @@ func (r *MCPServerReconciler) syncGatewayTargetStatus(ctx context.Context, mcpSe
 	}
 
 	// Create Bedrock client wrapper
-	bedrockWrapper := bedrock.NewBedrockClientWrapper(r.BedrockClient, log)
+	bedrockWrapper := r.newBedrockWrapper(log)
 
 	// Get gateway target status
 	log.V(1).Info("Syncing gateway target status", "targetId", mcpServer.Status.TargetID)
 	output, err := bedrockWrapper.GetGatewayTarget(ctx, gatewayID, mcpServer.Status.TargetID)
 	if err != nil {
+		if bedrock.IsAccessDeniedError(err) {
+			// The target was created, so the operator may write but not read gateway targets
+			if statusErr := r.reportReadDenied(ctx, mcpServer, err, log); statusErr != nil {
+				log.Error(statusErr, "Failed to update status with partial permissions")
+				return ctrl.Result{}, statusErr
+			}
+			return ctrl.Result{RequeueAfter: permissionsRecheckInterval}, nil
+		}
+		// Targets written moments ago may not be returned yet
+		if r.awaitConsistency(ctx, mcpServer, err, log) {
+			return ctrl.Result{RequeueAfter: consistencyRetryInterval}, nil
+		}
+		if isGatewayDeleted(ctx, bedrockWrapper, gatewayID, err) {
+			return r.handleGatewayDeleted(ctx, mcpServer, gatewayID, log)
+		}
 		log.Error(err, "Failed to get gateway target status")
 		return ctrl.Result{}, err
 	}
 
+	// Report targets taken over by another cluster; they are no longer updated
+	if _, err := r.checkOwnershipConflict(ctx, mcpServer, output, log); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
 	// Extract status reasons
 	var statusReasons []string
 	if output.StatusReasons != nil {
@@ -448,7 +1289,10 @@ func (r *MCPServerReconciler) syncGatewayTargetStatus(ctx context.Context, mcpSe
 	}
 
 	// Update status with current AWS status
-	if err := r.StatusManager.UpdateTargetStatus(ctx, latestMCPServer, string(output.Status), statusReasons); err != nil {
+	// While the target settles its modification time is refreshed, as AWS may bump it on status transitions
+	recordAppliedCredentials(latestMCPServer, output.CredentialProviderConfigurations)
+	if err := r.StatusManager.UpdateTargetStatus(ctx, latestMCPServer, string(output.Status), statusReasons,
+		output.UpdatedAt); err != nil {
 		log.Error(err, "Failed to update target status")
 		// If it's a conflict error, requeue to retry
 		if apierrors.IsConflict(err) {
@@ -457,6 +1301,8 @@ func (r *MCPServerReconciler) syncGatewayTargetStatus(ctx context.Context, mcpSe
 		}
 		return ctrl.Result{}, err
 	}
+	r.clearPartialPermissions(ctx, latestMCPServer, reasonReadAccessDenied, log)
+	r.clearAwaitingConsistency(ctx, latestMCPServer, log)
 
 	// Check if target is ready
 	if output.Status == "READY" {
@@ -477,7 +1323,19 @@ func (r *MCPServerReconciler) syncGatewayTargetStatus(ctx context.Context, mcpSe
 			}
 			return ctrl.Result{}, err
 		}
-		return ctrl.Result{}, nil
+
+		if err := r.checkMetadataDrift(ctx, latestMCPServer, output.MetadataConfiguration, log); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, err
+		}
+		return r.checkCredentialsExpiry(ctx, latestMCPServer, log)
+	}
+
+	// Don't wait for a status the operator does not know to settle
+	if bedrock.ClassifyTargetStatus(output.Status) == bedrock.TargetStatusClassUnknown {
+		return r.handleUnknownTargetStatus(ctx, latestMCPServer, string(output.Status), log)
 	}
 
 	// If not ready, log status and requeue
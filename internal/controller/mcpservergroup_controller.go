@@ -0,0 +1,177 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// maxNotReadyMembers caps the MCPServers listed in the status and Ready condition of a group
+const maxNotReadyMembers = 20
+
+// +kubebuilder:rbac:groups=mcpgateway.bedrock.aws,resources=mcpservergroups,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=mcpgateway.bedrock.aws,resources=mcpservergroups/status,verbs=get;update;patch
+
+// MCPServerGroupReconciler aggregates the readiness of the MCPServers selected by an
+// MCPServerGroup into its Ready condition. An MCPServer is ready once its gateway target is
+// READY for its current generation, as for the targetreadiness controller.
+type MCPServerGroupReconciler struct {
+	client.Client
+}
+
+// Reconcile updates the status of an MCPServerGroup from the status of its MCPServers
+func (r *MCPServerGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	group := &mcpgatewayv1alpha1.MCPServerGroup{}
+	if err := r.Get(ctx, req.NamespacedName, group); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if group.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+
+	status := mcpgatewayv1alpha1.MCPServerGroupStatus{
+		ObservedGeneration: group.Generation,
+		Conditions:         slices.Clone(group.Status.Conditions),
+	}
+	condition := metav1.Condition{Type: "Ready", ObservedGeneration: group.Generation}
+
+	selector, err := metav1.LabelSelectorAsSelector(&group.Spec.Selector)
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "InvalidSelector"
+		condition.Message = fmt.Sprintf("Invalid selector: %v", err)
+	} else {
+		members := &mcpgatewayv1alpha1.MCPServerList{}
+		if err := r.List(ctx, members, client.InNamespace(group.Namespace),
+			client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return ctrl.Result{}, err
+		}
+		aggregateMembers(group, members.Items, &status, &condition)
+	}
+
+	meta.SetStatusCondition(&status.Conditions, condition)
+	if equality.Semantic.DeepEqual(group.Status, status) {
+		return ctrl.Result{}, nil
+	}
+	group.Status = status
+	if err := r.Status().Update(ctx, group); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	log.Info("Updated MCPServer group status", "members", status.Members, "readyMembers", status.ReadyMembers,
+		"ready", condition.Status)
+	return ctrl.Result{}, nil
+}
+
+// aggregateMembers counts the ready MCPServers of the group and sets its Ready condition
+func aggregateMembers(
+	group *mcpgatewayv1alpha1.MCPServerGroup,
+	members []mcpgatewayv1alpha1.MCPServer,
+	status *mcpgatewayv1alpha1.MCPServerGroupStatus,
+	condition *metav1.Condition,
+) {
+	var notReady []string
+	for i := range members {
+		if mcpServerReady(&members[i]) {
+			status.ReadyMembers++
+		} else {
+			notReady = append(notReady, members[i].Name)
+		}
+	}
+	status.Members = int32(len(members))
+	slices.Sort(notReady)
+	status.NotReady = notReady[:min(len(notReady), maxNotReadyMembers)]
+
+	switch {
+	case status.Members < group.Spec.MinMembers:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "TooFewMembers"
+		condition.Message = fmt.Sprintf("%d MCPServers selected, at least %d required",
+			status.Members, group.Spec.MinMembers)
+	case len(notReady) > 0:
+		waiting := strings.Join(status.NotReady, ", ")
+		if len(notReady) > len(status.NotReady) {
+			waiting += fmt.Sprintf(" and %d more", len(notReady)-len(status.NotReady))
+		}
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "MembersNotReady"
+		condition.Message = fmt.Sprintf("%d of %d MCPServers ready, waiting for %s",
+			status.ReadyMembers, status.Members, waiting)
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "MembersReady"
+		condition.Message = fmt.Sprintf("Gateway targets of all %d MCPServers are ready", status.Members)
+	}
+}
+
+// mcpServerReady reports whether the gateway target of the MCPServer is READY for its current spec
+func mcpServerReady(mcpServer *mcpgatewayv1alpha1.MCPServer) bool {
+	return mcpServer.DeletionTimestamp == nil && mcpServer.Status.TargetStatus == "READY" &&
+		mcpServer.Status.ObservedGeneration == mcpServer.Generation
+}
+
+// mapMCPServerToGroups enqueues the MCPServerGroups in the namespace of the MCPServer whose
+// selector matches it. Label changes enqueue the groups of both the old and the new labels,
+// since the handler maps both objects of an update.
+func (r *MCPServerGroupReconciler) mapMCPServerToGroups(ctx context.Context, obj client.Object) []reconcile.Request {
+	groups := &mcpgatewayv1alpha1.MCPServerGroupList{}
+	if err := r.List(ctx, groups, client.InNamespace(obj.GetNamespace())); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list MCPServer groups", "mcpServer", obj.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, group := range groups.Items {
+		selector, err := metav1.LabelSelectorAsSelector(&group.Spec.Selector)
+		if err != nil || !selector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: group.Namespace, Name: group.Name},
+		})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *MCPServerGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mcpgatewayv1alpha1.MCPServerGroup{}).
+		Watches(
+			&mcpgatewayv1alpha1.MCPServer{},
+			handler.EnqueueRequestsFromMapFunc(r.mapMCPServerToGroups),
+		).
+		Named(MCPServerGroupControllerName).
+		Complete(r)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/internal/testutil"
+)
+
+var _ = Describe("MCPServer groups", func() {
+	ctx := context.Background()
+	groupName := types.NamespacedName{Name: "travel-agent", Namespace: "default"}
+
+	newMember := func(name, app, targetStatus string) *mcpgatewayv1alpha1.MCPServer {
+		return testutil.NewMCPServer(name, testutil.WithLabels(map[string]string{"app": app}),
+			testutil.WithTargetStatus("", targetStatus))
+	}
+
+	newReconciler := func(objects ...client.Object) *MCPServerGroupReconciler {
+		group := testutil.NewMCPServerGroup(groupName.Name, map[string]string{"app": "travel-agent"},
+			testutil.Edit(func(group *mcpgatewayv1alpha1.MCPServerGroup) {
+				group.Spec.MinMembers = 2
+			}))
+		return &MCPServerGroupReconciler{Client: testutil.NewFakeClient(append(objects, group)...)}
+	}
+
+	reconcileGroup := func(reconciler *MCPServerGroupReconciler) *mcpgatewayv1alpha1.MCPServerGroup {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: groupName})
+		Expect(err).NotTo(HaveOccurred())
+		group := &mcpgatewayv1alpha1.MCPServerGroup{}
+		Expect(reconciler.Get(ctx, groupName, group)).To(Succeed())
+		return group
+	}
+
+	It("should wait for the minimum number of members", func() {
+		reconciler := newReconciler(newMember("flights", "travel-agent", "READY"))
+
+		group := reconcileGroup(reconciler)
+		Expect(group.Status.Members).To(Equal(int32(1)))
+		condition := meta.FindStatusCondition(group.Status.Conditions, "Ready")
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("TooFewMembers"))
+	})
+
+	It("should only become ready once every selected MCPServer is ready", func() {
+		hotels := newMember("hotels", "travel-agent", "CREATING")
+		reconciler := newReconciler(
+			newMember("flights", "travel-agent", "READY"),
+			hotels,
+			newMember("weather", "other-agent", "FAILED"),
+		)
+
+		By("waiting for the target of the second member")
+		group := reconcileGroup(reconciler)
+		Expect(group.Status.Members).To(Equal(int32(2)))
+		Expect(group.Status.ReadyMembers).To(Equal(int32(1)))
+		Expect(group.Status.NotReady).To(Equal([]string{"hotels"}))
+		condition := meta.FindStatusCondition(group.Status.Conditions, "Ready")
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("MembersNotReady"))
+		Expect(condition.Message).To(ContainSubstring("waiting for hotels"))
+
+		By("becoming ready with the last member")
+		Expect(reconciler.Get(ctx, client.ObjectKeyFromObject(hotels), hotels)).To(Succeed())
+		hotels.Status.TargetStatus = "READY"
+		Expect(reconciler.Status().Update(ctx, hotels)).To(Succeed())
+		group = reconcileGroup(reconciler)
+		Expect(group.Status.ReadyMembers).To(Equal(int32(2)))
+		Expect(group.Status.NotReady).To(BeEmpty())
+		condition = meta.FindStatusCondition(group.Status.Conditions, "Ready")
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.ObservedGeneration).To(Equal(int64(1)))
+
+		By("treating a spec change not yet applied as not ready")
+		Expect(reconciler.Get(ctx, client.ObjectKeyFromObject(hotels), hotels)).To(Succeed())
+		hotels.Status.ObservedGeneration = 0
+		Expect(reconciler.Status().Update(ctx, hotels)).To(Succeed())
+		group = reconcileGroup(reconciler)
+		Expect(meta.IsStatusConditionTrue(group.Status.Conditions, "Ready")).To(BeFalse())
+	})
+
+	It("should report invalid selectors", func() {
+		reconciler := newReconciler()
+		group := &mcpgatewayv1alpha1.MCPServerGroup{}
+		Expect(reconciler.Get(ctx, groupName, group)).To(Succeed())
+		group.Spec.Selector = metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "app", Operator: "Like", Values: []string{"travel"}},
+		}}
+		Expect(reconciler.Update(ctx, group)).To(Succeed())
+
+		condition := meta.FindStatusCondition(reconcileGroup(reconciler).Status.Conditions, "Ready")
+		Expect(condition.Reason).To(Equal("InvalidSelector"))
+	})
+
+	It("should enqueue the groups selecting an MCPServer", func() {
+		reconciler := newReconciler()
+		Expect(reconciler.mapMCPServerToGroups(ctx, newMember("flights", "travel-agent", ""))).To(
+			ConsistOf(reconcile.Request{NamespacedName: groupName}))
+		Expect(reconciler.mapMCPServerToGroups(ctx, newMember("weather", "other-agent", ""))).To(BeEmpty())
+	})
+})
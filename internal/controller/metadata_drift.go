@@ -0,0 +1,55 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	bedrocktypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// metadataDriftCondition is the condition reporting metadata allowlists that differ from the spec
+const metadataDriftCondition = "MetadataDrift"
+
+// checkMetadataDrift compares the metadata allowlists of the ready gateway target with the spec
+// and sets the MetadataDrift condition if they differ, e.g. because entries were added to the
+// target outside of the operator. The drift is reported rather than reverted; the next update of
+// the MCPServer writes the allowlists of the spec again.
+// The condition is only added once drift was seen, and cleared when it is gone.
+func (r *MCPServerReconciler) checkMetadataDrift(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	current *bedrocktypes.MetadataConfiguration,
+	log logr.Logger,
+) error {
+	drift := r.TargetConfigBuilder.MetadataDrift(mcpServer, current)
+	if len(drift) == 0 {
+		if !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, metadataDriftCondition) {
+			return nil
+		}
+		return r.StatusManager.SetMetadataDrift(ctx, mcpServer, false, "Metadata allowlists match the MCPServer spec")
+	}
+
+	log.Info("Metadata allowlists of gateway target differ from the spec", "targetId", mcpServer.Status.TargetID,
+		"drift", drift)
+	return r.StatusManager.SetMetadataDrift(ctx, mcpServer, true, strings.Join(drift, "; "))
+}
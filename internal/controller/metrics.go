@@ -0,0 +1,160 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/aws/mcp-gateway-operator/pkg/metriclabels"
+)
+
+var (
+	// credentialsExpiryTimestamp is the expiry time of the credentials an MCPServer depends on
+	credentialsExpiryTimestamp = metriclabels.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "mcpgateway_credentials_expiry_timestamp_seconds",
+			Help: "Unix timestamp at which the endpoint certificate or OAuth credentials of an MCPServer expire",
+		},
+		[]string{"namespace", "name", "source"},
+	)
+
+	// lastSuccessfulSyncTimestamp is the time an MCPServer was last synchronized with its gateway target
+	lastSuccessfulSyncTimestamp = metriclabels.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "mcpgateway_last_successful_sync_timestamp_seconds",
+			Help: "Unix timestamp of the last successful synchronization of an MCPServer with its gateway target",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// targetDriftDetected counts how often the gateway target of an MCPServer was found to differ
+	// from its spec
+	targetDriftDetected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mcpgateway_target_drift_detected_total",
+			Help: "Number of drift checks that found the gateway target of an MCPServer to differ from its spec",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// targetConsistencyWaits counts the reads of a gateway target that AWS did not return yet,
+	// shortly after it was written
+	targetConsistencyWaits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mcpgateway_target_consistency_waits_total",
+			Help: "Number of reads of the gateway target of an MCPServer retried because AWS did not return it yet after a write",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// shardInfo identifies the shard handled by this replica
+	shardInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "mcpgateway_shard_info",
+			Help: "Shard assignment of this operator replica; always 1",
+		},
+		[]string{"shard", "shards"},
+	)
+
+	// backpressureFactor is the factor by which requeue intervals are stretched
+	backpressureFactor = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "mcpgateway_backpressure_factor",
+			Help: "Factor by which the requeue intervals of MCPServers are stretched because AWS throttles the operator; 1 without throttling",
+		},
+		func() float64 {
+			if r := fleetSource.Load(); r != nil {
+				return float64(r.Backpressure.Factor())
+			}
+			return 1
+		},
+	)
+
+	// effectiveSyncInterval is the drift check interval stretched by the backpressure factor
+	effectiveSyncInterval = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "mcpgateway_effective_sync_interval_seconds",
+			Help: "Interval at which ready MCPServers are compared with their gateway target, stretched by the backpressure factor; 0 if drift detection is disabled",
+		},
+		func() float64 {
+			if r := fleetSource.Load(); r != nil {
+				return r.syncInterval().Seconds()
+			}
+			return 0
+		},
+	)
+
+	// circuitBreakerOpen reports whether AWS calls are failed right away by the circuit breaker of
+	// the operator's own client or of any AWSProviderConfig
+	circuitBreakerOpen = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "mcpgateway_circuit_breaker_open",
+			Help: "1 while AWS calls of the operator are failed right away because AWS keeps failing, 0 otherwise",
+		},
+		func() float64 {
+			if r := fleetSource.Load(); r != nil && (r.CircuitBreaker.Open() || r.ProviderClients.CircuitBreakerOpen()) {
+				return 1
+			}
+			return 0
+		},
+	)
+
+	// shardResources is the number of MCPServers reconciled by this replica
+	shardResources = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "mcpgateway_shard_resources",
+			Help: "Number of MCPServers assigned to this operator replica",
+		},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		credentialsExpiryTimestamp,
+		lastSuccessfulSyncTimestamp,
+		targetDriftDetected,
+		targetConsistencyWaits,
+		shardInfo,
+		shardResources,
+		backpressureFactor,
+		effectiveSyncInterval,
+		circuitBreakerOpen,
+	)
+}
+
+// fleetSource is the reconciler whose backpressure and circuit breaker the fleet-wide gauges
+// report. The reconcilers of spoke clusters share them.
+var fleetSource atomic.Pointer[MCPServerReconciler]
+
+// SetMetricLabels adds the allowlisted labels of MCPServers to the per-MCPServer gauges. It must
+// be called before the manager starts.
+func SetMetricLabels(allowlist *metriclabels.Allowlist) {
+	credentialsExpiryTimestamp.SetAllowlist(allowlist)
+	lastSuccessfulSyncTimestamp.SetAllowlist(allowlist)
+}
+
+// deleteMetrics removes all metric series recorded for an MCPServer
+func deleteMetrics(namespace, name string) {
+	labels := prometheus.Labels{"namespace": namespace, "name": name}
+	credentialsExpiryTimestamp.DeletePartialMatch(labels)
+	lastSuccessfulSyncTimestamp.DeletePartialMatch(labels)
+	targetDriftDetected.DeletePartialMatch(labels)
+	targetConsistencyWaits.DeletePartialMatch(labels)
+}
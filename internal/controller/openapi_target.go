@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// reasonOpenAPISchemaError is the Ready reason of MCPServers whose OpenAPI schema cannot be read
+// from its ConfigMap
+const reasonOpenAPISchemaError = "OpenAPISchemaError"
+
+// openAPISchemaError reports an OpenAPI schema ConfigMap that cannot be read until the spec or
+// the ConfigMap is fixed
+type openAPISchemaError struct {
+	err error
+}
+
+func (e *openAPISchemaError) Error() string { return e.err.Error() }
+
+func (e *openAPISchemaError) Unwrap() error { return e.err }
+
+// openAPISchemaConfigMap returns the ConfigMap key selector of an OpenApiSchema target, or nil
+// if its schema is not read from a ConfigMap
+func openAPISchemaConfigMap(mcpServer *mcpgatewayv1alpha1.MCPServer) *corev1.ConfigMapKeySelector {
+	if mcpServer.Spec.TargetType != mcpgatewayv1alpha1.TargetTypeOpenAPISchema || mcpServer.Spec.OpenAPISchema == nil {
+		return nil
+	}
+	return mcpServer.Spec.OpenAPISchema.ConfigMapRef
+}
+
+// resolveOpenAPISchema replaces the ConfigMap reference of the OpenAPI schema of the in-memory
+// MCPServer with the schema it holds, so that the gateway target is built with an inline schema.
+// Like the resolved endpoint, the schema is never written to the spec.
+func (r *MCPServerReconciler) resolveOpenAPISchema(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	ref := openAPISchemaConfigMap(mcpServer)
+	if ref == nil {
+		return nil
+	}
+	if mcpServer.Spec.OpenAPISchema.Inline != "" || mcpServer.Spec.OpenAPISchema.S3URI != "" {
+		// Left to validateSpec, which reports the conflicting sources
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: mcpServer.Namespace, Name: ref.Name}
+	if err := r.Get(ctx, key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &openAPISchemaError{fmt.Errorf(
+				"OpenAPI schema ConfigMap %s labelled %s=true was not found", key, WatchLabel)}
+		}
+		return err
+	}
+	document, ok := configMap.Data[ref.Key]
+	if !ok || document == "" {
+		return &openAPISchemaError{fmt.Errorf("OpenAPI schema ConfigMap %s has no key %q", key, ref.Key)}
+	}
+
+	resolved := mcpServer.Spec.OpenAPISchema.DeepCopy()
+	resolved.Inline = document
+	resolved.ConfigMapRef = nil
+	mcpServer.Spec.OpenAPISchema = resolved
+	return nil
+}
+
+// validateOpenAPITarget validates the spec of an MCPServer with targetType OpenApiSchema, after
+// its schema was resolved. The gateway calls the REST API with an OAuth or API key credential
+// provider, so the gateway IAM role cannot be used.
+func validateOpenAPITarget(mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	spec := mcpServer.Spec
+	if spec.Endpoint != "" || spec.EndpointRef != nil || spec.ServiceRef != nil || spec.HTTPRouteRef != nil ||
+		spec.LambdaArn != "" || spec.ToolSchema != nil {
+		return fmt.Errorf("endpoint, endpointRef, serviceRef, httpRouteRef, lambdaArn and toolSchema cannot be " +
+			"combined with targetType OpenApiSchema")
+	}
+	if _, err := bedrock.BuildOpenAPISchema(spec.OpenAPISchema); err != nil {
+		return fmt.Errorf("invalid openApiSchema: %w", err)
+	}
+	for i, provider := range spec.CredentialProviders {
+		if provider.Type == "GatewayIamRole" {
+			return fmt.Errorf("credentialProviders[%d]: type GatewayIamRole is not supported for OpenApiSchema targets", i)
+		}
+	}
+	return nil
+}
+
+// hashOpenAPISchema returns the SHA-256 hash of an OpenAPI schema document
+func hashOpenAPISchema(document string) string {
+	sum := sha256.Sum256([]byte(document))
+	return hex.EncodeToString(sum[:])
+}
+
+// recordOpenAPISchemaHash records the hash of the OpenAPI schema applied to the gateway target in
+// the status of latest, the MCPServer as stored, if its schema is read from a ConfigMap
+func recordOpenAPISchemaHash(latest, applied *mcpgatewayv1alpha1.MCPServer) {
+	if openAPISchemaConfigMap(latest) == nil || applied.Spec.OpenAPISchema == nil {
+		latest.Status.OpenAPISchemaHash = ""
+		return
+	}
+	latest.Status.OpenAPISchemaHash = hashOpenAPISchema(applied.Spec.OpenAPISchema.Inline)
+}
+
+// openAPISchemaChanged reports whether the OpenAPI schema read from the ConfigMap of the
+// MCPServer changed since it was last applied to the gateway target. Changes of the spec itself
+// are detected by its generation.
+func openAPISchemaChanged(mcpServer *mcpgatewayv1alpha1.MCPServer) bool {
+	return mcpServer.Status.OpenAPISchemaHash != "" && mcpServer.Spec.OpenAPISchema != nil &&
+		mcpServer.Status.OpenAPISchemaHash != hashOpenAPISchema(mcpServer.Spec.OpenAPISchema.Inline)
+}
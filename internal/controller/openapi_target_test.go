@@ -0,0 +1,120 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("OpenAPI schema targets", func() {
+	ctx := context.Background()
+	const document = "openapi: 3.0.1\ninfo:\n  title: Weather API\n  version: 1.0.0\npaths: {}\n"
+
+	var reconciler *MCPServerReconciler
+	var configMap *corev1.ConfigMap
+
+	fromConfigMap := func(key string) *mcpgatewayv1alpha1.MCPServer {
+		return &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "default"},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				TargetType: mcpgatewayv1alpha1.TargetTypeOpenAPISchema,
+				OpenAPISchema: &mcpgatewayv1alpha1.OpenAPISchemaSpec{
+					ConfigMapRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "weather-api"},
+						Key:                  key,
+					},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		reconciler = &MCPServerReconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "weather-api",
+				Namespace: "default",
+				Labels:    map[string]string{WatchLabel: "true"},
+			},
+			Data: map[string]string{"openapi.yaml": document},
+		}
+		Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, configMap)).To(Succeed())
+	})
+
+	It("should read the schema from the ConfigMap into the in-memory spec", func() {
+		mcpServer := fromConfigMap("openapi.yaml")
+		stored := mcpServer.DeepCopy()
+
+		Expect(reconciler.resolveOpenAPISchema(ctx, mcpServer)).To(Succeed())
+		Expect(mcpServer.Spec.OpenAPISchema.Inline).To(Equal(document))
+		Expect(mcpServer.Spec.OpenAPISchema.ConfigMapRef).To(BeNil())
+		Expect(validateOpenAPITarget(mcpServer)).To(Succeed())
+		Expect(referencedObjects(stored)).To(ContainElement(referenceKey("ConfigMap", "default", "weather-api")))
+
+		By("recording the hash of the applied schema")
+		recordOpenAPISchemaHash(stored, mcpServer)
+		Expect(stored.Status.OpenAPISchemaHash).To(Equal(hashOpenAPISchema(document)))
+
+		mcpServer.Status = stored.Status
+		Expect(openAPISchemaChanged(mcpServer)).To(BeFalse())
+		mcpServer.Spec.OpenAPISchema.Inline = document + "servers: []\n"
+		Expect(openAPISchemaChanged(mcpServer)).To(BeTrue())
+	})
+
+	It("should report a missing key or ConfigMap", func() {
+		var schemaErr *openAPISchemaError
+
+		err := reconciler.resolveOpenAPISchema(ctx, fromConfigMap("missing.yaml"))
+		Expect(errors.As(err, &schemaErr)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring(`has no key "missing.yaml"`)))
+
+		mcpServer := fromConfigMap("openapi.yaml")
+		mcpServer.Spec.OpenAPISchema.ConfigMapRef.Name = "missing"
+		err = reconciler.resolveOpenAPISchema(ctx, mcpServer)
+		Expect(errors.As(err, &schemaErr)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("was not found")))
+	})
+
+	It("should reject the gateway IAM role and endpoints", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				TargetType:    mcpgatewayv1alpha1.TargetTypeOpenAPISchema,
+				OpenAPISchema: &mcpgatewayv1alpha1.OpenAPISchemaSpec{Inline: document},
+				CredentialProviders: []mcpgatewayv1alpha1.CredentialProvider{
+					{Type: "GatewayIamRole"},
+				},
+			},
+		}
+		Expect(validateOpenAPITarget(mcpServer)).To(MatchError(ContainSubstring("GatewayIamRole is not supported")))
+
+		mcpServer.Spec.CredentialProviders = nil
+		mcpServer.Spec.Endpoint = "https://api.example.com"
+		Expect(validateOpenAPITarget(mcpServer)).To(MatchError(ContainSubstring("cannot be combined")))
+	})
+})
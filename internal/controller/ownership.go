@@ -0,0 +1,188 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// targetOwnerAnnotation records the gateway target owned by an MCPServer as <gatewayId>/<targetId>.
+// Unlike the status it survives backup and restore of the MCPServer, so a restored resource
+// adopts its existing target instead of creating a duplicate.
+const targetOwnerAnnotation = "mcpgateway.bedrock.aws/gateway-target"
+
+// targetOwnerValue builds the value of the targetOwnerAnnotation
+func targetOwnerValue(gatewayID, targetID string) string {
+	return gatewayID + "/" + targetID
+}
+
+// parseTargetOwner splits a targetOwnerAnnotation value into the gateway and target IDs
+func parseTargetOwner(value string) (gatewayID, targetID string, ok bool) {
+	gatewayID, targetID, ok = strings.Cut(value, "/")
+	if !ok || gatewayID == "" || targetID == "" || strings.Contains(targetID, "/") {
+		return "", "", false
+	}
+	return gatewayID, targetID, true
+}
+
+// recordTargetOwnership sets the targetOwnerAnnotation on the MCPServer.
+// The annotation is merge patched so the operator never takes ownership of spec fields.
+func (r *MCPServerReconciler) recordTargetOwnership(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	gatewayID, targetID string,
+) error {
+	value := targetOwnerValue(gatewayID, targetID)
+	if mcpServer.Annotations[targetOwnerAnnotation] == value {
+		return nil
+	}
+
+	patch := client.MergeFrom(mcpServer.DeepCopy())
+	if mcpServer.Annotations == nil {
+		mcpServer.Annotations = map[string]string{}
+	}
+	mcpServer.Annotations[targetOwnerAnnotation] = value
+	return r.Patch(ctx, mcpServer, patch)
+}
+
+// adoptOwnedTarget restores the status of an MCPServer whose status lost the target ID, e.g. after
+// a restore from backup, from its targetOwnerAnnotation. It reports false if there is nothing to
+// adopt, in which case a new target is created.
+func (r *MCPServerReconciler) adoptOwnedTarget(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) (bool, ctrl.Result, error) {
+	gatewayID, targetID, ok := parseTargetOwner(mcpServer.Annotations[targetOwnerAnnotation])
+	if !ok {
+		return false, ctrl.Result{}, nil
+	}
+
+	bedrockWrapper := r.newBedrockWrapper(log)
+	output, err := bedrockWrapper.GetGatewayTarget(ctx, gatewayID, targetID)
+	if err != nil {
+		if bedrock.IsResourceNotFoundError(err) {
+			log.Info("Gateway target recorded in annotation no longer exists, creating a new one",
+				"gatewayId", gatewayID, "targetId", targetID)
+			return false, ctrl.Result{}, nil
+		}
+		return true, ctrl.Result{}, err
+	}
+
+	// Guard against the annotation having been copied onto another resource
+	targetName := r.targetName(mcpServer)
+	if aws.ToString(output.Name) != targetName {
+		log.Info("Gateway target recorded in annotation belongs to another resource, creating a new one",
+			"gatewayId", gatewayID, "targetId", targetID, "targetName", aws.ToString(output.Name))
+		return false, ctrl.Result{}, nil
+	}
+
+	// Leave the target alone if another cluster manages it
+	if conflict, err := r.checkOwnershipConflict(ctx, mcpServer, output, log); conflict || err != nil {
+		return true, ctrl.Result{}, err
+	}
+
+	recordAppliedCredentials(mcpServer, output.CredentialProviderConfigurations)
+	if err := r.StatusManager.UpdateTargetCreated(ctx, mcpServer, targetID, aws.ToString(output.GatewayArn),
+		string(output.Status), output.UpdatedAt); err != nil {
+		if apierrors.IsConflict(err) {
+			return true, ctrl.Result{Requeue: true}, nil
+		}
+		return true, ctrl.Result{}, err
+	}
+
+	log.Info("Adopted existing gateway target", "gatewayId", gatewayID, "targetId", targetID)
+	return true, ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+}
+
+// MigrateLegacyTargets takes over gateway targets created by operator versions that did not
+// record ownership markers. Every MCPServer with a target ID in its status but no
+// targetOwnerAnnotation is matched against its target; if the target exists the annotation is
+// backfilled, as is status.gatewayArn, which pins the target to its gateway.
+// Failures are logged and never prevent the operator from starting.
+func (r *MCPServerReconciler) MigrateLegacyTargets(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithName("migration")
+
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := r.List(ctx, mcpServers); err != nil {
+		log.Error(err, "Failed to list MCPServers for legacy target migration")
+		return nil
+	}
+
+	bedrockWrapper := r.newBedrockWrapper(log)
+	migrated := 0
+	for i := range mcpServers.Items {
+		mcpServer := &mcpServers.Items[i]
+		if mcpServer.Status.TargetID == "" || !mcpServer.DeletionTimestamp.IsZero() || !r.ownsResource(mcpServer) {
+			continue
+		}
+		if _, ok := mcpServer.Annotations[targetOwnerAnnotation]; ok {
+			continue
+		}
+		resourceLog := log.WithValues("namespace", mcpServer.Namespace, "name", mcpServer.Name)
+
+		gatewayID, err := r.ConfigParser.GetGatewayID(mcpServer)
+		if err != nil {
+			resourceLog.Info("Skipping legacy target without gateway ID", "error", err.Error())
+			continue
+		}
+		// The target lives in the account of the MCPServer's AWSProviderConfig
+		targetCtx, err := r.applyProviderConfig(ctx, mcpServer)
+		if err != nil {
+			resourceLog.Info("Skipping legacy target whose AWSProviderConfig cannot be used", "error", err.Error())
+			continue
+		}
+		output, err := bedrockWrapper.GetGatewayTarget(targetCtx, gatewayID, mcpServer.Status.TargetID)
+		if err != nil {
+			// Missing targets are recreated by the regular reconcile
+			resourceLog.Info("Skipping legacy target that could not be matched", "gatewayId", gatewayID,
+				"targetId", mcpServer.Status.TargetID, "error", err.Error())
+			continue
+		}
+
+		if mcpServer.Status.GatewayArn == "" && output.GatewayArn != nil {
+			mcpServer.Status.GatewayArn = aws.ToString(output.GatewayArn)
+			if err := r.Status().Update(ctx, mcpServer); err != nil {
+				resourceLog.Error(err, "Failed to backfill gateway ARN of legacy target")
+				continue
+			}
+		}
+		if err := r.recordTargetOwnership(ctx, mcpServer, gatewayID, mcpServer.Status.TargetID); err != nil {
+			resourceLog.Error(err, "Failed to record ownership of legacy target")
+			continue
+		}
+		resourceLog.Info("Took over legacy gateway target", "gatewayId", gatewayID, "targetId", mcpServer.Status.TargetID)
+		migrated++
+	}
+
+	if migrated > 0 {
+		log.Info("Legacy target migration complete", "migrated", migrated)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// ownershipConflictCondition is the condition reporting gateway targets managed by another cluster
+const ownershipConflictCondition = "OwnershipConflict"
+
+// targetOwner returns the owner the operator records in the description of the gateway targets
+// it writes: the cluster ID, followed by the spoke cluster name in hub mode. It is empty if no
+// cluster ID is configured, which disables ownership markers.
+func (r *MCPServerReconciler) targetOwner() string {
+	if r.ClusterID == "" {
+		return ""
+	}
+	if r.ClusterName != "" {
+		return r.ClusterID + "/" + r.ClusterName
+	}
+	return r.ClusterID
+}
+
+// targetDescription returns the description written to the gateway target, which carries the
+// owner marker of this cluster, or nil if there is neither description nor marker
+func (r *MCPServerReconciler) targetDescription(mcpServer *mcpgatewayv1alpha1.MCPServer) *string {
+	description := bedrock.WithOwnerMarker(mcpServer.Spec.Description, r.targetOwner())
+	if description == "" {
+		return nil
+	}
+	return aws.String(description)
+}
+
+// checkOwnershipConflict compares the owner marker of the current gateway target with this
+// cluster. If another cluster manages the target, e.g. because both adopted it, the
+// OwnershipConflict condition is set and true is returned, so the caller stops mutating the
+// target instead of reverting the other cluster's updates and having its own reverted in turn.
+// Targets without marker are claimed by the next update. The overwriteTargetAnnotation takes over
+// the target regardless of its marker.
+func (r *MCPServerReconciler) checkOwnershipConflict(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	current *bedrockagentcorecontrol.GetGatewayTargetOutput,
+	log logr.Logger,
+) (bool, error) {
+	owner := r.targetOwner()
+	if owner == "" {
+		return false, nil
+	}
+
+	foreignOwner, ok := bedrock.TargetOwner(aws.ToString(current.Description))
+	if !ok || foreignOwner == owner || mcpServer.Annotations[overwriteTargetAnnotation] == "true" {
+		if ok && foreignOwner != owner {
+			log.Info("Taking over gateway target managed by another cluster", "targetId", aws.ToString(current.TargetId),
+				"owner", foreignOwner)
+		}
+		if !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, ownershipConflictCondition) {
+			return false, nil
+		}
+		return false, r.StatusManager.SetOwnershipConflict(ctx, mcpServer, false,
+			fmt.Sprintf("Gateway target is managed by %s", owner))
+	}
+
+	message := fmt.Sprintf("Gateway target %s is managed by %s, not by %s; remove the MCPServer from one of the "+
+		"clusters, or set the %s=true annotation to take the target over", aws.ToString(current.TargetId),
+		foreignOwner, owner, overwriteTargetAnnotation)
+	log.Info("Gateway target is managed by another cluster, refusing to mutate it",
+		"targetId", aws.ToString(current.TargetId), "owner", foreignOwner)
+	if err := r.StatusManager.SetOwnershipConflict(ctx, mcpServer, true, message); err != nil {
+		log.Error(err, "Failed to set ownership conflict condition")
+		return true, err
+	}
+	return true, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Ownership conflict detection", func() {
+	const resourceName = "test-ownership-conflict"
+
+	ctx := context.Background()
+
+	typeNamespacedName := types.NamespacedName{
+		Name:      resourceName,
+		Namespace: "default",
+	}
+
+	var reconciler *MCPServerReconciler
+
+	target := func(description string) *bedrockagentcorecontrol.GetGatewayTargetOutput {
+		return &bedrockagentcorecontrol.GetGatewayTargetOutput{
+			TargetId:    aws.String("target-1"),
+			Description: aws.String(description),
+		}
+	}
+
+	BeforeEach(func() {
+		reconciler = &MCPServerReconciler{Client: k8sClient, StatusManager: status.NewManager(k8sClient), ClusterID: "east"}
+		resource := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+				Description:  "Weather tools",
+			},
+		}
+		Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		resource := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+	})
+
+	It("should mark the description with the owner", func() {
+		resource := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+		Expect(aws.ToString(reconciler.targetDescription(resource))).To(Equal("Weather tools [managed-by: east]"))
+
+		reconciler.ClusterName = "spoke-1"
+		Expect(aws.ToString(reconciler.targetDescription(resource))).To(Equal("Weather tools [managed-by: east/spoke-1]"))
+
+		reconciler = &MCPServerReconciler{}
+		Expect(aws.ToString(reconciler.targetDescription(resource))).To(Equal("Weather tools"))
+		resource.Spec.Description = ""
+		Expect(reconciler.targetDescription(resource)).To(BeNil())
+	})
+
+	It("should accept targets owned by this cluster or without marker", func() {
+		resource := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+		for _, description := range []string{"Weather tools [managed-by: east]", "Weather tools", ""} {
+			conflict, err := reconciler.checkOwnershipConflict(ctx, resource, target(description), logr.Discard())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conflict).To(BeFalse())
+		}
+		Expect(meta.FindStatusCondition(resource.Status.Conditions, ownershipConflictCondition)).To(BeNil())
+	})
+
+	It("should report targets owned by another cluster until they are taken over", func() {
+		resource := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+		conflict, err := reconciler.checkOwnershipConflict(ctx, resource, target("Weather tools [managed-by: west]"), logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflict).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, ownershipConflictCondition)).To(BeTrue())
+
+		resource.Annotations = map[string]string{overwriteTargetAnnotation: "true"}
+		conflict, err = reconciler.checkOwnershipConflict(ctx, resource, target("Weather tools [managed-by: west]"), logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflict).To(BeFalse())
+		Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, ownershipConflictCondition)).To(BeTrue())
+	})
+})
@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Gateway target ownership", func() {
+	DescribeTable("parses the ownership annotation",
+		func(value, gatewayID, targetID string, ok bool) {
+			gotGateway, gotTarget, gotOK := parseTargetOwner(value)
+			Expect(gotOK).To(Equal(ok))
+			Expect(gotGateway).To(Equal(gatewayID))
+			Expect(gotTarget).To(Equal(targetID))
+		},
+		Entry("valid", "gw-123/target-456", "gw-123", "target-456", true),
+		Entry("empty", "", "", "", false),
+		Entry("missing target", "gw-123/", "", "", false),
+		Entry("too many segments", "gw-123/target-456/extra", "", "", false),
+	)
+
+	Context("When recording ownership", func() {
+		const resourceName = "test-ownership"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &mcpgatewayv1alpha1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: mcpgatewayv1alpha1.MCPServerSpec{
+					Endpoint:     "https://mcp.example.com",
+					Capabilities: []string{"tools"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &mcpgatewayv1alpha1.MCPServer{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should annotate the MCPServer with its gateway target", func() {
+			reconciler := &MCPServerReconciler{Client: k8sClient}
+
+			resource := &mcpgatewayv1alpha1.MCPServer{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(reconciler.recordTargetOwnership(ctx, resource, "gw-123", "target-456")).To(Succeed())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Annotations).To(HaveKeyWithValue(targetOwnerAnnotation, "gw-123/target-456"))
+		})
+	})
+})
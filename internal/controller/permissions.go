@@ -0,0 +1,176 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// partialPermissionsCondition is the condition reporting that the operator may call some of the
+// gateway target APIs but not others
+const partialPermissionsCondition = "PartialPermissions"
+
+// Reasons of the PartialPermissions condition
+const (
+	// reasonReadAccessDenied reports that the target could be written but not read
+	reasonReadAccessDenied = "ReadAccessDenied"
+	// reasonWriteAccessDenied reports that the target could be read but not written
+	reasonWriteAccessDenied = "WriteAccessDenied"
+)
+
+// accessDeniedOnDeleteCondition is the condition reporting that a deleted MCPServer waits for the
+// permission to delete its gateway target
+const accessDeniedOnDeleteCondition = "AccessDeniedOnDelete"
+
+// DeletionFallbackAnnotation tells the operator what to do with the gateway target of a deleted
+// MCPServer if it may not delete the target. Its only value is DeletionFallbackOrphan; without it
+// the MCPServer waits until the permission is granted.
+const DeletionFallbackAnnotation = "mcpgateway.bedrock.aws/deletion-fallback"
+
+// DeletionFallbackOrphan leaves the gateway target in AWS and removes the finalizer, as if the
+// MCPServer had the Retain deletion policy
+const DeletionFallbackOrphan = "Orphan"
+
+// permissionsRecheckInterval is how often the status of a target that may not be read is retried.
+// Missing permissions are not fixed within seconds, so there is no point in backing off quickly.
+const permissionsRecheckInterval = 5 * time.Minute
+
+// reportReadDenied sets the PartialPermissions condition after GetGatewayTarget was denied for a
+// target the operator created, i.e. with permissions to write but not to read gateway targets
+func (r *MCPServerReconciler) reportReadDenied(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	err error,
+	log logr.Logger,
+) error {
+	log.Info("Not authorized to get gateway target, read-only checks are disabled",
+		"targetId", mcpServer.Status.TargetID, "error", err.Error())
+	message := fmt.Sprintf("GetGatewayTarget is denied although the gateway target was created: status sync, "+
+		"metadata drift detection and concurrent modification checks are disabled, and updates cannot "+
+		"carry over omitted metadata allowlists: %v", err)
+	return r.StatusManager.SetPartialPermissions(ctx, mcpServer, true, reasonReadAccessDenied, message)
+}
+
+// reportDeletionUnconfirmed handles a gateway target whose deletion AWS accepted but which may not
+// be read, i.e. with permissions to delete but not to read gateway targets. Waiting for a read that
+// is never allowed would block the deletion forever, so the target is taken as deleted. A warning
+// event and the PartialPermissions condition tell that a failed deletion would go unnoticed.
+func (r *MCPServerReconciler) reportDeletionUnconfirmed(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	err error,
+	log logr.Logger,
+) {
+	log.Info("Not authorized to get gateway target, assuming the accepted deletion succeeds",
+		"targetId", mcpServer.Status.TargetID, "error", err.Error())
+	message := fmt.Sprintf("GetGatewayTarget is denied although the gateway target could be deleted: "+
+		"the deletion of gateway target %s is not confirmed, check that it is gone from gateway %s: %v",
+		mcpServer.Status.TargetID, mcpServer.Status.GatewayID, err)
+	r.recordEvent(mcpServer, corev1.EventTypeWarning, "TargetDeletionUnconfirmed", "Delete", message)
+	if err := r.StatusManager.SetPartialPermissions(ctx, mcpServer, true, reasonReadAccessDenied, message); err != nil {
+		log.Error(err, "Failed to update status with partial permissions")
+	}
+}
+
+// reportWriteDenied sets the PartialPermissions condition after an update of a target the
+// operator can read was denied
+func (r *MCPServerReconciler) reportWriteDenied(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	err error,
+	log logr.Logger,
+) error {
+	log.Info("Not authorized to update gateway target, spec changes are not applied",
+		"targetId", mcpServer.Status.TargetID, "error", err.Error())
+	message := fmt.Sprintf("UpdateGatewayTarget is denied although the gateway target can be read: "+
+		"spec changes are not applied to the gateway target: %v", err)
+	return r.StatusManager.SetPartialPermissions(ctx, mcpServer, true, reasonWriteAccessDenied, message)
+}
+
+// clearPartialPermissions resets the PartialPermissions condition once a call of the kind that
+// was denied succeeded. A condition with another reason is kept.
+func (r *MCPServerReconciler) clearPartialPermissions(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	reason string,
+	log logr.Logger,
+) {
+	condition := meta.FindStatusCondition(mcpServer.Status.Conditions, partialPermissionsCondition)
+	if condition == nil || condition.Status != metav1.ConditionTrue || condition.Reason != reason {
+		return
+	}
+	if err := r.StatusManager.SetPartialPermissions(ctx, mcpServer, false, "",
+		"The operator can read and write the gateway target"); err != nil {
+		log.Error(err, "Failed to clear partial permissions condition")
+	}
+}
+
+// reportDeleteDenied handles a deleted MCPServer whose gateway target may not be deleted. Rather
+// than retrying with backoff forever, it sets the AccessDeniedOnDelete condition naming the IAM
+// action to grant and the deletion fallback annotation. It reports true if the annotation asks to
+// orphan the target, in which case the finalizer is to be removed.
+func (r *MCPServerReconciler) reportDeleteDenied(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	err error,
+	log logr.Logger,
+) (bool, error) {
+	action := deniedAction(err, "DeleteGatewayTarget")
+	if mcpServer.Annotations[DeletionFallbackAnnotation] == DeletionFallbackOrphan {
+		log.Info("Not authorized to delete gateway target, orphaning it as requested by the deletion fallback",
+			"targetId", mcpServer.Status.TargetID, "action", action)
+		r.recordEvent(mcpServer, corev1.EventTypeWarning, "TargetOrphaned", "Delete",
+			fmt.Sprintf("Gateway target %s was left in AWS because %s is denied and %s is %s",
+				mcpServer.Status.TargetID, action, DeletionFallbackAnnotation, DeletionFallbackOrphan))
+		return true, nil
+	}
+
+	resource := mcpServer.Status.GatewayArn
+	if resource == "" {
+		resource = "gateway " + mcpServer.Status.GatewayID
+	}
+	log.Info("Not authorized to delete gateway target, waiting for the permission to be granted",
+		"targetId", mcpServer.Status.TargetID, "action", action, "error", err.Error())
+	message := fmt.Sprintf("%s is denied for gateway target %s: grant the operator's IAM role %s on %s, "+
+		"or annotate the MCPServer with %s=%s to leave the target in AWS: %v",
+		action, mcpServer.Status.TargetID, action, resource, DeletionFallbackAnnotation, DeletionFallbackOrphan, err)
+	if !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, accessDeniedOnDeleteCondition) {
+		r.recordEvent(mcpServer, corev1.EventTypeWarning, "AccessDeniedOnDelete", "Delete", message)
+	}
+	return false, r.StatusManager.SetAccessDeniedOnDelete(ctx, mcpServer, message)
+}
+
+// deniedAction returns the IAM action of the AWS call that failed with err, e.g.
+// bedrock-agentcore:DeleteGatewayTarget, or of operation if err does not name the call
+func deniedAction(err error, operation string) string {
+	var operationErr *smithy.OperationError
+	if errors.As(err, &operationErr) && operationErr.Operation() != "" {
+		operation = operationErr.Operation()
+	}
+	return "bedrock-agentcore:" + operation
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Partial permissions", func() {
+	ctx := context.Background()
+
+	It("should only clear the condition once the denied kind of call succeeds", func() {
+		resource := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-partial-permissions", Namespace: "default"},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, resource)).To(Succeed()) })
+
+		reconciler := &MCPServerReconciler{
+			Client:        k8sClient,
+			Scheme:        k8sClient.Scheme(),
+			StatusManager: status.NewManager(k8sClient),
+		}
+
+		Expect(reconciler.reportReadDenied(ctx, resource, errors.New("AccessDeniedException"), logf.Log)).To(Succeed())
+		condition := meta.FindStatusCondition(resource.Status.Conditions, partialPermissionsCondition)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(reasonReadAccessDenied))
+		Expect(condition.Message).To(ContainSubstring("drift detection"))
+
+		By("keeping the condition after a successful update")
+		reconciler.clearPartialPermissions(ctx, resource, reasonWriteAccessDenied, logf.Log)
+		Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, partialPermissionsCondition)).To(BeTrue())
+
+		By("clearing the condition after a successful read")
+		reconciler.clearPartialPermissions(ctx, resource, reasonReadAccessDenied, logf.Log)
+		Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, partialPermissionsCondition)).To(BeTrue())
+	})
+})
@@ -0,0 +1,66 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// reconcilePhase names a boundary in the MCPServer reconcile at which a phase hook runs
+type reconcilePhase string
+
+// Phase boundaries of the MCPServer reconcile
+const (
+	// phaseFetched is reached once the MCPServer has been read
+	phaseFetched reconcilePhase = "fetched"
+	// phaseValidated is reached once the spec passed validation
+	phaseValidated reconcilePhase = "validated"
+	// phaseFinalizerAdded is reached once the MCPServer carries the finalizer
+	phaseFinalizerAdded reconcilePhase = "finalizerAdded"
+	// phaseBeforeCreate is reached right before CreateGatewayTarget is called
+	phaseBeforeCreate reconcilePhase = "beforeCreate"
+	// phaseAfterCreate is reached once AWS created the target, before it is recorded in the status
+	phaseAfterCreate reconcilePhase = "afterCreate"
+	// phaseBeforeUpdate is reached right before UpdateGatewayTarget is called
+	phaseBeforeUpdate reconcilePhase = "beforeUpdate"
+	// phaseAfterUpdate is reached once AWS updated the target, before it is recorded in the status
+	phaseAfterUpdate reconcilePhase = "afterUpdate"
+	// phaseBeforeDelete is reached right before DeleteGatewayTarget is called
+	phaseBeforeDelete reconcilePhase = "beforeDelete"
+	// phaseAfterDelete is reached once AWS deleted the target, before the finalizer is removed
+	phaseAfterDelete reconcilePhase = "afterDelete"
+)
+
+// phaseHook is called at every phase boundary a reconcile reaches. Returning an error ends the
+// reconcile right there with that error, as if the operator had crashed at that point.
+// Tests use phase hooks to inject changes between the steps of a reconcile and to replay tricky
+// sequences like a crash between creating a target and recording it.
+type phaseHook func(ctx context.Context, phase reconcilePhase, mcpServer *mcpgatewayv1alpha1.MCPServer) error
+
+// enterPhase runs the phase hook of the reconciler, if it has one
+func (r *MCPServerReconciler) enterPhase(
+	ctx context.Context,
+	phase reconcilePhase,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+) error {
+	if r.phaseHook == nil {
+		return nil
+	}
+	return r.phaseHook(ctx, phase, mcpServer)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/preview"
+)
+
+// previewTargetName renders the preview target name template for MCPServers labelled with
+// preview.Label, and returns false for other MCPServers or if the name cannot be rendered
+func (r *MCPServerReconciler) previewTargetName(mcpServer *mcpgatewayv1alpha1.MCPServer) (string, bool) {
+	previewName := mcpServer.Labels[preview.Label]
+	if previewName == "" || r.PreviewTargetNames == nil {
+		return "", false
+	}
+	name, err := preview.TargetName(r.PreviewTargetNames, preview.TargetNameData{
+		Name:      mcpServer.Name,
+		Namespace: mcpServer.Namespace,
+		Preview:   previewName,
+		Cluster:   r.ClusterName,
+	})
+	if err != nil {
+		return "", false
+	}
+	return name, true
+}
+
+// recordPreviewTarget registers the gateway target of a preview MCPServer, so that the target is
+// cleaned up even if its namespace is deleted without the MCPServer being finalized
+func (r *MCPServerReconciler) recordPreviewTarget(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	gatewayID, targetID string,
+	log logr.Logger,
+) {
+	previewName := mcpServer.Labels[preview.Label]
+	if previewName == "" || r.PreviewRegistry == nil {
+		return
+	}
+	if err := r.PreviewRegistry.Record(ctx, &preview.Entry{
+		Namespace:      mcpServer.Namespace,
+		Name:           mcpServer.Name,
+		Preview:        previewName,
+		GatewayID:      gatewayID,
+		TargetID:       targetID,
+		ProviderConfig: providerConfigName(mcpServer),
+	}); err != nil {
+		log.Error(err, "Failed to record preview gateway target", "preview", previewName)
+	}
+}
+
+// forgetPreviewTarget removes the gateway target of a preview MCPServer from the registry once
+// the target was deleted
+func (r *MCPServerReconciler) forgetPreviewTarget(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) {
+	if mcpServer.Labels[preview.Label] == "" || r.PreviewRegistry == nil || mcpServer.Status.TargetID == "" {
+		return
+	}
+	gatewayID, err := r.ConfigParser.GetGatewayID(mcpServer)
+	if err != nil {
+		log.Error(err, "Failed to get gateway ID of preview gateway target")
+		return
+	}
+	if err := r.PreviewRegistry.Forget(ctx, gatewayID, mcpServer.Status.TargetID); err != nil {
+		log.Error(err, "Failed to forget preview gateway target")
+	}
+}
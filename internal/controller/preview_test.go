@@ -0,0 +1,73 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/preview"
+)
+
+var _ = Describe("Preview target names", func() {
+	var reconciler *MCPServerReconciler
+
+	labelled := func(previewName string) *mcpgatewayv1alpha1.MCPServer {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "pr-42"},
+		}
+		if previewName != "" {
+			mcpServer.Labels = map[string]string{preview.Label: previewName}
+		}
+		return mcpServer
+	}
+
+	BeforeEach(func() {
+		tmpl, err := preview.ParseTargetNameTemplate(preview.DefaultTargetNameTemplate)
+		Expect(err).NotTo(HaveOccurred())
+		reconciler = &MCPServerReconciler{PreviewTargetNames: tmpl}
+	})
+
+	It("should name preview targets after the template", func() {
+		Expect(reconciler.targetName(labelled("42"))).To(Equal("pr42-weather"))
+	})
+
+	It("should name other targets after the resource", func() {
+		Expect(reconciler.targetName(labelled(""))).To(Equal("weather"))
+	})
+
+	It("should prefer spec.targetName", func() {
+		mcpServer := labelled("42")
+		mcpServer.Spec.TargetName = "custom"
+		Expect(reconciler.targetName(mcpServer)).To(Equal("custom"))
+	})
+
+	It("should start preview targets of spoke clusters with the cluster name by default", func() {
+		reconciler.ClusterName = "east"
+		Expect(reconciler.targetName(labelled("42"))).To(Equal("east-pr42-weather"))
+		Expect(reconciler.targetName(labelled(""))).To(Equal("east-weather"))
+	})
+
+	It("should pass the cluster name to the template", func() {
+		tmpl, err := preview.ParseTargetNameTemplate("{{ .Cluster }}-pr{{ .Preview }}-{{ .Name }}")
+		Expect(err).NotTo(HaveOccurred())
+		reconciler = &MCPServerReconciler{PreviewTargetNames: tmpl, ClusterName: "east"}
+		Expect(reconciler.targetName(labelled("42"))).To(Equal("east-pr42-weather"))
+	})
+})
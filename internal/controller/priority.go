@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// queuePriorities are the workqueue priorities of the MCPServer priority classes. They are spaced
+// further apart than handler.LowPriority, so unchanged objects of a class still come before any
+// object of a lower class.
+var queuePriorities = map[string]int{
+	mcpgatewayv1alpha1.PriorityHigh:   200,
+	mcpgatewayv1alpha1.PriorityNormal: 0,
+	mcpgatewayv1alpha1.PriorityLow:    -200,
+}
+
+// queuePriority returns the workqueue priority of an MCPServer event. Events for unchanged
+// objects, i.e. from the initial list or a resync, are lowered by handler.LowPriority like
+// controller-runtime does for all controllers, so that edits are handled first within a class.
+func queuePriority(obj client.Object, unchanged bool) int {
+	priority := 0
+	if mcpServer, ok := obj.(*mcpgatewayv1alpha1.MCPServer); ok {
+		priority = queuePriorities[mcpServer.Spec.Priority]
+	}
+	if unchanged {
+		priority += handler.LowPriority
+	}
+	return priority
+}
+
+// priorityEventHandler enqueues MCPServers with the priority of their spec.priority class.
+// Requeues keep the priority of the request, as reconcile results never set one. Without a
+// priority queue requests are enqueued in order of arrival.
+type priorityEventHandler[T client.Object] struct{}
+
+var _ handler.TypedEventHandler[client.Object, reconcile.Request] = priorityEventHandler[client.Object]{}
+
+// Create implements handler.TypedEventHandler
+func (priorityEventHandler[T]) Create(_ context.Context, e event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	enqueueWithPriority(q, e.Object, e.IsInInitialList)
+}
+
+// Update implements handler.TypedEventHandler
+func (priorityEventHandler[T]) Update(_ context.Context, e event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	enqueueWithPriority(q, e.ObjectNew, e.ObjectOld.GetResourceVersion() == e.ObjectNew.GetResourceVersion())
+}
+
+// Delete implements handler.TypedEventHandler
+func (priorityEventHandler[T]) Delete(_ context.Context, e event.TypedDeleteEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	enqueueWithPriority(q, e.Object, false)
+}
+
+// Generic implements handler.TypedEventHandler
+func (priorityEventHandler[T]) Generic(_ context.Context, e event.TypedGenericEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	enqueueWithPriority(q, e.Object, false)
+}
+
+// enqueueWithPriority adds the request for obj to the queue with the priority of obj
+func enqueueWithPriority(q workqueue.TypedRateLimitingInterface[reconcile.Request], obj client.Object, unchanged bool) {
+	request := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+	if pq, ok := q.(priorityqueue.PriorityQueue[reconcile.Request]); ok {
+		priority := queuePriority(obj, unchanged)
+		pq.AddWithOpts(priorityqueue.AddOpts{Priority: &priority}, request)
+		return
+	}
+	q.Add(request)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Reconcile priority", func() {
+	newMCPServer := func(name, priority string) *mcpgatewayv1alpha1.MCPServer {
+		return &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       mcpgatewayv1alpha1.MCPServerSpec{Priority: priority},
+		}
+	}
+
+	It("should order classes before changes", func() {
+		high := newMCPServer("prod", mcpgatewayv1alpha1.PriorityHigh)
+		normal := newMCPServer("staging", "")
+		low := newMCPServer("dev", mcpgatewayv1alpha1.PriorityLow)
+
+		Expect(queuePriority(high, true)).To(BeNumerically(">", queuePriority(normal, false)))
+		Expect(queuePriority(normal, false)).To(BeNumerically(">", queuePriority(normal, true)))
+		Expect(queuePriority(normal, true)).To(BeNumerically(">", queuePriority(low, false)))
+		Expect(queuePriority(normal, false)).To(Equal(queuePriority(newMCPServer("qa", mcpgatewayv1alpha1.PriorityNormal), false)))
+	})
+
+	It("should dequeue the initial list in order of priority", func() {
+		queue := priorityqueue.New[reconcile.Request]("test-priority")
+		DeferCleanup(queue.ShutDown)
+
+		eventHandler := priorityEventHandler[client.Object]{}
+		for _, mcpServer := range []*mcpgatewayv1alpha1.MCPServer{
+			newMCPServer("dev", mcpgatewayv1alpha1.PriorityLow),
+			newMCPServer("staging", ""),
+			newMCPServer("prod", mcpgatewayv1alpha1.PriorityHigh),
+		} {
+			eventHandler.Create(context.Background(), event.CreateEvent{Object: mcpServer, IsInInitialList: true}, queue)
+		}
+
+		var order []string
+		for range 3 {
+			request, _, shutdown := queue.GetWithPriority()
+			Expect(shutdown).To(BeFalse())
+			order = append(order, request.Name)
+			queue.Done(request)
+		}
+		Expect(order).To(Equal([]string{"prod", "staging", "dev"}))
+	})
+})
@@ -0,0 +1,107 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// Sources recorded in the provenance of gateway target values
+const (
+	// ProvenanceSpec is a value set in the MCPServer spec
+	ProvenanceSpec = "spec"
+	// ProvenanceEnvironment prefixes the environment profile that supplied a value
+	ProvenanceEnvironment = "environment/"
+	// ProvenanceExistingTarget is the gateway an existing target was created on
+	ProvenanceExistingTarget = "existingTarget"
+	// ProvenanceDefaultGateway is the operator's default gateway
+	ProvenanceDefaultGateway = "defaultGateway"
+	// ProvenanceWorkload prefixes the workload whose annotations supplied a value
+	ProvenanceWorkload = "workload/"
+	// ProvenanceSpokeCluster prefixes the spoke cluster whose name prefixes the default target name
+	ProvenanceSpokeCluster = "spokeCluster/"
+	// ProvenanceResourceName is the MCPServer name used as the default target name
+	ProvenanceResourceName = "resourceName"
+)
+
+// fieldProvenance returns the sources of the gateway ID, target name and description of the
+// in-memory MCPServer, given the spec as written before the environment profile and workload
+// metadata were applied to it. It follows the precedence of GetGatewayID and targetName.
+func (r *MCPServerReconciler) fieldProvenance(
+	written *mcpgatewayv1alpha1.MCPServerSpec,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	environmentName string,
+) *mcpgatewayv1alpha1.FieldProvenance {
+	provenance := &mcpgatewayv1alpha1.FieldProvenance{}
+
+	switch {
+	case written.GatewayID != "":
+		provenance.GatewayID = ProvenanceSpec
+	case mcpServer.Status.GatewayArn != "":
+		provenance.GatewayID = ProvenanceExistingTarget
+	case mcpServer.Spec.GatewayID != "":
+		provenance.GatewayID = ProvenanceEnvironment + environmentName
+	default:
+		provenance.GatewayID = ProvenanceDefaultGateway
+	}
+
+	switch {
+	case written.TargetName != "":
+		provenance.TargetName = ProvenanceSpec
+	case mcpServer.Spec.TargetName != "":
+		provenance.TargetName = workloadProvenance(mcpServer)
+	case r.ClusterName != "":
+		provenance.TargetName = ProvenanceSpokeCluster + r.ClusterName
+	default:
+		provenance.TargetName = ProvenanceResourceName
+	}
+
+	switch {
+	case written.Description != "":
+		provenance.Description = ProvenanceSpec
+	case mcpServer.Spec.Description != "":
+		provenance.Description = workloadProvenance(mcpServer)
+	}
+
+	return provenance
+}
+
+// workloadProvenance returns the provenance of a value taken from the annotations of the workload
+// referenced by spec.endpointRef
+func workloadProvenance(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	ref := mcpServer.Spec.EndpointRef
+	return ProvenanceWorkload + workloadKind(ref) + "/" + ref.Name
+}
+
+// recordProvenance writes the provenance of the gateway target values to the status if it changed
+func (r *MCPServerReconciler) recordProvenance(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	provenance *mcpgatewayv1alpha1.FieldProvenance,
+	log logr.Logger,
+) error {
+	if equality.Semantic.DeepEqual(provenance, mcpServer.Status.Provenance) {
+		return nil
+	}
+	log.V(1).Info("Recording provenance of gateway target values", "provenance", provenance)
+	return r.StatusManager.SetProvenance(ctx, mcpServer, provenance)
+}
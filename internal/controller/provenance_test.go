@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/environment"
+)
+
+var _ = Describe("Field provenance", func() {
+	var reconciler *MCPServerReconciler
+	var mcpServer *mcpgatewayv1alpha1.MCPServer
+
+	BeforeEach(func() {
+		reconciler = &MCPServerReconciler{}
+		mcpServer = &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-provenance", Namespace: "default"},
+		}
+	})
+
+	// resolve applies the environment profile and workload metadata like Reconcile does
+	resolve := func(profile *environment.Profile, metadata *mcpgatewayv1alpha1.WorkloadMetadata) *mcpgatewayv1alpha1.FieldProvenance {
+		written := mcpServer.Spec.DeepCopy()
+		applyEnvironmentProfile(ctx, mcpServer, profile)
+		applyWorkloadMetadata(mcpServer, metadata)
+		return reconciler.fieldProvenance(written, mcpServer, "prod")
+	}
+
+	It("should attribute values set in the spec to the spec", func() {
+		mcpServer.Spec.GatewayID = "gw-own"
+		mcpServer.Spec.TargetName = "own-name"
+		mcpServer.Spec.Description = "Own description"
+
+		provenance := resolve(&environment.Profile{GatewayID: "gw-prod"}, nil)
+		Expect(provenance).To(Equal(&mcpgatewayv1alpha1.FieldProvenance{
+			GatewayID:   ProvenanceSpec,
+			TargetName:  ProvenanceSpec,
+			Description: ProvenanceSpec,
+		}))
+	})
+
+	It("should attribute defaulted values to the environment and workload", func() {
+		mcpServer.Spec.EndpointRef = &mcpgatewayv1alpha1.WorkloadReference{Kind: "StatefulSet", Name: "backend"}
+
+		provenance := resolve(&environment.Profile{GatewayID: "gw-prod"},
+			&mcpgatewayv1alpha1.WorkloadMetadata{TargetName: "weather", Description: "Weather tools"})
+		Expect(provenance).To(Equal(&mcpgatewayv1alpha1.FieldProvenance{
+			GatewayID:   "environment/prod",
+			TargetName:  "workload/StatefulSet/backend",
+			Description: "workload/StatefulSet/backend",
+		}))
+	})
+
+	It("should attribute the gateway of an existing target to the target", func() {
+		mcpServer.Status.GatewayArn = "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-old"
+
+		provenance := resolve(&environment.Profile{GatewayID: "gw-prod"}, nil)
+		Expect(provenance.GatewayID).To(Equal(ProvenanceExistingTarget))
+	})
+
+	It("should fall back to the operator defaults", func() {
+		provenance := resolve(nil, nil)
+		Expect(provenance).To(Equal(&mcpgatewayv1alpha1.FieldProvenance{
+			GatewayID:  ProvenanceDefaultGateway,
+			TargetName: ProvenanceResourceName,
+		}))
+
+		reconciler.ClusterName = "team-a"
+		Expect(reconciler.fieldProvenance(&mcpServer.Spec, mcpServer, "").TargetName).To(Equal("spokeCluster/team-a"))
+	})
+})
@@ -0,0 +1,214 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+)
+
+// reasonProviderConfigError is the Ready reason of MCPServers whose AWSProviderConfig cannot be
+// used
+const reasonProviderConfigError = "ProviderConfigError"
+
+// providerConfigKind is the kind of AWSProviderConfigs in the reference index
+const providerConfigKind = "AWSProviderConfig"
+
+// providerConfigError reports an AWSProviderConfig reference that cannot be used until the spec is
+// fixed or the AWSProviderConfig is created
+type providerConfigError struct {
+	err error
+}
+
+func (e *providerConfigError) Error() string { return e.err.Error() }
+
+func (e *providerConfigError) Unwrap() error { return e.err }
+
+// providerRegionKey is the context key of the region of the AWSProviderConfig of a reconcile
+type providerRegionKey struct{}
+
+// targetRegion returns the region the gateway target of the reconcile is managed in, the region of
+// the AWSProviderConfig applied to ctx or else the region of the operator
+func (r *MCPServerReconciler) targetRegion(ctx context.Context) string {
+	if region, ok := ctx.Value(providerRegionKey{}).(string); ok {
+		return region
+	}
+	return r.ConfigParser.Region()
+}
+
+// applyProviderConfig makes the AWS calls of the reconcile use the client, circuit breaker and
+// retry policy of the AWSProviderConfig referenced by the MCPServer. The retry policy of the AWSProviderConfig takes
+// precedence over the one of the namespace's environment. A providerConfigError is returned if the
+// AWSProviderConfig does not exist, is in another region than the gateway ARN of the MCPServer, has
+// a role in another partition than its region, or the operator runs without ProviderClients or
+// reconciles a spoke cluster.
+func (r *MCPServerReconciler) applyProviderConfig(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+) (context.Context, error) {
+	ref := mcpServer.Spec.ProviderConfigRef
+	if ref == nil {
+		return ctx, nil
+	}
+	providerConfig, err := r.getProviderConfig(ctx, ref.Name)
+	if err != nil {
+		return ctx, err
+	}
+
+	// The gateway must be reachable with the regional client of the AWSProviderConfig
+	if gateway, err := config.ParseGatewayIdentifier(mcpServer.Spec.GatewayID); err == nil &&
+		gateway.Region != "" && gateway.Region != providerConfig.Spec.Region {
+		return ctx, &providerConfigError{fmt.Errorf("gateway %s is in region %s, but AWSProviderConfig %s manages region %s",
+			gateway.ID, gateway.Region, ref.Name, providerConfig.Spec.Region)}
+	}
+	return r.withProviderConfig(ctx, providerConfig), nil
+}
+
+// ProviderContext returns ctx with the client, circuit breaker and retry policy of the named
+// AWSProviderConfig, for
+// AWS calls made for an MCPServer outside of its reconcile, e.g. after the MCPServer is gone. An
+// empty name returns ctx, whose calls use the operator's own client.
+func (r *MCPServerReconciler) ProviderContext(ctx context.Context, name string) (context.Context, error) {
+	if name == "" {
+		return ctx, nil
+	}
+	providerConfig, err := r.getProviderConfig(ctx, name)
+	if err != nil {
+		return ctx, err
+	}
+	return r.withProviderConfig(ctx, providerConfig), nil
+}
+
+// getProviderConfig reads the named AWSProviderConfig. A providerConfigError is returned if it does
+// not exist, has a role in another partition than its region, or the operator runs without
+// ProviderClients. MCPServers of spoke clusters cannot reference AWSProviderConfigs: the hub's
+// credentials would assume the role of any AWSProviderConfig a spoke user creates.
+func (r *MCPServerReconciler) getProviderConfig(ctx context.Context, name string) (*mcpgatewayv1alpha1.AWSProviderConfig, error) {
+	if r.ClusterName != "" {
+		return nil, &providerConfigError{fmt.Errorf(
+			"spec.providerConfigRef is not supported for MCPServers of spoke cluster %s", r.ClusterName)}
+	}
+	if r.ProviderClients == nil {
+		return nil, &providerConfigError{fmt.Errorf(
+			"spec.providerConfigRef is not supported by this operator, e.g. with --aws-simulator")}
+	}
+
+	providerConfig := &mcpgatewayv1alpha1.AWSProviderConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name}, providerConfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, &providerConfigError{fmt.Errorf("AWSProviderConfig %s not found", name)}
+		}
+		return nil, err
+	}
+	if err := config.ValidateArnPartition(providerConfig.Spec.RoleARN, providerConfig.Spec.Region); err != nil {
+		return nil, &providerConfigError{fmt.Errorf("roleARN of AWSProviderConfig %s: %w", name, err)}
+	}
+	return providerConfig, nil
+}
+
+// withProviderConfig returns ctx with the region, client, circuit breaker and retry policy of the
+// AWSProviderConfig
+func (r *MCPServerReconciler) withProviderConfig(
+	ctx context.Context,
+	providerConfig *mcpgatewayv1alpha1.AWSProviderConfig,
+) context.Context {
+	settings := providerSettings(providerConfig)
+	ctx = context.WithValue(ctx, providerRegionKey{}, providerConfig.Spec.Region)
+	ctx = bedrock.WithContextClient(ctx, r.ProviderClients.Client(settings))
+	ctx = bedrock.WithContextCircuitBreaker(ctx, r.ProviderClients.CircuitBreaker(settings))
+	if retry := providerConfig.Spec.Retry; retry != nil {
+		ctx = bedrock.WithContextRetryPolicy(ctx, providerRetryPolicy(retry, bedrock.DefaultRetryPolicy()))
+	}
+	return ctx
+}
+
+// providerConfigName returns the name of the AWSProviderConfig referenced by the MCPServer, empty
+// if it uses the operator's own client
+func providerConfigName(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	if mcpServer.Spec.ProviderConfigRef == nil {
+		return ""
+	}
+	return mcpServer.Spec.ProviderConfigRef.Name
+}
+
+// reportProviderConfigUnusable handles a deleted MCPServer whose gateway target cannot be deleted
+// because its AWSProviderConfig cannot be used. The operator's default client may manage another
+// account, so it is not tried instead. Like a denied delete, see reportDeleteDenied, the MCPServer
+// waits for the AWSProviderConfig to be fixed unless the deletion fallback annotation asks to
+// orphan the target. It reports true if the target is orphaned, in which case the finalizer is to
+// be removed.
+func (r *MCPServerReconciler) reportProviderConfigUnusable(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	err error,
+	log logr.Logger,
+) (bool, error) {
+	targetID := mcpServer.Status.TargetID
+	if mcpServer.Annotations[DeletionFallbackAnnotation] == DeletionFallbackOrphan {
+		log.Info("AWSProviderConfig cannot be used, orphaning gateway target as requested by the deletion fallback",
+			"targetId", targetID, "error", err.Error())
+		r.recordEvent(mcpServer, corev1.EventTypeWarning, "TargetOrphaned", "Delete",
+			fmt.Sprintf("Gateway target %s was left in AWS because %v and %s is %s",
+				targetID, err, DeletionFallbackAnnotation, DeletionFallbackOrphan))
+		return true, nil
+	}
+
+	log.Info("AWSProviderConfig cannot be used, waiting for it to delete the gateway target",
+		"targetId", targetID, "error", err.Error())
+	message := fmt.Sprintf("Gateway target %s cannot be deleted: %v: fix the AWSProviderConfig, "+
+		"or annotate the MCPServer with %s=%s to leave the target in AWS",
+		targetID, err, DeletionFallbackAnnotation, DeletionFallbackOrphan)
+	if condition := meta.FindStatusCondition(mcpServer.Status.Conditions, "Ready"); condition == nil ||
+		condition.Message != message {
+		r.recordEvent(mcpServer, corev1.EventTypeWarning, reasonProviderConfigError, "Delete", message)
+	}
+	return false, r.StatusManager.SetError(ctx, mcpServer, reasonProviderConfigError, message)
+}
+
+// providerSettings returns the client settings of the AWSProviderConfig
+func providerSettings(providerConfig *mcpgatewayv1alpha1.AWSProviderConfig) bedrock.ProviderSettings {
+	return bedrock.ProviderSettings{
+		Region:      providerConfig.Spec.Region,
+		RoleARN:     providerConfig.Spec.RoleARN,
+		ExternalID:  providerConfig.Spec.ExternalID,
+		EndpointURL: providerConfig.Spec.EndpointURL,
+	}
+}
+
+// providerRetryPolicy returns base with the retry overrides of an AWSProviderConfig applied
+func providerRetryPolicy(retry *mcpgatewayv1alpha1.AWSRetrySpec, base bedrock.RetryPolicy) bedrock.RetryPolicy {
+	if retry.MaxRetries != nil {
+		base.MaxRetries = int(*retry.MaxRetries)
+	}
+	if retry.InitialBackoff != nil {
+		base.InitialBackoff = retry.InitialBackoff.Duration
+	}
+	if retry.MaxBackoff != nil {
+		base.MaxBackoff = retry.MaxBackoff.Duration
+	}
+	return base
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/internal/testutil"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+var _ = Describe("AWSProviderConfig", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	// providerHarness returns a harness whose MCPServer references the AWSProviderConfig "eu", and
+	// a second fake to serve the endpoint of that AWSProviderConfig
+	providerHarness := func() (*reconcileHarness, *fakeAgentCore) {
+		mcpServer := testutil.NewMCPServer(key.Name, testutil.Edit(func(mcpServer *mcpgatewayv1alpha1.MCPServer) {
+			mcpServer.Spec.ProviderConfigRef = &mcpgatewayv1alpha1.ProviderConfigReference{Name: "eu"}
+		}))
+		h := newReconcileHarness(mcpServer)
+		h.reconciler.ProviderClients = bedrock.NewProviderClients(aws.Config{
+			Region: "us-east-1",
+			Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+				return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+			}),
+		})
+		return h, newFakeAgentCore()
+	}
+
+	// euProviderConfig returns the AWSProviderConfig "eu" calling the fake
+	euProviderConfig := func(fake *fakeAgentCore) *mcpgatewayv1alpha1.AWSProviderConfig {
+		return testutil.NewAWSProviderConfig("eu", "eu-west-1",
+			testutil.Edit(func(providerConfig *mcpgatewayv1alpha1.AWSProviderConfig) {
+				providerConfig.Spec.EndpointURL = fake.server.URL
+			}))
+	}
+
+	It("should manage the gateway target with the client of the AWSProviderConfig", func() {
+		h, eu := providerHarness()
+		Expect(h.client.Create(ctx, euProviderConfig(eu))).To(Succeed())
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.get(ctx, key).Status.TargetID).To(Equal("TARGET1"))
+		Expect(eu.targetIDs()).To(ConsistOf("TARGET1"))
+		Expect(h.agentCore.callCount("CreateGatewayTarget")).To(BeZero())
+
+		By("deleting the target with the same client")
+		Expect(h.client.Delete(ctx, h.get(ctx, key))).To(Succeed())
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eu.callCount("DeleteGatewayTarget")).To(Equal(1))
+		Expect(eu.targetIDs()).To(BeEmpty())
+	})
+
+	It("should guard the calls of the AWSProviderConfig with a circuit breaker of its own", func() {
+		h, eu := providerHarness()
+		providerConfig := euProviderConfig(eu)
+		Expect(h.client.Create(ctx, providerConfig)).To(Succeed())
+		h.reconciler.ProviderClients.WithCircuitBreakers(1, time.Hour)
+		h.reconciler.CircuitBreaker = bedrock.NewCircuitBreaker(1, time.Hour)
+		h.reconciler.CircuitBreaker.Record(&smithy.GenericAPIError{Code: "ServiceUnavailableException"})
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eu.targetIDs()).To(ConsistOf("TARGET1"), "the open breaker of the operator's own client is not used")
+		Expect(meta.IsStatusConditionTrue(h.get(ctx, key).Status.Conditions, degradedConditionType)).To(BeFalse())
+
+		By("failing fast while the breaker of the AWSProviderConfig is open")
+		breaker := h.reconciler.ProviderClients.CircuitBreaker(providerSettings(providerConfig))
+		breaker.Record(&smithy.GenericAPIError{Code: "ServiceUnavailableException"})
+		result, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(meta.IsStatusConditionTrue(h.get(ctx, key).Status.Conditions, degradedConditionType)).To(BeTrue())
+	})
+
+	It("should wait for a missing AWSProviderConfig", func() {
+		h, eu := providerHarness()
+
+		result, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+		condition := meta.FindStatusCondition(h.get(ctx, key).Status.Conditions, "Ready")
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(reasonProviderConfigError))
+		Expect(condition.Message).To(ContainSubstring("AWSProviderConfig eu not found"))
+		Expect(h.agentCore.callCount("CreateGatewayTarget")).To(BeZero())
+
+		By("enqueuing the MCPServer once the AWSProviderConfig is created")
+		providerConfig := euProviderConfig(eu)
+		Expect(h.client.Create(ctx, providerConfig)).To(Succeed())
+		Expect(h.reconciler.mapReferenceToMCPServers(providerConfigKind)(ctx, providerConfig)).
+			To(ConsistOf(reconcile.Request{NamespacedName: key}))
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eu.targetIDs()).To(HaveLen(1))
+	})
+
+	It("should reject references without ProviderClients", func() {
+		h, eu := providerHarness()
+		Expect(h.client.Create(ctx, euProviderConfig(eu))).To(Succeed())
+		h.reconciler.ProviderClients = nil
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		condition := meta.FindStatusCondition(h.get(ctx, key).Status.Conditions, "Ready")
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(reasonProviderConfigError))
+		Expect(eu.targetIDs()).To(BeEmpty())
+	})
+
+	It("should reject ARNs in another partition than the region of the AWSProviderConfig", func() {
+		h, gov := providerHarness()
+		mcpServer := h.get(ctx, key)
+		mcpServer.Spec.AuthType = "OAuth2"
+		mcpServer.Spec.OauthProviderArn = "arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/oauth2credentialprovider/p"
+		Expect(h.client.Update(ctx, mcpServer)).To(Succeed())
+		providerConfig := euProviderConfig(gov)
+		providerConfig.Spec.Region = "us-gov-west-1"
+		Expect(h.client.Create(ctx, providerConfig)).To(Succeed())
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		condition := meta.FindStatusCondition(h.get(ctx, key).Status.Conditions, "Ready")
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal("ValidationError"))
+		Expect(condition.Message).To(ContainSubstring("spec.oauthProviderArn"))
+		Expect(gov.targetIDs()).To(BeEmpty())
+
+		By("rejecting a role in another partition")
+		Expect(h.client.Get(ctx, types.NamespacedName{Name: "eu"}, providerConfig)).To(Succeed())
+		providerConfig.Spec.RoleARN = "arn:aws:iam::123456789012:role/agentcore"
+		Expect(h.client.Update(ctx, providerConfig)).To(Succeed())
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		condition = meta.FindStatusCondition(h.get(ctx, key).Status.Conditions, "Ready")
+		Expect(condition.Reason).To(Equal(reasonProviderConfigError))
+		Expect(condition.Message).To(ContainSubstring("roleARN of AWSProviderConfig eu"))
+	})
+
+	It("should not delete the target of an MCPServer without its AWSProviderConfig", func() {
+		h, eu := providerHarness()
+		providerConfig := euProviderConfig(eu)
+		Expect(h.client.Create(ctx, providerConfig)).To(Succeed())
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eu.targetIDs()).To(ConsistOf("TARGET1"))
+
+		Expect(h.client.Delete(ctx, providerConfig)).To(Succeed())
+		Expect(h.client.Delete(ctx, h.get(ctx, key))).To(Succeed())
+		result, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+		deleting := h.get(ctx, key)
+		Expect(deleting.Finalizers).To(ContainElement(gatewayTargetFinalizer))
+		condition := meta.FindStatusCondition(deleting.Status.Conditions, "Ready")
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(reasonProviderConfigError))
+		Expect(condition.Message).To(ContainSubstring("AWSProviderConfig eu not found"))
+		Expect(condition.Message).To(ContainSubstring(DeletionFallbackAnnotation))
+		Expect(h.agentCore.callCount("DeleteGatewayTarget")).To(BeZero())
+
+		By("orphaning the target once the fallback annotation is set")
+		deleting.Annotations = map[string]string{DeletionFallbackAnnotation: DeletionFallbackOrphan}
+		Expect(h.client.Update(ctx, deleting)).To(Succeed())
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eu.targetIDs()).To(ConsistOf("TARGET1"))
+		Expect(h.agentCore.callCount("DeleteGatewayTarget")).To(BeZero())
+		Expect(apierrors.IsNotFound(h.client.Get(ctx, key, &mcpgatewayv1alpha1.MCPServer{}))).To(BeTrue())
+	})
+
+	It("should take over legacy targets with the client of the AWSProviderConfig", func() {
+		h, eu := providerHarness()
+		Expect(h.client.Create(ctx, euProviderConfig(eu))).To(Succeed())
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		// Targets created before the ownership annotation have none
+		mcpServer := h.get(ctx, key)
+		owner := mcpServer.Annotations[targetOwnerAnnotation]
+		Expect(owner).NotTo(BeEmpty())
+		delete(mcpServer.Annotations, targetOwnerAnnotation)
+		Expect(h.client.Update(ctx, mcpServer)).To(Succeed())
+		reads := eu.callCount("GetGatewayTarget")
+
+		Expect(h.reconciler.MigrateLegacyTargets(ctx)).To(Succeed())
+		Expect(h.get(ctx, key).Annotations).To(HaveKeyWithValue(targetOwnerAnnotation, owner))
+		Expect(eu.callCount("GetGatewayTarget")).To(Equal(reads + 1))
+		Expect(h.agentCore.callCount("GetGatewayTarget")).To(BeZero())
+	})
+
+	It("should provide the client of an AWSProviderConfig outside of reconciles", func() {
+		h, eu := providerHarness()
+		Expect(h.client.Create(ctx, euProviderConfig(eu))).To(Succeed())
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		gatewayID := h.get(ctx, key).Status.GatewayID
+
+		euCtx, err := h.reconciler.ProviderContext(ctx, "eu")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.reconciler.newBedrockWrapper(logf.Log).DeleteGatewayTarget(euCtx, gatewayID, "TARGET1")).To(Succeed())
+		Expect(eu.targetIDs()).To(BeEmpty())
+		Expect(h.agentCore.callCount("DeleteGatewayTarget")).To(BeZero())
+
+		_, err = h.reconciler.ProviderContext(ctx, "missing")
+		Expect(err).To(MatchError(ContainSubstring("AWSProviderConfig missing not found")))
+	})
+
+	It("should release an MCPServer without gateway target when its AWSProviderConfig is missing", func() {
+		h, _ := providerHarness()
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		// The finalizer of an MCPServer whose target was not created yet
+		mcpServer := h.get(ctx, key)
+		mcpServer.Finalizers = []string{gatewayTargetFinalizer}
+		Expect(h.client.Update(ctx, mcpServer)).To(Succeed())
+
+		Expect(h.client.Delete(ctx, mcpServer)).To(Succeed())
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apierrors.IsNotFound(h.client.Get(ctx, key, &mcpgatewayv1alpha1.MCPServer{}))).To(BeTrue())
+	})
+})
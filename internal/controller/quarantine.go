@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// quarantinedCondition is the condition type set on MCPServers whose reconcile panicked
+const quarantinedCondition = "Quarantined"
+
+// quarantine handles a panic recovered from the reconcile of the MCPServer. It marks the MCPServer
+// with the Quarantined condition and returns an error, so that the MCPServer is retried with the
+// exponential backoff of the workqueue while the operator keeps serving all other resources.
+// The MCPServer is re-fetched first, as the panic may have left it partially modified.
+func (r *MCPServerReconciler) quarantine(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	recovered any,
+	log logr.Logger,
+) error {
+	err := fmt.Errorf("reconcile panicked: %v", recovered)
+	log.Error(err, "Recovered from panic, quarantining MCPServer", "stack", string(debug.Stack()))
+	if mcpServer == nil || mcpServer.Name == "" {
+		return err
+	}
+
+	latest := &mcpgatewayv1alpha1.MCPServer{}
+	if getErr := r.Get(ctx, client.ObjectKeyFromObject(mcpServer), latest); getErr != nil {
+		log.Error(getErr, "Failed to re-fetch MCPServer before quarantining it")
+		return err
+	}
+	if statusErr := r.StatusManager.SetQuarantined(ctx, latest, true, err.Error()); statusErr != nil {
+		log.Error(statusErr, "Failed to update status with quarantine")
+	}
+	r.recordEvent(latest, corev1.EventTypeWarning, "Quarantined", "Reconcile", err.Error())
+	return err
+}
+
+// clearQuarantine clears the Quarantined condition once a reconcile of the MCPServer completed
+// without panicking
+func (r *MCPServerReconciler) clearQuarantine(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, log logr.Logger) {
+	if mcpServer == nil || !meta.IsStatusConditionTrue(mcpServer.Status.Conditions, quarantinedCondition) {
+		return
+	}
+
+	latest := &mcpgatewayv1alpha1.MCPServer{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(mcpServer), latest); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to re-fetch MCPServer before clearing quarantine")
+		}
+		return
+	}
+	if err := r.StatusManager.SetQuarantined(ctx, latest, false, "Reconcile completed without panicking"); err != nil {
+		log.Error(err, "Failed to clear quarantine condition")
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Quarantine", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "test-quarantine", Namespace: "default"}
+
+	BeforeEach(func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, mcpServer)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, mcpServer)).To(Succeed())
+	})
+
+	It("should quarantine a resource whose reconcile panics and clear it afterwards", func() {
+		// Without a ConfigParser validating the spec dereferences a nil pointer
+		reconciler := &MCPServerReconciler{
+			Client:        k8sClient,
+			Scheme:        k8sClient.Scheme(),
+			StatusManager: status.NewManager(k8sClient),
+		}
+
+		By("recovering from the panic")
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).To(MatchError(ContainSubstring("reconcile panicked")))
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+		condition := meta.FindStatusCondition(mcpServer.Status.Conditions, quarantinedCondition)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("ReconcilePanicked"))
+
+		By("clearing the quarantine after a reconcile that completes")
+		reconciler.clearQuarantine(ctx, mcpServer, logf.Log)
+		Expect(k8sClient.Get(ctx, key, mcpServer)).To(Succeed())
+		Expect(meta.IsStatusConditionFalse(mcpServer.Status.Conditions, quarantinedCondition)).To(BeTrue())
+	})
+})
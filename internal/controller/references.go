@@ -0,0 +1,172 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+const (
+	// WatchLabel must be set to "true" on every Secret, ConfigMap, Service and HTTPRoute referenced by an MCPServer,
+	// on workloads whose readiness or annotations feed into a gateway target, and on Pods whose
+	// readiness is gated on gateway targets. The operator only caches objects carrying this
+	// label, so unrelated Secrets, workloads and Pods in the cluster are never loaded into its
+	// memory.
+	WatchLabel = "mcpgateway.bedrock.aws/watch"
+
+	// referenceIndexField indexes MCPServers by the Secrets and ConfigMaps they reference
+	referenceIndexField = ".spec.references"
+
+	// lastAppliedAnnotation is stripped from cached objects since it duplicates Secret data
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// ReferenceCacheOptions returns the cache configuration for Secrets, ConfigMaps, workloads, Services
+// and Pods.
+// Informers for these kinds are restricted to objects labelled with WatchLabel=true
+// and strip managed fields and the last-applied annotation before caching.
+func ReferenceCacheOptions() map[client.Object]cache.ByObject {
+	selector := labels.SelectorFromSet(labels.Set{WatchLabel: "true"})
+
+	return map[client.Object]cache.ByObject{
+		&corev1.Secret{}: {
+			Label:     selector,
+			Transform: stripReferenceMetadata,
+		},
+		&corev1.ConfigMap{}: {
+			Label:     selector,
+			Transform: stripReferenceMetadata,
+		},
+		&appsv1.Deployment{}: {
+			Label:     selector,
+			Transform: stripReferenceMetadata,
+		},
+		&appsv1.StatefulSet{}: {
+			Label:     selector,
+			Transform: stripReferenceMetadata,
+		},
+		&corev1.Service{}: {
+			Label:     selector,
+			Transform: stripReferenceMetadata,
+		},
+		&corev1.Pod{}: {
+			Label:     selector,
+			Transform: stripReferenceMetadata,
+		},
+	}
+}
+
+// stripReferenceMetadata drops metadata the operator never reads to keep the cache small
+func stripReferenceMetadata(in any) (any, error) {
+	obj, err := meta.Accessor(in)
+	if err != nil {
+		return in, nil
+	}
+
+	if obj.GetManagedFields() != nil {
+		obj.SetManagedFields(nil)
+	}
+	if annotations := obj.GetAnnotations(); annotations != nil {
+		if _, ok := annotations[lastAppliedAnnotation]; ok {
+			delete(annotations, lastAppliedAnnotation)
+			obj.SetAnnotations(annotations)
+		}
+	}
+
+	return in, nil
+}
+
+// referenceKey builds the index key for a referenced object
+func referenceKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// referencedObjects returns the index keys of every Secret, ConfigMap, Service, HTTPRoute and AWSProviderConfig the MCPServer references,
+// and of its workload, whose availability and annotations feed into the gateway target. Spec fields
+// that reference such objects
+// must be added here so that changes to the referenced objects trigger a reconcile of the MCPServer.
+func referencedObjects(mcpServer *mcpgatewayv1alpha1.MCPServer) []string {
+	var refs []string
+	if probeSpec := mcpServer.Spec.Probe; probeSpec != nil && probeSpec.TLS != nil && probeSpec.TLS.CASecretRef != nil {
+		refs = append(refs, referenceKey("Secret", mcpServer.Namespace, probeSpec.TLS.CASecretRef.Name))
+	}
+	if ref := mcpServer.Spec.EndpointRef; ref != nil {
+		refs = append(refs, referenceKey(workloadKind(ref), mcpServer.Namespace, ref.Name))
+	}
+	if hasEndpointVariables(mcpServer) {
+		refs = append(refs, referenceKey("ConfigMap", mcpServer.Namespace, EndpointValuesConfigMap))
+	}
+	if ref := openAPISchemaConfigMap(mcpServer); ref != nil {
+		refs = append(refs, referenceKey("ConfigMap", mcpServer.Namespace, ref.Name))
+	}
+	if mcpServer.Spec.ServiceRef != nil {
+		key := serviceRefKey(mcpServer)
+		refs = append(refs, referenceKey("Service", key.Namespace, key.Name))
+	}
+	if mcpServer.Spec.HTTPRouteRef != nil {
+		key := httpRouteRefKey(mcpServer)
+		refs = append(refs, referenceKey(HTTPRouteGVK.Kind, key.Namespace, key.Name))
+	}
+	if ref := mcpServer.Spec.ProviderConfigRef; ref != nil {
+		// AWSProviderConfigs are cluster-scoped
+		refs = append(refs, referenceKey(providerConfigKind, "", ref.Name))
+	}
+	return refs
+}
+
+// indexReferences is the field indexer for referenceIndexField
+func indexReferences(obj client.Object) []string {
+	mcpServer, ok := obj.(*mcpgatewayv1alpha1.MCPServer)
+	if !ok {
+		return nil
+	}
+	return referencedObjects(mcpServer)
+}
+
+// mapReferenceToMCPServers returns a map function enqueuing every MCPServer that references
+// the changed object of the given kind
+func (r *MCPServerReconciler) mapReferenceToMCPServers(kind string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+		if err := r.List(ctx, mcpServers,
+			client.InNamespace(obj.GetNamespace()),
+			client.MatchingFields{referenceIndexField: referenceKey(kind, obj.GetNamespace(), obj.GetName())},
+		); err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(mcpServers.Items))
+		for _, item := range mcpServers.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: item.Namespace, Name: item.Name},
+			})
+		}
+		return requests
+	}
+}
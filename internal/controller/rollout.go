@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// rolloutPendingCondition is the condition reporting updates held back by a rollout
+const rolloutPendingCondition = "RolloutPending"
+
+// rolloutRecheckInterval is how often a held back update asks the rollout gate again
+const rolloutRecheckInterval = 15 * time.Second
+
+// checkRollout asks the rollout gate whether the pending update of the MCPServer may be applied.
+// The gate compares the MCPServer with every other MCPServer of its gateway in the cache. Held
+// back updates set the RolloutPending condition and are retried after rolloutRecheckInterval.
+func (r *MCPServerReconciler) checkRollout(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) (bool, ctrl.Result, error) {
+	if r.Rollout == nil {
+		return false, ctrl.Result{}, nil
+	}
+
+	gatewayID, err := r.ConfigParser.GetGatewayID(mcpServer)
+	if err != nil {
+		return true, ctrl.Result{}, err
+	}
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := r.List(ctx, mcpServers); err != nil {
+		return true, ctrl.Result{}, err
+	}
+	var fleet []mcpgatewayv1alpha1.MCPServer
+	for _, other := range mcpServers.Items {
+		if otherGatewayID, err := r.ConfigParser.GetGatewayID(&other); err == nil && otherGatewayID == gatewayID {
+			fleet = append(fleet, other)
+		}
+	}
+
+	decision := r.Rollout.Admit(mcpServer, gatewayID, fleet, time.Now())
+	if decision.Admitted {
+		if meta.IsStatusConditionTrue(mcpServer.Status.Conditions, rolloutPendingCondition) {
+			if err := r.StatusManager.SetRolloutPending(ctx, mcpServer, false, "",
+				"Update admitted by the rollout"); err != nil {
+				log.Error(err, "Failed to clear rollout pending condition")
+			}
+		}
+		return false, ctrl.Result{}, nil
+	}
+
+	log.Info("Update held back by rollout", "gatewayId", gatewayID, "reason", decision.Reason)
+	if err := r.StatusManager.SetRolloutPending(ctx, mcpServer, true, decision.Reason, decision.Message); err != nil {
+		if apierrors.IsConflict(err) {
+			return true, ctrl.Result{Requeue: true}, nil
+		}
+		return true, ctrl.Result{}, err
+	}
+	return true, ctrl.Result{RequeueAfter: rolloutRecheckInterval}, nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+
+// ServiceURLAnnotation on the Service referenced by spec.serviceRef sets the externally reachable
+// HTTPS URL of the Service, e.g. of an Ingress or a load balancer managed outside of Kubernetes.
+// It takes precedence over the load balancer address of the Service.
+const ServiceURLAnnotation = "mcpgateway.bedrock.aws/url"
+
+// reasonServiceRefError is the Ready reason of MCPServers whose spec.serviceRef cannot be resolved
+// to an endpoint
+const reasonServiceRefError = "ServiceRefError"
+
+// serviceRefError reports a Service that cannot be resolved to an endpoint until the spec or the
+// Service is fixed, e.g. because its load balancer has no address yet
+type serviceRefError struct {
+	err error
+}
+
+func (e *serviceRefError) Error() string { return e.err.Error() }
+
+func (e *serviceRefError) Unwrap() error { return e.err }
+
+// serviceRefKey returns the namespaced name of the Service referenced by the MCPServer
+func serviceRefKey(mcpServer *mcpgatewayv1alpha1.MCPServer) types.NamespacedName {
+	ref := mcpServer.Spec.ServiceRef
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = mcpServer.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: ref.Name}
+}
+
+// resolveServiceRef sets the endpoint of the in-memory MCPServer to the URL of the Service
+// referenced by spec.serviceRef, so that the gateway target is built with it. Like the
+// substituted endpoint, the resolved endpoint is never written to the spec; the status records
+// it, so a changed address of the Service updates the gateway target.
+func (r *MCPServerReconciler) resolveServiceRef(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	// Lambda and OpenApiSchema targets reject serviceRef during validation
+	targetType := mcpServer.Spec.TargetType
+	if mcpServer.Spec.ServiceRef == nil || (targetType != "" && targetType != mcpgatewayv1alpha1.TargetTypeMcpServer) {
+		return nil
+	}
+	if mcpServer.Spec.Endpoint != "" {
+		return &serviceRefError{fmt.Errorf("endpoint and serviceRef cannot be combined")}
+	}
+
+	service := &corev1.Service{}
+	key := serviceRefKey(mcpServer)
+	if err := r.Get(ctx, key, service); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &serviceRefError{fmt.Errorf("service %s labelled %s=true was not found", key, WatchLabel)}
+		}
+		return err
+	}
+
+	endpoint, err := serviceURL(service, mcpServer.Spec.ServiceRef)
+	if err != nil {
+		return &serviceRefError{fmt.Errorf("service %s: %w", key, err)}
+	}
+	mcpServer.Spec.Endpoint = endpoint
+	return nil
+}
+
+// serviceURL returns the externally reachable HTTPS URL of the MCP endpoint of a Service: the
+// URL of its ServiceURLAnnotation, or else the address of its load balancer with the referenced
+// port, followed by the path of ref
+func serviceURL(service *corev1.Service, ref *mcpgatewayv1alpha1.ServiceReference) (string, error) {
+	if url := service.Annotations[ServiceURLAnnotation]; url != "" {
+		if !strings.HasPrefix(url, "https://") {
+			return "", fmt.Errorf("%s annotation %q is not an HTTPS URL", ServiceURLAnnotation, url)
+		}
+		return strings.TrimSuffix(url, "/") + ref.Path, nil
+	}
+
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return "", fmt.Errorf("type %s is not reachable by the gateway; use a LoadBalancer Service or set "+
+			"the %s annotation to its externally reachable HTTPS URL", service.Spec.Type, ServiceURLAnnotation)
+	}
+
+	port, err := servicePort(service, ref.Port)
+	if err != nil {
+		return "", err
+	}
+
+	var host string
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		if ingress.Hostname != "" {
+			host = ingress.Hostname
+			break
+		}
+		if ingress.IP != "" && host == "" {
+			host = ingress.IP
+		}
+	}
+	if host == "" {
+		return "", fmt.Errorf("load balancer has no address yet")
+	}
+
+	if port != 443 {
+		host = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	return "https://" + host + ref.Path, nil
+}
+
+// servicePort returns the port of the Service the gateway connects to: port if set, or else the
+// only port of the Service
+func servicePort(service *corev1.Service, port int32) (int32, error) {
+	if port != 0 {
+		for _, servicePort := range service.Spec.Ports {
+			if servicePort.Port == port {
+				return port, nil
+			}
+		}
+		return 0, fmt.Errorf("has no port %d", port)
+	}
+	if len(service.Spec.Ports) != 1 {
+		return 0, fmt.Errorf("has %d ports; set serviceRef.port", len(service.Spec.Ports))
+	}
+	return service.Spec.Ports[0].Port, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Service references", func() {
+	ctx := context.Background()
+
+	loadBalancer := func(ports ...int32) *corev1.Service {
+		service := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "weather-mcp", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+			Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{Hostname: "weather.elb.amazonaws.com"}},
+			}},
+		}
+		for _, port := range ports {
+			service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{Port: port})
+		}
+		return service
+	}
+
+	It("should resolve the load balancer address", func() {
+		url, err := serviceURL(loadBalancer(443), &mcpgatewayv1alpha1.ServiceReference{Path: "/mcp"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(url).To(Equal("https://weather.elb.amazonaws.com/mcp"))
+
+		url, err = serviceURL(loadBalancer(443, 8443), &mcpgatewayv1alpha1.ServiceReference{Port: 8443})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(url).To(Equal("https://weather.elb.amazonaws.com:8443"))
+	})
+
+	It("should prefer the URL annotation", func() {
+		service := loadBalancer(443)
+		service.Annotations = map[string]string{ServiceURLAnnotation: "https://weather.example.com/"}
+		url, err := serviceURL(service, &mcpgatewayv1alpha1.ServiceReference{Path: "/mcp"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(url).To(Equal("https://weather.example.com/mcp"))
+
+		service.Annotations[ServiceURLAnnotation] = "http://weather.example.com"
+		_, err = serviceURL(service, &mcpgatewayv1alpha1.ServiceReference{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject Services the gateway cannot reach", func() {
+		pending := loadBalancer(443)
+		pending.Status.LoadBalancer.Ingress = nil
+		_, err := serviceURL(pending, &mcpgatewayv1alpha1.ServiceReference{})
+		Expect(err).To(MatchError(ContainSubstring("no address yet")))
+
+		internal := loadBalancer(443)
+		internal.Spec.Type = corev1.ServiceTypeClusterIP
+		_, err = serviceURL(internal, &mcpgatewayv1alpha1.ServiceReference{})
+		Expect(err).To(MatchError(ContainSubstring(ServiceURLAnnotation)))
+
+		_, err = serviceURL(loadBalancer(443, 8443), &mcpgatewayv1alpha1.ServiceReference{})
+		Expect(err).To(MatchError(ContainSubstring("set serviceRef.port")))
+		_, err = serviceURL(loadBalancer(443), &mcpgatewayv1alpha1.ServiceReference{Port: 8443})
+		Expect(err).To(MatchError(ContainSubstring("no port 8443")))
+	})
+
+	Context("with a Service in the cluster", func() {
+		var service *corev1.Service
+		var reconciler *MCPServerReconciler
+
+		referencing := func() *mcpgatewayv1alpha1.MCPServer {
+			return &mcpgatewayv1alpha1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "default"},
+				Spec: mcpgatewayv1alpha1.MCPServerSpec{
+					ServiceRef: &mcpgatewayv1alpha1.ServiceReference{Name: "weather-mcp", Path: "/mcp"},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			reconciler = &MCPServerReconciler{Client: k8sClient, Scheme: k8sClient.Scheme()}
+			service = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "weather-mcp",
+					Namespace:   "default",
+					Labels:      map[string]string{WatchLabel: "true"},
+					Annotations: map[string]string{ServiceURLAnnotation: "https://weather.example.com"},
+				},
+				Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}},
+			}
+			Expect(k8sClient.Create(ctx, service)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, service)).To(Succeed())
+		})
+
+		It("should set the endpoint of the in-memory MCPServer", func() {
+			mcpServer := referencing()
+			Expect(reconciler.resolveServiceRef(ctx, mcpServer)).To(Succeed())
+			Expect(mcpServer.Spec.Endpoint).To(Equal("https://weather.example.com/mcp"))
+		})
+
+		It("should report missing Services and endpoints set as well", func() {
+			mcpServer := referencing()
+			mcpServer.Spec.ServiceRef.Name = "missing"
+			var serviceErr *serviceRefError
+			Expect(errors.As(reconciler.resolveServiceRef(ctx, mcpServer), &serviceErr)).To(BeTrue())
+
+			mcpServer = referencing()
+			mcpServer.Spec.Endpoint = "https://weather.example.com/mcp"
+			Expect(errors.As(reconciler.resolveServiceRef(ctx, mcpServer), &serviceErr)).To(BeTrue())
+		})
+
+		It("should reference the Service and record the resolved endpoint", func() {
+			mcpServer := referencing()
+			mcpServer.Spec.ServiceRef.Namespace = "tools"
+			Expect(referencedObjects(mcpServer)).To(ContainElement(referenceKey("Service", "tools", "weather-mcp")))
+
+			stored := referencing()
+			applied := referencing()
+			Expect(reconciler.resolveServiceRef(ctx, applied)).To(Succeed())
+			recordResolvedEndpoint(stored, applied)
+			Expect(stored.Status.ResolvedEndpoint).To(Equal("https://weather.example.com/mcp"))
+		})
+	})
+})
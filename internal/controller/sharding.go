@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strconv"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/aws/mcp-gateway-operator/pkg/sharding"
+)
+
+// shardTracker counts the MCPServers reconciled by this replica for the shard metrics
+type shardTracker struct {
+	mu   sync.Mutex
+	keys map[types.NamespacedName]struct{}
+}
+
+// track records whether the resource is currently owned by this replica and updates the metric
+func (t *shardTracker) track(key types.NamespacedName, owned bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.keys == nil {
+		t.keys = make(map[types.NamespacedName]struct{})
+	}
+	if owned {
+		t.keys[key] = struct{}{}
+	} else {
+		delete(t.keys, key)
+	}
+	shardResources.Set(float64(len(t.keys)))
+}
+
+// ownsResource reports whether this replica reconciles the resource.
+// Every resource is owned when sharding is not configured.
+func (r *MCPServerReconciler) ownsResource(obj client.Object) bool {
+	return ownedBy(r.Sharder, obj)
+}
+
+// shardPredicate filters out events for MCPServers assigned to other shards
+func (r *MCPServerReconciler) shardPredicate() predicate.Predicate {
+	return shardPredicate(r.Sharder)
+}
+
+// ownedBy reports whether the replica of the sharder reconciles the resource.
+// Every resource is owned when sharding is not configured.
+func ownedBy(sharder *sharding.Sharder, obj client.Object) bool {
+	return sharder == nil || sharder.Owns(obj)
+}
+
+// shardPredicate filters out events for resources assigned to other shards.
+// Updates are let through when either version is owned so that a resource moving
+// away from this shard is released.
+func shardPredicate(sharder *sharding.Sharder) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return ownedBy(sharder, e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return ownedBy(sharder, e.ObjectOld) || ownedBy(sharder, e.ObjectNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return ownedBy(sharder, e.Object)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return ownedBy(sharder, e.Object)
+		},
+	}
+}
+
+// recordShardInfo publishes the shard assignment of this replica
+func (r *MCPServerReconciler) recordShardInfo() {
+	shard, shards := 0, 1
+	if r.Sharder != nil {
+		shard, shards = r.Sharder.Index(), r.Sharder.Count()
+	}
+	shardInfo.WithLabelValues(strconv.Itoa(shard), strconv.Itoa(shards)).Set(1)
+}
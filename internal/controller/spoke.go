@@ -0,0 +1,249 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+// SpokeClusterLabel marks a Secret holding the kubeconfig of a spoke cluster. Its value is the
+// cluster name, which prefixes the default target names of the spoke's MCPServers.
+const SpokeClusterLabel = "mcpgateway.bedrock.aws/spoke-cluster"
+
+// spokeKubeconfigKeys are the Secret keys a spoke kubeconfig is read from, in order of preference.
+// "value" is the key used by Cluster API kubeconfig Secrets.
+var spokeKubeconfigKeys = []string{"value", "kubeconfig"}
+
+// SpokeCluster is a cluster whose MCPServers are reconciled by the hub operator
+type SpokeCluster struct {
+	// Name identifies the cluster and must be a DNS label
+	Name string
+	// Config is the REST config of the cluster
+	Config *rest.Config
+}
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=list
+
+// LoadSpokeClusters reads the spoke clusters from the Secrets labelled with SpokeClusterLabel in
+// the given namespace. Secrets with an invalid cluster name or kubeconfig are skipped with an
+// error, so one broken spoke does not keep the hub from serving the others.
+func LoadSpokeClusters(ctx context.Context, reader client.Reader, namespace string) ([]SpokeCluster, []error) {
+	secrets := &corev1.SecretList{}
+	if err := reader.List(ctx, secrets, client.InNamespace(namespace), client.HasLabels{SpokeClusterLabel}); err != nil {
+		return nil, []error{fmt.Errorf("failed to list spoke cluster Secrets: %w", err)}
+	}
+	sort.Slice(secrets.Items, func(i, j int) bool { return secrets.Items[i].Name < secrets.Items[j].Name })
+
+	var spokes []SpokeCluster
+	var errs []error
+	seen := map[string]string{}
+	for _, secret := range secrets.Items {
+		spoke, err := spokeClusterFromSecret(&secret)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("spoke cluster Secret %s/%s: %w", secret.Namespace, secret.Name, err))
+			continue
+		}
+		if other, ok := seen[spoke.Name]; ok {
+			errs = append(errs, fmt.Errorf("spoke cluster Secret %s/%s: cluster %q is already defined by Secret %s",
+				secret.Namespace, secret.Name, spoke.Name, other))
+			continue
+		}
+		seen[spoke.Name] = secret.Name
+		spokes = append(spokes, spoke)
+	}
+	return spokes, errs
+}
+
+// spokeClusterFromSecret parses a spoke cluster Secret
+func spokeClusterFromSecret(secret *corev1.Secret) (SpokeCluster, error) {
+	name := secret.Labels[SpokeClusterLabel]
+	if msgs := validation.IsDNS1123Label(name); len(msgs) > 0 {
+		return SpokeCluster{}, fmt.Errorf("invalid cluster name %q: %s", name, strings.Join(msgs, ", "))
+	}
+
+	for _, key := range spokeKubeconfigKeys {
+		kubeconfig, ok := secret.Data[key]
+		if !ok {
+			continue
+		}
+		config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+		if err != nil {
+			return SpokeCluster{}, fmt.Errorf("invalid kubeconfig in key %q: %w", key, err)
+		}
+		return SpokeCluster{Name: name, Config: config}, nil
+	}
+	return SpokeCluster{}, fmt.Errorf("no kubeconfig found in keys %v", spokeKubeconfigKeys)
+}
+
+// SetupSpokeWithManager registers a controller that reconciles the MCPServers of a spoke cluster
+// against AWS with the hub's credentials. The spoke reconciler is a copy of r that reads and
+// writes the spoke cluster. It has its own call budget but shares the hub's fair share of AWS
+// calls. Environment profiles are selected by the namespaces of the spoke, rollout waves pace
+// the MCPServers of the spoke and events are emitted in the spoke. It neither journals
+// operations nor manages ScaledObjects or catalog ConfigMaps, which are specific to the hub cluster.
+func (r *MCPServerReconciler) SetupSpokeWithManager(mgr ctrl.Manager, spoke SpokeCluster) error {
+	spokeCluster, err := cluster.New(spoke.Config, func(o *cluster.Options) {
+		o.Scheme = mgr.GetScheme()
+		o.Cache = cache.Options{ByObject: ReferenceCacheOptions()}
+		o.NewClient = func(config *rest.Config, options client.Options) (client.Client, error) {
+			c, err := client.New(config, options)
+			if err != nil {
+				return nil, err
+			}
+			return status.NewClient(c, r.StatusMode), nil
+		}
+		if r.FeatureGates.Enabled(FeatureGatewayAPI) {
+			maps.Copy(o.Cache.ByObject, GatewayAPICacheOptions())
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create client for spoke cluster %s: %w", spoke.Name, err)
+	}
+	if err := mgr.Add(spokeCluster); err != nil {
+		return fmt.Errorf("failed to add spoke cluster %s: %w", spoke.Name, err)
+	}
+
+	if err := spokeCluster.GetFieldIndexer().IndexField(context.Background(), &mcpgatewayv1alpha1.MCPServer{},
+		referenceIndexField, indexReferences); err != nil {
+		return fmt.Errorf("failed to index MCPServer references of spoke cluster %s: %w", spoke.Name, err)
+	}
+	if err := spokeCluster.GetFieldIndexer().IndexField(context.Background(), &mcpgatewayv1alpha1.MCPServer{},
+		endpointIndexField, indexEndpoint); err != nil {
+		return fmt.Errorf("failed to index MCPServer endpoints of spoke cluster %s: %w", spoke.Name, err)
+	}
+
+	var recorder events.EventRecorder
+	if r.Recorder != nil {
+		recorder = spokeCluster.GetEventRecorder("mcpserver-controller")
+	}
+	spokeReconciler := r.newSpokeReconciler(spokeCluster.GetClient(), recorder, spoke.Name)
+
+	spokeCache := spokeCluster.GetCache()
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named("mcpserver_" + strings.ReplaceAll(spoke.Name, "-", "_")).
+		WatchesRawSource(source.Kind(spokeCache, &mcpgatewayv1alpha1.MCPServer{},
+			priorityEventHandler[*mcpgatewayv1alpha1.MCPServer]{})).
+		WatchesRawSource(source.Kind(spokeCache, &mcpgatewayv1alpha1.MCPServer{}, handler.TypedEnqueueRequestsFromMapFunc(
+			typedMapFunc[*mcpgatewayv1alpha1.MCPServer](spokeReconciler.mapEndpointToMCPServers)),
+			predicate.TypedGenerationChangedPredicate[*mcpgatewayv1alpha1.MCPServer]{})).
+		WatchesRawSource(source.Kind(spokeCache, &corev1.ConfigMap{}, handler.TypedEnqueueRequestsFromMapFunc(
+			typedMapFunc[*corev1.ConfigMap](spokeReconciler.mapReferenceToMCPServers("ConfigMap"))))).
+		WatchesRawSource(source.Kind(spokeCache, &corev1.Service{}, handler.TypedEnqueueRequestsFromMapFunc(
+			typedMapFunc[*corev1.Service](spokeReconciler.mapReferenceToMCPServers("Service"))))).
+		// Backend readiness and workload metadata are read from the workloads in the spoke
+		WatchesRawSource(source.Kind(spokeCache, &appsv1.Deployment{}, handler.TypedEnqueueRequestsFromMapFunc(
+			typedMapFunc[*appsv1.Deployment](spokeReconciler.mapReferenceToMCPServers("Deployment"))))).
+		WatchesRawSource(source.Kind(spokeCache, &appsv1.StatefulSet{}, handler.TypedEnqueueRequestsFromMapFunc(
+			typedMapFunc[*appsv1.StatefulSet](spokeReconciler.mapReferenceToMCPServers("StatefulSet")))))
+	if r.FeatureGates.Enabled(FeatureSecretReferences) {
+		b = b.WatchesRawSource(source.Kind(spokeCache, &corev1.Secret{}, handler.TypedEnqueueRequestsFromMapFunc(
+			typedMapFunc[*corev1.Secret](spokeReconciler.mapReferenceToMCPServers("Secret")))))
+	}
+	if r.FeatureGates.Enabled(FeatureGatewayAPI) {
+		b = b.WatchesRawSource(source.Kind(spokeCache, newHTTPRoute(), handler.TypedEnqueueRequestsFromMapFunc(
+			typedMapFunc[*unstructured.Unstructured](spokeReconciler.mapReferenceToMCPServers(HTTPRouteGVK.Kind))),
+			predicate.TypedGenerationChangedPredicate[*unstructured.Unstructured]{}))
+	}
+	if r.Environments != nil {
+		b = b.WatchesRawSource(source.Kind(spokeCache, &corev1.Namespace{}, handler.TypedEnqueueRequestsFromMapFunc(
+			typedMapFunc[*corev1.Namespace](spokeReconciler.mapNamespaceToMCPServers)),
+			predicate.TypedLabelChangedPredicate[*corev1.Namespace]{}))
+	}
+	return b.Complete(spokeReconciler)
+}
+
+// newSpokeReconciler returns the copy of r that reconciles the MCPServers of the named spoke
+// cluster through spokeClient. Events are recorded with recorder, in the spoke next to the
+// MCPServers they are about.
+func (r *MCPServerReconciler) newSpokeReconciler(
+	spokeClient client.Client,
+	recorder events.EventRecorder,
+	clusterName string,
+) *MCPServerReconciler {
+	statusManager := status.NewManager(spokeClient)
+	if recorder != nil {
+		statusManager = statusManager.WithEventRecorder(recorder)
+	}
+
+	spokeReconciler := &MCPServerReconciler{
+		Client:                         spokeClient,
+		Scheme:                         r.Scheme,
+		BedrockClient:                  r.BedrockClient,
+		DefaultGatewayID:               r.DefaultGatewayID,
+		ConfigParser:                   r.ConfigParser,
+		TargetConfigBuilder:            r.TargetConfigBuilder,
+		StatusManager:                  statusManager,
+		Recorder:                       recorder,
+		Environments:                   r.Environments,
+		Rollout:                        r.Rollout,
+		EndpointProber:                 r.EndpointProber,
+		CredentialsExpiryThreshold:     r.CredentialsExpiryThreshold,
+		Sharder:                        r.Sharder,
+		AuditLogger:                    r.AuditLogger,
+		GatewayCache:                   r.GatewayCache,
+		FairShare:                      r.FairShare,
+		FairSharePartition:             r.FairSharePartition,
+		FeatureGates:                   r.FeatureGates,
+		DriftCheckInterval:             r.DriftCheckInterval,
+		Backpressure:                   r.Backpressure,
+		RateLimiter:                    r.RateLimiter,
+		CircuitBreaker:                 r.CircuitBreaker,
+		DriftPolicy:                    r.DriftPolicy,
+		StatusMode:                     r.StatusMode,
+		AWSCallTimeout:                 r.AWSCallTimeout,
+		GatewayDeletedPolicy:           r.GatewayDeletedPolicy,
+		PreviewTargetNames:             r.PreviewTargetNames,
+		MaxConcurrentCreatesPerGateway: r.MaxConcurrentCreatesPerGateway,
+		ClusterID:                      r.ClusterID,
+		ClusterName:                    clusterName,
+	}
+	if r.CallBudget != nil {
+		spokeReconciler.CallBudget = bedrock.NewCallBudget(r.CallBudget.Limit(), r.CallBudget.Window())
+	}
+	return spokeReconciler
+}
+
+// typedMapFunc adapts a MapFunc to the typed handlers used by raw sources
+func typedMapFunc[T client.Object](fn handler.MapFunc) handler.TypedMapFunc[T, reconcile.Request] {
+	return func(ctx context.Context, obj T) []reconcile.Request {
+		return fn(ctx, obj)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/internal/testutil"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/environment"
+	"github.com/aws/mcp-gateway-operator/pkg/rollout"
+)
+
+const spokeKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: spoke
+  cluster:
+    server: https://spoke.example.com:6443
+contexts:
+- name: spoke
+  context:
+    cluster: spoke
+    user: hub
+current-context: spoke
+users:
+- name: hub
+  user:
+    token: hub-token
+`
+
+var _ = Describe("Hub and spoke", func() {
+	ctx := context.Background()
+
+	It("should prefix default target names of spoke MCPServers with the cluster name", func() {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{ObjectMeta: metav1.ObjectMeta{Name: "weather"}}
+
+		Expect((&MCPServerReconciler{}).targetName(mcpServer)).To(Equal("weather"))
+		Expect((&MCPServerReconciler{ClusterName: "team-a"}).targetName(mcpServer)).To(Equal("team-a-weather"))
+
+		mcpServer.Spec.TargetName = "custom"
+		Expect((&MCPServerReconciler{ClusterName: "team-a"}).targetName(mcpServer)).To(Equal("custom"))
+	})
+
+	It("should apply environment profiles, rollouts and events to spoke MCPServers", func() {
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "team-prod",
+			Labels: map[string]string{EnvironmentLabel: "prod"},
+		}}
+		mcpServer := testutil.NewMCPServer("weather", testutil.WithNamespace(namespace.Name))
+		key := client.ObjectKeyFromObject(mcpServer)
+		h := newReconcileHarness(namespace, mcpServer)
+
+		environments := environment.NewRegistry()
+		environments.Set(map[string]environment.Profile{"prod": {RequireApproval: true}})
+		h.reconciler.Environments = environments
+		h.reconciler.Rollout = rollout.NewGate(rollout.Policy{})
+		h.reconciler.Recorder = events.NewFakeRecorder(10)
+
+		recorder := events.NewFakeRecorder(10)
+		spoke := h.reconciler.newSpokeReconciler(h.client, recorder, "team-a")
+		Expect(spoke.Environments).To(BeIdenticalTo(environments))
+		Expect(spoke.Rollout).To(BeIdenticalTo(h.reconciler.Rollout))
+		Expect(spoke.Recorder).To(BeIdenticalTo(recorder))
+		Expect(spoke.ClusterName).To(Equal("team-a"))
+
+		By("holding back the target of the spoke MCPServer until it is approved")
+		_, err := spoke.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("CreateGatewayTarget")).To(BeZero())
+		Expect(meta.IsStatusConditionTrue(h.get(ctx, key).Status.Conditions, approvalPendingCondition)).To(BeTrue())
+	})
+
+	It("should reject AWSProviderConfig references of spoke MCPServers", func() {
+		mcpServer := testutil.NewMCPServer("weather", testutil.Edit(func(mcpServer *mcpgatewayv1alpha1.MCPServer) {
+			mcpServer.Spec.ProviderConfigRef = &mcpgatewayv1alpha1.ProviderConfigReference{Name: "eu"}
+		}))
+		key := client.ObjectKeyFromObject(mcpServer)
+		// An AWSProviderConfig created by a user of the spoke cluster
+		h := newReconcileHarness(mcpServer, testutil.NewAWSProviderConfig("eu", "eu-west-1"))
+		h.reconciler.ProviderClients = bedrock.NewProviderClients(aws.Config{Region: "us-east-1"})
+
+		spoke := h.reconciler.newSpokeReconciler(h.client, nil, "team-a")
+		Expect(spoke.ProviderClients).To(BeNil())
+		_, err := spoke.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		condition := meta.FindStatusCondition(h.get(ctx, key).Status.Conditions, "Ready")
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).To(Equal(reasonProviderConfigError))
+		Expect(condition.Message).To(ContainSubstring("not supported for MCPServers of spoke cluster team-a"))
+		Expect(h.agentCore.callCount("CreateGatewayTarget")).To(BeZero())
+	})
+
+	Context("When loading spoke clusters", func() {
+		secrets := []*corev1.Secret{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a-kubeconfig", Namespace: "default",
+					Labels: map[string]string{SpokeClusterLabel: "team-a"}},
+				Data: map[string][]byte{"value": []byte(spokeKubeconfig)},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-b-kubeconfig", Namespace: "default",
+					Labels: map[string]string{SpokeClusterLabel: "Team_B"}},
+				Data: map[string][]byte{"kubeconfig": []byte(spokeKubeconfig)},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-c-kubeconfig", Namespace: "default",
+					Labels: map[string]string{SpokeClusterLabel: "team-c"}},
+				Data: map[string][]byte{"config": []byte(spokeKubeconfig)},
+			},
+		}
+
+		BeforeEach(func() {
+			for _, secret := range secrets {
+				Expect(k8sClient.Create(ctx, secret.DeepCopy())).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			for _, secret := range secrets {
+				Expect(k8sClient.Delete(ctx, secret.DeepCopy())).To(Succeed())
+			}
+		})
+
+		It("should load valid spokes and report broken Secrets", func() {
+			spokes, errs := LoadSpokeClusters(ctx, k8sClient, "default")
+
+			Expect(spokes).To(HaveLen(1))
+			Expect(spokes[0].Name).To(Equal("team-a"))
+			Expect(spokes[0].Config.Host).To(Equal("https://spoke.example.com:6443"))
+			Expect(spokes[0].Config.BearerToken).To(Equal("hub-token"))
+
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Error()).To(ContainSubstring("invalid cluster name"))
+			Expect(errs[1].Error()).To(ContainSubstring("no kubeconfig found"))
+		})
+	})
+})
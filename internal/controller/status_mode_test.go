@@ -0,0 +1,68 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Conditions-only status mode", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	It("should reconcile from status details kept in the annotation", func() {
+		h := newReconcileHarness(&mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       key.Name,
+				Namespace:  key.Namespace,
+				UID:        types.UID("4b7e2d9c-1a3f-4c8e-b5d6-7e9f1a2b3c4d"),
+				Generation: 1,
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://weather.example.com/mcp",
+				Capabilities: []string{"tools"},
+			},
+		})
+		statusClient := status.NewClient(h.client, status.ModeConditionsOnly)
+		h.reconciler.Client = statusClient
+		h.reconciler.StatusManager = status.NewManager(statusClient)
+
+		for range 4 {
+			_, err := h.reconcile(ctx, key)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(h.agentCore.callCount("CreateGatewayTarget")).To(Equal(1))
+
+		stored := h.get(ctx, key)
+		Expect(stored.Status.TargetID).To(BeEmpty())
+		Expect(stored.Status.TargetStatus).To(BeEmpty())
+		Expect(stored.Annotations).To(HaveKey(status.DetailsAnnotation))
+
+		restored := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(statusClient.Get(ctx, key, restored)).To(Succeed())
+		Expect(restored.Status.TargetID).To(Equal("TARGET1"))
+		Expect(restored.Status.TargetStatus).To(Equal("READY"))
+	})
+})
@@ -0,0 +1,73 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// syncActions are the reconcile actions that synchronize the MCPServer with its gateway target
+var syncActions = map[string]bool{
+	actionCreate:     true,
+	actionUpdate:     true,
+	actionSyncStatus: true,
+}
+
+// recordSync records the outcome of a reconcile that synchronized the MCPServer with its gateway
+// target in its status and the last successful sync metric. Immediate requeues, which are only
+// returned after status conflicts, are retried before anything is recorded. Failures to record are
+// logged and never fail the reconcile.
+func (r *MCPServerReconciler) recordSync(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	action string,
+	result ctrl.Result,
+	err error,
+	log logr.Logger,
+) {
+	if mcpServer == nil || !syncActions[action] || (err == nil && result.Requeue) {
+		return
+	}
+
+	// The status was written during the reconcile, so record on the latest version
+	latest := &mcpgatewayv1alpha1.MCPServer{}
+	if getErr := r.Get(ctx, client.ObjectKeyFromObject(mcpServer), latest); getErr != nil {
+		if !apierrors.IsNotFound(getErr) {
+			log.Error(getErr, "Failed to re-fetch MCPServer before recording sync")
+		}
+		return
+	}
+
+	now := time.Now()
+	succeeded := err == nil
+	if succeeded {
+		lastSuccessfulSyncTimestamp.For(latest.Labels, prometheus.Labels{"namespace": latest.Namespace, "name": latest.Name}).
+			Set(float64(now.Unix()))
+	}
+	if recordErr := r.StatusManager.RecordSync(ctx, latest, succeeded, now); recordErr != nil && !apierrors.IsConflict(recordErr) {
+		log.Error(recordErr, "Failed to record sync")
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Sync recording", func() {
+	ctx := context.Background()
+
+	var mcpServer *mcpgatewayv1alpha1.MCPServer
+	var reconciler *MCPServerReconciler
+
+	BeforeEach(func() {
+		mcpServer = &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-sync", Namespace: "default"},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, mcpServer)).To(Succeed())
+		reconciler = &MCPServerReconciler{Client: k8sClient, StatusManager: status.NewManager(k8sClient)}
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, mcpServer)).To(Succeed())
+	})
+
+	It("should record the outcome of syncs only", func() {
+		latest := &mcpgatewayv1alpha1.MCPServer{}
+
+		By("skipping reconciles that did not call AWS")
+		reconciler.recordSync(ctx, mcpServer, actionSkip, ctrl.Result{}, nil, logf.Log)
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mcpServer), latest)).To(Succeed())
+		Expect(latest.Status.LastAttemptedSync).To(BeNil())
+
+		By("recording a failed sync")
+		reconciler.recordSync(ctx, mcpServer, actionUpdate, ctrl.Result{}, errors.New("update failed"), logf.Log)
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mcpServer), latest)).To(Succeed())
+		Expect(latest.Status.LastSyncOutcome).To(Equal(mcpgatewayv1alpha1.SyncOutcomeFailed))
+		Expect(latest.Status.LastAttemptedSync).NotTo(BeNil())
+		Expect(latest.Status.LastSuccessfulSync).To(BeNil())
+
+		By("recording a successful sync")
+		reconciler.recordSync(ctx, mcpServer, actionSyncStatus, ctrl.Result{}, nil, logf.Log)
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mcpServer), latest)).To(Succeed())
+		Expect(latest.Status.LastSyncOutcome).To(Equal(mcpgatewayv1alpha1.SyncOutcomeSucceeded))
+		Expect(latest.Status.LastSuccessfulSync).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,108 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+// targetDeletionPollInterval is how often a gateway target that AWS is still deleting is read
+// again before the finalizer of its MCPServer is removed
+const targetDeletionPollInterval = 5 * time.Second
+
+// finalizeGatewayTarget deletes the gateway target of a deleted MCPServer and reports whether it
+// is gone. AWS deletes targets asynchronously, and a delete accepted by the API can still fail, so
+// the target is read after the delete call. While it exists the status records the deletion with
+// TargetStatus DELETING and the Progressing condition, and the finalizer is kept. A target that
+// leaves DELETING without disappearing failed to delete; a warning event is emitted and the target
+// is deleted again. If the target may be deleted but not read, the accepted delete is trusted and
+// the target reported as gone, see reportDeletionUnconfirmed.
+func (r *MCPServerReconciler) finalizeGatewayTarget(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	log logr.Logger,
+) (bool, error) {
+	targetID := mcpServer.Status.TargetID
+	if targetID == "" {
+		log.Info("No target ID found, skipping deletion")
+		return true, nil
+	}
+	gatewayID, err := r.ConfigParser.GetGatewayID(mcpServer)
+	if err != nil {
+		log.Error(err, "Failed to get gateway ID")
+		return false, err
+	}
+	bedrockWrapper := r.newBedrockWrapper(log)
+
+	// Check on a deletion started by an earlier reconcile
+	if mcpServer.Status.TargetStatus == status.TargetStatusDeleting {
+		current, err := bedrockWrapper.GetGatewayTarget(ctx, gatewayID, targetID)
+		if bedrock.IsResourceNotFoundError(err) {
+			log.Info("Gateway target deleted", "targetId", targetID)
+			return true, nil
+		}
+		if bedrock.IsAccessDeniedError(err) {
+			r.reportDeletionUnconfirmed(ctx, mcpServer, err, log)
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if current.Status == types.TargetStatusDeleting {
+			log.V(1).Info("Waiting for AWS to delete gateway target", "targetId", targetID)
+			return false, nil
+		}
+		message := fmt.Sprintf("Deleting gateway target %s failed, it is %s", targetID, current.Status)
+		if len(current.StatusReasons) > 0 {
+			message += ": " + strings.Join(current.StatusReasons, "; ")
+		}
+		log.Info("Gateway target was not deleted, deleting it again", "targetId", targetID,
+			"status", current.Status, "statusReasons", current.StatusReasons)
+		r.recordEvent(mcpServer, corev1.EventTypeWarning, "TargetDeletionFailed", "DeleteGatewayTarget",
+			message+"; deleting it again")
+	}
+
+	if err := r.deleteGatewayTarget(ctx, mcpServer, log); err != nil {
+		return false, err
+	}
+
+	// Most targets are gone by the time the delete call returns, which saves a status write
+	_, err = bedrockWrapper.GetGatewayTarget(ctx, gatewayID, targetID)
+	if bedrock.IsResourceNotFoundError(err) {
+		return true, nil
+	}
+	if bedrock.IsAccessDeniedError(err) {
+		r.reportDeletionUnconfirmed(ctx, mcpServer, err, log)
+		return true, nil
+	}
+	if err != nil {
+		log.V(1).Info("Unable to confirm deletion of gateway target", "targetId", targetID, "error", err.Error())
+	}
+	return false, r.StatusManager.SetDeleting(ctx, mcpServer,
+		fmt.Sprintf("Waiting for AWS to delete gateway target %s", targetID))
+}
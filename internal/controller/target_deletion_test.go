@@ -0,0 +1,161 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Gateway target deletion", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "weather"}
+
+	// deletedHarness returns a harness whose MCPServer has a gateway target and was deleted
+	deletedHarness := func() *reconcileHarness {
+		h := newReconcileHarness(&mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       key.Name,
+				Namespace:  key.Namespace,
+				UID:        types.UID("4e7a2c9d-1b3f-4d6e-8a5c-7f2b9d0e3c61"),
+				Generation: 1,
+			},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://weather.example.com/mcp",
+				Capabilities: []string{"tools"},
+			},
+		})
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.get(ctx, key).Status.TargetID).To(Equal("TARGET1"))
+		Expect(h.client.Delete(ctx, h.get(ctx, key))).To(Succeed())
+		return h
+	}
+
+	It("should keep the finalizer until AWS deleted the target", func() {
+		h := deletedHarness()
+		h.agentCore.deleteAsync("TARGET1", 1, false)
+
+		result, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(targetDeletionPollInterval))
+		deleting := h.get(ctx, key)
+		Expect(deleting.Finalizers).To(ContainElement(gatewayTargetFinalizer))
+		Expect(deleting.Status.TargetStatus).To(Equal(status.TargetStatusDeleting))
+		condition := meta.FindStatusCondition(deleting.Status.Conditions, "Progressing")
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("Deleting"))
+
+		By("removing the finalizer once the target is gone")
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("DeleteGatewayTarget")).To(Equal(1))
+		Expect(h.agentCore.targetIDs()).To(BeEmpty())
+		Expect(apierrors.IsNotFound(h.client.Get(ctx, key, &mcpgatewayv1alpha1.MCPServer{}))).To(BeTrue())
+	})
+
+	It("should delete a target again when its deletion failed", func() {
+		h := deletedHarness()
+		recorder := events.NewFakeRecorder(10)
+		h.reconciler.Recorder = recorder
+		h.agentCore.deleteAsync("TARGET1", 0, true)
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.get(ctx, key).Status.TargetStatus).To(Equal(status.TargetStatusDeleting))
+
+		By("noticing the failed deletion and deleting the target again")
+		h.agentCore.deleteAsync("TARGET1", 0, false)
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("DeleteGatewayTarget")).To(Equal(2))
+		Expect(recorder.Events).To(Receive(ContainSubstring("TargetDeletionFailed")))
+		Expect(h.agentCore.targetIDs()).To(BeEmpty())
+		Expect(apierrors.IsNotFound(h.client.Get(ctx, key, &mcpgatewayv1alpha1.MCPServer{}))).To(BeTrue())
+	})
+
+	It("should take the target as deleted when it may be deleted but not read", func() {
+		h := deletedHarness()
+		recorder := events.NewFakeRecorder(10)
+		h.reconciler.Recorder = recorder
+		h.agentCore.deleteAsync("TARGET1", 1, false)
+		h.agentCore.deny("GetGatewayTarget", true)
+		// A second finalizer keeps the MCPServer around to check its status
+		deleting := h.get(ctx, key)
+		deleting.Finalizers = append(deleting.Finalizers, "example.com/keep")
+		Expect(h.client.Update(ctx, deleting)).To(Succeed())
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.callCount("DeleteGatewayTarget")).To(Equal(1))
+		Expect(recorder.Events).To(Receive(ContainSubstring("TargetDeletionUnconfirmed")))
+		deleted := h.get(ctx, key)
+		Expect(deleted.Finalizers).To(ConsistOf("example.com/keep"))
+		condition := meta.FindStatusCondition(deleted.Status.Conditions, partialPermissionsCondition)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(reasonReadAccessDenied))
+		Expect(condition.Message).To(ContainSubstring("TARGET1"))
+	})
+
+	It("should report the missing permission when the delete is denied", func() {
+		h := deletedHarness()
+		h.agentCore.deny("DeleteGatewayTarget", true)
+
+		result, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(permissionsRecheckInterval))
+		deleting := h.get(ctx, key)
+		Expect(deleting.Finalizers).To(ContainElement(gatewayTargetFinalizer))
+		condition := meta.FindStatusCondition(deleting.Status.Conditions, accessDeniedOnDeleteCondition)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring("bedrock-agentcore:DeleteGatewayTarget"))
+		Expect(condition.Message).To(ContainSubstring(DeletionFallbackAnnotation))
+
+		By("deleting the target once the permission is granted")
+		h.agentCore.deny("DeleteGatewayTarget", false)
+		_, err = h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.targetIDs()).To(BeEmpty())
+		Expect(apierrors.IsNotFound(h.client.Get(ctx, key, &mcpgatewayv1alpha1.MCPServer{}))).To(BeTrue())
+	})
+
+	It("should orphan the target when the delete is denied and the fallback annotation is set", func() {
+		h := deletedHarness()
+		h.agentCore.deny("DeleteGatewayTarget", true)
+		deleting := h.get(ctx, key)
+		deleting.Annotations = map[string]string{DeletionFallbackAnnotation: DeletionFallbackOrphan}
+		Expect(h.client.Update(ctx, deleting)).To(Succeed())
+
+		_, err := h.reconcile(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.agentCore.targetIDs()).To(ConsistOf("TARGET1"))
+		Expect(apierrors.IsNotFound(h.client.Get(ctx, key, &mcpgatewayv1alpha1.MCPServer{}))).To(BeTrue())
+	})
+})
@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/internal/testutil"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Gateway target lifecycle", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: testutil.DefaultNamespace, Name: "weather"}
+
+	var (
+		aws        *testutil.FakeAWS
+		k8s        client.Client
+		reconciler *MCPServerReconciler
+	)
+
+	BeforeEach(func() {
+		aws = testutil.NewFakeAWS()
+		k8s = testutil.NewFakeClientBuilder(testutil.NewMCPServer(key.Name)).
+			WithIndex(&mcpgatewayv1alpha1.MCPServer{}, endpointIndexField, indexEndpoint).
+			WithIndex(&mcpgatewayv1alpha1.MCPServer{}, referenceIndexField, indexReferences).
+			Build()
+		reconciler = &MCPServerReconciler{
+			Client:              k8s,
+			Scheme:              k8s.Scheme(),
+			BedrockClient:       aws,
+			ConfigParser:        config.NewConfigParser(testutil.DefaultGatewayID),
+			TargetConfigBuilder: bedrock.NewTargetConfigBuilder(),
+			StatusManager:       status.NewManager(k8s),
+		}
+	})
+
+	reconcileMCPServer := func() *mcpgatewayv1alpha1.MCPServer {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		if err := k8s.Get(ctx, key, mcpServer); apierrors.IsNotFound(err) {
+			return nil
+		}
+		return mcpServer
+	}
+
+	It("should follow the target from CREATING to READY and wait for its deletion", func() {
+		By("creating the target")
+		mcpServer := reconcileMCPServer()
+		Expect(mcpServer.Status.TargetID).NotTo(BeEmpty())
+		Expect(aws.TargetIDs()).To(ConsistOf(mcpServer.Status.TargetID))
+		Expect(reconcileMCPServer().Status.TargetStatus).To(Equal("CREATING"))
+
+		By("observing the target once AWS created it")
+		aws.Settle()
+		Expect(reconcileMCPServer().Status.TargetStatus).To(Equal("READY"))
+
+		By("keeping the finalizer while AWS deletes the target")
+		Expect(k8s.Delete(ctx, reconcileMCPServer())).To(Succeed())
+		mcpServer = reconcileMCPServer()
+		Expect(mcpServer).NotTo(BeNil())
+		Expect(mcpServer.Status.TargetStatus).To(Equal(status.TargetStatusDeleting))
+
+		By("releasing the MCPServer once the target is gone")
+		aws.Settle()
+		Expect(reconcileMCPServer()).To(BeNil())
+		Expect(aws.TargetIDs()).To(BeEmpty())
+		Expect(aws.Calls("DeleteGatewayTarget")).To(Equal(1))
+	})
+})
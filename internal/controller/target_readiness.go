@@ -0,0 +1,212 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+const (
+	// RequiredTargetsAnnotation lists, comma-separated, the MCPServers in the Pod's namespace
+	// whose gateway targets must be READY before the Pod is
+	RequiredTargetsAnnotation = "mcpgateway.bedrock.aws/required-targets"
+
+	// TargetsReadyCondition is the Pod condition set by the targetreadiness controller. Pods
+	// declare it in spec.readinessGates to stay out of Service endpoints until their tools exist.
+	TargetsReadyCondition corev1.PodConditionType = "mcpgateway.bedrock.aws/targets-ready"
+
+	// requiredTargetIndexField indexes Pods by the MCPServers they require
+	requiredTargetIndexField = ".metadata.annotations.requiredTargets"
+)
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods/status,verbs=patch
+
+// TargetReadinessReconciler sets the TargetsReadyCondition of Pods annotated with
+// RequiredTargetsAnnotation once the gateway targets of all the MCPServers they list are READY.
+// The condition is never reset to False once set. Pods must carry the WatchLabel to be visible through the operator's cache.
+type TargetReadinessReconciler struct {
+	client.Client
+}
+
+// Reconcile updates the TargetsReadyCondition of a Pod from the status of its required MCPServers
+func (r *TargetReadinessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	pod := &corev1.Pod{}
+	if err := r.Get(ctx, req.NamespacedName, pod); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	names := requiredTargets(pod)
+	if len(names) == 0 || pod.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+	// The gate only holds back Pods that have not been ready yet; targets updated or recreated
+	// later must not take running agents out of service
+	if podConditionTrue(pod, TargetsReadyCondition) {
+		return ctrl.Result{}, nil
+	}
+
+	status, reason, message, err := r.targetsReady(ctx, pod.Namespace, names)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == TargetsReadyCondition &&
+			condition.Status == status && condition.Reason == reason && condition.Message == message {
+			return ctrl.Result{}, nil
+		}
+	}
+
+	base := pod.DeepCopy()
+	setPodCondition(pod, corev1.PodCondition{
+		Type:               TargetsReadyCondition,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+	if err := r.Status().Patch(ctx, pod, client.StrategicMergeFrom(base)); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	log.Info("Updated required targets condition", "status", status, "message", message)
+	return ctrl.Result{}, nil
+}
+
+// targetsReady reports whether the gateway targets of the named MCPServers are READY and, if
+// not, which MCPServer the Pod is still waiting for
+func (r *TargetReadinessReconciler) targetsReady(
+	ctx context.Context,
+	namespace string,
+	names []string,
+) (corev1.ConditionStatus, string, string, error) {
+	for _, name := range names {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, mcpServer); err != nil {
+			if apierrors.IsNotFound(err) {
+				return corev1.ConditionFalse, "MCPServerNotFound", fmt.Sprintf("MCPServer %s not found", name), nil
+			}
+			return "", "", "", err
+		}
+		if mcpServer.Status.TargetStatus != "READY" || mcpServer.Status.ObservedGeneration != mcpServer.Generation {
+			return corev1.ConditionFalse, "TargetNotReady",
+				fmt.Sprintf("Gateway target of MCPServer %s is not ready", name), nil
+		}
+	}
+	return corev1.ConditionTrue, "TargetsReady", "Gateway targets of all required MCPServers are ready", nil
+}
+
+// requiredTargets returns the names of the MCPServers listed in the RequiredTargetsAnnotation
+func requiredTargets(pod *corev1.Pod) []string {
+	var names []string
+	for _, name := range strings.Split(pod.Annotations[RequiredTargetsAnnotation], ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// podConditionTrue reports whether the Pod has the condition with status True
+func podConditionTrue(pod *corev1.Pod, conditionType corev1.PodConditionType) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// setPodCondition adds the condition to the Pod or replaces the condition of the same type
+func setPodCondition(pod *corev1.Pod, condition corev1.PodCondition) {
+	for i := range pod.Status.Conditions {
+		if pod.Status.Conditions[i].Type == condition.Type {
+			if pod.Status.Conditions[i].Status == condition.Status {
+				condition.LastTransitionTime = pod.Status.Conditions[i].LastTransitionTime
+			}
+			pod.Status.Conditions[i] = condition
+			return
+		}
+	}
+	pod.Status.Conditions = append(pod.Status.Conditions, condition)
+}
+
+// indexRequiredTargets indexes Pods by the MCPServers listed in their RequiredTargetsAnnotation
+func indexRequiredTargets(obj client.Object) []string {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil
+	}
+	return requiredTargets(pod)
+}
+
+// mapMCPServerToPods enqueues the Pods that require the MCPServer
+func (r *TargetReadinessReconciler) mapMCPServerToPods(ctx context.Context, obj client.Object) []reconcile.Request {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{requiredTargetIndexField: obj.GetName()}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list Pods requiring MCPServer", "mcpServer", obj.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name},
+		})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *TargetReadinessReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &corev1.Pod{},
+		requiredTargetIndexField, indexRequiredTargets); err != nil {
+		return err
+	}
+
+	requiresTargets := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := obj.GetAnnotations()[RequiredTargetsAnnotation]
+		return ok
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Pod{}, builder.WithPredicates(requiresTargets)).
+		Watches(
+			&mcpgatewayv1alpha1.MCPServer{},
+			handler.EnqueueRequestsFromMapFunc(r.mapMCPServerToPods),
+		).
+		Named(TargetReadinessControllerName).
+		Complete(r)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Target readiness gates", func() {
+	ctx := context.Background()
+	podName := types.NamespacedName{Name: "agent", Namespace: "default"}
+
+	var reconciler *TargetReadinessReconciler
+	var mcpServer *mcpgatewayv1alpha1.MCPServer
+
+	targetsReady := func() *corev1.PodCondition {
+		pod := &corev1.Pod{}
+		Expect(k8sClient.Get(ctx, podName, pod)).To(Succeed())
+		for i := range pod.Status.Conditions {
+			if pod.Status.Conditions[i].Type == TargetsReadyCondition {
+				return &pod.Status.Conditions[i]
+			}
+		}
+		return nil
+	}
+
+	reconcilePod := func() {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: podName})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	setTargetStatus := func(targetStatus string) {
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mcpServer), mcpServer)).To(Succeed())
+		mcpServer.Status.TargetStatus = targetStatus
+		mcpServer.Status.ObservedGeneration = mcpServer.Generation
+		Expect(k8sClient.Status().Update(ctx, mcpServer)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		reconciler = &TargetReadinessReconciler{Client: k8sClient}
+		mcpServer = &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "default"},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, mcpServer)).To(Succeed())
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        podName.Name,
+				Namespace:   podName.Namespace,
+				Labels:      map[string]string{WatchLabel: "true"},
+				Annotations: map[string]string{RequiredTargetsAnnotation: "weather, calendar"},
+			},
+			Spec: corev1.PodSpec{
+				Containers:     []corev1.Container{{Name: "agent", Image: "agent:latest"}},
+				ReadinessGates: []corev1.PodReadinessGate{{ConditionType: TargetsReadyCondition}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: podName.Name, Namespace: podName.Namespace},
+		})).To(Succeed())
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "calendar", Namespace: "default"},
+		}))).To(Succeed())
+		Expect(k8sClient.Delete(ctx, mcpServer)).To(Succeed())
+	})
+
+	It("should parse the required targets annotation", func() {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{RequiredTargetsAnnotation: " weather,,calendar "},
+		}}
+		Expect(requiredTargets(pod)).To(Equal([]string{"weather", "calendar"}))
+		Expect(requiredTargets(&corev1.Pod{})).To(BeEmpty())
+	})
+
+	It("should hold the gate until every required target is ready", func() {
+		By("waiting for the target of the first MCPServer")
+		reconcilePod()
+		condition := targetsReady()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("TargetNotReady"))
+		Expect(condition.Message).To(ContainSubstring("weather"))
+
+		By("waiting for a missing MCPServer")
+		setTargetStatus("READY")
+		reconcilePod()
+		condition = targetsReady()
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("MCPServerNotFound"))
+		Expect(condition.Message).To(ContainSubstring("calendar"))
+
+		By("opening the gate once all targets are ready")
+		calendar := &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "calendar", Namespace: "default"},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://calendar.example.com",
+				Capabilities: []string{"tools"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, calendar)).To(Succeed())
+		calendar.Status.TargetStatus = "READY"
+		calendar.Status.ObservedGeneration = calendar.Generation
+		Expect(k8sClient.Status().Update(ctx, calendar)).To(Succeed())
+		reconcilePod()
+		Expect(targetsReady().Status).To(Equal(corev1.ConditionTrue))
+
+		By("keeping the gate open while a target is updated")
+		setTargetStatus("UPDATING")
+		reconcilePod()
+		Expect(targetsReady().Status).To(Equal(corev1.ConditionTrue))
+	})
+})
@@ -0,0 +1,62 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// unknownStatusRecheckInterval is how often a target with an unknown status is read again.
+// It is longer than the polling of targets in transition, as an unknown status may never settle.
+const unknownStatusRecheckInterval = 5 * time.Minute
+
+// reportedUnknownStatuses are the unknown target statuses already logged by this process
+var reportedUnknownStatuses sync.Map
+
+// handleUnknownTargetStatus reports a target status the operator does not recognize with the
+// UnknownStatus reason of the Ready condition and reads the target again after
+// unknownStatusRecheckInterval. Each unknown status is logged once per process.
+func (r *MCPServerReconciler) handleUnknownTargetStatus(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	targetStatus string,
+	log logr.Logger,
+) (ctrl.Result, error) {
+	if _, reported := reportedUnknownStatuses.LoadOrStore(targetStatus, true); !reported {
+		log.Info("Gateway target reports a status this operator version does not recognize, consider upgrading the operator",
+			"status", targetStatus)
+	}
+
+	message := fmt.Sprintf("Gateway target reports status %q, which this operator version does not recognize", targetStatus)
+	if err := r.StatusManager.SetError(ctx, mcpServer, "UnknownStatus", message); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		log.Error(err, "Failed to update status with unknown target status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: unknownStatusRecheckInterval}, nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/status"
+)
+
+var _ = Describe("Unknown target status", func() {
+	ctx := context.Background()
+
+	var mcpServer *mcpgatewayv1alpha1.MCPServer
+
+	BeforeEach(func() {
+		mcpServer = &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-unknown-status", Namespace: "default"},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, mcpServer)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, mcpServer)).To(Succeed())
+	})
+
+	It("should surface the status and requeue conservatively", func() {
+		reconciler := &MCPServerReconciler{Client: k8sClient, StatusManager: status.NewManager(k8sClient)}
+
+		result, err := reconciler.handleUnknownTargetStatus(ctx, mcpServer, "ARCHIVED", logf.Log)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(unknownStatusRecheckInterval))
+
+		latest := &mcpgatewayv1alpha1.MCPServer{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(mcpServer), latest)).To(Succeed())
+		ready := meta.FindStatusCondition(latest.Status.Conditions, "Ready")
+		Expect(ready).NotTo(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal("UnknownStatus"))
+		Expect(ready.Message).To(ContainSubstring(`"ARCHIVED"`))
+	})
+})
@@ -0,0 +1,64 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// tracerName is the instrumentation scope of the reconcile spans
+const tracerName = "github.com/aws/mcp-gateway-operator/internal/controller"
+
+// Attributes of the reconcile spans
+const (
+	spanAttributeName         = "mcpgateway.name"
+	spanAttributeAction       = "mcpgateway.action"
+	spanAttributeRequeueAfter = "mcpgateway.requeue_after"
+)
+
+// startReconcileSpan starts the span of a reconcile of the resource of the given kind. The AWS
+// calls made with the returned context record their spans as its children, so a slow reconcile
+// can be traced to the calls that held it up.
+func startReconcileSpan(ctx context.Context, kind string, req ctrl.Request) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, kind+".Reconcile", trace.WithAttributes(
+		semconv.K8SNamespaceName(req.Namespace),
+		attribute.String(spanAttributeName, req.Name),
+	))
+}
+
+// endReconcileSpan records the action taken, the requeue and the error of a reconcile on its
+// span, and ends it
+func endReconcileSpan(span trace.Span, action string, result ctrl.Result, err error) {
+	if action != "" {
+		span.SetAttributes(attribute.String(spanAttributeAction, action))
+	}
+	if result.RequeueAfter > 0 {
+		span.SetAttributes(attribute.String(spanAttributeRequeueAfter, result.RequeueAfter.String()))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
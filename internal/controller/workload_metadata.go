@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+const (
+	// DescriptionAnnotation on the workload referenced by spec.endpointRef sets the gateway target
+	// description of MCPServers that do not set spec.description
+	DescriptionAnnotation = "mcpgateway.bedrock.aws/description"
+	// TargetNameAnnotation on the workload referenced by spec.endpointRef sets the gateway target
+	// name, which prefixes the tool names, of MCPServers that do not set spec.targetName
+	TargetNameAnnotation = "mcpgateway.bedrock.aws/target-name"
+)
+
+// resolveWorkloadMetadata returns the gateway target metadata the MCPServer takes from the
+// annotations of its workload: the annotated fields that the spec does not set. It returns nil if
+// the MCPServer takes nothing from its workload, e.g. because the workload does not carry the
+// WatchLabel.
+func (r *MCPServerReconciler) resolveWorkloadMetadata(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+) (*mcpgatewayv1alpha1.WorkloadMetadata, error) {
+	if mcpServer.Spec.EndpointRef == nil {
+		return nil, nil
+	}
+	workload, err := r.getWorkload(ctx, mcpServer)
+	if err != nil || workload == nil {
+		return nil, err
+	}
+
+	annotations := workload.GetAnnotations()
+	metadata := &mcpgatewayv1alpha1.WorkloadMetadata{}
+	if mcpServer.Spec.Description == "" {
+		metadata.Description = annotations[DescriptionAnnotation]
+	}
+	if mcpServer.Spec.TargetName == "" {
+		metadata.TargetName = annotations[TargetNameAnnotation]
+	}
+	if *metadata == (mcpgatewayv1alpha1.WorkloadMetadata{}) {
+		return nil, nil
+	}
+	return metadata, nil
+}
+
+// applyWorkloadMetadata defaults the description and target name of the in-memory MCPServer from
+// the workload metadata, so that the gateway target is built with them. The MCPServer spec is only
+// ever written with merge patches against a copy of this object, which never include these fields.
+func applyWorkloadMetadata(mcpServer *mcpgatewayv1alpha1.MCPServer, metadata *mcpgatewayv1alpha1.WorkloadMetadata) {
+	if metadata == nil {
+		return
+	}
+	if metadata.Description != "" {
+		mcpServer.Spec.Description = metadata.Description
+	}
+	if metadata.TargetName != "" {
+		mcpServer.Spec.TargetName = metadata.TargetName
+	}
+}
+
+// workloadMetadataChanged reports whether the workload metadata differs from the metadata last
+// applied to the gateway target
+func workloadMetadataChanged(
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	metadata *mcpgatewayv1alpha1.WorkloadMetadata,
+	log logr.Logger,
+) bool {
+	if equality.Semantic.DeepEqual(metadata, mcpServer.Status.WorkloadMetadata) {
+		return false
+	}
+	log.Info("Workload metadata change detected", "workloadMetadata", metadata,
+		"appliedWorkloadMetadata", mcpServer.Status.WorkloadMetadata)
+	return true
+}
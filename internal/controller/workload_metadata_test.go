@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Workload metadata", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "test-workload-metadata", Namespace: "default"}
+
+	BeforeEach(func() {
+		labels := map[string]string{"app": key.Name}
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Annotations: map[string]string{
+					DescriptionAnnotation: "Weather forecasts",
+					TargetNameAnnotation:  "weather",
+				},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "server", Image: "mcp-server"}},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		deployment := &appsv1.Deployment{}
+		Expect(k8sClient.Get(ctx, key, deployment)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, deployment)).To(Succeed())
+	})
+
+	newMCPServer := func() *mcpgatewayv1alpha1.MCPServer {
+		return &mcpgatewayv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Endpoint:     "https://mcp.example.com",
+				Capabilities: []string{"tools"},
+				EndpointRef:  &mcpgatewayv1alpha1.WorkloadReference{Name: key.Name},
+			},
+		}
+	}
+
+	It("should take the description and target name from the workload annotations", func() {
+		reconciler := &MCPServerReconciler{Client: k8sClient}
+		mcpServer := newMCPServer()
+
+		metadata, err := reconciler.resolveWorkloadMetadata(ctx, mcpServer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata).To(Equal(&mcpgatewayv1alpha1.WorkloadMetadata{
+			Description: "Weather forecasts",
+			TargetName:  "weather",
+		}))
+
+		applyWorkloadMetadata(mcpServer, metadata)
+		Expect(mcpServer.Spec.Description).To(Equal("Weather forecasts"))
+		Expect(reconciler.targetName(mcpServer)).To(Equal("weather"))
+	})
+
+	It("should prefer the spec over the workload annotations", func() {
+		reconciler := &MCPServerReconciler{Client: k8sClient}
+		mcpServer := newMCPServer()
+		mcpServer.Spec.Description = "Spec description"
+		mcpServer.Spec.TargetName = "spec-name"
+
+		metadata, err := reconciler.resolveWorkloadMetadata(ctx, mcpServer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata).To(BeNil())
+	})
+
+	It("should detect changes of the workload metadata", func() {
+		mcpServer := newMCPServer()
+		mcpServer.Status.WorkloadMetadata = &mcpgatewayv1alpha1.WorkloadMetadata{TargetName: "weather"}
+
+		Expect(workloadMetadataChanged(mcpServer,
+			&mcpgatewayv1alpha1.WorkloadMetadata{TargetName: "weather"}, logf.Log)).To(BeFalse())
+		Expect(workloadMetadataChanged(mcpServer,
+			&mcpgatewayv1alpha1.WorkloadMetadata{TargetName: "forecast"}, logf.Log)).To(BeTrue())
+		Expect(workloadMetadataChanged(mcpServer, nil, logf.Log)).To(BeTrue())
+	})
+})
@@ -0,0 +1,68 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutil
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/mcp-gateway-operator/pkg/simulator"
+)
+
+// DefaultGatewayID is the gateway that exists in a FakeAWS from the start
+const DefaultGatewayID = "gw-1"
+
+// FakeAWS is an AgentCore control plane served from memory, see simulator.Simulator. Its clock
+// only moves with Advance, so that tests decide when targets leave CREATING, UPDATING and
+// DELETING.
+type FakeAWS struct {
+	*simulator.Simulator
+
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeAWS returns a FakeAWS with DefaultGatewayID and the given gateways
+func NewFakeAWS(gateways ...string) *FakeAWS {
+	f := &FakeAWS{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	f.Simulator = simulator.New(simulator.Options{
+		Gateways:        append([]string{DefaultGatewayID}, gateways...),
+		TransitionDelay: simulator.DefaultTransitionDelay,
+		Clock:           f.Now,
+	})
+	return f
+}
+
+// Now returns the time of the fake clock
+func (f *FakeAWS) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Advance moves the fake clock forward by d
+func (f *FakeAWS) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// Settle moves the fake clock past the transitions that are in progress, so that targets and
+// gateways are READY or gone
+func (f *FakeAWS) Settle() {
+	f.Advance(simulator.DefaultTransitionDelay)
+}
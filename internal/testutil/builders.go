@@ -0,0 +1,179 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutil
+
+import (
+	"maps"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// DefaultNamespace is the namespace of the objects built by this package unless WithNamespace
+// says otherwise
+const DefaultNamespace = "default"
+
+// Option changes an object built by this package. Options are applied in order, after the
+// defaults of the builder.
+type Option func(client.Object)
+
+// WithNamespace sets the namespace of the object
+func WithNamespace(namespace string) Option {
+	return func(obj client.Object) {
+		obj.SetNamespace(namespace)
+	}
+}
+
+// WithLabels adds labels to the object
+func WithLabels(labels map[string]string) Option {
+	return func(obj client.Object) {
+		obj.SetLabels(merge(obj.GetLabels(), labels))
+	}
+}
+
+// WithAnnotations adds annotations to the object
+func WithAnnotations(annotations map[string]string) Option {
+	return func(obj client.Object) {
+		obj.SetAnnotations(merge(obj.GetAnnotations(), annotations))
+	}
+}
+
+// WithFinalizers adds finalizers to the object
+func WithFinalizers(finalizers ...string) Option {
+	return func(obj client.Object) {
+		obj.SetFinalizers(append(obj.GetFinalizers(), finalizers...))
+	}
+}
+
+// WithGeneration sets the generation of the object
+func WithGeneration(generation int64) Option {
+	return func(obj client.Object) {
+		obj.SetGeneration(generation)
+	}
+}
+
+// Edit changes an object of type T with edit, e.g. to set spec fields no other option covers.
+// Objects of other types are left alone.
+func Edit[T client.Object](edit func(T)) Option {
+	return func(obj client.Object) {
+		if typed, ok := obj.(T); ok {
+			edit(typed)
+		}
+	}
+}
+
+// WithEndpoint sets the endpoint of an MCPServer
+func WithEndpoint(endpoint string) Option {
+	return Edit(func(mcpServer *mcpgatewayv1alpha1.MCPServer) {
+		mcpServer.Spec.Endpoint = endpoint
+	})
+}
+
+// WithTargetStatus records a gateway target with the status in an MCPServer that observed its
+// current generation
+func WithTargetStatus(targetID, targetStatus string) Option {
+	return Edit(func(mcpServer *mcpgatewayv1alpha1.MCPServer) {
+		mcpServer.Status.TargetID = targetID
+		mcpServer.Status.TargetStatus = targetStatus
+		mcpServer.Status.ObservedGeneration = mcpServer.Generation
+	})
+}
+
+// NewMCPServer returns an MCPServer with the tools capability and the endpoint
+// https://<name>.example.com/mcp, at generation 1
+func NewMCPServer(name string, opts ...Option) *mcpgatewayv1alpha1.MCPServer {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: newObjectMeta(name),
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://" + name + ".example.com/mcp",
+			Capabilities: []string{"tools"},
+		},
+	}
+	apply(mcpServer, opts)
+	return mcpServer
+}
+
+// NewMCPServerGroup returns an MCPServerGroup selecting the MCPServers with the labels, at
+// generation 1
+func NewMCPServerGroup(name string, selector map[string]string, opts ...Option) *mcpgatewayv1alpha1.MCPServerGroup {
+	group := &mcpgatewayv1alpha1.MCPServerGroup{
+		ObjectMeta: newObjectMeta(name),
+		Spec: mcpgatewayv1alpha1.MCPServerGroupSpec{
+			Selector: metav1.LabelSelector{MatchLabels: selector},
+		},
+	}
+	apply(group, opts)
+	return group
+}
+
+// NewAgentCoreStack returns an AgentCoreStack with a gateway named <name>-gateway and nothing
+// else, at generation 1
+func NewAgentCoreStack(name string, opts ...Option) *mcpgatewayv1alpha1.AgentCoreStack {
+	stack := &mcpgatewayv1alpha1.AgentCoreStack{
+		ObjectMeta: newObjectMeta(name),
+		Spec: mcpgatewayv1alpha1.AgentCoreStackSpec{
+			Gateway: mcpgatewayv1alpha1.StackGatewaySpec{
+				Name:    name + "-gateway",
+				RoleArn: "arn:aws:iam::123456789012:role/agentcore-gateway-role",
+			},
+		},
+	}
+	apply(stack, opts)
+	return stack
+}
+
+// NewAWSProviderConfig returns an AWSProviderConfig for the region, at generation 1. Like every
+// AWSProviderConfig it is cluster-scoped.
+func NewAWSProviderConfig(name, region string, opts ...Option) *mcpgatewayv1alpha1.AWSProviderConfig {
+	meta := newObjectMeta(name)
+	meta.Namespace = ""
+	providerConfig := &mcpgatewayv1alpha1.AWSProviderConfig{
+		ObjectMeta: meta,
+		Spec:       mcpgatewayv1alpha1.AWSProviderConfigSpec{Region: region},
+	}
+	apply(providerConfig, opts)
+	return providerConfig
+}
+
+// newObjectMeta returns the metadata of a new object in DefaultNamespace. The UID is derived from
+// the name, so that objects built the same way have the same UID in every run.
+func newObjectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:       name,
+		Namespace:  DefaultNamespace,
+		Generation: 1,
+		UID:        types.UID(uuid.NewSHA1(uuid.NameSpaceOID, []byte(name)).String()),
+	}
+}
+
+func apply(obj client.Object, opts []Option) {
+	for _, opt := range opts {
+		opt(obj)
+	}
+}
+
+func merge(into, from map[string]string) map[string]string {
+	if into == nil {
+		into = make(map[string]string, len(from))
+	}
+	maps.Copy(into, from)
+	return into
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutil
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// NewScheme returns a scheme with the built-in Kubernetes types and the types of the operator
+func NewScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(mcpgatewayv1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+// NewFakeClientBuilder returns a builder of fake clients holding the objects, with the status
+// subresource of every custom resource enabled like in a real API server. Tests add the field
+// indexes of the controller under test before building the client.
+func NewFakeClientBuilder(objects ...client.Object) *fake.ClientBuilder {
+	return fake.NewClientBuilder().
+		WithScheme(NewScheme()).
+		WithObjects(objects...).
+		WithStatusSubresource(
+			&mcpgatewayv1alpha1.MCPServer{},
+			&mcpgatewayv1alpha1.MCPServerGroup{},
+			&mcpgatewayv1alpha1.AgentCoreStack{},
+		)
+}
+
+// NewFakeClient returns a fake client holding the objects, see NewFakeClientBuilder
+func NewFakeClient(objects ...client.Object) client.Client {
+	return NewFakeClientBuilder(objects...).Build()
+}
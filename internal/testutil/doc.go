@@ -0,0 +1,5 @@
+// Package testutil holds the fixtures shared by the behavior tests of the controllers: builders
+// for the custom resources, a fake Kubernetes client, a fake AgentCore control plane, and an
+// envtest API server with a manager running the controllers under test. It is only imported by
+// tests.
+package testutil
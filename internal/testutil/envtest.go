@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutil
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+// Environment is an envtest API server with the CRDs of the operator installed
+type Environment struct {
+	// Config connects to the API server
+	Config *rest.Config
+	// Client reads and writes objects directly, without a cache
+	Client client.Client
+	// Scheme holds the types known to Client and to the managers started by StartManager
+	Scheme *k8sruntime.Scheme
+}
+
+// StartEnvironment starts an API server with the CRDs from config/crd/bases and registers its
+// shutdown with DeferCleanup. It is called from BeforeSuite. The API server binaries are taken
+// from KUBEBUILDER_ASSETS, or else from bin/k8s as installed by 'make setup-envtest'.
+func StartEnvironment(scheme *k8sruntime.Scheme) *Environment {
+	By("bootstrapping test environment")
+	root := moduleRoot()
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join(root, "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+		BinaryAssetsDirectory: firstBinaryDir(filepath.Join(root, "bin", "k8s")),
+	}
+
+	cfg, err := testEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cfg).NotTo(BeNil())
+	DeferCleanup(func() {
+		By("tearing down the test environment")
+		Eventually(testEnv.Stop, time.Minute, time.Second).Should(Succeed())
+	})
+
+	k8sClient, err := client.New(cfg, client.Options{Scheme: scheme})
+	Expect(err).NotTo(HaveOccurred())
+	return &Environment{Config: cfg, Client: k8sClient, Scheme: scheme}
+}
+
+// StartManager starts a manager against the API server with the controllers registered by
+// setup, e.g. a reconciler's SetupWithManager, and stops it when the current spec or container
+// ends. Metrics and health probes are not served, and controller names may repeat, so that every
+// spec can start its own manager.
+func (e *Environment) StartManager(setup func(ctrl.Manager) error) ctrl.Manager {
+	mgr, err := ctrl.NewManager(e.Config, ctrl.Options{
+		Scheme:                 e.Scheme,
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+		Controller:             config.Controller{SkipNameValidation: ptr.To(true)},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(setup(mgr)).To(Succeed())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer GinkgoRecover()
+		defer close(done)
+		Expect(mgr.Start(ctx)).To(Succeed())
+	}()
+	DeferCleanup(func() {
+		cancel()
+		Eventually(done, time.Minute).Should(BeClosed())
+	})
+	Expect(mgr.GetCache().WaitForCacheSync(ctx)).To(BeTrue())
+	return mgr
+}
+
+// moduleRoot returns the root directory of the module, so that tests find config/ and bin/
+// wherever their package is
+func moduleRoot() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..")
+}
+
+// firstBinaryDir returns the first directory below dir, which holds the envtest binaries of one
+// Kubernetes version, or an empty string if there is none
+func firstBinaryDir(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			return filepath.Join(dir, entry.Name())
+		}
+	}
+	return ""
+}
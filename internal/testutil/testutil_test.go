@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestNewMCPServer(t *testing.T) {
+	mcpServer := NewMCPServer("weather",
+		WithNamespace("tools"),
+		WithLabels(map[string]string{"app": "travel"}),
+		WithAnnotations(map[string]string{"note": "test"}),
+		WithFinalizers("example.com/finalizer"),
+		WithGeneration(3),
+		WithEndpoint("https://forecast.example.com/mcp"),
+		WithTargetStatus("TARGET1", "READY"),
+		Edit(func(mcpServer *mcpgatewayv1alpha1.MCPServer) {
+			mcpServer.Spec.Description = "Weather tools"
+		}),
+		Edit(func(*mcpgatewayv1alpha1.MCPServerGroup) {
+			t.Fatal("edits of other types are not applied")
+		}),
+	)
+
+	assert.Equal(t, "tools", mcpServer.Namespace)
+	assert.Equal(t, map[string]string{"app": "travel"}, mcpServer.Labels)
+	assert.Equal(t, map[string]string{"note": "test"}, mcpServer.Annotations)
+	assert.Equal(t, []string{"example.com/finalizer"}, mcpServer.Finalizers)
+	assert.Equal(t, "https://forecast.example.com/mcp", mcpServer.Spec.Endpoint)
+	assert.Equal(t, []string{"tools"}, mcpServer.Spec.Capabilities)
+	assert.Equal(t, "Weather tools", mcpServer.Spec.Description)
+	assert.Equal(t, "READY", mcpServer.Status.TargetStatus)
+	assert.Equal(t, int64(3), mcpServer.Status.ObservedGeneration)
+	assert.Equal(t, NewMCPServer("weather").UID, mcpServer.UID, "UIDs are derived from the name")
+	assert.NotEqual(t, NewMCPServer("news").UID, mcpServer.UID)
+}
+
+func TestNewFakeClient(t *testing.T) {
+	ctx := context.Background()
+	group := NewMCPServerGroup("travel", map[string]string{"app": "travel"})
+	k8s := NewFakeClient(NewMCPServer("weather"), group, NewAgentCoreStack("team"), NewAWSProviderConfig("prod", "eu-west-1"))
+
+	group.Status.Members = 1
+	require.NoError(t, k8s.Status().Update(ctx, group))
+	group.Spec.MinMembers = 2
+	group.Status.Members = 5
+	require.NoError(t, k8s.Update(ctx, group))
+
+	stored := &mcpgatewayv1alpha1.MCPServerGroup{}
+	require.NoError(t, k8s.Get(ctx, client.ObjectKeyFromObject(group), stored))
+	assert.Equal(t, int32(2), stored.Spec.MinMembers)
+	assert.Equal(t, int32(1), stored.Status.Members, "status is a subresource")
+
+	stack := &mcpgatewayv1alpha1.AgentCoreStack{}
+	require.NoError(t, k8s.Get(ctx, client.ObjectKey{Namespace: DefaultNamespace, Name: "team"}, stack))
+	assert.Equal(t, "team-gateway", stack.Spec.Gateway.Name)
+
+	providerConfig := &mcpgatewayv1alpha1.AWSProviderConfig{}
+	require.NoError(t, k8s.Get(ctx, client.ObjectKey{Name: "prod"}, providerConfig), "provider configs are cluster-scoped")
+	assert.Equal(t, "eu-west-1", providerConfig.Spec.Region)
+}
+
+func TestFakeAWS(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeAWS("gw-2")
+
+	created, err := fake.CreateGatewayTarget(ctx, &bedrockagentcorecontrol.CreateGatewayTargetInput{
+		GatewayIdentifier: aws.String("gw-2"),
+		Name:              aws.String("weather"),
+		TargetConfiguration: &types.TargetConfigurationMemberMcp{
+			Value: &types.McpTargetConfigurationMemberMcpServer{
+				Value: types.McpServerTargetConfiguration{Endpoint: aws.String("https://weather.example.com/mcp")},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.TargetStatusCreating, created.Status)
+
+	get := &bedrockagentcorecontrol.GetGatewayTargetInput{GatewayIdentifier: aws.String("gw-2"), TargetId: created.TargetId}
+	current, err := fake.GetGatewayTarget(ctx, get)
+	require.NoError(t, err)
+	assert.Equal(t, types.TargetStatusCreating, current.Status, "the clock only moves when told to")
+
+	fake.Settle()
+	current, err = fake.GetGatewayTarget(ctx, get)
+	require.NoError(t, err)
+	assert.Equal(t, types.TargetStatusReady, current.Status)
+
+	_, err = fake.GetGateway(ctx, &bedrockagentcorecontrol.GetGatewayInput{GatewayIdentifier: aws.String(DefaultGatewayID)})
+	require.NoError(t, err)
+}
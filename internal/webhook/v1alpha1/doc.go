@@ -0,0 +1,23 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains the admission webhooks of the v1alpha1 API.
+//
+// The MCPServer webhook checks at admission whether the gateway already has a target with the
+// name the MCPServer asks for, so that users are warned right away instead of at the first
+// reconcile. It only ever returns warnings and is registered with failurePolicy Ignore, so it
+// never blocks admission.
+package v1alpha1
@@ -0,0 +1,196 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+)
+
+// DefaultLookupTimeout bounds the AWS calls made for a single admission request
+const DefaultLookupTimeout = 2 * time.Second
+
+var mcpserverlog = logf.Log.WithName("mcpserver-webhook")
+
+// TargetFinder looks up a gateway target by name
+type TargetFinder interface {
+	FindGatewayTargetByName(ctx context.Context, gatewayID, name string) (*types.TargetSummary, error)
+}
+
+// EndpointFinder looks up the other MCPServers that register the endpoint of an MCPServer on its
+// gateway
+type EndpointFinder interface {
+	FindDuplicateEndpoints(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) ([]string, error)
+}
+
+// +kubebuilder:webhook:path=/validate-mcpgateway-bedrock-aws-v1alpha1-mcpserver,mutating=false,failurePolicy=ignore,sideEffects=None,groups=mcpgateway.bedrock.aws,resources=mcpservers,verbs=create;update,versions=v1alpha1,name=vmcpserver-v1alpha1.kb.io,admissionReviewVersions=v1,timeoutSeconds=5
+
+// MCPServerCustomValidator warns about MCPServers whose target name is already taken on their
+// gateway, and about MCPServers registering an endpoint that another MCPServer already registers
+// on the same gateway. It fails open: lookups that fail or time out admit the MCPServer without
+// warning.
+type MCPServerCustomValidator struct {
+	// Finder looks up the gateway targets
+	Finder TargetFinder
+	// Endpoints looks up the MCPServers with the same endpoint. Endpoints are not checked if nil.
+	Endpoints EndpointFinder
+	// ConfigParser resolves the gateway of an MCPServer
+	ConfigParser *config.ConfigParser
+	// Timeout bounds the lookup of a single admission request
+	Timeout time.Duration
+}
+
+var _ admission.Validator[*mcpgatewayv1alpha1.MCPServer] = &MCPServerCustomValidator{}
+
+// SetupMCPServerWebhookWithManager registers the MCPServer webhook with the manager
+func SetupMCPServerWebhookWithManager(mgr ctrl.Manager, validator *MCPServerCustomValidator) error {
+	return ctrl.NewWebhookManagedBy(mgr, &mcpgatewayv1alpha1.MCPServer{}).
+		WithValidator(validator).
+		Complete()
+}
+
+// ValidateCreate implements admission.Validator. MCPServers with invalid OAuth scopes are
+// rejected, see config.ValidateScopes.
+func (v *MCPServerCustomValidator) ValidateCreate(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+) (admission.Warnings, error) {
+	if err := validateScopes(mcpServer); err != nil {
+		return nil, err
+	}
+	warnings := v.checkDuplicateTarget(ctx, mcpServer)
+	return append(warnings, v.checkDuplicateEndpoint(ctx, mcpServer)...), nil
+}
+
+// ValidateUpdate implements admission.Validator. MCPServers with invalid OAuth scopes are
+// rejected. The gateway is only checked if the target name or gateway changed, as the MCPServer
+// already owns its current target, and the endpoint only if the endpoint or gateway changed.
+func (v *MCPServerCustomValidator) ValidateUpdate(
+	ctx context.Context,
+	oldMCPServer, newMCPServer *mcpgatewayv1alpha1.MCPServer,
+) (admission.Warnings, error) {
+	if err := validateScopes(newMCPServer); err != nil {
+		return nil, err
+	}
+	gatewayChanged := oldMCPServer.Spec.GatewayID != newMCPServer.Spec.GatewayID
+
+	var warnings admission.Warnings
+	if gatewayChanged || targetName(oldMCPServer) != targetName(newMCPServer) {
+		warnings = append(warnings, v.checkDuplicateTarget(ctx, newMCPServer)...)
+	}
+	if gatewayChanged || oldMCPServer.Spec.Endpoint != newMCPServer.Spec.Endpoint {
+		warnings = append(warnings, v.checkDuplicateEndpoint(ctx, newMCPServer)...)
+	}
+	return warnings, nil
+}
+
+// ValidateDelete implements admission.Validator
+func (v *MCPServerCustomValidator) ValidateDelete(
+	_ context.Context,
+	_ *mcpgatewayv1alpha1.MCPServer,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// checkDuplicateTarget returns a warning if the gateway of the MCPServer has a target with its
+// target name that is not the MCPServer's own
+func (v *MCPServerCustomValidator) checkDuplicateTarget(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) admission.Warnings {
+	log := mcpserverlog.WithValues("namespace", mcpServer.Namespace, "name", mcpServer.Name)
+
+	gatewayID, err := v.ConfigParser.GetGatewayID(mcpServer)
+	if err != nil {
+		// Invalid specs are reported by the controller
+		return nil
+	}
+
+	timeout := v.Timeout
+	if timeout <= 0 {
+		timeout = DefaultLookupTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	name := targetName(mcpServer)
+	target, err := v.Finder.FindGatewayTargetByName(ctx, gatewayID, name)
+	if err != nil {
+		log.V(1).Info("Skipping duplicate target check", "gatewayId", gatewayID, "error", err.Error())
+		return nil
+	}
+	if target == nil || aws.ToString(target.TargetId) == mcpServer.Status.TargetID {
+		return nil
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"gateway %s already has a target named %q (target ID %s); the gateway target of this MCPServer "+
+			"cannot be created unless the existing target is deleted or spec.targetName is changed",
+		gatewayID, name, aws.ToString(target.TargetId))}
+}
+
+// checkDuplicateEndpoint returns a warning if other MCPServers register the endpoint of the
+// MCPServer on its gateway
+func (v *MCPServerCustomValidator) checkDuplicateEndpoint(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) admission.Warnings {
+	if v.Endpoints == nil {
+		return nil
+	}
+
+	duplicates, err := v.Endpoints.FindDuplicateEndpoints(ctx, mcpServer)
+	if err != nil {
+		mcpserverlog.V(1).Info("Skipping duplicate endpoint check", "namespace", mcpServer.Namespace,
+			"name", mcpServer.Name, "error", err.Error())
+		return nil
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	return admission.Warnings{fmt.Sprintf(
+		"endpoint %s is already registered on the same gateway by %s; the gateway will expose the tools "+
+			"of this endpoint more than once",
+		mcpServer.Spec.Endpoint, strings.Join(duplicates, ", "))}
+}
+
+// validateScopes returns an Invalid error listing the invalid OAuth scopes of the MCPServer, or
+// nil if its scopes are valid
+func validateScopes(mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	errs := config.ValidateScopes(mcpServer)
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(mcpgatewayv1alpha1.GroupVersion.WithKind("MCPServer").GroupKind(), mcpServer.Name, errs)
+}
+
+// targetName returns the name of the gateway target of the MCPServer
+func targetName(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	if mcpServer.Spec.TargetName != "" {
+		return mcpServer.Spec.TargetName
+	}
+	return mcpServer.Name
+}
+
+// Ensure the wrapper satisfies TargetFinder
+var _ TargetFinder = &bedrock.BedrockClientWrapper{}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+)
+
+type fakeFinder struct {
+	target *types.TargetSummary
+	err    error
+	calls  int
+}
+
+func (f *fakeFinder) FindGatewayTargetByName(_ context.Context, _, _ string) (*types.TargetSummary, error) {
+	f.calls++
+	return f.target, f.err
+}
+
+func newMCPServer(targetName string) *mcpgatewayv1alpha1.MCPServer {
+	return &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "default"},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			GatewayID:  "gw-123",
+			TargetName: targetName,
+		},
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	tests := []struct {
+		name         string
+		finder       *fakeFinder
+		ownTargetID  string
+		wantWarnings int
+	}{
+		{
+			name:         "name is free",
+			finder:       &fakeFinder{},
+			wantWarnings: 0,
+		},
+		{
+			name:         "name is taken",
+			finder:       &fakeFinder{target: &types.TargetSummary{Name: aws.String("weather"), TargetId: aws.String("T1")}},
+			wantWarnings: 1,
+		},
+		{
+			name:         "name is taken by own target",
+			finder:       &fakeFinder{target: &types.TargetSummary{Name: aws.String("weather"), TargetId: aws.String("T1")}},
+			ownTargetID:  "T1",
+			wantWarnings: 0,
+		},
+		{
+			name:         "lookup fails open",
+			finder:       &fakeFinder{err: errors.New("access denied")},
+			wantWarnings: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &MCPServerCustomValidator{Finder: tt.finder, ConfigParser: config.NewConfigParser("")}
+			mcpServer := newMCPServer("")
+			mcpServer.Status.TargetID = tt.ownTargetID
+
+			warnings, err := v.ValidateCreate(context.Background(), mcpServer)
+			assert.NoError(t, err)
+			assert.Len(t, warnings, tt.wantWarnings)
+			assert.Equal(t, 1, tt.finder.calls)
+		})
+	}
+}
+
+func TestValidateCreate_NoGateway(t *testing.T) {
+	finder := &fakeFinder{}
+	v := &MCPServerCustomValidator{Finder: finder, ConfigParser: config.NewConfigParser("")}
+	mcpServer := newMCPServer("")
+	mcpServer.Spec.GatewayID = ""
+
+	warnings, err := v.ValidateCreate(context.Background(), mcpServer)
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Zero(t, finder.calls)
+}
+
+func TestValidateUpdate(t *testing.T) {
+	taken := &types.TargetSummary{Name: aws.String("forecast"), TargetId: aws.String("T2")}
+
+	t.Run("unchanged target name skips lookup", func(t *testing.T) {
+		finder := &fakeFinder{target: taken}
+		v := &MCPServerCustomValidator{Finder: finder, ConfigParser: config.NewConfigParser("")}
+
+		warnings, err := v.ValidateUpdate(context.Background(), newMCPServer("forecast"), newMCPServer("forecast"))
+		assert.NoError(t, err)
+		assert.Empty(t, warnings)
+		assert.Zero(t, finder.calls)
+	})
+
+	t.Run("renamed target is checked", func(t *testing.T) {
+		finder := &fakeFinder{target: taken}
+		v := &MCPServerCustomValidator{Finder: finder, ConfigParser: config.NewConfigParser("")}
+
+		warnings, err := v.ValidateUpdate(context.Background(), newMCPServer(""), newMCPServer("forecast"))
+		assert.NoError(t, err)
+		assert.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0], "forecast")
+	})
+}
+
+func TestValidate_InvalidScopes(t *testing.T) {
+	finder := &fakeFinder{}
+	v := &MCPServerCustomValidator{Finder: finder, ConfigParser: config.NewConfigParser("")}
+	mcpServer := newMCPServer("")
+	mcpServer.Spec.OauthScopes = []string{"read write", "admin", "admin"}
+
+	_, err := v.ValidateCreate(context.Background(), mcpServer)
+	assert.True(t, apierrors.IsInvalid(err))
+	assert.ErrorContains(t, err, "spec.oauthScopes[0]")
+	assert.ErrorContains(t, err, "list each of the 2 scopes as a separate entry")
+	assert.ErrorContains(t, err, `spec.oauthScopes[2]: Duplicate value: "admin"`)
+	assert.Zero(t, finder.calls)
+
+	_, err = v.ValidateUpdate(context.Background(), newMCPServer(""), mcpServer)
+	assert.True(t, apierrors.IsInvalid(err))
+
+	mcpServer.Spec.OauthScopes = []string{"write", "read"}
+	_, err = v.ValidateCreate(context.Background(), mcpServer)
+	assert.NoError(t, err)
+}
+
+type fakeEndpointFinder struct {
+	duplicates []string
+	err        error
+	calls      int
+}
+
+func (f *fakeEndpointFinder) FindDuplicateEndpoints(
+	_ context.Context,
+	_ *mcpgatewayv1alpha1.MCPServer,
+) ([]string, error) {
+	f.calls++
+	return f.duplicates, f.err
+}
+
+func TestValidateCreate_DuplicateEndpoint(t *testing.T) {
+	tests := []struct {
+		name         string
+		endpoints    *fakeEndpointFinder
+		wantWarnings int
+	}{
+		{
+			name:         "endpoint is unique",
+			endpoints:    &fakeEndpointFinder{},
+			wantWarnings: 0,
+		},
+		{
+			name:         "endpoint is registered by another MCPServer",
+			endpoints:    &fakeEndpointFinder{duplicates: []string{"team-b/weather-copy"}},
+			wantWarnings: 1,
+		},
+		{
+			name:         "lookup fails open",
+			endpoints:    &fakeEndpointFinder{err: errors.New("index not found")},
+			wantWarnings: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &MCPServerCustomValidator{
+				Finder:       &fakeFinder{},
+				Endpoints:    tt.endpoints,
+				ConfigParser: config.NewConfigParser(""),
+			}
+			mcpServer := newMCPServer("")
+			mcpServer.Spec.Endpoint = "https://weather.example.com/mcp"
+
+			warnings, err := v.ValidateCreate(context.Background(), mcpServer)
+			assert.NoError(t, err)
+			assert.Len(t, warnings, tt.wantWarnings)
+			if tt.wantWarnings > 0 {
+				assert.Contains(t, warnings[0], "team-b/weather-copy")
+			}
+		})
+	}
+}
+
+func TestValidateUpdate_DuplicateEndpoint(t *testing.T) {
+	endpoints := &fakeEndpointFinder{duplicates: []string{"team-b/weather-copy"}}
+	v := &MCPServerCustomValidator{Finder: &fakeFinder{}, Endpoints: endpoints, ConfigParser: config.NewConfigParser("")}
+
+	oldMCPServer := newMCPServer("")
+	oldMCPServer.Spec.Endpoint = "https://weather.example.com/mcp"
+	warnings, err := v.ValidateUpdate(context.Background(), oldMCPServer, oldMCPServer.DeepCopy())
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Zero(t, endpoints.calls)
+
+	moved := oldMCPServer.DeepCopy()
+	moved.Spec.Endpoint = "https://forecast.example.com/mcp"
+	warnings, err = v.ValidateUpdate(context.Background(), oldMCPServer, moved)
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 1)
+	assert.Equal(t, 1, endpoints.calls)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activitylog
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/smithy-go"
+
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+)
+
+// signingName is the SigV4 signing name of CloudWatch Logs
+const signingName = "logs"
+
+// LogEvent is a single log event of a PutLogEvents call
+type LogEvent struct {
+	// Timestamp is the time of the event in milliseconds since the epoch
+	Timestamp int64 `json:"timestamp"`
+	// Message is the JSON record
+	Message string `json:"message"`
+}
+
+// LogsAPI is the subset of CloudWatch Logs used by the Sink
+type LogsAPI interface {
+	CreateLogStream(ctx context.Context, group, stream string) error
+	PutLogEvents(ctx context.Context, group, stream string, events []LogEvent) error
+}
+
+// Client calls the CloudWatch Logs API. The operator only needs two of its operations, so they are
+// sent as signed JSON requests instead of through the CloudWatch Logs SDK.
+type Client struct {
+	config   aws.Config
+	endpoint string
+	signer   *v4.Signer
+	now      func() time.Time
+}
+
+// NewClient creates a Client for the region and credentials of cfg. The FIPS endpoint is used if
+// cfg enables FIPS endpoints, e.g. with AWS_USE_FIPS_ENDPOINT. cfg.BaseEndpoint replaces the
+// regional endpoint, e.g. with a VPC endpoint.
+func NewClient(cfg aws.Config) *Client {
+	service := signingName
+	if useFIPSEndpoint(cfg) {
+		service += "-fips"
+	}
+	endpoint := fmt.Sprintf("https://%s.%s.%s", service, cfg.Region, config.DNSSuffix(cfg.Region))
+	if cfg.BaseEndpoint != nil {
+		endpoint = strings.TrimSuffix(*cfg.BaseEndpoint, "/")
+	}
+	return &Client{config: cfg, endpoint: endpoint, signer: v4.NewSigner(), now: time.Now}
+}
+
+// fipsEndpointSource is implemented by the configuration sources that can enable FIPS endpoints
+type fipsEndpointSource interface {
+	GetUseFIPSEndpoint(ctx context.Context) (aws.FIPSEndpointState, bool, error)
+}
+
+// useFIPSEndpoint reports whether the first configuration source of cfg that sets the FIPS
+// endpoint state enables it, as the SDK clients resolve it
+func useFIPSEndpoint(cfg aws.Config) bool {
+	for _, source := range cfg.ConfigSources {
+		if fips, ok := source.(fipsEndpointSource); ok {
+			if state, found, err := fips.GetUseFIPSEndpoint(context.Background()); err == nil && found {
+				return state == aws.FIPSEndpointStateEnabled
+			}
+		}
+	}
+	return false
+}
+
+// CreateLogStream creates the log stream in the log group. The log group must exist.
+func (c *Client) CreateLogStream(ctx context.Context, group, stream string) error {
+	return c.call(ctx, "CreateLogStream", map[string]any{
+		"logGroupName":  group,
+		"logStreamName": stream,
+	})
+}
+
+// PutLogEvents appends events to the log stream. Events must be in chronological order.
+func (c *Client) PutLogEvents(ctx context.Context, group, stream string, events []LogEvent) error {
+	return c.call(ctx, "PutLogEvents", map[string]any{
+		"logGroupName":  group,
+		"logStreamName": stream,
+		"logEvents":     events,
+	})
+}
+
+// call sends a signed request for operation and returns the service error of a failed call as
+// a smithy.APIError
+func (c *Client) call(ctx context.Context, operation string, input any) error {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s request: %w", operation, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create %s request: %w", operation, err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "Logs_20140328."+operation)
+
+	if c.config.Credentials == nil {
+		return fmt.Errorf("no AWS credentials to sign %s", operation)
+	}
+	credentials, err := c.config.Credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+	hash := sha256.Sum256(body)
+	if err := c.signer.SignHTTP(ctx, credentials, req, hex.EncodeToString(hash[:]),
+		signingName, c.config.Region, c.now()); err != nil {
+		return fmt.Errorf("failed to sign %s request: %w", operation, err)
+	}
+
+	var httpClient aws.HTTPClient = http.DefaultClient
+	if c.config.HTTPClient != nil {
+		httpClient = c.config.HTTPClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", operation, err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode < 300 {
+		return nil
+	}
+
+	var serviceErr struct {
+		Type    string `json:"__type"`
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(respBody, &serviceErr)
+	code := serviceErr.Type
+	if i := strings.LastIndex(code, "#"); i >= 0 {
+		code = code[i+1:]
+	}
+	if code == "" {
+		code = http.StatusText(resp.StatusCode)
+	}
+	return fmt.Errorf("%s failed: %w", operation, &smithy.GenericAPIError{Code: code, Message: serviceErr.Message})
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activitylog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient_Endpoint(t *testing.T) {
+	assert.Equal(t, "https://logs.us-east-1.amazonaws.com", NewClient(aws.Config{Region: "us-east-1"}).endpoint)
+	assert.Equal(t, "https://logs.cn-north-1.amazonaws.com.cn", NewClient(aws.Config{Region: "cn-north-1"}).endpoint)
+	assert.Equal(t, "https://vpce-123.logs.us-east-1.vpce.amazonaws.com", NewClient(aws.Config{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String("https://vpce-123.logs.us-east-1.vpce.amazonaws.com/"),
+	}).endpoint)
+	assert.Equal(t, "https://logs-fips.us-east-1.amazonaws.com", NewClient(aws.Config{
+		Region:        "us-east-1",
+		ConfigSources: []any{config.LoadOptions{UseFIPSEndpoint: aws.FIPSEndpointStateEnabled}},
+	}).endpoint)
+}
+
+func TestClient_PutLogEvents(t *testing.T) {
+	var target, authorization string
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target = r.Header.Get("X-Amz-Target")
+		authorization = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if strings.HasSuffix(target, ".CreateLogStream") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"com.amazonaws.logs#ResourceAlreadyExistsException","message":"exists"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"nextSequenceToken":"1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(aws.Config{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+	})
+
+	err := client.PutLogEvents(context.Background(), "operator", "pod-0", []LogEvent{{Timestamp: 1, Message: "{}"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Logs_20140328.PutLogEvents", target)
+	assert.Contains(t, authorization, "/us-east-1/logs/aws4_request")
+	assert.Equal(t, "operator", body["logGroupName"])
+	assert.Equal(t, "pod-0", body["logStreamName"])
+	assert.Len(t, body["logEvents"], 1)
+
+	err = client.CreateLogStream(context.Background(), "operator", "pod-0")
+	var apiErr smithy.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "ResourceAlreadyExistsException", apiErr.ErrorCode())
+	assert.Equal(t, "exists", apiErr.ErrorMessage())
+}
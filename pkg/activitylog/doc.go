@@ -0,0 +1,4 @@
+// Package activitylog mirrors the operator's lifecycle events and audit records into a
+// CloudWatch Logs log stream as JSON, so the operator's activity is kept next to the other AWS
+// logs of a team even if the cluster's logs and events are not collected.
+package activitylog
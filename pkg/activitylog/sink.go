@@ -0,0 +1,272 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activitylog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/aws/mcp-gateway-operator/pkg/audit"
+)
+
+const (
+	// DefaultFlushInterval is how often buffered records are sent to CloudWatch Logs
+	DefaultFlushInterval = 5 * time.Second
+
+	// maxBuffered bounds the records kept while CloudWatch Logs is unreachable; the oldest
+	// records are dropped first
+	maxBuffered = 10000
+	// maxBatchEvents and maxBatchBytes are the limits of a single PutLogEvents call, which counts
+	// eventOverhead bytes per event in addition to its message
+	maxBatchEvents = 10000
+	maxBatchBytes  = 1048576
+	eventOverhead  = 26
+	// maxMessageBytes is the size limit of a single log event
+	maxMessageBytes = 256*1024 - eventOverhead
+	// shutdownFlushTimeout bounds the final flush when the operator stops
+	shutdownFlushTimeout = 10 * time.Second
+)
+
+// EventRecord is the log record of a Kubernetes event emitted by the operator. Audit records
+// are mirrored as written by the audit logger; event records are told apart by their type.
+type EventRecord struct {
+	// Time is when the event was emitted
+	Time time.Time `json:"time"`
+	// Actor, Version and Cluster identify the operator, as in audit records
+	Actor   string `json:"actor"`
+	Version string `json:"version,omitempty"`
+	Cluster string `json:"cluster,omitempty"`
+	// Kind is the kind of the object the event is about, e.g. MCPServer
+	Kind string `json:"kind,omitempty"`
+	// Resource is the object the event is about, as <namespace>.<name> like in audit records
+	Resource string `json:"resource"`
+	// Type, Reason, Action and Note are the fields of the event
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+	Action string `json:"action,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+// Sink buffers records and sends them to a CloudWatch Logs log stream every flush interval.
+// It is safe for concurrent use.
+type Sink struct {
+	api      LogsAPI
+	group    string
+	stream   string
+	interval time.Duration
+	version  string
+	cluster  string
+	logger   logr.Logger
+	now      func() time.Time
+
+	mu            sync.Mutex
+	pending       []LogEvent
+	dropped       int
+	streamCreated bool
+}
+
+// NewSink creates a Sink writing to stream in group. The operator version and cluster ID are
+// added to every event record.
+func NewSink(api LogsAPI, group, stream string, interval time.Duration, version, cluster string,
+	logger logr.Logger) *Sink {
+	return &Sink{
+		api:      api,
+		group:    group,
+		stream:   stream,
+		interval: interval,
+		version:  version,
+		cluster:  cluster,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Write buffers every line of p as a record. It lets the audit logger write to the Sink.
+func (s *Sink) Write(p []byte) (int, error) {
+	for _, line := range bytes.Split(p, []byte("\n")) {
+		if len(line) > 0 {
+			s.add(string(line))
+		}
+	}
+	return len(p), nil
+}
+
+// Recorder returns an event recorder that emits events through delegate and mirrors them into
+// the Sink. scheme resolves the kind of the objects events are about.
+func (s *Sink) Recorder(delegate events.EventRecorder, scheme *runtime.Scheme) events.EventRecorder {
+	return &recorder{delegate: delegate, sink: s, scheme: scheme}
+}
+
+// Start sends the buffered records every flush interval until ctx is cancelled, then sends the
+// remaining records. It implements manager.Runnable.
+func (s *Sink) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), shutdownFlushTimeout)
+			defer cancel()
+			if err := s.flush(flushCtx); err != nil {
+				s.logger.Error(err, "Failed to send activity records to CloudWatch Logs on shutdown")
+			}
+			return nil
+		case <-ticker.C:
+			if err := s.flush(ctx); err != nil {
+				s.logger.Error(err, "Failed to send activity records to CloudWatch Logs")
+			}
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every replica mirrors its own
+// records, so the records of a replica losing its lease are not lost.
+func (s *Sink) NeedLeaderElection() bool {
+	return false
+}
+
+// add buffers a record, dropping the oldest record if the buffer is full
+func (s *Sink) add(message string) {
+	if len(message) > maxMessageBytes {
+		message = message[:maxMessageBytes]
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = append(s.pending, LogEvent{Timestamp: s.now().UnixMilli(), Message: message})
+	if len(s.pending) > maxBuffered {
+		s.dropped += len(s.pending) - maxBuffered
+		s.pending = s.pending[len(s.pending)-maxBuffered:]
+	}
+}
+
+// flush sends the buffered records in batches. Records of failed batches are buffered again.
+func (s *Sink) flush(ctx context.Context) error {
+	s.mu.Lock()
+	pending, dropped := s.pending, s.dropped
+	s.pending, s.dropped = nil, 0
+	s.mu.Unlock()
+
+	if dropped > 0 {
+		s.logger.Info("Dropped activity records while CloudWatch Logs was unreachable", "dropped", dropped)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if err := s.ensureStream(ctx); err != nil {
+		s.requeue(pending)
+		return err
+	}
+	for len(pending) > 0 {
+		n := batchSize(pending)
+		if err := s.api.PutLogEvents(ctx, s.group, s.stream, pending[:n]); err != nil {
+			s.requeue(pending)
+			return fmt.Errorf("failed to put %d records into %s/%s: %w", len(pending), s.group, s.stream, err)
+		}
+		pending = pending[n:]
+	}
+	return nil
+}
+
+// ensureStream creates the log stream once. A stream that already exists, e.g. from before a
+// restart, is written to as well.
+func (s *Sink) ensureStream(ctx context.Context) error {
+	if s.streamCreated {
+		return nil
+	}
+	err := s.api.CreateLogStream(ctx, s.group, s.stream)
+	var apiErr smithy.APIError
+	if err != nil && !(errors.As(err, &apiErr) && apiErr.ErrorCode() == "ResourceAlreadyExistsException") {
+		return fmt.Errorf("failed to create log stream %s/%s: %w", s.group, s.stream, err)
+	}
+	s.streamCreated = true
+	return nil
+}
+
+// requeue buffers unsent records again ahead of the records added in the meantime
+func (s *Sink) requeue(unsent []LogEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = append(unsent, s.pending...)
+	if len(s.pending) > maxBuffered {
+		s.dropped += len(s.pending) - maxBuffered
+		s.pending = s.pending[len(s.pending)-maxBuffered:]
+	}
+}
+
+// batchSize returns how many of the records fit into a single PutLogEvents call
+func batchSize(pending []LogEvent) int {
+	size := 0
+	for i, event := range pending {
+		size += len(event.Message) + eventOverhead
+		if i == maxBatchEvents || size > maxBatchBytes {
+			return i
+		}
+	}
+	return len(pending)
+}
+
+// recorder mirrors the events of a delegate event recorder into a Sink
+type recorder struct {
+	delegate events.EventRecorder
+	sink     *Sink
+	scheme   *runtime.Scheme
+}
+
+// Eventf implements events.EventRecorder
+func (r *recorder) Eventf(regarding runtime.Object, related runtime.Object, eventtype, reason, action,
+	note string, args ...interface{}) {
+	if r.delegate != nil {
+		r.delegate.Eventf(regarding, related, eventtype, reason, action, note, args...)
+	}
+
+	record := EventRecord{
+		Time:    r.sink.now().UTC(),
+		Actor:   audit.Actor,
+		Version: r.sink.version,
+		Cluster: r.sink.cluster,
+		Type:    eventtype,
+		Reason:  reason,
+		Action:  action,
+		Note:    fmt.Sprintf(note, args...),
+	}
+	if accessor, err := meta.Accessor(regarding); err == nil {
+		record.Resource = accessor.GetNamespace() + "." + accessor.GetName()
+	}
+	if gvk, err := apiutil.GVKForObject(regarding, r.scheme); err == nil {
+		record.Kind = gvk.Kind
+	}
+	message, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	r.sink.add(string(message))
+}
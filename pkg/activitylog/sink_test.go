@@ -0,0 +1,186 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activitylog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/audit"
+)
+
+// fakeLogs records the log events put into each stream
+type fakeLogs struct {
+	mu        sync.Mutex
+	streams   map[string][]LogEvent
+	createErr error
+	putErr    error
+	puts      int
+}
+
+func newFakeLogs() *fakeLogs {
+	return &fakeLogs{streams: map[string][]LogEvent{}}
+}
+
+func (f *fakeLogs) CreateLogStream(_ context.Context, group, stream string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.createErr != nil {
+		return f.createErr
+	}
+	key := group + "/" + stream
+	if _, ok := f.streams[key]; ok {
+		return &smithy.GenericAPIError{Code: "ResourceAlreadyExistsException"}
+	}
+	f.streams[key] = nil
+	return nil
+}
+
+func (f *fakeLogs) PutLogEvents(_ context.Context, group, stream string, logEvents []LogEvent) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.puts++
+	if f.putErr != nil {
+		return f.putErr
+	}
+	key := group + "/" + stream
+	if _, ok := f.streams[key]; !ok {
+		return &smithy.GenericAPIError{Code: "ResourceNotFoundException"}
+	}
+	f.streams[key] = append(f.streams[key], logEvents...)
+	return nil
+}
+
+func newTestSink(api LogsAPI) *Sink {
+	sink := NewSink(api, "operator", "pod-0", DefaultFlushInterval, "v1.2.3", "prod-east", logr.Discard())
+	sink.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+	return sink
+}
+
+func TestSink_AuditRecords(t *testing.T) {
+	logs := newFakeLogs()
+	sink := newTestSink(logs)
+	logger := audit.NewLogger(sink, "v1.2.3", "prod-east")
+
+	require.NoError(t, logger.Log(audit.Record{Operation: "CreateGatewayTarget", GatewayID: "gw-123"}, nil))
+	require.NoError(t, logger.Log(audit.Record{Operation: "DeleteGatewayTarget", GatewayID: "gw-123"}, nil))
+	require.NoError(t, sink.flush(context.Background()))
+
+	got := logs.streams["operator/pod-0"]
+	require.Len(t, got, 2)
+	assert.Equal(t, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC).UnixMilli(), got[0].Timestamp)
+	var record audit.Record
+	require.NoError(t, json.Unmarshal([]byte(got[1].Message), &record))
+	assert.Equal(t, "DeleteGatewayTarget", record.Operation)
+	assert.Equal(t, audit.ResultSuccess, record.Result)
+
+	// Flushing again sends nothing and writes to the existing stream
+	require.NoError(t, sink.flush(context.Background()))
+	assert.Equal(t, 1, logs.puts)
+}
+
+func TestSink_Recorder(t *testing.T) {
+	logs := newFakeLogs()
+	sink := newTestSink(logs)
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+	delegate := events.NewFakeRecorder(1)
+
+	mcp := &mcpgatewayv1alpha1.MCPServer{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "weather"}}
+	sink.Recorder(delegate, scheme).Eventf(mcp, nil, "Warning", "TargetFailed", "Reconcile", "target %s failed", "TGT123")
+	require.NoError(t, sink.flush(context.Background()))
+
+	// The event is still emitted to Kubernetes
+	assert.Equal(t, "Warning TargetFailed target TGT123 failed", <-delegate.Events)
+
+	got := logs.streams["operator/pod-0"]
+	require.Len(t, got, 1)
+	var record EventRecord
+	require.NoError(t, json.Unmarshal([]byte(got[0].Message), &record))
+	assert.Equal(t, EventRecord{
+		Time:     time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		Actor:    audit.Actor,
+		Version:  "v1.2.3",
+		Cluster:  "prod-east",
+		Kind:     "MCPServer",
+		Resource: "default.weather",
+		Type:     "Warning",
+		Reason:   "TargetFailed",
+		Action:   "Reconcile",
+		Note:     "target TGT123 failed",
+	}, record)
+}
+
+func TestSink_RetriesFailedRecords(t *testing.T) {
+	logs := newFakeLogs()
+	logs.createErr = errors.New("unreachable")
+	sink := newTestSink(logs)
+
+	_, _ = sink.Write([]byte(`{"operation":"CreateGatewayTarget"}` + "\n"))
+	require.Error(t, sink.flush(context.Background()))
+
+	logs.createErr = nil
+	logs.putErr = errors.New("throttled")
+	_, _ = sink.Write([]byte(`{"operation":"UpdateGatewayTarget"}` + "\n"))
+	require.Error(t, sink.flush(context.Background()))
+
+	// Unsent records are kept in order until CloudWatch Logs accepts them
+	logs.putErr = nil
+	require.NoError(t, sink.flush(context.Background()))
+	got := logs.streams["operator/pod-0"]
+	require.Len(t, got, 2)
+	assert.Contains(t, got[0].Message, "CreateGatewayTarget")
+	assert.Contains(t, got[1].Message, "UpdateGatewayTarget")
+}
+
+func TestSink_BufferBound(t *testing.T) {
+	sink := newTestSink(newFakeLogs())
+	for range maxBuffered + 5 {
+		_, _ = sink.Write([]byte("{}"))
+	}
+	assert.Len(t, sink.pending, maxBuffered)
+	assert.Equal(t, 5, sink.dropped)
+}
+
+func TestBatchSize(t *testing.T) {
+	small := make([]LogEvent, maxBatchEvents+1)
+	assert.Equal(t, maxBatchEvents, batchSize(small))
+
+	large := []LogEvent{
+		{Message: strings.Repeat("a", maxMessageBytes)},
+		{Message: strings.Repeat("a", maxMessageBytes)},
+		{Message: strings.Repeat("a", maxMessageBytes)},
+		{Message: strings.Repeat("a", maxMessageBytes)},
+		{Message: strings.Repeat("a", maxMessageBytes)},
+	}
+	assert.Equal(t, 4, batchSize(large))
+	assert.Equal(t, 1, batchSize(large[:1]))
+}
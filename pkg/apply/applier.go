@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// ConflictError is returned when an apply would overwrite fields owned by another field manager
+type ConflictError struct {
+	// Fields are the conflicting field paths with the manager that owns each of them
+	Fields []string
+	err    error
+}
+
+// Error implements the error interface
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("apply conflicts with fields owned by another manager: %s", strings.Join(e.Fields, "; "))
+}
+
+// Unwrap returns the underlying API error
+func (e *ConflictError) Unwrap() error {
+	return e.err
+}
+
+// IsConflict reports whether err is a ConflictError
+func IsConflict(err error) bool {
+	var conflictErr *ConflictError
+	return errors.As(err, &conflictErr)
+}
+
+// Applier applies MCPServers using server-side apply under a fixed field manager
+type Applier struct {
+	client       client.Client
+	fieldManager string
+}
+
+// NewApplier creates a new Applier writing as fieldManager
+func NewApplier(c client.Client, fieldManager string) *Applier {
+	return &Applier{
+		client:       c,
+		fieldManager: fieldManager,
+	}
+}
+
+// Apply server-side applies the spec, labels and annotations of the given MCPServer.
+// Only fields set on mcpServer are claimed by the field manager, so fields edited by
+// users or other generators are left untouched. Conflicts are never forced; they are
+// returned as a ConflictError naming the contested fields and their owners.
+func (a *Applier) Apply(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	obj, err := applyObject(mcpServer)
+	if err != nil {
+		return err
+	}
+
+	err = a.client.Apply(ctx, client.ApplyConfigurationFromUnstructured(obj), client.FieldOwner(a.fieldManager))
+	if apierrors.IsConflict(err) {
+		return newConflictError(err)
+	}
+	return err
+}
+
+// applyObject converts an MCPServer into the unstructured apply configuration sent to the API server.
+// Status and server-populated metadata are dropped so the field manager never claims them.
+func applyObject(mcpServer *mcpgatewayv1alpha1.MCPServer) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mcpServer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert MCPServer: %w", err)
+	}
+
+	obj := &unstructured.Unstructured{Object: content}
+	obj.SetGroupVersionKind(mcpgatewayv1alpha1.GroupVersion.WithKind("MCPServer"))
+	unstructured.RemoveNestedField(obj.Object, "status")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+
+	return obj, nil
+}
+
+// newConflictError extracts the conflicting fields from an apply conflict
+func newConflictError(err error) error {
+	conflictErr := &ConflictError{err: err}
+
+	var statusErr *apierrors.StatusError
+	if errors.As(err, &statusErr) && statusErr.ErrStatus.Details != nil {
+		for _, cause := range statusErr.ErrStatus.Details.Causes {
+			if cause.Type != metav1.CauseTypeFieldManagerConflict {
+				continue
+			}
+			conflictErr.Fields = append(conflictErr.Fields, fmt.Sprintf("%s (%s)", cause.Field, cause.Message))
+		}
+	}
+	if len(conflictErr.Fields) == 0 {
+		conflictErr.Fields = []string{err.Error()}
+	}
+
+	return conflictErr
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestApplyObject(t *testing.T) {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "test-server",
+			Namespace:       "default",
+			ResourceVersion: "42",
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+		Status: mcpgatewayv1alpha1.MCPServerStatus{
+			TargetID: "target-123",
+		},
+	}
+
+	obj, err := applyObject(mcpServer)
+	require.NoError(t, err)
+
+	assert.Equal(t, "MCPServer", obj.GetKind())
+	assert.Equal(t, "mcpgateway.bedrock.aws/v1alpha1", obj.GetAPIVersion())
+	assert.Empty(t, obj.GetResourceVersion())
+
+	_, found, err := unstructured.NestedFieldNoCopy(obj.Object, "status")
+	require.NoError(t, err)
+	assert.False(t, found, "status must not be applied")
+
+	endpoint, _, err := unstructured.NestedString(obj.Object, "spec", "endpoint")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com", endpoint)
+}
+
+func TestNewConflictError(t *testing.T) {
+	err := apierrors.NewApplyConflict([]metav1.StatusCause{
+		{
+			Type:    metav1.CauseTypeFieldManagerConflict,
+			Field:   ".spec.endpoint",
+			Message: `conflict with "kubectl-edit"`,
+		},
+	}, "Apply failed with 1 conflict")
+
+	conflictErr := newConflictError(err)
+	assert.True(t, IsConflict(conflictErr))
+	assert.True(t, apierrors.IsConflict(conflictErr))
+	assert.Contains(t, conflictErr.Error(), `.spec.endpoint (conflict with "kubectl-edit")`)
+
+	otherErr := apierrors.NewConflict(schema.GroupResource{Resource: "mcpservers"}, "test-server", nil)
+	assert.True(t, IsConflict(newConflictError(otherErr)))
+}
@@ -0,0 +1,3 @@
+// Package apply writes MCPServer resources with server-side apply so that tools
+// generating MCPServers only own the fields they set.
+package apply
@@ -0,0 +1,4 @@
+// Package audit writes one JSON record per mutating AWS call made by the operator, separate
+// from the regular logs, so that operator actions can be shipped to a SIEM without parsing
+// free-form log lines.
+package audit
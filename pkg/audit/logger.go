@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go"
+)
+
+// Actor is the operator component recorded as the actor of every audit record
+const Actor = "agentcore-operator"
+
+// Results of an audited call
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// Record is a single audit record
+type Record struct {
+	// Time is when the call completed
+	Time time.Time `json:"time"`
+	// Actor, Version and Cluster identify the operator that made the call
+	Actor   string `json:"actor"`
+	Version string `json:"version,omitempty"`
+	Cluster string `json:"cluster,omitempty"`
+	// Kind and Resource identify the Kubernetes resource the call was made for, e.g. MCPServer
+	// and <namespace>.<name>. They match the mcpserver/<namespace>.<name> or
+	// agentcorestack/<namespace>.<name> user-agent component recorded by CloudTrail.
+	Kind     string `json:"kind,omitempty"`
+	Resource string `json:"resource,omitempty"`
+	// Operation is the AWS API operation, e.g. CreateGatewayTarget
+	Operation string `json:"operation"`
+	// GatewayID, TargetID and Name identify the AWS resource the call acted on
+	GatewayID string `json:"gatewayId,omitempty"`
+	TargetID  string `json:"targetId,omitempty"`
+	Name      string `json:"name,omitempty"`
+	// Result is ResultSuccess or ResultFailure
+	Result string `json:"result"`
+	// ErrorCode and Error describe a failed call
+	ErrorCode string `json:"errorCode,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Logger writes audit records as JSON lines. It is safe for concurrent use.
+type Logger struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	version string
+	cluster string
+	now     func() time.Time
+}
+
+// NewLogger creates a Logger writing to w. The operator version and cluster ID are added to
+// every record.
+func NewLogger(w io.Writer, version, cluster string) *Logger {
+	return &Logger{
+		encoder: json.NewEncoder(w),
+		version: version,
+		cluster: cluster,
+		now:     time.Now,
+	}
+}
+
+// Log writes a record, filling in its time, actor and result. A record with an error is a failure.
+func (l *Logger) Log(record Record, err error) error {
+	record.Time = l.now().UTC()
+	record.Actor = Actor
+	record.Version = l.version
+	record.Cluster = l.cluster
+	record.Result = ResultSuccess
+	if err != nil {
+		record.Result = ResultFailure
+		record.Error = err.Error()
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			record.ErrorCode = apiErr.ErrorCode()
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.encoder.Encode(record)
+}
+
+// OpenSink opens the destination of the audit records: "stdout", "stderr", or the path of a
+// file that records are appended to. The returned closer is a no-op for stdout and stderr.
+func OpenSink(sink string) (io.WriteCloser, error) {
+	switch sink {
+	case "":
+		return nil, errors.New("audit sink must not be empty")
+	case "stdout":
+		return nopCloser{os.Stdout}, nil
+	case "stderr":
+		return nopCloser{os.Stderr}, nil
+	}
+
+	file, err := os.OpenFile(sink, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %s: %w", sink, err)
+	}
+	return file, nil
+}
+
+// nopCloser keeps the standard streams open when the audit sink is closed
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger_Log(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(&buf, "v1.2.3", "prod-east")
+	logger.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+
+	require.NoError(t, logger.Log(Record{
+		Resource:  "default.weather",
+		Operation: "CreateGatewayTarget",
+		GatewayID: "gw-123",
+		Name:      "weather",
+	}, nil))
+	require.NoError(t, logger.Log(Record{
+		Resource:  "default.weather",
+		Operation: "DeleteGatewayTarget",
+		GatewayID: "gw-123",
+		TargetID:  "target-456",
+	}, fmt.Errorf("delete failed: %w", &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "denied"})))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var created Record
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &created))
+	assert.Equal(t, Record{
+		Time:      time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		Actor:     Actor,
+		Version:   "v1.2.3",
+		Cluster:   "prod-east",
+		Resource:  "default.weather",
+		Operation: "CreateGatewayTarget",
+		GatewayID: "gw-123",
+		Name:      "weather",
+		Result:    ResultSuccess,
+	}, created)
+
+	var deleted Record
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &deleted))
+	assert.Equal(t, ResultFailure, deleted.Result)
+	assert.Equal(t, "AccessDeniedException", deleted.ErrorCode)
+	assert.Contains(t, deleted.Error, "delete failed")
+}
+
+func TestOpenSink(t *testing.T) {
+	_, err := OpenSink("")
+	assert.Error(t, err)
+
+	stdout, err := OpenSink("stdout")
+	require.NoError(t, err)
+	assert.NoError(t, stdout.Close())
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	for i := 0; i < 2; i++ {
+		sink, err := OpenSink(path)
+		require.NoError(t, err)
+		require.NoError(t, NewLogger(sink, "dev", "").Log(Record{Operation: "DeleteGateway"}, nil))
+		require.NoError(t, sink.Close())
+	}
+
+	// Records are appended across reopens
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(data), "\n"))
+}
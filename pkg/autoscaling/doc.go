@@ -0,0 +1,6 @@
+// Package autoscaling builds KEDA ScaledObjects that scale the workload behind an
+// MCPServer on the gateway request rate exported by the operator.
+//
+// ScaledObjects are built as unstructured objects so that the operator does not
+// depend on the KEDA API module and runs in clusters without KEDA installed.
+package autoscaling
@@ -0,0 +1,105 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+const (
+	// defaultWorkloadKind is used when the endpoint reference does not set a kind
+	defaultWorkloadKind = "Deployment"
+
+	// defaultMinReplicas is used when the autoscaling spec does not set a lower bound
+	defaultMinReplicas int32 = 1
+
+	// requestRateMetric is the operator metric the ScaledObject queries
+	requestRateMetric = "mcpgateway_target_requests_per_second"
+)
+
+// ScaledObjectGVK is the GroupVersionKind of KEDA ScaledObjects
+var ScaledObjectGVK = schema.GroupVersionKind{Group: "keda.sh", Version: "v1alpha1", Kind: "ScaledObject"}
+
+// Enabled reports whether the MCPServer asks for a ScaledObject
+func Enabled(mcpServer *mcpgatewayv1alpha1.MCPServer) bool {
+	return mcpServer.Spec.Autoscaling != nil && mcpServer.Spec.EndpointRef != nil
+}
+
+// NewScaledObject returns an empty ScaledObject with the name and namespace of the MCPServer
+func NewScaledObject(mcpServer *mcpgatewayv1alpha1.MCPServer) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(ScaledObjectGVK)
+	obj.SetNamespace(mcpServer.Namespace)
+	obj.SetName(mcpServer.Name)
+	return obj
+}
+
+// SetScaledObjectSpec sets the spec of obj so that KEDA scales the workload referenced by
+// the MCPServer on its gateway request rate, read from Prometheus at prometheusAddress
+func SetScaledObjectSpec(obj *unstructured.Unstructured, mcpServer *mcpgatewayv1alpha1.MCPServer,
+	prometheusAddress string) error {
+	if !Enabled(mcpServer) {
+		return fmt.Errorf("autoscaling requires both spec.autoscaling and spec.endpointRef")
+	}
+
+	ref := mcpServer.Spec.EndpointRef
+	kind := ref.Kind
+	if kind == "" {
+		kind = defaultWorkloadKind
+	}
+
+	scaling := mcpServer.Spec.Autoscaling
+	minReplicas := defaultMinReplicas
+	if scaling.MinReplicas != nil {
+		minReplicas = *scaling.MinReplicas
+	}
+	if minReplicas > scaling.MaxReplicas {
+		return fmt.Errorf("minReplicas (%d) exceeds maxReplicas (%d)", minReplicas, scaling.MaxReplicas)
+	}
+
+	spec := map[string]any{
+		"scaleTargetRef": map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       kind,
+			"name":       ref.Name,
+		},
+		"minReplicaCount": int64(minReplicas),
+		"maxReplicaCount": int64(scaling.MaxReplicas),
+		"triggers": []any{
+			map[string]any{
+				"type": "prometheus",
+				"metadata": map[string]any{
+					"serverAddress": prometheusAddress,
+					"query":         RequestRateQuery(mcpServer),
+					"threshold":     strconv.Itoa(int(scaling.TargetRequestsPerSecond)),
+				},
+			},
+		},
+	}
+	return unstructured.SetNestedField(obj.Object, spec, "spec")
+}
+
+// RequestRateQuery returns the PromQL query for the gateway request rate of the MCPServer
+func RequestRateQuery(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	return fmt.Sprintf(`sum(%s{namespace=%q,name=%q})`, requestRateMetric, mcpServer.Namespace, mcpServer.Name)
+}
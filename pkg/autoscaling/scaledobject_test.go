@@ -0,0 +1,108 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func TestSetScaledObjectSpec(t *testing.T) {
+	tests := []struct {
+		name            string
+		endpointRef     *mcpgatewayv1alpha1.WorkloadReference
+		autoscaling     *mcpgatewayv1alpha1.AutoscalingSpec
+		wantErr         bool
+		wantKind        string
+		wantMinReplicas int64
+	}{
+		{
+			name:            "defaults kind and min replicas",
+			endpointRef:     &mcpgatewayv1alpha1.WorkloadReference{Name: "backend"},
+			autoscaling:     &mcpgatewayv1alpha1.AutoscalingSpec{MaxReplicas: 5, TargetRequestsPerSecond: 20},
+			wantKind:        "Deployment",
+			wantMinReplicas: 1,
+		},
+		{
+			name:        "explicit kind and min replicas",
+			endpointRef: &mcpgatewayv1alpha1.WorkloadReference{Kind: "StatefulSet", Name: "backend"},
+			autoscaling: &mcpgatewayv1alpha1.AutoscalingSpec{
+				MinReplicas: int32Ptr(0), MaxReplicas: 5, TargetRequestsPerSecond: 20,
+			},
+			wantKind:        "StatefulSet",
+			wantMinReplicas: 0,
+		},
+		{
+			name:        "min replicas above max",
+			endpointRef: &mcpgatewayv1alpha1.WorkloadReference{Name: "backend"},
+			autoscaling: &mcpgatewayv1alpha1.AutoscalingSpec{
+				MinReplicas: int32Ptr(6), MaxReplicas: 5, TargetRequestsPerSecond: 20,
+			},
+			wantErr: true,
+		},
+		{
+			name:        "missing endpoint reference",
+			autoscaling: &mcpgatewayv1alpha1.AutoscalingSpec{MaxReplicas: 5, TargetRequestsPerSecond: 20},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mcpServer := &mcpgatewayv1alpha1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-server", Namespace: "default"},
+				Spec: mcpgatewayv1alpha1.MCPServerSpec{
+					EndpointRef: tt.endpointRef,
+					Autoscaling: tt.autoscaling,
+				},
+			}
+
+			obj := NewScaledObject(mcpServer)
+			err := SetScaledObjectSpec(obj, mcpServer, "http://prometheus:9090")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, ScaledObjectGVK, obj.GroupVersionKind())
+			kind, _, _ := unstructured.NestedString(obj.Object, "spec", "scaleTargetRef", "kind")
+			assert.Equal(t, tt.wantKind, kind)
+			minReplicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "minReplicaCount")
+			assert.Equal(t, tt.wantMinReplicas, minReplicas)
+			maxReplicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "maxReplicaCount")
+			assert.Equal(t, int64(5), maxReplicas)
+
+			triggers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "triggers")
+			require.Len(t, triggers, 1)
+			metadata := triggers[0].(map[string]any)["metadata"].(map[string]any)
+			assert.Equal(t, "20", metadata["threshold"])
+			assert.Equal(t, `sum(mcpgateway_target_requests_per_second{namespace="default",name="test-server"})`,
+				metadata["query"])
+		})
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// lastExportTimestamp is the time of the last snapshot written successfully
+var lastExportTimestamp = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "mcpgateway_backup_last_export_timestamp_seconds",
+		Help: "Unix time of the last snapshot of AWS resource identifiers written successfully",
+	},
+)
+
+func init() {
+	metrics.Registry.MustRegister(lastExportTimestamp)
+}
+
+// Exporter periodically writes a snapshot of the managed resources to a file, e.g. on a
+// persistent volume that outlives the cluster
+type Exporter struct {
+	client    client.Client
+	path      string
+	interval  time.Duration
+	clusterID string
+	logger    logr.Logger
+}
+
+// NewExporter creates a new Exporter writing a snapshot to path every interval
+func NewExporter(c client.Client, path string, interval time.Duration, clusterID string, logger logr.Logger) *Exporter {
+	return &Exporter{
+		client:    c,
+		path:      path,
+		interval:  interval,
+		clusterID: clusterID,
+		logger:    logger,
+	}
+}
+
+// Start runs the export loop until ctx is cancelled. It implements manager.Runnable.
+func (e *Exporter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Export(ctx); err != nil {
+			e.logger.Error(err, "Failed to export snapshot", "path", e.path)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable so only the leader writes the snapshot
+func (e *Exporter) NeedLeaderElection() bool {
+	return true
+}
+
+// Export writes a snapshot of the current state. AgentCoreStacks are skipped if their CRD is
+// not installed or the operator may not read them, as their controller is then not enabled.
+func (e *Exporter) Export(ctx context.Context) error {
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := e.client.List(ctx, mcpServers); err != nil {
+		return fmt.Errorf("failed to list MCPServers: %w", err)
+	}
+	stacks := &mcpgatewayv1alpha1.AgentCoreStackList{}
+	if err := e.client.List(ctx, stacks); err != nil && !meta.IsNoMatchError(err) && !apierrors.IsForbidden(err) {
+		return fmt.Errorf("failed to list AgentCoreStacks: %w", err)
+	}
+
+	now := time.Now()
+	snapshot, err := Build(mcpServers.Items, stacks.Items, e.clusterID, now)
+	if err != nil {
+		return err
+	}
+	if err := WriteFile(e.path, snapshot); err != nil {
+		return err
+	}
+
+	lastExportTimestamp.Set(float64(now.Unix()))
+	e.logger.V(1).Info("Exported snapshot", "path", e.path,
+		"mcpServers", len(snapshot.MCPServers), "agentCoreStacks", len(snapshot.AgentCoreStacks))
+	return nil
+}
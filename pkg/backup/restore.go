@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// Outcome is the result of restoring a single record
+type Outcome string
+
+const (
+	// OutcomeRestored means the AWS identifiers were written to the resource status
+	OutcomeRestored Outcome = "Restored"
+	// OutcomeSpecChanged means the AWS identifiers were restored, but the spec differs from the
+	// one in the snapshot, so the operator will update the AWS resources to the new spec
+	OutcomeSpecChanged Outcome = "SpecChanged"
+	// OutcomeAlreadyLinked means the resource already records AWS identifiers and was left alone
+	OutcomeAlreadyLinked Outcome = "AlreadyLinked"
+	// OutcomeNotFound means the resource has not been recreated in the cluster
+	OutcomeNotFound Outcome = "NotFound"
+)
+
+// RestoredResource is the outcome of restoring the record of one resource
+type RestoredResource struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Outcome   Outcome
+}
+
+// Restore writes the AWS identifiers in the snapshot to the status of the recreated resources
+// with the same namespace and name, so that the operator adopts the existing AWS resources.
+// Resources that already record AWS identifiers are never overwritten, which makes restoring
+// the same snapshot twice safe. It must run before the controllers reconcile the resources,
+// or they create new AWS resources instead.
+func Restore(ctx context.Context, c client.Client, snapshot *Snapshot, logger logr.Logger) ([]RestoredResource, error) {
+	var restored []RestoredResource
+
+	for _, record := range snapshot.MCPServers {
+		outcome, err := restoreMCPServer(ctx, c, record)
+		if err != nil {
+			return restored, fmt.Errorf("failed to restore MCPServer %s/%s: %w", record.Namespace, record.Name, err)
+		}
+		logger.Info("Restored MCPServer", "namespace", record.Namespace, "name", record.Name,
+			"targetId", record.TargetID, "outcome", outcome)
+		restored = append(restored, RestoredResource{
+			Kind: "MCPServer", Namespace: record.Namespace, Name: record.Name, Outcome: outcome,
+		})
+	}
+
+	for _, record := range snapshot.AgentCoreStacks {
+		outcome, err := restoreStack(ctx, c, record)
+		if err != nil {
+			return restored, fmt.Errorf("failed to restore AgentCoreStack %s/%s: %w", record.Namespace, record.Name, err)
+		}
+		logger.Info("Restored AgentCoreStack", "namespace", record.Namespace, "name", record.Name,
+			"gatewayId", record.GatewayID, "outcome", outcome)
+		restored = append(restored, RestoredResource{
+			Kind: "AgentCoreStack", Namespace: record.Namespace, Name: record.Name, Outcome: outcome,
+		})
+	}
+	return restored, nil
+}
+
+// restoreMCPServer links a recreated MCPServer to its gateway target
+func restoreMCPServer(ctx context.Context, c client.Client, record MCPServerRecord) (Outcome, error) {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: record.Namespace, Name: record.Name}, mcpServer); err != nil {
+		if apierrors.IsNotFound(err) {
+			return OutcomeNotFound, nil
+		}
+		return "", err
+	}
+	if mcpServer.Status.TargetID != "" {
+		return OutcomeAlreadyLinked, nil
+	}
+
+	mcpServer.Status.TargetID = record.TargetID
+	mcpServer.Status.GatewayID = record.GatewayID
+	mcpServer.Status.GatewayArn = record.GatewayArn
+	if err := c.Status().Update(ctx, mcpServer); err != nil {
+		return "", err
+	}
+	return specOutcome(mcpServer.Spec, record.SpecHash)
+}
+
+// restoreStack links a recreated AgentCoreStack to its gateway and credential providers
+func restoreStack(ctx context.Context, c client.Client, record StackRecord) (Outcome, error) {
+	stack := &mcpgatewayv1alpha1.AgentCoreStack{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: record.Namespace, Name: record.Name}, stack); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return OutcomeNotFound, nil
+		}
+		return "", err
+	}
+	if stack.Status.GatewayID != "" || len(stack.Status.CredentialProviders) > 0 {
+		return OutcomeAlreadyLinked, nil
+	}
+
+	stack.Status.GatewayID = record.GatewayID
+	stack.Status.GatewayArn = record.GatewayArn
+	stack.Status.GatewayURL = record.GatewayURL
+	stack.Status.CredentialProviders = record.CredentialProviders
+	if err := c.Status().Update(ctx, stack); err != nil {
+		return "", err
+	}
+	return specOutcome(stack.Spec, record.SpecHash)
+}
+
+// specOutcome reports whether the spec of a restored resource still matches the snapshot
+func specOutcome(spec any, specHash string) (Outcome, error) {
+	hash, err := SpecHash(spec)
+	if err != nil {
+		return "", err
+	}
+	if hash != specHash {
+		return OutcomeSpecChanged, nil
+	}
+	return OutcomeRestored, nil
+}
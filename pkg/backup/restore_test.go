@@ -0,0 +1,109 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestRestore(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	unchangedSpec := mcpgatewayv1alpha1.MCPServerSpec{Endpoint: "https://weather.example.com"}
+	unchangedHash, err := SpecHash(unchangedSpec)
+	require.NoError(t, err)
+
+	unchanged := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "default"},
+		Spec:       unchangedSpec,
+	}
+	changed := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "search", Namespace: "default"},
+		Spec:       mcpgatewayv1alpha1.MCPServerSpec{Endpoint: "https://search-v2.example.com"},
+	}
+	linked := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "linked", Namespace: "default"},
+		Status:     mcpgatewayv1alpha1.MCPServerStatus{TargetID: "NEW"},
+	}
+	stack := &mcpgatewayv1alpha1.AgentCoreStack{
+		ObjectMeta: metav1.ObjectMeta{Name: "platform", Namespace: "infra"},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(unchanged, changed, linked, stack).
+		WithStatusSubresource(unchanged, changed, linked, stack).
+		Build()
+
+	snapshot := &Snapshot{
+		Version: SnapshotVersion,
+		MCPServers: []MCPServerRecord{
+			{Namespace: "default", Name: "weather", SpecHash: unchangedHash, GatewayID: "gw-1", TargetID: "T1"},
+			{Namespace: "default", Name: "search", SpecHash: unchangedHash, GatewayID: "gw-1", TargetID: "T2"},
+			{Namespace: "default", Name: "linked", SpecHash: unchangedHash, GatewayID: "gw-1", TargetID: "T3"},
+			{Namespace: "default", Name: "gone", SpecHash: unchangedHash, GatewayID: "gw-1", TargetID: "T4"},
+		},
+		AgentCoreStacks: []StackRecord{
+			{Namespace: "infra", Name: "platform", GatewayID: "gw-1", GatewayArn: "arn:gw-1"},
+		},
+	}
+
+	ctx := context.Background()
+	restored, err := Restore(ctx, fakeClient, snapshot, logr.Discard())
+	require.NoError(t, err)
+
+	outcomes := map[string]Outcome{}
+	for _, r := range restored {
+		outcomes[r.Kind+"/"+r.Name] = r.Outcome
+	}
+	assert.Equal(t, map[string]Outcome{
+		"MCPServer/weather":       OutcomeRestored,
+		"MCPServer/search":        OutcomeSpecChanged,
+		"MCPServer/linked":        OutcomeAlreadyLinked,
+		"MCPServer/gone":          OutcomeNotFound,
+		"AgentCoreStack/platform": OutcomeSpecChanged,
+	}, outcomes)
+
+	got := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "weather"}, got))
+	assert.Equal(t, "T1", got.Status.TargetID)
+	assert.Equal(t, "gw-1", got.Status.GatewayID)
+
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "linked"}, got))
+	assert.Equal(t, "NEW", got.Status.TargetID)
+
+	gotStack := &mcpgatewayv1alpha1.AgentCoreStack{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: "infra", Name: "platform"}, gotStack))
+	assert.Equal(t, "gw-1", gotStack.Status.GatewayID)
+	assert.Equal(t, "arn:gw-1", gotStack.Status.GatewayArn)
+
+	// Restoring again leaves the linked resources alone
+	restored, err = Restore(ctx, fakeClient, snapshot, logr.Discard())
+	require.NoError(t, err)
+	assert.Equal(t, OutcomeAlreadyLinked, restored[0].Outcome)
+}
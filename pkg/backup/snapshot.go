@@ -0,0 +1,203 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// SnapshotVersion is the version of the snapshot format written by this operator
+const SnapshotVersion = 1
+
+// Snapshot records the AWS identifiers of every managed resource in a cluster
+type Snapshot struct {
+	// Version is the snapshot format version
+	Version int `json:"version"`
+	// ClusterID identifies the cluster the snapshot was taken from, if configured
+	ClusterID string `json:"clusterId,omitempty"`
+	// ExportedAt is the time the snapshot was taken
+	ExportedAt time.Time `json:"exportedAt"`
+	// MCPServers are the MCPServers with a gateway target
+	MCPServers []MCPServerRecord `json:"mcpServers"`
+	// AgentCoreStacks are the AgentCoreStacks with a gateway or credential providers
+	AgentCoreStacks []StackRecord `json:"agentCoreStacks"`
+}
+
+// MCPServerRecord links an MCPServer to its gateway target
+type MCPServerRecord struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// SpecHash is the hash of the spec the gateway target was last reconciled from
+	SpecHash   string `json:"specHash"`
+	GatewayID  string `json:"gatewayId,omitempty"`
+	GatewayArn string `json:"gatewayArn,omitempty"`
+	TargetID   string `json:"targetId"`
+}
+
+// StackRecord links an AgentCoreStack to its gateway and credential providers
+type StackRecord struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// SpecHash is the hash of the spec the stack was last reconciled from
+	SpecHash            string                                             `json:"specHash"`
+	GatewayID           string                                             `json:"gatewayId,omitempty"`
+	GatewayArn          string                                             `json:"gatewayArn,omitempty"`
+	GatewayURL          string                                             `json:"gatewayUrl,omitempty"`
+	CredentialProviders []mcpgatewayv1alpha1.StackCredentialProviderStatus `json:"credentialProviders,omitempty"`
+}
+
+// SpecHash returns a hash of the JSON encoding of a spec. MCPServer specs are hashed in the form
+// they are sent to AWS, see bedrock.NormalizeSpec, so formatting changes do not change the hash.
+func SpecHash(spec any) (string, error) {
+	if mcpServerSpec, ok := spec.(mcpgatewayv1alpha1.MCPServerSpec); ok {
+		spec = bedrock.NormalizeSpec(mcpServerSpec)
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode spec: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// Build takes a snapshot of the given resources. Resources without AWS identifiers are left
+// out. Records are sorted by namespace and name.
+func Build(
+	mcpServers []mcpgatewayv1alpha1.MCPServer,
+	stacks []mcpgatewayv1alpha1.AgentCoreStack,
+	clusterID string,
+	now time.Time,
+) (*Snapshot, error) {
+	snapshot := &Snapshot{
+		Version:         SnapshotVersion,
+		ClusterID:       clusterID,
+		ExportedAt:      now.UTC(),
+		MCPServers:      []MCPServerRecord{},
+		AgentCoreStacks: []StackRecord{},
+	}
+
+	for i := range mcpServers {
+		mcpServer := &mcpServers[i]
+		if mcpServer.Status.TargetID == "" {
+			continue
+		}
+		hash, err := SpecHash(mcpServer.Spec)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.MCPServers = append(snapshot.MCPServers, MCPServerRecord{
+			Namespace:  mcpServer.Namespace,
+			Name:       mcpServer.Name,
+			SpecHash:   hash,
+			GatewayID:  mcpServer.Status.GatewayID,
+			GatewayArn: mcpServer.Status.GatewayArn,
+			TargetID:   mcpServer.Status.TargetID,
+		})
+	}
+
+	for i := range stacks {
+		stack := &stacks[i]
+		if stack.Status.GatewayID == "" && len(stack.Status.CredentialProviders) == 0 {
+			continue
+		}
+		hash, err := SpecHash(stack.Spec)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.AgentCoreStacks = append(snapshot.AgentCoreStacks, StackRecord{
+			Namespace:           stack.Namespace,
+			Name:                stack.Name,
+			SpecHash:            hash,
+			GatewayID:           stack.Status.GatewayID,
+			GatewayArn:          stack.Status.GatewayArn,
+			GatewayURL:          stack.Status.GatewayURL,
+			CredentialProviders: stack.Status.CredentialProviders,
+		})
+	}
+
+	sort.Slice(snapshot.MCPServers, func(i, j int) bool {
+		return less(snapshot.MCPServers[i].Namespace, snapshot.MCPServers[i].Name,
+			snapshot.MCPServers[j].Namespace, snapshot.MCPServers[j].Name)
+	})
+	sort.Slice(snapshot.AgentCoreStacks, func(i, j int) bool {
+		return less(snapshot.AgentCoreStacks[i].Namespace, snapshot.AgentCoreStacks[i].Name,
+			snapshot.AgentCoreStacks[j].Namespace, snapshot.AgentCoreStacks[j].Name)
+	})
+	return snapshot, nil
+}
+
+// less orders resources by namespace and name
+func less(namespaceA, nameA, namespaceB, nameB string) bool {
+	if namespaceA != namespaceB {
+		return namespaceA < namespaceB
+	}
+	return nameA < nameB
+}
+
+// WriteFile writes the snapshot to path. The file is replaced atomically, so a crash while
+// writing never leaves a truncated snapshot behind.
+func WriteFile(path string, snapshot *Snapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // the file is gone after a successful rename
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close() //nolint:errcheck // the write error is reported
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// ReadFile reads a snapshot written by WriteFile
+func ReadFile(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot %s: %w", path, err)
+	}
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("snapshot %s has version %d, this operator reads version %d",
+			path, snapshot.Version, SnapshotVersion)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestBuild(t *testing.T) {
+	mcpServers := []mcpgatewayv1alpha1.MCPServer{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "team-b"},
+			Spec:       mcpgatewayv1alpha1.MCPServerSpec{Endpoint: "https://weather.example.com"},
+			Status:     mcpgatewayv1alpha1.MCPServerStatus{TargetID: "T2", GatewayID: "gw-1"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "search", Namespace: "team-a"},
+			Spec:       mcpgatewayv1alpha1.MCPServerSpec{Endpoint: "https://search.example.com"},
+			Status:     mcpgatewayv1alpha1.MCPServerStatus{TargetID: "T1", GatewayID: "gw-1"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "team-a"},
+		},
+	}
+	stacks := []mcpgatewayv1alpha1.AgentCoreStack{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform", Namespace: "infra"},
+			Status: mcpgatewayv1alpha1.AgentCoreStackStatus{
+				GatewayID: "gw-1",
+				CredentialProviders: []mcpgatewayv1alpha1.StackCredentialProviderStatus{
+					{Name: "okta", Arn: "arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/oauth2credentialprovider/okta"},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "infra"},
+		},
+	}
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	snapshot, err := Build(mcpServers, stacks, "prod-east", now)
+	require.NoError(t, err)
+
+	assert.Equal(t, SnapshotVersion, snapshot.Version)
+	assert.Equal(t, "prod-east", snapshot.ClusterID)
+	assert.Equal(t, now, snapshot.ExportedAt)
+	require.Len(t, snapshot.MCPServers, 2)
+	assert.Equal(t, "search", snapshot.MCPServers[0].Name)
+	assert.Equal(t, "T1", snapshot.MCPServers[0].TargetID)
+	assert.Equal(t, "weather", snapshot.MCPServers[1].Name)
+	require.Len(t, snapshot.AgentCoreStacks, 1)
+	assert.Equal(t, "gw-1", snapshot.AgentCoreStacks[0].GatewayID)
+	assert.Len(t, snapshot.AgentCoreStacks[0].CredentialProviders, 1)
+
+	hash, err := SpecHash(mcpServers[1].Spec)
+	require.NoError(t, err)
+	assert.Equal(t, hash, snapshot.MCPServers[0].SpecHash)
+}
+
+func TestSpecHash(t *testing.T) {
+	a, err := SpecHash(mcpgatewayv1alpha1.MCPServerSpec{Endpoint: "https://a.example.com"})
+	require.NoError(t, err)
+	b, err := SpecHash(mcpgatewayv1alpha1.MCPServerSpec{Endpoint: "https://b.example.com"})
+	require.NoError(t, err)
+	again, err := SpecHash(mcpgatewayv1alpha1.MCPServerSpec{Endpoint: "https://a.example.com"})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, a, b)
+	assert.Equal(t, a, again)
+	assert.Contains(t, a, "sha256:")
+}
+
+func TestSpecHash_Normalized(t *testing.T) {
+	a, err := SpecHash(mcpgatewayv1alpha1.MCPServerSpec{
+		Endpoint:              "https://a.example.com/mcp",
+		AllowedRequestHeaders: []string{"x-tenant-id"},
+	})
+	require.NoError(t, err)
+	formatted, err := SpecHash(mcpgatewayv1alpha1.MCPServerSpec{
+		Endpoint:              " HTTPS://A.example.com/mcp ",
+		AllowedRequestHeaders: []string{"X-TENANT-ID "},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, a, formatted)
+}
+
+func TestWriteFileReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	snapshot := &Snapshot{
+		Version:    SnapshotVersion,
+		ExportedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		MCPServers: []MCPServerRecord{{Namespace: "default", Name: "weather", TargetID: "T1"}},
+	}
+
+	require.NoError(t, WriteFile(path, snapshot))
+	// Overwriting leaves no temporary files behind
+	require.NoError(t, WriteFile(path, snapshot))
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	read, err := ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot.MCPServers, read.MCPServers)
+	assert.Equal(t, snapshot.ExportedAt, read.ExportedAt)
+}
+
+func TestReadFile_UnknownVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 2}`), 0o600))
+
+	_, err := ReadFile(path)
+	assert.ErrorContains(t, err, "version 2")
+}
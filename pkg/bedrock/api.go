@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+)
+
+// GatewayTargetAPI is the part of the AgentCore control plane API called by the operator: gateway
+// targets, and the gateways, credential providers and token vault of AgentCoreStacks. It is
+// implemented by *bedrockagentcorecontrol.Client, and can be implemented by mocks in unit tests,
+// simulators, or clients routing calls to different accounts.
+type GatewayTargetAPI interface {
+	CreateGatewayTarget(ctx context.Context, params *bedrockagentcorecontrol.CreateGatewayTargetInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.CreateGatewayTargetOutput, error)
+	GetGatewayTarget(ctx context.Context, params *bedrockagentcorecontrol.GetGatewayTargetInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.GetGatewayTargetOutput, error)
+	ListGatewayTargets(ctx context.Context, params *bedrockagentcorecontrol.ListGatewayTargetsInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.ListGatewayTargetsOutput, error)
+	UpdateGatewayTarget(ctx context.Context, params *bedrockagentcorecontrol.UpdateGatewayTargetInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.UpdateGatewayTargetOutput, error)
+	DeleteGatewayTarget(ctx context.Context, params *bedrockagentcorecontrol.DeleteGatewayTargetInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.DeleteGatewayTargetOutput, error)
+
+	CreateGateway(ctx context.Context, params *bedrockagentcorecontrol.CreateGatewayInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.CreateGatewayOutput, error)
+	GetGateway(ctx context.Context, params *bedrockagentcorecontrol.GetGatewayInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.GetGatewayOutput, error)
+	DeleteGateway(ctx context.Context, params *bedrockagentcorecontrol.DeleteGatewayInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.DeleteGatewayOutput, error)
+
+	CreateOauth2CredentialProvider(ctx context.Context, params *bedrockagentcorecontrol.CreateOauth2CredentialProviderInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.CreateOauth2CredentialProviderOutput, error)
+	GetOauth2CredentialProvider(ctx context.Context, params *bedrockagentcorecontrol.GetOauth2CredentialProviderInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.GetOauth2CredentialProviderOutput, error)
+	DeleteOauth2CredentialProvider(ctx context.Context, params *bedrockagentcorecontrol.DeleteOauth2CredentialProviderInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.DeleteOauth2CredentialProviderOutput, error)
+	GetTokenVault(ctx context.Context, params *bedrockagentcorecontrol.GetTokenVaultInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.GetTokenVaultOutput, error)
+	SetTokenVaultCMK(ctx context.Context, params *bedrockagentcorecontrol.SetTokenVaultCMKInput,
+		optFns ...func(*bedrockagentcorecontrol.Options)) (*bedrockagentcorecontrol.SetTokenVaultCMKOutput, error)
+}
+
+// Ensure the SDK client satisfies GatewayTargetAPI
+var _ GatewayTargetAPI = &bedrockagentcorecontrol.Client{}
+
+// credentialsOf returns the credentials provider of api if it exposes its SDK options, as the SDK
+// client does, or nil otherwise
+func credentialsOf(api GatewayTargetAPI) aws.CredentialsProvider {
+	client, ok := api.(interface {
+		Options() bedrockagentcorecontrol.Options
+	})
+	if !ok {
+		return nil
+	}
+	return client.Options().Credentials
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubGatewayTargetAPI implements GetGatewayTarget and panics on any other call
+type stubGatewayTargetAPI struct {
+	GatewayTargetAPI
+	calls int
+	errs  []error
+}
+
+func (s *stubGatewayTargetAPI) GetGatewayTarget(
+	_ context.Context,
+	params *bedrockagentcorecontrol.GetGatewayTargetInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.GetGatewayTargetOutput, error) {
+	s.calls++
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+		return nil, err
+	}
+	return &bedrockagentcorecontrol.GetGatewayTargetOutput{
+		GatewayArn: aws.String("arn:aws:bedrock-agentcore:us-west-2:123456789012:gateway/" + aws.ToString(params.GatewayIdentifier)),
+		TargetId:   params.TargetId,
+		Name:       aws.String("weather"),
+	}, nil
+}
+
+func TestWrapperWithStubAPI(t *testing.T) {
+	stub := &stubGatewayTargetAPI{errs: []error{&smithy.GenericAPIError{Code: "ResourceNotFoundException"}}}
+	wrapper := NewBedrockClientWrapper(stub, logr.Discard())
+
+	_, err := wrapper.GetGatewayTarget(context.Background(), "gw-1", "TARGET1")
+	require.Error(t, err)
+	assert.True(t, IsResourceNotFoundError(err))
+
+	output, err := wrapper.GetGatewayTarget(context.Background(), "gw-1", "TARGET1")
+	require.NoError(t, err)
+	assert.Equal(t, "TARGET1", aws.ToString(output.TargetId))
+	assert.Equal(t, 2, stub.calls)
+}
+
+func TestGetGatewayTarget_NotFoundIsNotLoggedAsError(t *testing.T) {
+	stub := &stubGatewayTargetAPI{errs: []error{
+		&smithy.GenericAPIError{Code: "ResourceNotFoundException"},
+		&smithy.GenericAPIError{Code: "AccessDeniedException"},
+	}}
+	var logged []string
+	logger := funcr.New(func(prefix, args string) { logged = append(logged, args) }, funcr.Options{})
+	wrapper := NewBedrockClientWrapper(stub, logger)
+
+	_, err := wrapper.GetGatewayTarget(context.Background(), "gw-1", "TARGET1")
+	assert.True(t, IsResourceNotFoundError(err))
+	assert.Empty(t, logged)
+
+	_, err = wrapper.GetGatewayTarget(context.Background(), "gw-1", "TARGET1")
+	assert.True(t, IsAccessDeniedError(err))
+	require.Len(t, logged, 1)
+	assert.Contains(t, logged[0], "Failed to get gateway target")
+}
+
+func TestCredentialsOf(t *testing.T) {
+	assert.Nil(t, credentialsOf(&stubGatewayTargetAPI{}), "implementations without SDK options have no credentials")
+
+	provider := aws.NewCredentialsCache(aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+	}))
+	client := bedrockagentcorecontrol.New(bedrockagentcorecontrol.Options{Region: "us-west-2", Credentials: provider})
+	assert.True(t, invalidateCredentials(credentialsOf(client)), "the credentials cache of the SDK client is found")
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+)
+
+const (
+	// userAgentProduct is the user-agent key identifying calls made by the operator
+	userAgentProduct = "agentcore-operator"
+
+	// userAgentCluster is the user-agent key identifying the cluster the operator runs in
+	userAgentCluster = "cluster"
+
+	// userAgentResource is the user-agent key identifying the MCPServer a call was made for
+	userAgentResource = "mcpserver"
+
+	// userAgentStack is the user-agent key identifying the AgentCoreStack a call was made for
+	userAgentStack = "agentcorestack"
+)
+
+type attributionKey struct{}
+
+// attributed is the resource a context is attributed to
+type attributed struct {
+	// kind is the Kubernetes kind of the resource and key the user-agent key naming it
+	kind     string
+	key      string
+	resource string
+}
+
+type tenantKey struct{}
+
+// WithUserAgent returns a client option that appends
+// "agentcore-operator/<version> cluster/<clusterID>" to the SDK user-agent so that
+// operator calls can be told apart from other SDK users in CloudTrail.
+// The cluster component is omitted when clusterID is empty.
+func WithUserAgent(version, clusterID string) func(*bedrockagentcorecontrol.Options) {
+	return func(o *bedrockagentcorecontrol.Options) {
+		if version == "" {
+			version = "dev"
+		}
+		o.APIOptions = append(o.APIOptions, awsmiddleware.AddUserAgentKeyValue(userAgentProduct, version))
+		if clusterID != "" {
+			o.APIOptions = append(o.APIOptions, awsmiddleware.AddUserAgentKeyValue(userAgentCluster, clusterID))
+		}
+	}
+}
+
+// WithAttribution returns a context that tags every AWS call made through the
+// BedrockClientWrapper with the given namespace and name. The tag is sent as a
+// "mcpserver/<namespace>.<name>" user-agent component, which CloudTrail records
+// alongside the API call.
+func WithAttribution(ctx context.Context, namespace, name string) context.Context {
+	return context.WithValue(ctx, attributionKey{},
+		attributed{kind: "MCPServer", key: userAgentResource, resource: ResourceKey(namespace, name)})
+}
+
+// WithStackAttribution is WithAttribution for calls made on behalf of an AgentCoreStack.
+// The tag is sent as an "agentcorestack/<namespace>.<name>" user-agent component so that
+// the calls of a stack cannot be mistaken for those of an MCPServer with the same name.
+func WithStackAttribution(ctx context.Context, namespace, name string) context.Context {
+	return context.WithValue(ctx, attributionKey{},
+		attributed{kind: "AgentCoreStack", key: userAgentStack, resource: ResourceKey(namespace, name)})
+}
+
+// ResourceKey returns the attribution of the resource with the given namespace and name,
+// which also identifies the resource in a CallBudget
+func ResourceKey(namespace, name string) string {
+	return namespace + "." + name
+}
+
+// attribution returns the attribution stored in ctx, or an empty string if there is none
+func attribution(ctx context.Context) string {
+	a, _ := ctx.Value(attributionKey{}).(attributed)
+	return a.resource
+}
+
+// WithTenant returns a context that charges every AWS call made through the
+// BedrockClientWrapper to the FairShare of the given tenant
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// tenantOf returns the tenant stored in ctx, or an empty string if there is none
+func tenantOf(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantKey{}).(string)
+	return tenant
+}
+
+// attributionOptions returns the per-call options carrying the attribution
+// stored in ctx, or nil if the context carries none
+func attributionOptions(ctx context.Context) []func(*bedrockagentcorecontrol.Options) {
+	a, _ := ctx.Value(attributionKey{}).(attributed)
+	if a.resource == "" {
+		return nil
+	}
+
+	return []func(*bedrockagentcorecontrol.Options){
+		func(o *bedrockagentcorecontrol.Options) {
+			o.APIOptions = append(o.APIOptions, awsmiddleware.AddUserAgentKeyValue(a.key, a.resource))
+		},
+	}
+}
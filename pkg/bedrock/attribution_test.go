@@ -0,0 +1,69 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserAgentAttribution(t *testing.T) {
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"gatewayId":"gw-1","status":"READY"}`))
+	}))
+	defer server.Close()
+
+	client := bedrockagentcorecontrol.New(bedrockagentcorecontrol.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(server.URL),
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+		}),
+	}, WithUserAgent("1.2.3", "prod-eu"))
+	wrapper := NewBedrockClientWrapper(client, logr.Discard())
+
+	_, err := wrapper.GetGateway(WithAttribution(context.Background(), "default", "weather"), "gw-1")
+	require.NoError(t, err)
+	_, err = wrapper.GetGatewayTarget(context.Background(), "gw-1", "TARGET1")
+	require.NoError(t, err)
+	_, err = wrapper.GetGateway(WithStackAttribution(context.Background(), "default", "weather"), "gw-1")
+	require.NoError(t, err)
+
+	require.Len(t, userAgents, 3)
+	assert.Contains(t, userAgents[0], "agentcore-operator/1.2.3")
+	assert.Contains(t, userAgents[0], "cluster/prod-eu")
+	assert.Contains(t, userAgents[0], "mcpserver/default.weather")
+
+	// Calls without attribution carry the operator components only
+	assert.Contains(t, userAgents[1], "agentcore-operator/1.2.3")
+	assert.NotContains(t, userAgents[1], "mcpserver/")
+
+	// Calls of a stack are attributed to the stack rather than to an MCPServer of the same name
+	assert.Contains(t, userAgents[2], "agentcorestack/default.weather")
+	assert.NotContains(t, userAgents[2], "mcpserver/")
+}
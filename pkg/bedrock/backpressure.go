@@ -0,0 +1,108 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultBackpressureWindow is the window over which Backpressure counts throttled calls
+const DefaultBackpressureWindow = time.Minute
+
+// Backpressure turns sustained throttling of the operator's AWS calls into a factor by which the
+// controllers stretch their requeue intervals, so that the whole fleet backs off during an AWS
+// incident instead of every resource retrying at its own pace. Throttling is counted per window:
+// the factor doubles, up to maxFactor, as soon as a window sees threshold throttled calls, and
+// every window that ends with fewer halves it again, down to 1. A nil Backpressure never
+// stretches.
+type Backpressure struct {
+	threshold int
+	window    time.Duration
+	maxFactor int
+	now       func() time.Time
+
+	mu          sync.Mutex
+	factor      int
+	windowStart time.Time
+	throttles   int
+}
+
+// NewBackpressure creates a Backpressure that doubles its factor in every window with threshold
+// throttled calls, up to maxFactor
+func NewBackpressure(threshold int, window time.Duration, maxFactor int) *Backpressure {
+	return &Backpressure{
+		threshold:   max(threshold, 1),
+		window:      window,
+		maxFactor:   max(maxFactor, 1),
+		now:         time.Now,
+		factor:      1,
+		windowStart: time.Now(),
+	}
+}
+
+// RecordThrottle records a call that was throttled, by AWS or by a client-side limit shared by
+// all resources
+func (b *Backpressure) RecordThrottle() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(b.now())
+	b.throttles++
+	if b.throttles == b.threshold {
+		b.factor = min(b.factor*2, b.maxFactor)
+	}
+}
+
+// Factor returns the factor by which requeue intervals are stretched at the moment, 1 without
+// sustained throttling
+func (b *Backpressure) Factor() int {
+	if b == nil {
+		return 1
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(b.now())
+	return b.factor
+}
+
+// Stretch returns interval multiplied by the current factor
+func (b *Backpressure) Stretch(interval time.Duration) time.Duration {
+	return interval * time.Duration(b.Factor())
+}
+
+// advance starts a new window if the current one ended before now, halving the factor for every
+// window that ended without threshold throttled calls. The caller must hold the lock.
+func (b *Backpressure) advance(now time.Time) {
+	elapsed := int(now.Sub(b.windowStart) / b.window)
+	if elapsed <= 0 {
+		return
+	}
+	calm := elapsed
+	if b.throttles >= b.threshold {
+		calm--
+	}
+	for ; calm > 0 && b.factor > 1; calm-- {
+		b.factor /= 2
+	}
+	b.throttles = 0
+	b.windowStart = b.windowStart.Add(time.Duration(elapsed) * b.window)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackpressure(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	backpressure := NewBackpressure(2, time.Minute, 4)
+	backpressure.now = func() time.Time { return now }
+	backpressure.windowStart = now
+
+	backpressure.RecordThrottle()
+	assert.Equal(t, 1, backpressure.Factor(), "a single throttle is not sustained throttling")
+	backpressure.RecordThrottle()
+	assert.Equal(t, 2, backpressure.Factor(), "the factor doubles as soon as throttling is sustained")
+	backpressure.RecordThrottle()
+	assert.Equal(t, 2, backpressure.Factor(), "the factor doubles once per window")
+
+	for range 2 {
+		now = now.Add(time.Minute)
+		backpressure.RecordThrottle()
+		backpressure.RecordThrottle()
+	}
+	assert.Equal(t, 4, backpressure.Factor(), "the factor is capped")
+	assert.Equal(t, 20*time.Minute, backpressure.Stretch(5*time.Minute))
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, 4, backpressure.Factor(), "a throttled window keeps the factor")
+	now = now.Add(time.Minute)
+	assert.Equal(t, 2, backpressure.Factor(), "a calm window halves the factor")
+	now = now.Add(10 * time.Minute)
+	assert.Equal(t, 1, backpressure.Factor())
+}
+
+func TestBackpressure_Nil(t *testing.T) {
+	var backpressure *Backpressure
+	backpressure.RecordThrottle()
+	assert.Equal(t, 1, backpressure.Factor())
+	assert.Equal(t, time.Minute, backpressure.Stretch(time.Minute))
+}
+
+func TestWithBackpressure(t *testing.T) {
+	backpressure := NewBackpressure(1, time.Hour, 8)
+	wrapper := NewBedrockClientWrapper(nil, logr.Discard(), WithBackpressure(backpressure),
+		WithRetryPolicy(RetryPolicy{MaxRetries: 1, BackoffMultiplier: 1}))
+
+	err := wrapper.withRetry(context.Background(), "Test", func(context.Context) error {
+		return &smithy.GenericAPIError{Code: "ThrottlingException"}
+	})
+	require.Error(t, err)
+	assert.Equal(t, 2, backpressure.throttles)
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// CircuitBreaker stops the operator from calling AgentCore while it keeps failing, so that a
+// degraded API is not hammered by the retries of every resource. It opens after threshold
+// consecutive throttled, server-side or timed-out calls, and fails every call right away until
+// cooldown has passed. A single call is then let through as a probe: its success closes the
+// breaker, its failure opens it for another cooldown. Other errors, e.g. validation errors, show
+// that the API works and close the breaker too. A nil CircuitBreaker never opens.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	lastErr  error
+	probing  bool
+}
+
+// NewCircuitBreaker creates a CircuitBreaker that opens after threshold consecutive failures and
+// probes AWS again after cooldown
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: max(threshold, 1),
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// CircuitOpenError is returned instead of calling AWS while the CircuitBreaker is open
+type CircuitOpenError struct {
+	Operation string
+	Failures  int
+	// RetryAfter is how long until the next probe may be made
+	RetryAfter time.Duration
+	// LastErr is the failure that opened the breaker last
+	LastErr error
+}
+
+// Error implements the error interface
+func (e *CircuitOpenError) Error() string {
+	operation := e.Operation
+	if operation == "" {
+		operation = "AWS"
+	}
+	return fmt.Sprintf("not calling %s, AWS failed %d times in a row, next attempt in %s: %v",
+		operation, e.Failures, e.RetryAfter.Round(time.Second), e.LastErr)
+}
+
+// IsCircuitOpenError checks if the error is a CircuitOpenError
+func IsCircuitOpenError(err error) bool {
+	var openErr *CircuitOpenError
+	return errors.As(err, &openErr)
+}
+
+// Allow returns a CircuitOpenError if the breaker is open, or nil if operation may be called. Once
+// the cooldown has passed, the first caller is allowed as the probe; every call allowed must be
+// followed by Record.
+func (b *CircuitBreaker) Allow(operation string) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.check(operation); err != nil {
+		return err
+	}
+	if b.open() {
+		b.probing = true
+	}
+	return nil
+}
+
+// Check returns the CircuitOpenError calls would fail with at the moment, or nil if the next
+// call would be let through. Unlike Allow, it never makes the caller the probe.
+func (b *CircuitBreaker) Check() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.check("")
+}
+
+// check implements Check. The caller must hold the lock.
+func (b *CircuitBreaker) check(operation string) error {
+	if retryAfter := b.retryAfter(b.now()); retryAfter > 0 {
+		return &CircuitOpenError{Operation: operation, Failures: b.failures, RetryAfter: retryAfter, LastErr: b.lastErr}
+	}
+	return nil
+}
+
+// Record records the outcome of a call allowed by Allow. Calls abandoned because their context
+// ended or their call budget ran out say nothing about AWS and only release the probe.
+func (b *CircuitBreaker) Record(err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	probe := b.probing
+	b.probing = false
+	switch {
+	case IsCanceledError(err) || IsBudgetExceededError(err):
+		return
+	case !isServiceFailure(err):
+		b.failures = 0
+		b.lastErr = nil
+		return
+	}
+
+	b.failures++
+	b.lastErr = err
+	if probe || b.failures == b.threshold {
+		b.openedAt = b.now()
+	}
+}
+
+// release lets another call be the probe if the call allowed by Allow is abandoned before it
+// reaches AWS, e.g. because the call budget ran out
+func (b *CircuitBreaker) release() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// Open reports whether the breaker is open, including while a probe is allowed or in flight
+func (b *CircuitBreaker) Open() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.open()
+}
+
+// open implements Open. The caller must hold the lock.
+func (b *CircuitBreaker) open() bool {
+	return b.failures >= b.threshold
+}
+
+// retryAfter returns how long calls are failed right away, zero if the breaker is closed or the
+// next call would be the probe. While a probe is in flight, the other calls wait for another
+// cooldown. The caller must hold the lock.
+func (b *CircuitBreaker) retryAfter(now time.Time) time.Duration {
+	if !b.open() {
+		return 0
+	}
+	if b.probing {
+		return b.cooldown
+	}
+	return max(b.openedAt.Add(b.cooldown).Sub(now), 0)
+}
+
+type circuitBreakerKey struct{}
+
+// WithContextCircuitBreaker returns a context whose AWS calls made through a BedrockClientWrapper
+// are guarded by breaker instead of the wrapper's own circuit breaker, e.g. the breaker of the
+// provider settings of the context's client, see ProviderClients.CircuitBreaker. A nil breaker
+// lets every call through.
+func WithContextCircuitBreaker(ctx context.Context, breaker *CircuitBreaker) context.Context {
+	return context.WithValue(ctx, circuitBreakerKey{}, breaker)
+}
+
+// ContextCircuitBreaker returns the circuit breaker stored in ctx, or fallback if there is none
+func ContextCircuitBreaker(ctx context.Context, fallback *CircuitBreaker) *CircuitBreaker {
+	if breaker, ok := ctx.Value(circuitBreakerKey{}).(*CircuitBreaker); ok {
+		return breaker
+	}
+	return fallback
+}
+
+// breakerFor returns the circuit breaker stored in ctx, or the wrapper's circuit breaker
+func (w *BedrockClientWrapper) breakerFor(ctx context.Context) *CircuitBreaker {
+	return ContextCircuitBreaker(ctx, w.breaker)
+}
+
+// isServiceFailure reports whether err shows that AWS is failing rather than rejecting the call
+func isServiceFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if IsThrottlingError(err) || IsInternalServerError(err) || IsCallTimeoutError(err) {
+		return true
+	}
+	var responseErr *smithyhttp.ResponseError
+	return errors.As(err, &responseErr) && responseErr.HTTPStatusCode() >= http.StatusInternalServerError
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+	unavailable := &smithy.GenericAPIError{Code: "ServiceUnavailableException"}
+
+	require.NoError(t, breaker.Allow("CreateGatewayTarget"))
+	breaker.Record(unavailable)
+	require.NoError(t, breaker.Allow("CreateGatewayTarget"))
+	breaker.Record(&smithy.GenericAPIError{Code: "ValidationException"})
+	assert.False(t, breaker.Open(), "a rejected call resets the failures")
+
+	for range 2 {
+		require.NoError(t, breaker.Allow("CreateGatewayTarget"))
+		breaker.Record(unavailable)
+	}
+	assert.True(t, breaker.Open())
+	err := breaker.Allow("GetGatewayTarget")
+	require.True(t, IsCircuitOpenError(err))
+	var openErr *CircuitOpenError
+	require.True(t, errors.As(err, &openErr))
+	assert.Equal(t, time.Minute, openErr.RetryAfter)
+	assert.Equal(t, 2, openErr.Failures)
+
+	// Probe once the cooldown has passed
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.Check())
+	require.NoError(t, breaker.Allow("GetGatewayTarget"))
+	assert.True(t, IsCircuitOpenError(breaker.Allow("GetGatewayTarget")), "only one probe is in flight")
+	breaker.Record(unavailable)
+	assert.True(t, IsCircuitOpenError(breaker.Check()), "a failed probe opens the breaker again")
+
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.Allow("GetGatewayTarget"))
+	breaker.Record(context.Canceled)
+	require.NoError(t, breaker.Allow("GetGatewayTarget"), "a canceled probe is made again")
+	breaker.Record(nil)
+	assert.False(t, breaker.Open(), "a successful probe closes the breaker")
+}
+
+func TestCircuitBreaker_Nil(t *testing.T) {
+	var breaker *CircuitBreaker
+	require.NoError(t, breaker.Allow("CreateGatewayTarget"))
+	breaker.Record(errors.New("failed"))
+	assert.False(t, breaker.Open())
+	assert.NoError(t, breaker.Check())
+}
+
+func TestWithCircuitBreaker(t *testing.T) {
+	breaker := NewCircuitBreaker(1, time.Hour)
+	backpressure := NewBackpressure(1, time.Hour, 8)
+	wrapper := NewBedrockClientWrapper(nil, logr.Discard(), WithCircuitBreaker(breaker),
+		WithBackpressure(backpressure), WithRetryPolicy(RetryPolicy{MaxRetries: 2, BackoffMultiplier: 1}))
+
+	calls := 0
+	err := wrapper.withRetry(context.Background(), "Test", func(context.Context) error {
+		calls++
+		return &smithy.GenericAPIError{Code: "InternalServerException"}
+	})
+	assert.True(t, IsCircuitOpenError(err), "the retry is failed by the open breaker")
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 2, backpressure.Factor(), "calls failed by the open breaker count as throttling")
+}
+
+func TestWithCircuitBreaker_CallsWithoutRetries(t *testing.T) {
+	breaker := NewCircuitBreaker(1, time.Hour)
+	breaker.Record(&smithy.GenericAPIError{Code: "InternalServerException"})
+	require.True(t, breaker.Open())
+	wrapper := NewBedrockClientWrapper(nil, logr.Discard(), WithCircuitBreaker(breaker))
+
+	// The open breaker fails the calls before they reach AWS
+	_, err := wrapper.GetGatewayTarget(context.Background(), "gw-1", "TARGET1")
+	assert.True(t, IsCircuitOpenError(err))
+	_, err = wrapper.FindGatewayTargetByName(context.Background(), "gw-1", "weather")
+	assert.True(t, IsCircuitOpenError(err))
+}
+
+func TestWithCircuitBreaker_BeforeCallBudget(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(1, time.Minute)
+	breaker.now = func() time.Time { return now }
+	breaker.Record(&smithy.GenericAPIError{Code: "InternalServerException"})
+	budget := NewCallBudget(1, time.Hour)
+	wrapper := NewBedrockClientWrapper(&stubGatewayTargetAPI{}, logr.Discard(), WithCircuitBreaker(breaker),
+		WithCallBudget(budget))
+	ctx := WithAttribution(context.Background(), "default", "weather")
+
+	_, err := wrapper.GetGatewayTarget(ctx, "gw-1", "TARGET1")
+	assert.True(t, IsCircuitOpenError(err))
+	assert.Zero(t, budget.RetryAfter(ResourceKey("default", "weather")), "calls failed by the open breaker are not charged")
+
+	// A probe abandoned for the call budget lets the next call probe
+	require.NoError(t, budget.Spend(ResourceKey("default", "weather")))
+	now = now.Add(time.Minute)
+	_, err = wrapper.GetGatewayTarget(ctx, "gw-1", "TARGET1")
+	assert.True(t, IsBudgetExceededError(err))
+	assert.NoError(t, breaker.Check())
+	assert.True(t, breaker.Open(), "the abandoned probe does not close the breaker")
+}
+
+func TestWithContextCircuitBreaker(t *testing.T) {
+	own := NewCircuitBreaker(1, time.Hour)
+	provider := NewCircuitBreaker(1, time.Hour)
+	provider.Record(&smithy.GenericAPIError{Code: "InternalServerException"})
+	wrapper := NewBedrockClientWrapper(&stubGatewayTargetAPI{}, logr.Discard(), WithCircuitBreaker(own))
+
+	_, err := wrapper.GetGatewayTarget(WithContextCircuitBreaker(context.Background(), provider), "gw-1", "TARGET1")
+	assert.True(t, IsCircuitOpenError(err), "the breaker of the context guards the call")
+
+	_, err = wrapper.GetGatewayTarget(context.Background(), "gw-1", "TARGET1")
+	assert.NoError(t, err, "the breaker of another provider does not stop the wrapper's calls")
+	assert.False(t, own.Open())
+}
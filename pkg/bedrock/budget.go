@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CallBudget limits the number of AWS calls made on behalf of a single resource within a
+// sliding time window, so that one misconfigured resource cannot consume the rate limit
+// shared by every resource. Resources are identified by their attribution (see WithAttribution).
+type CallBudget struct {
+	limit  int
+	window time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	calls map[string][]time.Time
+}
+
+// NewCallBudget creates a new CallBudget allowing limit calls per resource within window
+func NewCallBudget(limit int, window time.Duration) *CallBudget {
+	return &CallBudget{
+		limit:  limit,
+		window: window,
+		now:    time.Now,
+		calls:  make(map[string][]time.Time),
+	}
+}
+
+// Limit returns the number of calls allowed per resource within the window
+func (b *CallBudget) Limit() int {
+	return b.limit
+}
+
+// Window returns the duration of the sliding window
+func (b *CallBudget) Window() time.Duration {
+	return b.window
+}
+
+// BudgetExceededError is returned instead of calling AWS once a resource has exhausted its budget,
+// or its tenant has exhausted its FairShare
+type BudgetExceededError struct {
+	Resource string
+	// Tenant is set if the tenant exhausted its fair share, in which case Limit is the share
+	Tenant     string
+	Limit      int
+	Window     time.Duration
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface
+func (e *BudgetExceededError) Error() string {
+	if e.Tenant != "" {
+		return fmt.Sprintf("fair share of %d AWS calls per %s exhausted for tenant %s, next call allowed in %s",
+			e.Limit, e.Window, e.Tenant, e.RetryAfter.Round(time.Second))
+	}
+	return fmt.Sprintf("AWS call budget of %d calls per %s exhausted for %s, next call allowed in %s",
+		e.Limit, e.Window, e.Resource, e.RetryAfter.Round(time.Second))
+}
+
+// IsBudgetExceededError checks if the error is a BudgetExceededError
+func IsBudgetExceededError(err error) bool {
+	var budgetErr *BudgetExceededError
+	return errors.As(err, &budgetErr)
+}
+
+// Spend records a call for the resource, or returns a BudgetExceededError if the resource
+// has already made limit calls within the window
+func (b *CallBudget) Spend(resource string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	calls := b.prune(resource, now)
+	if len(calls) >= b.limit {
+		return &BudgetExceededError{
+			Resource:   resource,
+			Limit:      b.limit,
+			Window:     b.window,
+			RetryAfter: calls[0].Add(b.window).Sub(now),
+		}
+	}
+	b.calls[resource] = append(calls, now)
+	return nil
+}
+
+// RetryAfter returns how long the resource has to wait before its next call is allowed,
+// or zero if it has budget left
+func (b *CallBudget) RetryAfter(resource string) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	calls := b.prune(resource, now)
+	if len(calls) < b.limit {
+		return 0
+	}
+	return calls[0].Add(b.window).Sub(now)
+}
+
+// Forget drops the call history of a deleted resource
+func (b *CallBudget) Forget(resource string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.calls, resource)
+}
+
+// prune drops the calls of the resource that left the window and returns the remaining ones.
+// The caller must hold the lock.
+func (b *CallBudget) prune(resource string, now time.Time) []time.Time {
+	calls := b.calls[resource]
+	cutoff := now.Add(-b.window)
+	i := 0
+	for i < len(calls) && !calls[i].After(cutoff) {
+		i++
+	}
+	calls = calls[i:]
+	if len(calls) == 0 {
+		delete(b.calls, resource)
+		return nil
+	}
+	b.calls[resource] = calls
+	return calls
+}
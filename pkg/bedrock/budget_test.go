@@ -0,0 +1,72 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallBudget(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	budget := NewCallBudget(2, time.Hour)
+	budget.now = func() time.Time { return now }
+
+	require.NoError(t, budget.Spend("default.a"))
+	now = now.Add(10 * time.Minute)
+	require.NoError(t, budget.Spend("default.a"))
+	assert.Equal(t, time.Duration(0), budget.RetryAfter("default.b"), "budgets are per resource")
+
+	err := budget.Spend("default.a")
+	require.Error(t, err)
+	assert.True(t, IsBudgetExceededError(err))
+	assert.Equal(t, 50*time.Minute, budget.RetryAfter("default.a"))
+
+	// The first call leaves the window
+	now = now.Add(50 * time.Minute)
+	assert.Equal(t, time.Duration(0), budget.RetryAfter("default.a"))
+	require.NoError(t, budget.Spend("default.a"))
+
+	budget.Forget("default.a")
+	assert.Empty(t, budget.calls)
+}
+
+func TestWithCallBudget(t *testing.T) {
+	budget := NewCallBudget(1, time.Hour)
+	wrapper := NewBedrockClientWrapper(nil, logr.Discard(), WithCallBudget(budget))
+
+	calls := 0
+	call := func(context.Context) error {
+		calls++
+		return nil
+	}
+
+	// Calls without attribution are not budgeted
+	require.NoError(t, wrapper.withRetry(context.Background(), "Test", call))
+	require.NoError(t, wrapper.withRetry(context.Background(), "Test", call))
+
+	ctx := WithAttribution(context.Background(), "default", "a")
+	require.NoError(t, wrapper.withRetry(ctx, "Test", call))
+	err := wrapper.withRetry(ctx, "Test", call)
+	assert.True(t, IsBudgetExceededError(err))
+	assert.Equal(t, 3, calls)
+}
@@ -26,9 +26,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
 	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
-	"github.com/aws/smithy-go"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/aws/mcp-gateway-operator/pkg/audit"
 )
 
 const (
@@ -36,20 +39,44 @@ const (
 	initialBackoff    = 1 * time.Second
 	maxBackoff        = 30 * time.Second
 	backoffMultiplier = 2.0
+
+	// DefaultCallTimeout bounds every attempt of an AWS call unless WithCallTimeout is given
+	DefaultCallTimeout = 30 * time.Second
 )
 
 // BedrockClientWrapper wraps the AWS Bedrock AgentCore client with retry logic and error handling
 type BedrockClientWrapper struct {
-	client *bedrockagentcorecontrol.Client
-	logger logr.Logger
+	client      GatewayTargetAPI
+	logger      logr.Logger
+	retryPolicy RetryPolicy
+	budget      *CallBudget
+	fairShare   *FairShare
+	auditLogger *audit.Logger
+	gateways    *GatewayCache
+	callTimeout time.Duration
+	tracer      trace.Tracer
+	pressure    *Backpressure
+	limiter     *RateLimiter
+	breaker     *CircuitBreaker
 }
 
 // NewBedrockClientWrapper creates a new BedrockClientWrapper
-func NewBedrockClientWrapper(client *bedrockagentcorecontrol.Client, logger logr.Logger) *BedrockClientWrapper {
-	return &BedrockClientWrapper{
-		client: client,
-		logger: logger,
+func NewBedrockClientWrapper(
+	client GatewayTargetAPI,
+	logger logr.Logger,
+	opts ...Option,
+) *BedrockClientWrapper {
+	w := &BedrockClientWrapper{
+		client:      client,
+		logger:      logger,
+		retryPolicy: DefaultRetryPolicy(),
+		callTimeout: DefaultCallTimeout,
+		tracer:      otel.GetTracerProvider().Tracer(tracerName),
+	}
+	for _, opt := range opts {
+		opt(w)
 	}
+	return w
 }
 
 // CreateGatewayTarget creates a new gateway target in AWS Bedrock AgentCore
@@ -65,40 +92,30 @@ func (w *BedrockClientWrapper) CreateGatewayTarget(
 		w.logger.V(1).Info("Generated client token for idempotency", "clientToken", clientToken)
 	}
 
-	var lastErr error
-	backoff := initialBackoff
-
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		if attempt > 0 {
-			w.logger.Info("Retrying CreateGatewayTarget", "attempt", attempt, "backoff", backoff)
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(backoff):
-			}
-			backoff = time.Duration(math.Min(float64(backoff)*backoffMultiplier, float64(maxBackoff)))
-		}
-
-		output, err := w.client.CreateGatewayTarget(ctx, input)
-		if err == nil {
-			w.logger.Info("Successfully created gateway target",
-				"targetId", aws.ToString(output.TargetId),
-				"status", output.Status)
-			return output, nil
-		}
-
-		lastErr = err
-
-		// Check if error is retryable
-		if !w.isRetryableError(err) {
-			w.logger.Error(err, "Non-retryable error creating gateway target")
-			return nil, err
-		}
-
-		w.logger.Info("Retryable error creating gateway target", "error", err, "attempt", attempt)
+	var output *bedrockagentcorecontrol.CreateGatewayTargetOutput
+	err := w.withRetry(ctx, "CreateGatewayTarget", func(ctx context.Context) error {
+		var err error
+		output, err = w.clientFor(ctx).CreateGatewayTarget(ctx, input,
+			append(attributionOptions(ctx), credentialExtensionOptions(ctx)...)...)
+		return err
+	})
+	record := audit.Record{
+		Operation: "CreateGatewayTarget",
+		GatewayID: aws.ToString(input.GatewayIdentifier),
+		Name:      aws.ToString(input.Name),
+	}
+	if output != nil {
+		record.TargetID = aws.ToString(output.TargetId)
+	}
+	w.audit(ctx, record, err)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("failed to create gateway target after %d attempts: %w", maxRetries+1, lastErr)
+	w.logger.Info("Successfully created gateway target",
+		"targetId", aws.ToString(output.TargetId),
+		"status", output.Status)
+	return output, nil
 }
 
 // GetGatewayTarget retrieves information about a gateway target
@@ -112,7 +129,19 @@ func (w *BedrockClientWrapper) GetGatewayTarget(
 		TargetId:          aws.String(targetID),
 	}
 
-	output, err := w.client.GetGatewayTarget(ctx, input)
+	var output *bedrockagentcorecontrol.GetGatewayTargetOutput
+	err := w.withSpan(ctx, "GetGatewayTarget", func(ctx context.Context) error {
+		return w.call(ctx, "GetGatewayTarget", func(ctx context.Context) error {
+			var err error
+			output, err = w.clientFor(ctx).GetGatewayTarget(ctx, input, attributionOptions(ctx)...)
+			return err
+		})
+	})
+	// Callers expect a target to be gone, e.g. while polling a deletion, so it is not an error here
+	if IsResourceNotFoundError(err) {
+		w.logger.V(1).Info("Gateway target not found", "gatewayId", gatewayID, "targetId", targetID)
+		return nil, err
+	}
 	if err != nil {
 		w.logger.Error(err, "Failed to get gateway target",
 			"gatewayId", gatewayID,
@@ -126,45 +155,108 @@ func (w *BedrockClientWrapper) GetGatewayTarget(
 	return output, nil
 }
 
-// UpdateGatewayTarget updates an existing gateway target
-func (w *BedrockClientWrapper) UpdateGatewayTarget(
+// GetGateway retrieves information about a gateway.
+// Results are served from the gateway cache, if the wrapper has one, until they expire.
+func (w *BedrockClientWrapper) GetGateway(
 	ctx context.Context,
-	input *bedrockagentcorecontrol.UpdateGatewayTargetInput,
-) (*bedrockagentcorecontrol.UpdateGatewayTargetOutput, error) {
-	var lastErr error
-	backoff := initialBackoff
+	gatewayID string,
+) (*bedrockagentcorecontrol.GetGatewayOutput, error) {
+	if output, ok := w.gateways.Get(gatewayID); ok {
+		w.logger.V(1).Info("Using cached gateway", "gatewayId", gatewayID, "status", output.Status)
+		return output, nil
+	}
 
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		if attempt > 0 {
-			w.logger.Info("Retrying UpdateGatewayTarget", "attempt", attempt, "backoff", backoff)
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(backoff):
-			}
-			backoff = time.Duration(math.Min(float64(backoff)*backoffMultiplier, float64(maxBackoff)))
-		}
+	input := &bedrockagentcorecontrol.GetGatewayInput{
+		GatewayIdentifier: aws.String(gatewayID),
+	}
 
-		output, err := w.client.UpdateGatewayTarget(ctx, input)
-		if err == nil {
-			w.logger.Info("Successfully updated gateway target",
-				"targetId", aws.ToString(input.TargetId),
-				"status", output.Status)
-			return output, nil
-		}
+	var output *bedrockagentcorecontrol.GetGatewayOutput
+	err := w.withRetry(ctx, "GetGateway", func(ctx context.Context) error {
+		var err error
+		output, err = w.clientFor(ctx).GetGateway(ctx, input, attributionOptions(ctx)...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		lastErr = err
+	w.gateways.Set(gatewayID, output)
 
-		// Check if error is retryable
-		if !w.isRetryableError(err) {
-			w.logger.Error(err, "Non-retryable error updating gateway target")
+	w.logger.V(1).Info("Successfully retrieved gateway",
+		"gatewayId", gatewayID,
+		"status", output.Status)
+	return output, nil
+}
+
+// InvalidateGateway drops the cached GetGateway result of the gateway, so that the next
+// GetGateway calls AWS
+func (w *BedrockClientWrapper) InvalidateGateway(gatewayID string) {
+	w.gateways.Invalidate(gatewayID)
+}
+
+// FindGatewayTargetByName returns the summary of the target of the gateway with the given name,
+// or nil if the gateway has no such target. Targets are listed page by page without retries, so
+// callers on a deadline, such as admission webhooks, fail fast. Every page is recorded in a span.
+func (w *BedrockClientWrapper) FindGatewayTargetByName(
+	ctx context.Context,
+	gatewayID string,
+	name string,
+) (*types.TargetSummary, error) {
+	input := &bedrockagentcorecontrol.ListGatewayTargetsInput{
+		GatewayIdentifier: aws.String(gatewayID),
+	}
+
+	for {
+		var output *bedrockagentcorecontrol.ListGatewayTargetsOutput
+		err := w.withSpan(ctx, "ListGatewayTargets", func(ctx context.Context) error {
+			return w.call(ctx, "ListGatewayTargets", func(ctx context.Context) error {
+				var err error
+				output, err = w.clientFor(ctx).ListGatewayTargets(ctx, input, attributionOptions(ctx)...)
+				return err
+			})
+		})
+		if err != nil {
 			return nil, err
 		}
 
-		w.logger.Info("Retryable error updating gateway target", "error", err, "attempt", attempt)
+		for i := range output.Items {
+			if aws.ToString(output.Items[i].Name) == name {
+				return &output.Items[i], nil
+			}
+		}
+		if aws.ToString(output.NextToken) == "" {
+			return nil, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// UpdateGatewayTarget updates an existing gateway target
+func (w *BedrockClientWrapper) UpdateGatewayTarget(
+	ctx context.Context,
+	input *bedrockagentcorecontrol.UpdateGatewayTargetInput,
+) (*bedrockagentcorecontrol.UpdateGatewayTargetOutput, error) {
+	var output *bedrockagentcorecontrol.UpdateGatewayTargetOutput
+	err := w.withRetry(ctx, "UpdateGatewayTarget", func(ctx context.Context) error {
+		var err error
+		output, err = w.clientFor(ctx).UpdateGatewayTarget(ctx, input,
+			append(attributionOptions(ctx), credentialExtensionOptions(ctx)...)...)
+		return err
+	})
+	w.audit(ctx, audit.Record{
+		Operation: "UpdateGatewayTarget",
+		GatewayID: aws.ToString(input.GatewayIdentifier),
+		TargetID:  aws.ToString(input.TargetId),
+		Name:      aws.ToString(input.Name),
+	}, err)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("failed to update gateway target after %d attempts: %w", maxRetries+1, lastErr)
+	w.logger.Info("Successfully updated gateway target",
+		"targetId", aws.ToString(input.TargetId),
+		"status", output.Status)
+	return output, nil
 }
 
 // DeleteGatewayTarget deletes a gateway target
@@ -179,124 +271,377 @@ func (w *BedrockClientWrapper) DeleteGatewayTarget(
 		TargetId:          aws.String(targetID),
 	}
 
-	var lastErr error
-	backoff := initialBackoff
+	err := w.withRetry(ctx, "DeleteGatewayTarget", func(ctx context.Context) error {
+		_, err := w.clientFor(ctx).DeleteGatewayTarget(ctx, input, attributionOptions(ctx)...)
+		return err
+	})
+	w.audit(ctx, audit.Record{Operation: "DeleteGatewayTarget", GatewayID: gatewayID, TargetID: targetID}, err)
+
+	// ResourceNotFoundException means the target is already deleted - treat as success
+	if IsResourceNotFoundError(err) {
+		w.logger.Info("Gateway target not found, treating as successful deletion",
+			"gatewayId", gatewayID,
+			"targetId", targetID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	w.logger.Info("Successfully deleted gateway target",
+		"gatewayId", gatewayID,
+		"targetId", targetID)
+	return nil
+}
+
+// CreateGateway creates a new gateway
+// The caller should set a client token so that retried creates are idempotent
+func (w *BedrockClientWrapper) CreateGateway(
+	ctx context.Context,
+	input *bedrockagentcorecontrol.CreateGatewayInput,
+) (*bedrockagentcorecontrol.CreateGatewayOutput, error) {
+	var output *bedrockagentcorecontrol.CreateGatewayOutput
+	err := w.withRetry(ctx, "CreateGateway", func(ctx context.Context) error {
+		var err error
+		output, err = w.clientFor(ctx).CreateGateway(ctx, input, attributionOptions(ctx)...)
+		return err
+	})
+	record := audit.Record{Operation: "CreateGateway", Name: aws.ToString(input.Name)}
+	if output != nil {
+		record.GatewayID = aws.ToString(output.GatewayId)
+	}
+	w.audit(ctx, record, err)
+	if err != nil {
+		return nil, err
+	}
+
+	w.logger.Info("Successfully created gateway",
+		"gatewayId", aws.ToString(output.GatewayId),
+		"status", output.Status)
+	return output, nil
+}
+
+// DeleteGateway deletes a gateway
+// ResourceNotFoundException is treated as success (idempotent deletion)
+func (w *BedrockClientWrapper) DeleteGateway(ctx context.Context, gatewayID string) error {
+	input := &bedrockagentcorecontrol.DeleteGatewayInput{
+		GatewayIdentifier: aws.String(gatewayID),
+	}
+
+	err := w.withRetry(ctx, "DeleteGateway", func(ctx context.Context) error {
+		_, err := w.clientFor(ctx).DeleteGateway(ctx, input, attributionOptions(ctx)...)
+		return err
+	})
+	w.audit(ctx, audit.Record{Operation: "DeleteGateway", GatewayID: gatewayID}, err)
+	w.gateways.Invalidate(gatewayID)
+	if IsResourceNotFoundError(err) {
+		w.logger.Info("Gateway not found, treating as successful deletion", "gatewayId", gatewayID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	w.logger.Info("Successfully deleted gateway", "gatewayId", gatewayID)
+	return nil
+}
+
+// CreateOauth2CredentialProvider creates an OAuth2 credential provider in the token vault
+func (w *BedrockClientWrapper) CreateOauth2CredentialProvider(
+	ctx context.Context,
+	input *bedrockagentcorecontrol.CreateOauth2CredentialProviderInput,
+) (*bedrockagentcorecontrol.CreateOauth2CredentialProviderOutput, error) {
+	var output *bedrockagentcorecontrol.CreateOauth2CredentialProviderOutput
+	err := w.withRetry(ctx, "CreateOauth2CredentialProvider", func(ctx context.Context) error {
+		var err error
+		output, err = w.clientFor(ctx).CreateOauth2CredentialProvider(ctx, input, attributionOptions(ctx)...)
+		return err
+	})
+	w.audit(ctx, audit.Record{Operation: "CreateOauth2CredentialProvider", Name: aws.ToString(input.Name)}, err)
+	if err != nil {
+		return nil, err
+	}
+
+	w.logger.Info("Successfully created OAuth2 credential provider",
+		"name", aws.ToString(output.Name),
+		"arn", aws.ToString(output.CredentialProviderArn))
+	return output, nil
+}
+
+// GetOauth2CredentialProvider retrieves an OAuth2 credential provider by name
+func (w *BedrockClientWrapper) GetOauth2CredentialProvider(
+	ctx context.Context,
+	name string,
+) (*bedrockagentcorecontrol.GetOauth2CredentialProviderOutput, error) {
+	input := &bedrockagentcorecontrol.GetOauth2CredentialProviderInput{
+		Name: aws.String(name),
+	}
+
+	var output *bedrockagentcorecontrol.GetOauth2CredentialProviderOutput
+	err := w.withRetry(ctx, "GetOauth2CredentialProvider", func(ctx context.Context) error {
+		var err error
+		output, err = w.clientFor(ctx).GetOauth2CredentialProvider(ctx, input, attributionOptions(ctx)...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// DeleteOauth2CredentialProvider deletes an OAuth2 credential provider
+// ResourceNotFoundException is treated as success (idempotent deletion)
+func (w *BedrockClientWrapper) DeleteOauth2CredentialProvider(ctx context.Context, name string) error {
+	input := &bedrockagentcorecontrol.DeleteOauth2CredentialProviderInput{
+		Name: aws.String(name),
+	}
+
+	err := w.withRetry(ctx, "DeleteOauth2CredentialProvider", func(ctx context.Context) error {
+		_, err := w.clientFor(ctx).DeleteOauth2CredentialProvider(ctx, input, attributionOptions(ctx)...)
+		return err
+	})
+	w.audit(ctx, audit.Record{Operation: "DeleteOauth2CredentialProvider", Name: name}, err)
+	if IsResourceNotFoundError(err) {
+		w.logger.Info("OAuth2 credential provider not found, treating as successful deletion", "name", name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	w.logger.Info("Successfully deleted OAuth2 credential provider", "name", name)
+	return nil
+}
+
+// GetTokenVault retrieves the encryption configuration of a token vault
+func (w *BedrockClientWrapper) GetTokenVault(
+	ctx context.Context,
+	tokenVaultID string,
+) (*bedrockagentcorecontrol.GetTokenVaultOutput, error) {
+	input := &bedrockagentcorecontrol.GetTokenVaultInput{
+		TokenVaultId: aws.String(tokenVaultID),
+	}
+
+	var output *bedrockagentcorecontrol.GetTokenVaultOutput
+	err := w.withRetry(ctx, "GetTokenVault", func(ctx context.Context) error {
+		var err error
+		output, err = w.clientFor(ctx).GetTokenVault(ctx, input, attributionOptions(ctx)...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// SetTokenVaultCMK switches a token vault to the given customer managed KMS key
+func (w *BedrockClientWrapper) SetTokenVaultCMK(
+	ctx context.Context,
+	tokenVaultID string,
+	kmsKeyArn string,
+) (*bedrockagentcorecontrol.SetTokenVaultCMKOutput, error) {
+	input := &bedrockagentcorecontrol.SetTokenVaultCMKInput{
+		TokenVaultId: aws.String(tokenVaultID),
+		KmsConfiguration: &types.KmsConfiguration{
+			KeyType:   types.KeyTypeCustomerManagedKey,
+			KmsKeyArn: aws.String(kmsKeyArn),
+		},
+	}
+
+	var output *bedrockagentcorecontrol.SetTokenVaultCMKOutput
+	err := w.withRetry(ctx, "SetTokenVaultCMK", func(ctx context.Context) error {
+		var err error
+		output, err = w.clientFor(ctx).SetTokenVaultCMK(ctx, input, attributionOptions(ctx)...)
+		return err
+	})
+	w.audit(ctx, audit.Record{Operation: "SetTokenVaultCMK", Name: tokenVaultID}, err)
+	if err != nil {
+		return nil, err
+	}
 
-	for attempt := 0; attempt <= maxRetries; attempt++ {
+	w.logger.Info("Successfully set token vault KMS key", "tokenVaultId", tokenVaultID, "kmsKeyArn", kmsKeyArn)
+	return output, nil
+}
+
+// withRetry calls fn until it succeeds, returns a non-retryable error, or the retry policy
+// is exhausted. The last error is returned unwrapped so callers can classify it. Attempts that
+// exceed the call timeout are retried; once ctx is done, its error is returned right away.
+// All attempts are recorded in a single span, see withSpan.
+func (w *BedrockClientWrapper) withRetry(ctx context.Context, operation string, fn func(context.Context) error) error {
+	return w.withSpan(ctx, operation, func(ctx context.Context) error {
+		return w.retry(ctx, operation, fn)
+	})
+}
+
+// retry implements withRetry
+func (w *BedrockClientWrapper) retry(ctx context.Context, operation string, fn func(context.Context) error) error {
+	policy := w.retryPolicyFor(ctx)
+	backoff := policy.InitialBackoff
+
+	var lastErr error
+	for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
 		if attempt > 0 {
-			w.logger.Info("Retrying DeleteGatewayTarget", "attempt", attempt, "backoff", backoff)
+			w.logger.Info("Retrying "+operation, "attempt", attempt, "backoff", backoff)
+			recordRetry(ctx, attempt, backoff)
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
 			case <-time.After(backoff):
 			}
-			backoff = time.Duration(math.Min(float64(backoff)*backoffMultiplier, float64(maxBackoff)))
+			backoff = time.Duration(math.Min(float64(backoff)*policy.BackoffMultiplier, float64(policy.MaxBackoff)))
 		}
 
-		_, err := w.client.DeleteGatewayTarget(ctx, input)
+		err := w.call(ctx, operation, fn)
 		if err == nil {
-			w.logger.Info("Successfully deleted gateway target",
-				"gatewayId", gatewayID,
-				"targetId", targetID)
 			return nil
 		}
-
-		// ResourceNotFoundException means the target is already deleted - treat as success
-		if w.isResourceNotFoundError(err) {
-			w.logger.Info("Gateway target not found, treating as successful deletion",
-				"gatewayId", gatewayID,
-				"targetId", targetID)
-			return nil
+		// Calls skipped by the budget or the breaker are not AWS errors
+		if IsBudgetExceededError(err) || IsCircuitOpenError(err) {
+			return err
 		}
 
 		lastErr = err
 
+		if IsThrottlingError(err) {
+			w.pressure.RecordThrottle()
+		}
+
+		// Wait as long as AWS asks for, which is left to the caller rather than blocking here
+		if IsThrottlingError(err) {
+			if retryAfter, ok := RetryAfter(err, time.Now()); ok {
+				w.logger.Info("AWS throttled "+operation, "retryAfter", retryAfter)
+				return &ThrottledError{Operation: operation, RetryAfter: retryAfter, Err: err}
+			}
+		}
+
 		// Check if error is retryable
-		if !w.isRetryableError(err) {
-			w.logger.Error(err, "Non-retryable error deleting gateway target")
+		if !IsRetryableError(err) {
+			if !IsResourceNotFoundError(err) && !IsCanceledError(err) {
+				w.logger.Error(err, "Non-retryable error calling "+operation)
+			}
 			return err
 		}
 
-		w.logger.Info("Retryable error deleting gateway target", "error", err, "attempt", attempt)
+		w.logger.Info("Retryable error calling "+operation, "error", err, "attempt", attempt)
 	}
 
-	return fmt.Errorf("failed to delete gateway target after %d attempts: %w", maxRetries+1, lastErr)
+	return fmt.Errorf("%s failed after %d attempts: %w", operation, policy.MaxRetries+1, lastErr)
 }
 
-// isRetryableError determines if an error should be retried
-func (w *BedrockClientWrapper) isRetryableError(err error) bool {
-	if err == nil {
-		return false
+// call runs fn once, if the circuit breaker, the call budget and the rate limit allow a call of
+// operation, and records the outcome in the circuit breaker. The breaker is asked first, so that
+// calls it fails right away neither use up the call budget nor wait for the rate limit.
+// GetGatewayTarget and FindGatewayTargetByName, which do not retry, use it directly so they obey
+// the same limits.
+func (w *BedrockClientWrapper) call(ctx context.Context, operation string, fn func(context.Context) error) error {
+	breaker := w.breakerFor(ctx)
+	if err := breaker.Allow(operation); err != nil {
+		w.logger.V(1).Info("Skipping "+operation, "reason", err.Error())
+		w.pressure.RecordThrottle()
+		return err
 	}
-
-	// Check for throttling errors
-	if w.isThrottlingError(err) {
-		return true
+	if err := w.spend(ctx); err != nil {
+		breaker.release()
+		w.logger.Info("Skipping "+operation, "reason", err.Error())
+		return err
 	}
-
-	// Check for internal server errors
-	if w.isInternalServerError(err) {
-		return true
+	if err := w.waitForRateLimit(ctx, operation); err != nil {
+		breaker.release()
+		return err
 	}
 
-	// Check for network/timeout errors
-	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
-		return false // Don't retry context errors
+	err := w.withCredentialRefresh(ctx, operation, fn)
+	breaker.Record(err)
+	return err
+}
+
+// waitForRateLimit waits until the RateLimiter allows a call of operation. Long waits mean the
+// operator as a whole makes more calls than the limit allows, so they count as throttling.
+func (w *BedrockClientWrapper) waitForRateLimit(ctx context.Context, operation string) error {
+	waited, err := w.limiter.Wait(ctx, operation)
+	if err != nil {
+		return err
+	}
+	if waited >= sustainedRateLimitWait {
+		w.logger.V(1).Info("Waited for the rate limit of "+operation, "wait", waited)
+		w.pressure.RecordThrottle()
 	}
+	return nil
+}
 
-	// Check for validation errors (not retryable)
-	if w.isValidationError(err) {
-		return false
+// withCredentialRefresh calls fn and, if AWS rejects the credentials as expired, refreshes them
+// and calls fn once more. This covers web identity token rotation, where the cached credentials
+// can be rejected shortly before their recorded expiry. The repeated call is charged to the
+// call budget like any other. Every call is bounded by the call timeout, see withCallTimeout.
+func (w *BedrockClientWrapper) withCredentialRefresh(ctx context.Context, operation string, fn func(context.Context) error) error {
+	err := w.withCallTimeout(ctx, operation, fn)
+	if !IsExpiredCredentialsError(err) || !invalidateCredentials(credentialsOf(w.clientFor(ctx))) {
+		return err
 	}
 
-	// Default to not retrying unknown errors
-	return false
+	w.logger.Info("Credentials rejected, refreshing them and retrying "+operation, "error", err)
+	if err := w.spend(ctx); err != nil {
+		return err
+	}
+	return w.withCallTimeout(ctx, operation, fn)
 }
 
-// isThrottlingError checks if the error is a throttling error
-func (w *BedrockClientWrapper) isThrottlingError(err error) bool {
-	var apiErr smithy.APIError
-	if errors.As(err, &apiErr) {
-		code := apiErr.ErrorCode()
-		return code == "ThrottlingException" ||
-			code == "TooManyRequestsException" ||
-			code == "RequestLimitExceeded"
+// withCallTimeout calls fn with a context bounded by the call timeout. If the call timeout expires
+// while ctx is still live, the error is returned as a CallTimeoutError, so that a slow call is
+// retried rather than mistaken for the end of the reconcile. Errors caused by ctx itself, e.g.
+// its cancellation when the operator shuts down, are returned as they are.
+func (w *BedrockClientWrapper) withCallTimeout(ctx context.Context, operation string, fn func(context.Context) error) error {
+	if w.callTimeout <= 0 {
+		return fn(ctx)
 	}
-	return false
-}
+	callCtx, cancel := context.WithTimeout(ctx, w.callTimeout)
+	defer cancel()
 
-// isInternalServerError checks if the error is an internal server error
-func (w *BedrockClientWrapper) isInternalServerError(err error) bool {
-	var apiErr smithy.APIError
-	if errors.As(err, &apiErr) {
-		code := apiErr.ErrorCode()
-		return code == "InternalServerException" ||
-			code == "ServiceUnavailableException" ||
-			code == "InternalFailure"
+	err := fn(callCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+		return &CallTimeoutError{Operation: operation, Timeout: w.callTimeout, Err: err}
 	}
-	return false
+	return err
 }
 
-// isValidationError checks if the error is a validation error
-func (w *BedrockClientWrapper) isValidationError(err error) bool {
-	var apiErr smithy.APIError
-	if errors.As(err, &apiErr) {
-		code := apiErr.ErrorCode()
-		return code == "ValidationException" ||
-			code == "InvalidParameterException" ||
-			code == "InvalidRequestException"
+// invalidateCredentials drops the cached credentials of provider, so that the next call
+// retrieves new ones. It reports false if the provider does not cache credentials.
+func invalidateCredentials(provider aws.CredentialsProvider) bool {
+	cache, ok := provider.(interface{ Invalidate() })
+	if !ok {
+		return false
 	}
-	return false
+	cache.Invalidate()
+	return true
 }
 
-// isResourceNotFoundError checks if the error is a ResourceNotFoundException
-func (w *BedrockClientWrapper) isResourceNotFoundError(err error) bool {
-	var apiErr smithy.APIError
-	if errors.As(err, &apiErr) {
-		return apiErr.ErrorCode() == "ResourceNotFoundException"
+// spend charges a call to the fair share of the tenant and to the budget of the resource
+// attributed in ctx
+func (w *BedrockClientWrapper) spend(ctx context.Context) error {
+	if tenant := tenantOf(ctx); w.fairShare != nil && tenant != "" {
+		if err := w.fairShare.Spend(tenant); err != nil {
+			return err
+		}
 	}
+	if w.budget == nil {
+		return nil
+	}
+	resource := attribution(ctx)
+	if resource == "" {
+		return nil
+	}
+	return w.budget.Spend(resource)
+}
 
-	// Also check for the typed error
-	var notFoundErr *types.ResourceNotFoundException
-	return errors.As(err, &notFoundErr)
+// audit writes the audit record of a mutating call attributed to the resource in ctx.
+// Calls skipped by the call budget never reached AWS and are not recorded.
+func (w *BedrockClientWrapper) audit(ctx context.Context, record audit.Record, err error) {
+	if w.auditLogger == nil || IsBudgetExceededError(err) {
+		return
+	}
+	a, _ := ctx.Value(attributionKey{}).(attributed)
+	record.Kind, record.Resource = a.kind, a.resource
+	if auditErr := w.auditLogger.Log(record, err); auditErr != nil {
+		w.logger.Error(auditErr, "Failed to write audit record", "operation", record.Operation)
+	}
 }
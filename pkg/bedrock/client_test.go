@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvalidateCredentials(t *testing.T) {
+	retrieved := 0
+	provider := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		retrieved++
+		return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret", CanExpire: false}, nil
+	})
+	cache := aws.NewCredentialsCache(provider)
+
+	_, err := cache.Retrieve(context.Background())
+	require.NoError(t, err)
+	_, err = cache.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, retrieved)
+
+	assert.True(t, invalidateCredentials(cache))
+	_, err = cache.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, retrieved)
+
+	assert.False(t, invalidateCredentials(provider), "providers without a cache cannot be refreshed")
+}
+
+func TestWithContextRetryPolicy(t *testing.T) {
+	wrapper := NewBedrockClientWrapper(nil, logr.Discard(), WithRetryPolicy(RetryPolicy{MaxRetries: 3}))
+
+	calls := 0
+	call := func(context.Context) error {
+		calls++
+		return &smithy.GenericAPIError{Code: "InternalServerException"}
+	}
+
+	require.Error(t, wrapper.withRetry(context.Background(), "Test", call))
+	assert.Equal(t, 4, calls)
+
+	calls = 0
+	ctx := WithContextRetryPolicy(context.Background(), RetryPolicy{MaxRetries: 1})
+	require.Error(t, wrapper.withRetry(ctx, "Test", call))
+	assert.Equal(t, 2, calls)
+}
+
+func TestWithCallTimeout(t *testing.T) {
+	wrapper := NewBedrockClientWrapper(nil, logr.Discard(), WithCallTimeout(10*time.Millisecond),
+		WithRetryPolicy(RetryPolicy{MaxRetries: 1, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}))
+
+	calls := 0
+	call := func(ctx context.Context) error {
+		calls++
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	err := wrapper.withRetry(context.Background(), "Test", call)
+	require.Error(t, err)
+	assert.Equal(t, 2, calls, "attempts that time out are retried")
+	assert.True(t, IsCallTimeoutError(err))
+	assert.False(t, IsCanceledError(err))
+	assert.Contains(t, err.Error(), "Test timed out after 10ms")
+
+	calls = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = wrapper.withRetry(ctx, "Test", call)
+	require.Error(t, err)
+	assert.Equal(t, 1, calls, "canceled calls are not retried")
+	assert.True(t, IsCanceledError(err))
+	assert.False(t, IsCallTimeoutError(err))
+}
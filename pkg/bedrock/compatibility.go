@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+)
+
+// Reasons reported by IncompatibilityError
+const (
+	// ReasonIncompatibleGatewayProtocol means the gateway does not speak MCP
+	ReasonIncompatibleGatewayProtocol = "IncompatibleGatewayProtocol"
+	// ReasonIncompatibleGatewayAuthorizer means the gateway cannot use a configured credential provider
+	ReasonIncompatibleGatewayAuthorizer = "IncompatibleGatewayAuthorizer"
+	// ReasonGatewayNotReady means the gateway cannot accept new targets in its current state
+	ReasonGatewayNotReady = "GatewayNotReady"
+)
+
+// IncompatibilityError reports a target configuration the gateway cannot serve
+type IncompatibilityError struct {
+	// Reason is a CamelCase condition reason identifying the incompatibility
+	Reason string
+	// Message describes the incompatibility
+	Message string
+}
+
+func (e *IncompatibilityError) Error() string {
+	return e.Message
+}
+
+// IsIncompatibilityError checks if the error is an IncompatibilityError
+func IsIncompatibilityError(err error) bool {
+	var incompatible *IncompatibilityError
+	return errors.As(err, &incompatible)
+}
+
+// ValidateGatewayCompatibility checks that the gateway can serve a target with the given
+// credential provider configurations. It returns an *IncompatibilityError describing the
+// first incompatibility found, or nil.
+func ValidateGatewayCompatibility(
+	gateway *bedrockagentcorecontrol.GetGatewayOutput,
+	credentialConfigs []types.CredentialProviderConfiguration,
+) error {
+	switch gateway.Status {
+	case types.GatewayStatusFailed, types.GatewayStatusDeleting:
+		return &IncompatibilityError{
+			Reason:  ReasonGatewayNotReady,
+			Message: fmt.Sprintf("gateway is %s and cannot accept targets", gateway.Status),
+		}
+	}
+
+	if gateway.ProtocolType != "" && gateway.ProtocolType != types.GatewayProtocolTypeMcp {
+		return &IncompatibilityError{
+			Reason:  ReasonIncompatibleGatewayProtocol,
+			Message: fmt.Sprintf("gateway protocol %s does not support MCP server targets", gateway.ProtocolType),
+		}
+	}
+
+	for _, config := range credentialConfigs {
+		switch config.CredentialProviderType {
+		case types.CredentialProviderTypeGatewayIamRole:
+			if gateway.RoleArn == nil {
+				return &IncompatibilityError{
+					Reason:  ReasonIncompatibleGatewayAuthorizer,
+					Message: "credential provider GATEWAY_IAM_ROLE requires a gateway with an execution role",
+				}
+			}
+		case types.CredentialProviderTypeOauth, types.CredentialProviderTypeApiKey:
+			if gateway.WorkloadIdentityDetails == nil {
+				return &IncompatibilityError{
+					Reason: ReasonIncompatibleGatewayAuthorizer,
+					Message: fmt.Sprintf("credential provider %s requires a gateway with a workload identity "+
+						"(gateway authorizer is %s)", config.CredentialProviderType, gateway.AuthorizerType),
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGatewayCompatibility(t *testing.T) {
+	oauth := []types.CredentialProviderConfiguration{{CredentialProviderType: types.CredentialProviderTypeOauth}}
+	iamRole := []types.CredentialProviderConfiguration{{CredentialProviderType: types.CredentialProviderTypeGatewayIamRole}}
+
+	tests := []struct {
+		name       string
+		gateway    *bedrockagentcorecontrol.GetGatewayOutput
+		configs    []types.CredentialProviderConfiguration
+		wantReason string
+	}{
+		{
+			name: "compatible OAuth gateway",
+			gateway: &bedrockagentcorecontrol.GetGatewayOutput{
+				Status:                  types.GatewayStatusReady,
+				ProtocolType:            types.GatewayProtocolTypeMcp,
+				WorkloadIdentityDetails: &types.WorkloadIdentityDetails{},
+			},
+			configs: oauth,
+		},
+		{
+			name: "failed gateway",
+			gateway: &bedrockagentcorecontrol.GetGatewayOutput{
+				Status:       types.GatewayStatusFailed,
+				ProtocolType: types.GatewayProtocolTypeMcp,
+			},
+			configs:    oauth,
+			wantReason: ReasonGatewayNotReady,
+		},
+		{
+			name: "OAuth without workload identity",
+			gateway: &bedrockagentcorecontrol.GetGatewayOutput{
+				Status:         types.GatewayStatusReady,
+				ProtocolType:   types.GatewayProtocolTypeMcp,
+				AuthorizerType: types.AuthorizerTypeAwsIam,
+			},
+			configs:    oauth,
+			wantReason: ReasonIncompatibleGatewayAuthorizer,
+		},
+		{
+			name: "IAM role provider without execution role",
+			gateway: &bedrockagentcorecontrol.GetGatewayOutput{
+				Status:       types.GatewayStatusReady,
+				ProtocolType: types.GatewayProtocolTypeMcp,
+			},
+			configs:    iamRole,
+			wantReason: ReasonIncompatibleGatewayAuthorizer,
+		},
+		{
+			name: "IAM role provider with execution role",
+			gateway: &bedrockagentcorecontrol.GetGatewayOutput{
+				Status:       types.GatewayStatusReady,
+				ProtocolType: types.GatewayProtocolTypeMcp,
+				RoleArn:      aws.String("arn:aws:iam::123456789012:role/gateway"),
+			},
+			configs: iamRole,
+		},
+		{
+			name: "non-MCP gateway",
+			gateway: &bedrockagentcorecontrol.GetGatewayOutput{
+				Status:       types.GatewayStatusReady,
+				ProtocolType: types.GatewayProtocolType("A2A"),
+			},
+			configs:    oauth,
+			wantReason: ReasonIncompatibleGatewayProtocol,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGatewayCompatibility(tt.gateway, tt.configs)
+			if tt.wantReason == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var incompatible *IncompatibilityError
+			if assert.True(t, errors.As(err, &incompatible)) {
+				assert.Equal(t, tt.wantReason, incompatible.Reason)
+			}
+			assert.True(t, IsIncompatibilityError(err))
+		})
+	}
+}
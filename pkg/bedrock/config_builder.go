@@ -18,6 +18,7 @@ package bedrock
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
@@ -34,11 +35,21 @@ func NewTargetConfigBuilder() *TargetConfigBuilder {
 }
 
 // Build creates a TargetConfiguration for an MCP server
-// It builds the MCP server configuration with the endpoint from the MCPServer spec
+// It builds the MCP server configuration with the endpoint from the MCPServer spec, the Lambda
+// configuration with the function and tool schema if spec.targetType is Lambda, or the OpenAPI
+// schema configuration if spec.targetType is OpenApiSchema
 func (b *TargetConfigBuilder) Build(mcpServer *mcpgatewayv1alpha1.MCPServer) (types.TargetConfiguration, error) {
 	if mcpServer == nil {
 		return nil, fmt.Errorf("mcpServer cannot be nil")
 	}
+	mcpServer = withNormalizedSpec(mcpServer)
+
+	switch mcpServer.Spec.TargetType {
+	case mcpgatewayv1alpha1.TargetTypeLambda:
+		return buildLambdaTarget(mcpServer.Spec.LambdaArn, mcpServer.Spec.ToolSchema)
+	case mcpgatewayv1alpha1.TargetTypeOpenAPISchema:
+		return buildOpenAPITarget(mcpServer.Spec.OpenAPISchema)
+	}
 
 	if mcpServer.Spec.Endpoint == "" {
 		return nil, fmt.Errorf("endpoint is required")
@@ -54,15 +65,30 @@ func (b *TargetConfigBuilder) Build(mcpServer *mcpgatewayv1alpha1.MCPServer) (ty
 }
 
 // BuildCredentialConfig creates credential provider configuration based on the auth type
-// For NoAuth: returns GatewayIamRole credential type
+// If spec.credentialProviders is set, one configuration is returned per entry, in order
+// For NoAuth and Lambda targets: returns GatewayIamRole credential type
 // For OAuth2: returns OAuth credential type with provider ARN and scopes
 func (b *TargetConfigBuilder) BuildCredentialConfig(mcpServer *mcpgatewayv1alpha1.MCPServer) ([]types.CredentialProviderConfiguration, error) {
 	if mcpServer == nil {
 		return nil, fmt.Errorf("mcpServer cannot be nil")
 	}
+	mcpServer = withNormalizedSpec(mcpServer)
 
+	if len(mcpServer.Spec.CredentialProviders) > 0 {
+		configs := make([]types.CredentialProviderConfiguration, 0, len(mcpServer.Spec.CredentialProviders))
+		for i, provider := range mcpServer.Spec.CredentialProviders {
+			config, err := buildCredentialProvider(provider)
+			if err != nil {
+				return nil, fmt.Errorf("credentialProviders[%d]: %w", i, err)
+			}
+			configs = append(configs, config)
+		}
+		return configs, nil
+	}
+
+	// Lambda functions are always invoked with the gateway IAM role, whatever authType defaults to
 	authType := mcpServer.Spec.AuthType
-	if authType == "" {
+	if authType == "" || mcpServer.Spec.TargetType == mcpgatewayv1alpha1.TargetTypeLambda {
 		authType = "NoAuth" // Default to NoAuth
 	}
 
@@ -97,28 +123,158 @@ func (b *TargetConfigBuilder) BuildCredentialConfig(mcpServer *mcpgatewayv1alpha
 	}
 }
 
+// buildCredentialProvider converts one spec.credentialProviders entry to its AWS configuration
+func buildCredentialProvider(provider mcpgatewayv1alpha1.CredentialProvider) (types.CredentialProviderConfiguration, error) {
+	switch provider.Type {
+	case "GatewayIamRole":
+		return types.CredentialProviderConfiguration{
+			CredentialProviderType: types.CredentialProviderTypeGatewayIamRole,
+		}, nil
+
+	case "OAuth2":
+		if provider.ProviderArn == "" {
+			return types.CredentialProviderConfiguration{}, fmt.Errorf("providerArn is required for OAuth2 providers")
+		}
+		if len(provider.Scopes) == 0 {
+			return types.CredentialProviderConfiguration{}, fmt.Errorf("at least one scope is required for OAuth2 providers")
+		}
+		return types.CredentialProviderConfiguration{
+			CredentialProviderType: types.CredentialProviderTypeOauth,
+			CredentialProvider: &types.CredentialProviderMemberOauthCredentialProvider{
+				Value: types.OAuthCredentialProvider{
+					ProviderArn: aws.String(provider.ProviderArn),
+					Scopes:      provider.Scopes,
+					GrantType:   types.OAuthGrantTypeClientCredentials,
+				},
+			},
+		}, nil
+
+	case "ApiKey":
+		if provider.ProviderArn == "" {
+			return types.CredentialProviderConfiguration{}, fmt.Errorf("providerArn is required for ApiKey providers")
+		}
+		apiKey := types.GatewayApiKeyCredentialProvider{
+			ProviderArn:        aws.String(provider.ProviderArn),
+			CredentialLocation: types.ApiKeyCredentialLocation(provider.CredentialLocation),
+		}
+		if provider.CredentialParameterName != "" {
+			apiKey.CredentialParameterName = aws.String(provider.CredentialParameterName)
+		}
+		if provider.CredentialPrefix != "" {
+			apiKey.CredentialPrefix = aws.String(provider.CredentialPrefix)
+		}
+		return types.CredentialProviderConfiguration{
+			CredentialProviderType: types.CredentialProviderTypeApiKey,
+			CredentialProvider:     &types.CredentialProviderMemberApiKeyCredentialProvider{Value: apiKey},
+		}, nil
+
+	default:
+		return types.CredentialProviderConfiguration{}, fmt.Errorf("unsupported credential provider type: %s", provider.Type)
+	}
+}
+
 // BuildMetadataConfig creates metadata configuration for header and parameter propagation
-// Returns nil if no metadata fields are present
-// Returns MetadataConfiguration with allowed headers/parameters if at least one field is present
-func (b *TargetConfigBuilder) BuildMetadataConfig(mcpServer *mcpgatewayv1alpha1.MCPServer) *types.MetadataConfiguration {
+// The allowlists of the spec are normalized first, see NormalizeSpec
+// Returns nil if no metadata fields are present, which keeps the settings of the target
+// Returns MetadataConfiguration with the present fields otherwise. Every present list replaces
+// the allowlist of the target as a whole, so entries removed from the spec are cleared and an
+// empty list clears the allowlist. Omitted lists carry over the allowlists of current, the
+// metadata configuration of the existing target, as an update replaces the whole configuration.
+// current is nil when the target is created.
+// DisableMetadataPropagation clears all allowlists.
+func (b *TargetConfigBuilder) BuildMetadataConfig(mcpServer *mcpgatewayv1alpha1.MCPServer, current *types.MetadataConfiguration) *types.MetadataConfiguration {
 	if mcpServer == nil {
 		return nil
 	}
+	mcpServer = withNormalizedSpec(mcpServer)
 
-	// Check if any metadata fields are present
-	hasRequestHeaders := len(mcpServer.Spec.AllowedRequestHeaders) > 0
-	hasQueryParameters := len(mcpServer.Spec.AllowedQueryParameters) > 0
-	hasResponseHeaders := len(mcpServer.Spec.AllowedResponseHeaders) > 0
+	if mcpServer.Spec.DisableMetadataPropagation {
+		return &types.MetadataConfiguration{
+			AllowedRequestHeaders:  []string{},
+			AllowedQueryParameters: []string{},
+			AllowedResponseHeaders: []string{},
+		}
+	}
 
 	// Return nil if no metadata fields are present
-	if !hasRequestHeaders && !hasQueryParameters && !hasResponseHeaders {
+	// A nil slice is an omitted field, while an empty one was set explicitly
+	if mcpServer.Spec.AllowedRequestHeaders == nil &&
+		mcpServer.Spec.AllowedQueryParameters == nil &&
+		mcpServer.Spec.AllowedResponseHeaders == nil {
 		return nil
 	}
 
+	if current == nil {
+		current = &types.MetadataConfiguration{}
+	}
+
 	// Build metadata configuration with present fields
 	return &types.MetadataConfiguration{
-		AllowedRequestHeaders:  mcpServer.Spec.AllowedRequestHeaders,
-		AllowedQueryParameters: mcpServer.Spec.AllowedQueryParameters,
-		AllowedResponseHeaders: mcpServer.Spec.AllowedResponseHeaders,
+		AllowedRequestHeaders:  allowlistOrCurrent(mcpServer.Spec.AllowedRequestHeaders, current.AllowedRequestHeaders),
+		AllowedQueryParameters: allowlistOrCurrent(mcpServer.Spec.AllowedQueryParameters, current.AllowedQueryParameters),
+		AllowedResponseHeaders: allowlistOrCurrent(mcpServer.Spec.AllowedResponseHeaders, current.AllowedResponseHeaders),
+	}
+}
+
+// allowlistOrCurrent returns the allowlist of the spec, or the current one if it is omitted
+func allowlistOrCurrent(desired, current []string) []string {
+	if desired == nil {
+		return current
+	}
+	return desired
+}
+
+// MetadataDrift compares the allowlists of the spec with current, the metadata configuration of
+// the gateway target, and describes every entry that differs. Omitted allowlists are not managed
+// by the operator and never drift. Entries of both sides are compared in their normalized form,
+// see NormalizeSpec, so header names are compared case-insensitively and surrounding whitespace
+// is ignored.
+// Returns nil if the target matches the spec.
+func (b *TargetConfigBuilder) MetadataDrift(mcpServer *mcpgatewayv1alpha1.MCPServer, current *types.MetadataConfiguration) []string {
+	desired := b.BuildMetadataConfig(mcpServer, nil)
+	if desired == nil {
+		return nil
+	}
+	if current == nil {
+		current = &types.MetadataConfiguration{}
+	}
+
+	var drift []string
+	drift = append(drift, allowlistDrift("allowedRequestHeaders", desired.AllowedRequestHeaders, current.AllowedRequestHeaders, NormalizeHeaderName)...)
+	drift = append(drift, allowlistDrift("allowedQueryParameters", desired.AllowedQueryParameters, current.AllowedQueryParameters, strings.TrimSpace)...)
+	drift = append(drift, allowlistDrift("allowedResponseHeaders", desired.AllowedResponseHeaders, current.AllowedResponseHeaders, NormalizeHeaderName)...)
+	return drift
+}
+
+// allowlistDrift describes the entries missing from or unexpected in the current allowlist.
+// normalize, if set, maps entries to the form they are compared in.
+func allowlistDrift(field string, desired, current []string, normalize func(string) string) []string {
+	if desired == nil {
+		return nil
+	}
+	if normalize == nil {
+		normalize = func(s string) string { return s }
+	}
+
+	inDesired := make(map[string]bool, len(desired))
+	for _, entry := range desired {
+		inDesired[normalize(entry)] = true
+	}
+	inCurrent := make(map[string]bool, len(current))
+	for _, entry := range current {
+		inCurrent[normalize(entry)] = true
+	}
+
+	var drift []string
+	for _, entry := range desired {
+		if !inCurrent[normalize(entry)] {
+			drift = append(drift, fmt.Sprintf("%s: missing %q", field, entry))
+		}
+	}
+	for _, entry := range current {
+		if !inDesired[normalize(entry)] {
+			drift = append(drift, fmt.Sprintf("%s: unexpected %q", field, entry))
+		}
 	}
+	return drift
 }
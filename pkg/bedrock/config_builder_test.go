@@ -0,0 +1,248 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestBuildCredentialConfig(t *testing.T) {
+	const oauthArn = "arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/oauth2credentialprovider/p"
+	const apiKeyArn = "arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/apikeycredentialprovider/k"
+
+	tests := []struct {
+		name      string
+		spec      mcpgatewayv1alpha1.MCPServerSpec
+		wantTypes []types.CredentialProviderType
+		wantErr   bool
+	}{
+		{
+			name: "single OAuth2 fields",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{
+				AuthType:         "OAuth2",
+				OauthProviderArn: oauthArn,
+				OauthScopes:      []string{"read"},
+			},
+			wantTypes: []types.CredentialProviderType{types.CredentialProviderTypeOauth},
+		},
+		{
+			name: "credential providers in order",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{
+				AuthType: "OAuth2",
+				CredentialProviders: []mcpgatewayv1alpha1.CredentialProvider{
+					{Type: "ApiKey", ProviderArn: apiKeyArn, CredentialLocation: "HEADER"},
+					{Type: "OAuth2", ProviderArn: oauthArn, Scopes: []string{"read"}},
+				},
+			},
+			wantTypes: []types.CredentialProviderType{
+				types.CredentialProviderTypeApiKey,
+				types.CredentialProviderTypeOauth,
+			},
+		},
+		{
+			name: "credential providers take precedence over single fields",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{
+				AuthType:         "OAuth2",
+				OauthProviderArn: oauthArn,
+				CredentialProviders: []mcpgatewayv1alpha1.CredentialProvider{
+					{Type: "GatewayIamRole"},
+				},
+			},
+			wantTypes: []types.CredentialProviderType{types.CredentialProviderTypeGatewayIamRole},
+		},
+		{
+			name: "OAuth2 provider without scopes",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{
+				CredentialProviders: []mcpgatewayv1alpha1.CredentialProvider{
+					{Type: "OAuth2", ProviderArn: oauthArn},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ApiKey provider without ARN",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{
+				CredentialProviders: []mcpgatewayv1alpha1.CredentialProvider{
+					{Type: "ApiKey"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported provider type",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{
+				CredentialProviders: []mcpgatewayv1alpha1.CredentialProvider{
+					{Type: "Basic"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	builder := NewTargetConfigBuilder()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configs, err := builder.BuildCredentialConfig(&mcpgatewayv1alpha1.MCPServer{Spec: tt.spec})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			gotTypes := make([]types.CredentialProviderType, 0, len(configs))
+			for _, config := range configs {
+				gotTypes = append(gotTypes, config.CredentialProviderType)
+			}
+			assert.Equal(t, tt.wantTypes, gotTypes)
+		})
+	}
+}
+
+func TestBuildMetadataConfig(t *testing.T) {
+	current := &types.MetadataConfiguration{
+		AllowedRequestHeaders:  []string{"X-Custom-Header", "X-Tenant"},
+		AllowedQueryParameters: []string{"page"},
+	}
+
+	tests := []struct {
+		name    string
+		spec    mcpgatewayv1alpha1.MCPServerSpec
+		current *types.MetadataConfiguration
+		want    *types.MetadataConfiguration
+	}{
+		{
+			name: "omitted lists keep the target settings",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{},
+			want: nil,
+		},
+		{
+			name: "omitted lists stay omitted on create",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{AllowedRequestHeaders: []string{"X-Custom-Header"}},
+			want: &types.MetadataConfiguration{AllowedRequestHeaders: []string{"X-Custom-Header"}},
+		},
+		{
+			name: "empty list clears the allowlist",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{AllowedQueryParameters: []string{}},
+			want: &types.MetadataConfiguration{AllowedQueryParameters: []string{}},
+		},
+		{
+			name: "disabled propagation clears all allowlists",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{DisableMetadataPropagation: true},
+			want: &types.MetadataConfiguration{
+				AllowedRequestHeaders:  []string{},
+				AllowedQueryParameters: []string{},
+				AllowedResponseHeaders: []string{},
+			},
+		},
+		{
+			name:    "added entries are written on update",
+			spec:    mcpgatewayv1alpha1.MCPServerSpec{AllowedRequestHeaders: []string{"X-Custom-Header", "X-Tenant", "X-Trace"}},
+			current: current,
+			want: &types.MetadataConfiguration{
+				AllowedRequestHeaders:  []string{"X-Custom-Header", "X-Tenant", "X-Trace"},
+				AllowedQueryParameters: []string{"page"},
+			},
+		},
+		{
+			name:    "removed entries are cleared on update",
+			spec:    mcpgatewayv1alpha1.MCPServerSpec{AllowedRequestHeaders: []string{"X-Custom-Header"}},
+			current: current,
+			want: &types.MetadataConfiguration{
+				AllowedRequestHeaders:  []string{"X-Custom-Header"},
+				AllowedQueryParameters: []string{"page"},
+			},
+		},
+		{
+			name:    "empty list clears the allowlist on update",
+			spec:    mcpgatewayv1alpha1.MCPServerSpec{AllowedRequestHeaders: []string{}},
+			current: current,
+			want: &types.MetadataConfiguration{
+				AllowedRequestHeaders:  []string{},
+				AllowedQueryParameters: []string{"page"},
+			},
+		},
+	}
+
+	builder := NewTargetConfigBuilder()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := builder.BuildMetadataConfig(&mcpgatewayv1alpha1.MCPServer{Spec: tt.spec}, tt.current)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMetadataDrift(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    mcpgatewayv1alpha1.MCPServerSpec
+		current *types.MetadataConfiguration
+		want    []string
+	}{
+		{
+			name:    "omitted lists are not managed",
+			spec:    mcpgatewayv1alpha1.MCPServerSpec{},
+			current: &types.MetadataConfiguration{AllowedRequestHeaders: []string{"X-Custom-Header"}},
+			want:    nil,
+		},
+		{
+			name:    "matching allowlists",
+			spec:    mcpgatewayv1alpha1.MCPServerSpec{AllowedRequestHeaders: []string{"X-Custom-Header"}},
+			current: &types.MetadataConfiguration{AllowedRequestHeaders: []string{"x-custom-header"}},
+			want:    nil,
+		},
+		{
+			name: "extra and missing entries",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{AllowedQueryParameters: []string{"page", "filter"}},
+			current: &types.MetadataConfiguration{
+				AllowedQueryParameters: []string{"page", "debug"},
+			},
+			want: []string{`allowedQueryParameters: missing "filter"`, `allowedQueryParameters: unexpected "debug"`},
+		},
+		{
+			name:    "cleared allowlist with entries in AWS",
+			spec:    mcpgatewayv1alpha1.MCPServerSpec{AllowedResponseHeaders: []string{}},
+			current: &types.MetadataConfiguration{AllowedResponseHeaders: []string{"X-Response-Id"}},
+			want:    []string{`allowedResponseHeaders: unexpected "X-Response-Id"`},
+		},
+		{
+			name:    "disabled propagation with entries in AWS",
+			spec:    mcpgatewayv1alpha1.MCPServerSpec{DisableMetadataPropagation: true},
+			current: &types.MetadataConfiguration{AllowedRequestHeaders: []string{"Authorization"}},
+			want:    []string{`allowedRequestHeaders: unexpected "Authorization"`},
+		},
+		{
+			name: "target without metadata configuration",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{AllowedRequestHeaders: []string{"X-Custom-Header"}},
+			want: []string{`allowedRequestHeaders: missing "X-Custom-Header"`},
+		},
+	}
+
+	builder := NewTargetConfigBuilder()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := builder.MetadataDrift(&mcpgatewayv1alpha1.MCPServer{Spec: tt.spec}, tt.current)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
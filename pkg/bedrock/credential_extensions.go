@@ -0,0 +1,140 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+const (
+	// credentialProviderConfigurationsKey is the request body field of the credential providers
+	// of CreateGatewayTarget and UpdateGatewayTarget
+	credentialProviderConfigurationsKey = "credentialProviderConfigurations"
+
+	// credentialExtensionsMiddleware is the ID of the middleware merging credential extensions
+	credentialExtensionsMiddleware = "CredentialProviderExtensions"
+)
+
+type credentialExtensionsKey struct{}
+
+// WithCredentialProviderExtensions returns a context whose gateway target creates and updates,
+// made through a BedrockClientWrapper, merge extensions into the credential provider
+// configurations of the request. Extension i is a JSON merge patch (RFC 7386) of the i-th
+// configuration in the form of the AWS API; extensions beyond the built configurations are
+// appended as configurations of their own. This passes options of the AWS API that the SDK does
+// not model yet through to AWS.
+func WithCredentialProviderExtensions(ctx context.Context, extensions []json.RawMessage) context.Context {
+	if len(extensions) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, credentialExtensionsKey{}, extensions)
+}
+
+// credentialExtensionOptions returns the per-call options merging the credential provider
+// extensions stored in ctx into the request body, or nil if the context carries none
+func credentialExtensionOptions(ctx context.Context) []func(*bedrockagentcorecontrol.Options) {
+	extensions, _ := ctx.Value(credentialExtensionsKey{}).([]json.RawMessage)
+	if len(extensions) == 0 {
+		return nil
+	}
+
+	merge := middleware.SerializeMiddlewareFunc(credentialExtensionsMiddleware, func(
+		ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler,
+	) (middleware.SerializeOutput, middleware.Metadata, error) {
+		request, ok := in.Request.(*smithyhttp.Request)
+		if !ok || request.GetStream() == nil {
+			return next.HandleSerialize(ctx, in)
+		}
+		body, err := io.ReadAll(request.GetStream())
+		if err != nil {
+			return middleware.SerializeOutput{}, middleware.Metadata{}, fmt.Errorf("failed to read request body: %w", err)
+		}
+		merged, err := mergeCredentialProviderExtensions(body, extensions)
+		if err != nil {
+			return middleware.SerializeOutput{}, middleware.Metadata{}, err
+		}
+		if in.Request, err = request.SetStream(bytes.NewReader(merged)); err != nil {
+			return middleware.SerializeOutput{}, middleware.Metadata{}, err
+		}
+		return next.HandleSerialize(ctx, in)
+	})
+
+	return []func(*bedrockagentcorecontrol.Options){
+		func(o *bedrockagentcorecontrol.Options) {
+			o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+				return stack.Serialize.Insert(merge, "OperationSerializer", middleware.After)
+			})
+		},
+	}
+}
+
+// mergeCredentialProviderExtensions merges the extensions into the credential provider
+// configurations of a serialized gateway target request body
+func mergeCredentialProviderExtensions(body []byte, extensions []json.RawMessage) ([]byte, error) {
+	var request map[string]any
+	if err := json.Unmarshal(body, &request); err != nil {
+		return nil, fmt.Errorf("failed to decode request body: %w", err)
+	}
+
+	configurations, _ := request[credentialProviderConfigurationsKey].([]any)
+	for i, extension := range extensions {
+		var patch any
+		if err := json.Unmarshal(extension, &patch); err != nil {
+			return nil, fmt.Errorf("credential provider extension %d: %w", i, err)
+		}
+		if _, ok := patch.(map[string]any); !ok {
+			return nil, fmt.Errorf("credential provider extension %d: must be a JSON object", i)
+		}
+		if i < len(configurations) {
+			configurations[i] = mergePatch(configurations[i], patch)
+		} else {
+			configurations = append(configurations, mergePatch(nil, patch))
+		}
+	}
+	request[credentialProviderConfigurationsKey] = configurations
+
+	return json.Marshal(request)
+}
+
+// mergePatch applies a JSON merge patch (RFC 7386) to target: objects are merged recursively,
+// null removes a field and any other value replaces the target
+func mergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+	return targetObject
+}
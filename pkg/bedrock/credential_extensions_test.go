@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeCredentialProviderExtensions(t *testing.T) {
+	body := []byte(`{"name":"weather","credentialProviderConfigurations":[` +
+		`{"credentialProviderType":"OAUTH","credentialProvider":{"oauthCredentialProvider":` +
+		`{"providerArn":"arn:provider","scopes":["read"],"grantType":"CLIENT_CREDENTIALS"}}}]}`)
+
+	tests := []struct {
+		name       string
+		extensions []json.RawMessage
+		want       string
+		wantErr    string
+	}{
+		{
+			name: "adds and removes fields of a built configuration",
+			extensions: []json.RawMessage{
+				json.RawMessage(`{"credentialProvider":{"oauthCredentialProvider":{"grantType":null,"customParameters":{"audience":"mcp"}}}}`),
+			},
+			want: `{"name":"weather","credentialProviderConfigurations":[` +
+				`{"credentialProviderType":"OAUTH","credentialProvider":{"oauthCredentialProvider":` +
+				`{"providerArn":"arn:provider","scopes":["read"],"customParameters":{"audience":"mcp"}}}}]}`,
+		},
+		{
+			name: "appends configurations beyond the built ones",
+			extensions: []json.RawMessage{
+				json.RawMessage(`{}`),
+				json.RawMessage(`{"credentialProviderType":"NEW_TYPE","credentialProvider":{"newCredentialProvider":{"option":true}}}`),
+			},
+			want: `{"name":"weather","credentialProviderConfigurations":[` +
+				`{"credentialProviderType":"OAUTH","credentialProvider":{"oauthCredentialProvider":` +
+				`{"providerArn":"arn:provider","scopes":["read"],"grantType":"CLIENT_CREDENTIALS"}}},` +
+				`{"credentialProviderType":"NEW_TYPE","credentialProvider":{"newCredentialProvider":{"option":true}}}]}`,
+		},
+		{
+			name:       "rejects extensions that are not objects",
+			extensions: []json.RawMessage{json.RawMessage(`["GATEWAY_IAM_ROLE"]`)},
+			wantErr:    "must be a JSON object",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := mergeCredentialProviderExtensions(body, tt.extensions)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(merged))
+		})
+	}
+}
+
+func TestWithCredentialProviderExtensions(t *testing.T) {
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &received))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"targetId":"TARGET1","gatewayArn":"arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-1","status":"CREATING"}`))
+	}))
+	defer server.Close()
+
+	client := bedrockagentcorecontrol.New(bedrockagentcorecontrol.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(server.URL),
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+		}),
+	})
+	wrapper := NewBedrockClientWrapper(client, logr.Discard())
+
+	ctx := WithCredentialProviderExtensions(context.Background(), []json.RawMessage{
+		json.RawMessage(`{"credentialProviderType":"GATEWAY_IAM_ROLE_V2"}`),
+	})
+	_, err := wrapper.CreateGatewayTarget(ctx, &bedrockagentcorecontrol.CreateGatewayTargetInput{
+		GatewayIdentifier: aws.String("gw-1"),
+		Name:              aws.String("weather"),
+		TargetConfiguration: &types.TargetConfigurationMemberMcp{
+			Value: &types.McpTargetConfigurationMemberMcpServer{
+				Value: types.McpServerTargetConfiguration{Endpoint: aws.String("https://mcp.example.com")},
+			},
+		},
+		CredentialProviderConfigurations: []types.CredentialProviderConfiguration{
+			{CredentialProviderType: types.CredentialProviderTypeGatewayIamRole},
+		},
+	})
+	require.NoError(t, err)
+
+	configurations, ok := received["credentialProviderConfigurations"].([]any)
+	require.True(t, ok)
+	require.Len(t, configurations, 1)
+	assert.Equal(t, "GATEWAY_IAM_ROLE_V2", configurations[0].(map[string]any)["credentialProviderType"])
+	assert.Equal(t, "weather", received["name"])
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// credentialProviderTypes maps the AWS credential provider types to the types of the MCPServer spec
+var credentialProviderTypes = map[types.CredentialProviderType]string{
+	types.CredentialProviderTypeGatewayIamRole: "GatewayIamRole",
+	types.CredentialProviderTypeOauth:          "OAuth2",
+	types.CredentialProviderTypeApiKey:         "ApiKey",
+}
+
+// SummarizeCredentialProviders describes the credential provider configurations of a gateway
+// target in the terms of the MCPServer spec, so that users can confirm what AWS received.
+// Only non-secret settings are included: scopes are counted rather than listed, and custom OAuth
+// parameters are left out. Types unknown to the operator are reported as AWS names them.
+func SummarizeCredentialProviders(configs []types.CredentialProviderConfiguration) []mcpgatewayv1alpha1.AppliedCredentialProvider {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	applied := make([]mcpgatewayv1alpha1.AppliedCredentialProvider, 0, len(configs))
+	for _, config := range configs {
+		provider := mcpgatewayv1alpha1.AppliedCredentialProvider{Type: string(config.CredentialProviderType)}
+		if name, ok := credentialProviderTypes[config.CredentialProviderType]; ok {
+			provider.Type = name
+		}
+
+		switch credential := config.CredentialProvider.(type) {
+		case *types.CredentialProviderMemberOauthCredentialProvider:
+			provider.ProviderArn = aws.ToString(credential.Value.ProviderArn)
+			provider.GrantType = string(credential.Value.GrantType)
+			provider.ScopeCount = int32(len(credential.Value.Scopes))
+		case *types.CredentialProviderMemberApiKeyCredentialProvider:
+			provider.ProviderArn = aws.ToString(credential.Value.ProviderArn)
+			provider.CredentialLocation = string(credential.Value.CredentialLocation)
+		}
+		applied = append(applied, provider)
+	}
+	return applied
+}
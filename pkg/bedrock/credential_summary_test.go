@@ -0,0 +1,72 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/stretchr/testify/assert"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestSummarizeCredentialProviders(t *testing.T) {
+	assert.Nil(t, SummarizeCredentialProviders(nil))
+
+	applied := SummarizeCredentialProviders([]types.CredentialProviderConfiguration{
+		{
+			CredentialProviderType: types.CredentialProviderTypeOauth,
+			CredentialProvider: &types.CredentialProviderMemberOauthCredentialProvider{
+				Value: types.OAuthCredentialProvider{
+					ProviderArn:      aws.String("arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/oauth2credentialprovider/weather"),
+					Scopes:           []string{"read", "write"},
+					GrantType:        types.OAuthGrantTypeClientCredentials,
+					CustomParameters: map[string]string{"audience": "secret"},
+				},
+			},
+		},
+		{
+			CredentialProviderType: types.CredentialProviderTypeApiKey,
+			CredentialProvider: &types.CredentialProviderMemberApiKeyCredentialProvider{
+				Value: types.GatewayApiKeyCredentialProvider{
+					ProviderArn:        aws.String("arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/apikeycredentialprovider/weather"),
+					CredentialLocation: types.ApiKeyCredentialLocationHeader,
+				},
+			},
+		},
+		{CredentialProviderType: types.CredentialProviderTypeGatewayIamRole},
+		{CredentialProviderType: types.CredentialProviderType("FUTURE_TYPE")},
+	})
+
+	assert.Equal(t, []mcpgatewayv1alpha1.AppliedCredentialProvider{
+		{
+			Type:        "OAuth2",
+			ProviderArn: "arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/oauth2credentialprovider/weather",
+			GrantType:   "CLIENT_CREDENTIALS",
+			ScopeCount:  2,
+		},
+		{
+			Type:               "ApiKey",
+			ProviderArn:        "arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/apikeycredentialprovider/weather",
+			CredentialLocation: "HEADER",
+		},
+		{Type: "GatewayIamRole"},
+		{Type: "FUTURE_TYPE"},
+	}, applied)
+}
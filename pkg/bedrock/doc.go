@@ -1,3 +1,20 @@
 // Package bedrock provides AWS Bedrock AgentCore client wrappers and utilities
 // for managing gateway targets.
+//
+// The package is usable outside of the operator by any controller that needs
+// AgentCore control-plane access:
+//
+//	client := bedrockagentcorecontrol.NewFromConfig(awsCfg, bedrock.WithUserAgent(version, clusterID))
+//	wrapper := bedrock.NewBedrockClientWrapper(client, logger,
+//		bedrock.WithRetryPolicy(bedrock.DefaultRetryPolicy()))
+//	output, err := wrapper.CreateGatewayTarget(bedrock.WithAttribution(ctx, namespace, name), input)
+//
+// Throttling and internal server errors are retried according to the RetryPolicy.
+// The exported Is*Error helpers classify errors returned by the wrapper.
+//
+// The wrapper calls AWS through the GatewayTargetAPI interface, which the SDK client
+// implements. Unit tests pass the in-memory implementation of pkg/bedrock/fake instead.
+//
+// The exported API of this package follows the module's semantic version: breaking
+// changes to exported identifiers are only made in a new major version.
 package bedrock
@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// TargetDrift compares current, the gateway target as AWS reports it, with the target built from
+// the MCPServer and named targetName, and describes every field that differs. Both sides are
+// compared in their normalized form, see NormalizeSpec, so formatting alone is no drift.
+// Compared are the name, the description without owner marker, the endpoint of MCP server
+// targets, the function of Lambda targets, the credential providers and the metadata allowlists.
+// Tool and OpenAPI schemas are not compared, as AWS does not return them as sent. Credential
+// providers are not compared either if the spec extends them, since the extensions are only
+// merged into the request on the wire. Returns nil if the target matches the MCPServer.
+func (b *TargetConfigBuilder) TargetDrift(
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	targetName string,
+	current *bedrockagentcorecontrol.GetGatewayTargetOutput,
+) []string {
+	if mcpServer == nil || current == nil {
+		return nil
+	}
+	spec := NormalizeSpec(mcpServer.Spec)
+
+	var drift []string
+	if name := aws.ToString(current.Name); name != targetName {
+		drift = append(drift, fmt.Sprintf("name: %q, want %q", name, targetName))
+	}
+	if description := strings.TrimSpace(StripOwnerMarker(aws.ToString(current.Description))); description != spec.Description {
+		drift = append(drift, fmt.Sprintf("description: %q, want %q", description, spec.Description))
+	}
+
+	switch spec.TargetType {
+	case mcpgatewayv1alpha1.TargetTypeLambda:
+		if lambdaArn := lambdaFunctionArn(current.TargetConfiguration); lambdaArn != spec.LambdaArn {
+			drift = append(drift, fmt.Sprintf("lambdaArn: %q, want %q", lambdaArn, spec.LambdaArn))
+		}
+	case mcpgatewayv1alpha1.TargetTypeOpenAPISchema:
+	default:
+		endpoint := NormalizeEndpoint(MCPServerEndpoint(current.TargetConfiguration))
+		if endpoint != spec.Endpoint {
+			drift = append(drift, fmt.Sprintf("endpoint: %q, want %q", endpoint, spec.Endpoint))
+		}
+	}
+
+	if len(spec.CredentialProviderExtensions) == 0 {
+		// Invalid credential settings are reported by the validation of the spec
+		if desired, err := b.BuildCredentialConfig(mcpServer); err == nil {
+			want := describeCredentialProviders(desired)
+			if got := describeCredentialProviders(current.CredentialProviderConfigurations); !slices.Equal(got, want) {
+				drift = append(drift, fmt.Sprintf("credentialProviders: [%s], want [%s]",
+					strings.Join(got, ", "), strings.Join(want, ", ")))
+			}
+		}
+	}
+
+	return append(drift, b.MetadataDrift(mcpServer, current.MetadataConfiguration)...)
+}
+
+// describeCredentialProviders describes each credential provider configuration by its type,
+// provider ARN and number of scopes
+func describeCredentialProviders(configs []types.CredentialProviderConfiguration) []string {
+	descriptions := make([]string, 0, len(configs))
+	for _, provider := range SummarizeCredentialProviders(configs) {
+		description := provider.Type
+		if provider.ProviderArn != "" {
+			description += " " + strings.TrimSpace(provider.ProviderArn)
+		}
+		if provider.ScopeCount > 0 {
+			description += fmt.Sprintf(" (%d scopes)", provider.ScopeCount)
+		}
+		descriptions = append(descriptions, description)
+	}
+	return descriptions
+}
+
+// MCPServerEndpoint returns the endpoint of an MCP server target configuration, or an empty
+// string for other targets
+func MCPServerEndpoint(config types.TargetConfiguration) string {
+	mcp, ok := config.(*types.TargetConfigurationMemberMcp)
+	if !ok {
+		return ""
+	}
+	server, ok := mcp.Value.(*types.McpTargetConfigurationMemberMcpServer)
+	if !ok {
+		return ""
+	}
+	return aws.ToString(server.Value.Endpoint)
+}
+
+// lambdaFunctionArn returns the function ARN of a Lambda target configuration, or an empty string
+// for other targets
+func lambdaFunctionArn(config types.TargetConfiguration) string {
+	mcp, ok := config.(*types.TargetConfigurationMemberMcp)
+	if !ok {
+		return ""
+	}
+	lambda, ok := mcp.Value.(*types.McpTargetConfigurationMemberLambda)
+	if !ok {
+		return ""
+	}
+	return aws.ToString(lambda.Value.LambdaArn)
+}
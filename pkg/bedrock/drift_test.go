@@ -0,0 +1,93 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestTargetDrift(t *testing.T) {
+	builder := NewTargetConfigBuilder()
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:              "https://weather.example.com/mcp",
+			Description:           "Weather tools",
+			AllowedRequestHeaders: []string{"X-Tenant-Id"},
+		},
+	}
+	target := func() *bedrockagentcorecontrol.GetGatewayTargetOutput {
+		return &bedrockagentcorecontrol.GetGatewayTargetOutput{
+			Name:        aws.String("weather"),
+			Description: aws.String("Weather tools [managed-by: east]"),
+			TargetConfiguration: &types.TargetConfigurationMemberMcp{
+				Value: &types.McpTargetConfigurationMemberMcpServer{
+					Value: types.McpServerTargetConfiguration{Endpoint: aws.String("https://Weather.example.com/mcp")},
+				},
+			},
+			CredentialProviderConfigurations: []types.CredentialProviderConfiguration{
+				{CredentialProviderType: types.CredentialProviderTypeGatewayIamRole},
+			},
+			MetadataConfiguration: &types.MetadataConfiguration{AllowedRequestHeaders: []string{"x-tenant-id"}},
+		}
+	}
+
+	t.Run("matching target", func(t *testing.T) {
+		assert.Empty(t, builder.TargetDrift(mcpServer, "weather", target()))
+	})
+
+	t.Run("edited target", func(t *testing.T) {
+		current := target()
+		current.Name = aws.String("weather-old")
+		current.Description = aws.String("Edited in the console")
+		current.TargetConfiguration = &types.TargetConfigurationMemberMcp{
+			Value: &types.McpTargetConfigurationMemberMcpServer{
+				Value: types.McpServerTargetConfiguration{Endpoint: aws.String("https://elsewhere.example.com/mcp")},
+			},
+		}
+		current.CredentialProviderConfigurations = []types.CredentialProviderConfiguration{{
+			CredentialProviderType: types.CredentialProviderTypeOauth,
+			CredentialProvider: &types.CredentialProviderMemberOauthCredentialProvider{
+				Value: types.OAuthCredentialProvider{ProviderArn: aws.String("arn:aws:provider"), Scopes: []string{"read"}},
+			},
+		}}
+		current.MetadataConfiguration = nil
+
+		assert.Equal(t, []string{
+			`name: "weather-old", want "weather"`,
+			`description: "Edited in the console", want "Weather tools"`,
+			`endpoint: "https://elsewhere.example.com/mcp", want "https://weather.example.com/mcp"`,
+			`credentialProviders: [OAuth2 arn:aws:provider (1 scopes)], want [GatewayIamRole]`,
+			`allowedRequestHeaders: missing "X-Tenant-Id"`,
+		}, builder.TargetDrift(mcpServer, "weather", current))
+	})
+
+	t.Run("credential provider extensions", func(t *testing.T) {
+		extended := mcpServer.DeepCopy()
+		extended.Spec.CredentialProviderExtensions = []runtime.RawExtension{{Raw: []byte(`{}`)}}
+		current := target()
+		current.CredentialProviderConfigurations = nil
+		assert.Empty(t, builder.TargetDrift(extended, "weather", current))
+	})
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/go-logr/logr"
+)
+
+// dryRunMiddleware is the ID of the middleware holding back mutating calls
+const dryRunMiddleware = "DryRun"
+
+// mutatingOperationPrefixes are the prefixes of the AWS operations that change resources
+var mutatingOperationPrefixes = []string{"Create", "Update", "Delete", "Put", "Set", "Synchronize"}
+
+// DryRunError is returned instead of sending a mutating AWS call in dry-run mode. It is not
+// retryable, so the resource the call was made for reports it in its status.
+type DryRunError struct {
+	Operation string
+}
+
+// Error implements the error interface
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry run: %s was not sent to AWS", e.Operation)
+}
+
+// IsDryRunError checks if the error is a DryRunError
+func IsDryRunError(err error) bool {
+	var dryRunErr *DryRunError
+	return errors.As(err, &dryRunErr)
+}
+
+// WithDryRun returns a client option that logs every mutating call with its full input and
+// returns a DryRunError instead of sending it. Read-only calls are sent as usual, so the operator
+// can be run against a sandbox account to see what it would change.
+func WithDryRun(logger logr.Logger) func(*bedrockagentcorecontrol.Options) {
+	hold := middleware.InitializeMiddlewareFunc(dryRunMiddleware, func(
+		ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+	) (middleware.InitializeOutput, middleware.Metadata, error) {
+		operation := awsmiddleware.GetOperationName(ctx)
+		if !isMutatingOperation(operation) {
+			return next.HandleInitialize(ctx, in)
+		}
+		input, err := json.Marshal(in.Parameters)
+		if err != nil {
+			input = []byte(fmt.Sprintf("%+v", in.Parameters))
+		}
+		logger.Info("Dry run, not sending AWS call", "operation", operation, "input", string(input))
+		return middleware.InitializeOutput{}, middleware.Metadata{}, &DryRunError{Operation: operation}
+	})
+
+	return func(o *bedrockagentcorecontrol.Options) {
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(hold, middleware.After)
+		})
+	}
+}
+
+// isMutatingOperation reports whether the AWS operation changes resources
+func isMutatingOperation(operation string) bool {
+	for _, prefix := range mutatingOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+	return false
+}
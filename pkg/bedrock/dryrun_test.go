@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDryRun(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"gatewayId":"gw-1","status":"READY"}`))
+	}))
+	defer server.Close()
+
+	var logged []string
+	logger := funcr.New(func(prefix, args string) { logged = append(logged, args) }, funcr.Options{})
+	client := bedrockagentcorecontrol.New(bedrockagentcorecontrol.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(server.URL),
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+		}),
+	}, WithDryRun(logger))
+	wrapper := NewBedrockClientWrapper(client, logr.Discard())
+
+	err := wrapper.DeleteGatewayTarget(context.Background(), "gw-1", "TARGET1")
+	require.Error(t, err)
+	assert.True(t, IsDryRunError(err))
+	assert.False(t, IsRetryableError(err))
+	assert.Contains(t, err.Error(), "dry run: DeleteGatewayTarget was not sent to AWS")
+	assert.Empty(t, requests)
+	require.Len(t, logged, 1)
+	assert.Contains(t, logged[0], `"operation"="DeleteGatewayTarget"`)
+	assert.Contains(t, logged[0], `TARGET1`)
+
+	// Read-only calls are sent
+	_, err = wrapper.GetGateway(context.Background(), "gw-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GET /gateways/gw-1/"}, requests)
+}
+
+func TestIsMutatingOperation(t *testing.T) {
+	assert.True(t, isMutatingOperation("CreateGatewayTarget"))
+	assert.True(t, isMutatingOperation("SetTokenVaultCMK"))
+	assert.True(t, isMutatingOperation("SynchronizeGatewayTargets"))
+	assert.False(t, isMutatingOperation("GetGatewayTarget"))
+	assert.False(t, isMutatingOperation("ListGatewayTargets"))
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+)
+
+// WithEndpointURL returns a client option that sends calls to endpointURL instead of the regional
+// endpoint of the AgentCore control plane, e.g. to an interface VPC endpoint in clusters without
+// internet egress. An empty URL keeps the regional endpoint.
+func WithEndpointURL(endpointURL string) func(*bedrockagentcorecontrol.Options) {
+	return func(o *bedrockagentcorecontrol.Options) {
+		if endpointURL != "" {
+			o.BaseEndpoint = aws.String(endpointURL)
+		}
+	}
+}
+
+// ValidateEndpointURL checks that endpointURL is an absolute http or https URL without a query
+func ValidateEndpointURL(endpointURL string) error {
+	parsed, err := url.Parse(endpointURL)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint URL %q: %w", endpointURL, err)
+	}
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		return fmt.Errorf("endpoint URL %q must use https or http", endpointURL)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("endpoint URL %q has no host", endpointURL)
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		return fmt.Errorf("endpoint URL %q must not have a query or fragment", endpointURL)
+	}
+	return nil
+}
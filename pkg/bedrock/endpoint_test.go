@@ -0,0 +1,55 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithEndpointURL(t *testing.T) {
+	endpoint := "https://vpce-1.bedrock-agentcore-control.us-east-1.vpce.amazonaws.com"
+	client := bedrockagentcorecontrol.New(bedrockagentcorecontrol.Options{Region: "us-east-1"}, WithEndpointURL(endpoint))
+	assert.Equal(t, endpoint, aws.ToString(client.Options().BaseEndpoint))
+
+	client = bedrockagentcorecontrol.New(bedrockagentcorecontrol.Options{Region: "us-east-1"}, WithEndpointURL(""))
+	assert.Nil(t, client.Options().BaseEndpoint)
+}
+
+func TestValidateEndpointURL(t *testing.T) {
+	tests := map[string]bool{
+		"https://vpce-1.bedrock-agentcore-control.us-east-1.vpce.amazonaws.com": true,
+		"http://localhost:4566":            true,
+		"https://proxy.internal/agentcore": true,
+		"vpce-1.amazonaws.com":             false,
+		"ftp://vpce-1.amazonaws.com":       false,
+		"https://":                         false,
+		"https://host?region=us-east-1":    false,
+		"://bad":                           false,
+	}
+	for endpointURL, valid := range tests {
+		err := ValidateEndpointURL(endpointURL)
+		if valid {
+			assert.NoError(t, err, endpointURL)
+		} else {
+			assert.Error(t, err, endpointURL)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// MaxRetryAfter caps the wait a throttling response can ask for
+const MaxRetryAfter = 15 * time.Minute
+
+// ThrottledError is returned instead of retrying when AWS throttles a call and advises how long to
+// wait before the next one. It wraps the throttling error, so IsThrottlingError still matches it.
+type ThrottledError struct {
+	Operation  string
+	RetryAfter time.Duration
+	Err        error
+}
+
+// Error implements the error interface
+func (e *ThrottledError) Error() string {
+	return fmt.Sprintf("%s throttled by AWS, retry after %s: %v", e.Operation, e.RetryAfter.Round(time.Second), e.Err)
+}
+
+// Unwrap returns the throttling error
+func (e *ThrottledError) Unwrap() error {
+	return e.Err
+}
+
+// CallTimeoutError is returned when a single attempt of an AWS call exceeds the call timeout of
+// the BedrockClientWrapper, while the context of the call was still live. Unlike the cancellation
+// of that context, e.g. on shutdown, it is retryable.
+type CallTimeoutError struct {
+	Operation string
+	Timeout   time.Duration
+	Err       error
+}
+
+// Error implements the error interface
+func (e *CallTimeoutError) Error() string {
+	return fmt.Sprintf("%s timed out after %s: %v", e.Operation, e.Timeout, e.Err)
+}
+
+// Unwrap returns the error of the call
+func (e *CallTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// IsCallTimeoutError checks if the error is a CallTimeoutError
+func IsCallTimeoutError(err error) bool {
+	var timeoutErr *CallTimeoutError
+	return errors.As(err, &timeoutErr)
+}
+
+// IsCanceledError checks if the call was abandoned because its context was canceled or ran past
+// its own deadline, e.g. when the operator shuts down. Such errors say nothing about AWS; the
+// work is to be picked up again rather than counted as a failure. Call timeouts are not
+// cancellations.
+func IsCanceledError(err error) bool {
+	if err == nil || IsCallTimeoutError(err) {
+		return false
+	}
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// RetryAfter returns the wait advised by the Retry-After header of the response that failed with
+// err, given in seconds or as an HTTP date, capped at MaxRetryAfter. It reports false if the
+// response carries no usable advice.
+func RetryAfter(err error, now time.Time) (time.Duration, bool) {
+	var respErr *smithyhttp.ResponseError
+	if !errors.As(err, &respErr) || respErr.Response == nil || respErr.Response.Response == nil {
+		return 0, false
+	}
+	value := strings.TrimSpace(respErr.Response.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	var wait time.Duration
+	if seconds, parseErr := strconv.Atoi(value); parseErr == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, parseErr := http.ParseTime(value); parseErr == nil {
+		wait = date.Sub(now)
+	} else {
+		return 0, false
+	}
+
+	if wait <= 0 {
+		return 0, false
+	}
+	return min(wait, MaxRetryAfter), true
+}
+
+// IsRetryableError determines if an error should be retried
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// Check for throttling errors
+	if IsThrottlingError(err) {
+		return true
+	}
+
+	// Check for internal server errors
+	if IsInternalServerError(err) {
+		return true
+	}
+
+	// Attempts that exceeded the call timeout may succeed when repeated
+	if IsCallTimeoutError(err) {
+		return true
+	}
+
+	// Check for network/timeout errors
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return false // Don't retry context errors
+	}
+
+	// Check for validation errors (not retryable)
+	if IsValidationError(err) {
+		return false
+	}
+
+	// Default to not retrying unknown errors
+	return false
+}
+
+// IsThrottlingError checks if the error is a throttling error
+func IsThrottlingError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		code := apiErr.ErrorCode()
+		return code == "ThrottlingException" ||
+			code == "TooManyRequestsException" ||
+			code == "RequestLimitExceeded"
+	}
+	return false
+}
+
+// IsInternalServerError checks if the error is an internal server error
+func IsInternalServerError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		code := apiErr.ErrorCode()
+		return code == "InternalServerException" ||
+			code == "ServiceUnavailableException" ||
+			code == "InternalFailure"
+	}
+	return false
+}
+
+// IsValidationError checks if the error is a validation error
+func IsValidationError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		code := apiErr.ErrorCode()
+		return code == "ValidationException" ||
+			code == "InvalidParameterException" ||
+			code == "InvalidRequestException"
+	}
+	return false
+}
+
+// IsExpiredCredentialsError checks if the error reports expired or unrecognized credentials,
+// which new credentials may resolve
+func IsExpiredCredentialsError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		code := apiErr.ErrorCode()
+		return code == "ExpiredTokenException" ||
+			code == "ExpiredToken" ||
+			code == "UnrecognizedClientException"
+	}
+	return false
+}
+
+// IsAccessDeniedError checks if the error is an AccessDeniedException
+func IsAccessDeniedError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "AccessDeniedException"
+	}
+	return false
+}
+
+// IsResourceNotFoundError checks if the error is a ResourceNotFoundException
+func IsResourceNotFoundError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "ResourceNotFoundException"
+	}
+
+	// Also check for the typed error
+	var notFoundErr *types.ResourceNotFoundException
+	return errors.As(err, &notFoundErr)
+}
+
+// IsConflictError checks if the error is a ConflictException, returned when the resource
+// is being modified concurrently
+func IsConflictError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "ConflictException"
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil error",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "throttling error",
+			err:  &smithy.GenericAPIError{Code: "ThrottlingException"},
+			want: true,
+		},
+		{
+			name: "wrapped internal server error",
+			err:  fmt.Errorf("call failed: %w", &smithy.GenericAPIError{Code: "InternalServerException"}),
+			want: true,
+		},
+		{
+			name: "validation error",
+			err:  &smithy.GenericAPIError{Code: "ValidationException"},
+			want: false,
+		},
+		{
+			name: "context canceled",
+			err:  context.Canceled,
+			want: false,
+		},
+		{
+			name: "call timeout",
+			err:  &CallTimeoutError{Operation: "GetGatewayTarget", Timeout: time.Second, Err: context.DeadlineExceeded},
+			want: true,
+		},
+		{
+			name: "unknown error",
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRetryableError(tt.err))
+		})
+	}
+}
+
+func TestIsResourceNotFoundError(t *testing.T) {
+	assert.True(t, IsResourceNotFoundError(&smithy.GenericAPIError{Code: "ResourceNotFoundException"}))
+	assert.False(t, IsResourceNotFoundError(&smithy.GenericAPIError{Code: "AccessDeniedException"}))
+	assert.False(t, IsResourceNotFoundError(errors.New("not found")))
+}
+
+func TestIsConflictError(t *testing.T) {
+	assert.True(t, IsConflictError(&smithy.GenericAPIError{Code: "ConflictException"}))
+	assert.True(t, IsConflictError(fmt.Errorf("update failed: %w", &smithy.GenericAPIError{Code: "ConflictException"})))
+	assert.False(t, IsConflictError(&smithy.GenericAPIError{Code: "ValidationException"}))
+	assert.False(t, IsConflictError(errors.New("conflict")))
+}
+
+func TestIsExpiredCredentialsError(t *testing.T) {
+	assert.True(t, IsExpiredCredentialsError(&smithy.GenericAPIError{Code: "ExpiredTokenException"}))
+	assert.True(t, IsExpiredCredentialsError(fmt.Errorf("get failed: %w", &smithy.GenericAPIError{Code: "UnrecognizedClientException"})))
+	assert.False(t, IsExpiredCredentialsError(&smithy.GenericAPIError{Code: "AccessDeniedException"}))
+	assert.False(t, IsExpiredCredentialsError(nil))
+}
+
+// throttlingResponse returns a throttling error as the SDK reports it, with the given Retry-After header
+func throttlingResponse(retryAfter string) error {
+	header := http.Header{}
+	if retryAfter != "" {
+		header.Set("Retry-After", retryAfter)
+	}
+	return &smithy.OperationError{
+		ServiceID:     "Bedrock AgentCore Control",
+		OperationName: "UpdateGatewayTarget",
+		Err: &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 429, Header: header}},
+				Err:      &smithy.GenericAPIError{Code: "ThrottlingException"},
+			},
+		},
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		err    error
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "seconds", err: throttlingResponse("30"), want: 30 * time.Second, wantOK: true},
+		{name: "HTTP date", err: throttlingResponse("Thu, 01 Jan 2026 12:02:00 GMT"), want: 2 * time.Minute, wantOK: true},
+		{name: "capped", err: throttlingResponse("86400"), want: MaxRetryAfter, wantOK: true},
+		{name: "date in the past", err: throttlingResponse("Thu, 01 Jan 2026 11:00:00 GMT")},
+		{name: "malformed", err: throttlingResponse("soon")},
+		{name: "no header", err: throttlingResponse("")},
+		{name: "no response", err: &smithy.GenericAPIError{Code: "ThrottlingException"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := RetryAfter(tt.err, now)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWithRetry_HonorsRetryAfter(t *testing.T) {
+	wrapper := NewBedrockClientWrapper(nil, logr.Discard())
+
+	calls := 0
+	err := wrapper.withRetry(context.Background(), "UpdateGatewayTarget", func(context.Context) error {
+		calls++
+		return throttlingResponse("20")
+	})
+
+	var throttledErr *ThrottledError
+	require.ErrorAs(t, err, &throttledErr)
+	assert.Equal(t, 20*time.Second, throttledErr.RetryAfter)
+	assert.True(t, IsThrottlingError(err))
+	assert.Equal(t, 1, calls, "advised waits are left to the caller instead of retrying")
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"sync"
+	"time"
+)
+
+// FairShare divides a capacity of AWS calls per sliding time window evenly among tenants, e.g.
+// namespaces or gateways, so that one tenant's churn cannot starve the reconciles of another.
+// Every tenant that made a call within the window, and the tenant asking for a call, is active;
+// each active tenant may make capacity/active calls within the window, but at least one. Capacity
+// is not reserved for idle tenants, so a single active tenant may use all of it. All tenants
+// together never make more than capacity calls within the window: with more active tenants than
+// capacity, or calls made before the shares shrank, a tenant below its share waits for the
+// oldest call to leave the window.
+type FairShare struct {
+	capacity int
+	window   time.Duration
+	now      func() time.Time
+
+	mu    sync.Mutex
+	calls map[string][]time.Time
+}
+
+// NewFairShare creates a new FairShare dividing capacity calls within window among tenants
+func NewFairShare(capacity int, window time.Duration) *FairShare {
+	return &FairShare{
+		capacity: capacity,
+		window:   window,
+		now:      time.Now,
+		calls:    make(map[string][]time.Time),
+	}
+}
+
+// Capacity returns the number of calls divided among the tenants within the window
+func (f *FairShare) Capacity() int {
+	return f.capacity
+}
+
+// Window returns the duration of the sliding window
+func (f *FairShare) Window() time.Duration {
+	return f.window
+}
+
+// Spend records a call for the tenant, or returns a BudgetExceededError if the tenant has
+// already made its share of calls within the window, or the tenants together the capacity
+func (f *FairShare) Spend(tenant string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+	f.prune(now)
+	share := f.share(tenant)
+	calls := f.calls[tenant]
+	if retryAfter := f.retryAfter(calls, share, now); retryAfter > 0 {
+		return &BudgetExceededError{
+			Tenant:     tenant,
+			Limit:      share,
+			Window:     f.window,
+			RetryAfter: retryAfter,
+		}
+	}
+	f.calls[tenant] = append(calls, now)
+	return nil
+}
+
+// RetryAfter returns how long the tenant has to wait before its next call is allowed,
+// or zero if it has calls left in its share
+func (f *FairShare) RetryAfter(tenant string) time.Duration {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+	f.prune(now)
+	return f.retryAfter(f.calls[tenant], f.share(tenant), now)
+}
+
+// Share returns the number of calls the tenant may make within the window at the moment
+func (f *FairShare) Share(tenant string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.prune(f.now())
+	return f.share(tenant)
+}
+
+// share returns the share of the tenant among the active tenants. The caller must hold the lock.
+func (f *FairShare) share(tenant string) int {
+	active := len(f.calls)
+	if _, ok := f.calls[tenant]; !ok {
+		active++
+	}
+	return max(f.capacity/active, 1)
+}
+
+// retryAfter returns how long it takes until enough of the calls leave the window for the
+// tenant to be below share again, and the tenants together below capacity, or zero if the
+// tenant may call now. The share may have shrunk below the calls already made, as more tenants
+// became active. The caller must hold the lock.
+func (f *FairShare) retryAfter(calls []time.Time, share int, now time.Time) time.Duration {
+	var retryAfter time.Duration
+	if len(calls) >= share {
+		retryAfter = calls[len(calls)-share].Add(f.window).Sub(now)
+	}
+	total := 0
+	var oldest time.Time
+	for _, tenantCalls := range f.calls {
+		total += len(tenantCalls)
+		if oldest.IsZero() || tenantCalls[0].Before(oldest) {
+			oldest = tenantCalls[0]
+		}
+	}
+	if total >= f.capacity {
+		retryAfter = max(retryAfter, oldest.Add(f.window).Sub(now))
+	}
+	return retryAfter
+}
+
+// prune drops the calls that left the window and the tenants without calls left.
+// The caller must hold the lock.
+func (f *FairShare) prune(now time.Time) {
+	cutoff := now.Add(-f.window)
+	for tenant, calls := range f.calls {
+		i := 0
+		for i < len(calls) && !calls[i].After(cutoff) {
+			i++
+		}
+		if i == len(calls) {
+			delete(f.calls, tenant)
+			continue
+		}
+		f.calls[tenant] = calls[i:]
+	}
+}
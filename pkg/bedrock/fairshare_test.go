@@ -0,0 +1,106 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFairShare(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	share := NewFairShare(4, time.Hour)
+	share.now = func() time.Time { return now }
+
+	// A single active tenant may use the whole capacity
+	for range 3 {
+		require.NoError(t, share.Spend("team-a"))
+		now = now.Add(time.Minute)
+	}
+	assert.Equal(t, 4, share.Share("team-a"))
+
+	// A second tenant halves the share, which team-a has already exceeded
+	assert.Equal(t, 2, share.Share("team-b"))
+	require.NoError(t, share.Spend("team-b"))
+	err := share.Spend("team-a")
+	require.Error(t, err)
+	assert.True(t, IsBudgetExceededError(err))
+	assert.Contains(t, err.Error(), "tenant team-a")
+
+	// team-a is below its share once its first two calls left the window, and team-b, which
+	// has calls left in its share, waits for the capacity team-a used before team-b was active
+	assert.Equal(t, 58*time.Minute, share.RetryAfter("team-a"))
+	assert.Equal(t, 57*time.Minute, share.RetryAfter("team-b"))
+
+	now = now.Add(58 * time.Minute)
+	assert.Equal(t, time.Duration(0), share.RetryAfter("team-a"))
+	require.NoError(t, share.Spend("team-a"))
+
+	// Idle tenants leave the window and release their share
+	now = now.Add(2 * time.Hour)
+	assert.Equal(t, 4, share.Share("team-a"))
+	assert.Empty(t, share.calls)
+}
+
+func TestFairShare_MoreTenantsThanCapacity(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	share := NewFairShare(2, time.Hour)
+	share.now = func() time.Time { return now }
+
+	require.NoError(t, share.Spend("team-a"))
+	now = now.Add(time.Minute)
+	require.NoError(t, share.Spend("team-b"))
+	now = now.Add(time.Minute)
+
+	// team-c has a share of one call, but the tenants together used up the capacity
+	assert.Equal(t, 1, share.Share("team-c"), "every tenant may make at least one call")
+	err := share.Spend("team-c")
+	require.Error(t, err)
+	assert.True(t, IsBudgetExceededError(err))
+	assert.Equal(t, 58*time.Minute, share.RetryAfter("team-c"))
+
+	// The oldest call leaving the window frees capacity for team-c
+	now = now.Add(58 * time.Minute)
+	require.NoError(t, share.Spend("team-c"))
+	assert.Error(t, share.Spend("team-a"))
+}
+
+func TestWithFairShare(t *testing.T) {
+	share := NewFairShare(1, time.Hour)
+	wrapper := NewBedrockClientWrapper(nil, logr.Discard(), WithFairShare(share))
+
+	calls := 0
+	call := func(context.Context) error {
+		calls++
+		return nil
+	}
+
+	// Calls without tenant are not limited
+	require.NoError(t, wrapper.withRetry(context.Background(), "Test", call))
+	require.NoError(t, wrapper.withRetry(context.Background(), "Test", call))
+
+	ctx := WithTenant(context.Background(), "team-a")
+	require.NoError(t, wrapper.withRetry(ctx, "Test", call))
+	err := wrapper.withRetry(ctx, "Test", call)
+	assert.True(t, IsBudgetExceededError(err))
+	assert.Equal(t, 3, calls)
+}
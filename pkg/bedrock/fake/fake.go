@@ -0,0 +1,36 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides a bedrock.GatewayTargetAPI for the unit tests of controllers built on
+// pkg/bedrock, like the fake clientsets of client-go:
+//
+//	api := fake.NewGatewayTargetAPI("gw-1")
+//	wrapper := bedrock.NewBedrockClientWrapper(api, logger)
+//	api.Throttle("CreateGatewayTarget", 1)
+//
+// Tests that need to observe CREATING, UPDATING or DELETING use pkg/simulator with a clock instead.
+package fake
+
+import (
+	"github.com/aws/mcp-gateway-operator/pkg/simulator"
+)
+
+// NewGatewayTargetAPI returns an AgentCore control plane served from memory that has the given
+// gateways. Gateways and gateway targets are READY or gone as soon as a call returns. Errors are
+// injected with Throttle and Fail, and Calls counts the calls of an operation.
+func NewGatewayTargetAPI(gateways ...string) *simulator.Simulator {
+	return simulator.New(simulator.Options{Gateways: gateways, TransitionDelay: -1})
+}
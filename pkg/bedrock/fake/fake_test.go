@@ -0,0 +1,56 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock/fake"
+)
+
+func TestGatewayTargetAPI(t *testing.T) {
+	ctx := context.Background()
+	api := fake.NewGatewayTargetAPI("gw-1")
+	wrapper := bedrock.NewBedrockClientWrapper(api, logr.Discard(), bedrock.WithRetryPolicy(bedrock.RetryPolicy{MaxRetries: 1}))
+
+	api.Throttle("CreateGatewayTarget", 1)
+	created, err := wrapper.CreateGatewayTarget(ctx, &bedrockagentcorecontrol.CreateGatewayTargetInput{
+		GatewayIdentifier: aws.String("gw-1"),
+		Name:              aws.String("weather"),
+		TargetConfiguration: &types.TargetConfigurationMemberMcp{
+			Value: &types.McpTargetConfigurationMemberMcpServer{
+				Value: types.McpServerTargetConfiguration{Endpoint: aws.String("https://weather.example.com/mcp")},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.TargetStatusReady, created.Status)
+	assert.Equal(t, 2, api.Calls("CreateGatewayTarget"), "the throttled call is retried")
+
+	require.NoError(t, wrapper.DeleteGatewayTarget(ctx, "gw-1", aws.ToString(created.TargetId)))
+	_, err = wrapper.GetGatewayTarget(ctx, "gw-1", aws.ToString(created.TargetId))
+	assert.True(t, bedrock.IsResourceNotFoundError(err))
+}
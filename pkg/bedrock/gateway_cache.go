@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+)
+
+// GatewayCache caches GetGateway results per gateway for a fixed TTL. Gateway metadata such as
+// the URL, authorizer and role rarely changes, so reconciles that only need to inspect the gateway
+// do not each have to call AWS. Entries are dropped when their TTL expires or when the gateway is
+// known to have changed (see Invalidate). A nil cache caches nothing.
+type GatewayCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]gatewayCacheEntry
+}
+
+type gatewayCacheEntry struct {
+	output    *bedrockagentcorecontrol.GetGatewayOutput
+	fetchedAt time.Time
+}
+
+// NewGatewayCache creates a new GatewayCache keeping GetGateway results for ttl
+func NewGatewayCache(ttl time.Duration) *GatewayCache {
+	return &GatewayCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]gatewayCacheEntry),
+	}
+}
+
+// TTL returns how long GetGateway results are kept
+func (c *GatewayCache) TTL() time.Duration {
+	return c.ttl
+}
+
+// Get returns the cached GetGateway result of the gateway, if it has not expired yet.
+// The result is shared and must not be modified.
+func (c *GatewayCache) Get(gatewayID string) (*bedrockagentcorecontrol.GetGatewayOutput, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[gatewayID]
+	if !ok {
+		return nil, false
+	}
+	if c.now().Sub(entry.fetchedAt) >= c.ttl {
+		delete(c.entries, gatewayID)
+		return nil, false
+	}
+	return entry.output, true
+}
+
+// Set caches the GetGateway result of the gateway
+func (c *GatewayCache) Set(gatewayID string, output *bedrockagentcorecontrol.GetGatewayOutput) {
+	if c == nil || output == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[gatewayID] = gatewayCacheEntry{output: output, fetchedAt: c.now()}
+}
+
+// Invalidate drops the cached result of the gateway, so that the next GetGateway calls AWS
+func (c *GatewayCache) Invalidate(gatewayID string) {
+	if c == nil || gatewayID == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, gatewayID)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayCache(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewGatewayCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	_, ok := cache.Get("gw-1")
+	assert.False(t, ok)
+
+	output := &bedrockagentcorecontrol.GetGatewayOutput{GatewayId: aws.String("gw-1")}
+	cache.Set("gw-1", output)
+	cached, ok := cache.Get("gw-1")
+	require.True(t, ok)
+	assert.Same(t, output, cached)
+
+	now = now.Add(time.Minute)
+	_, ok = cache.Get("gw-1")
+	assert.False(t, ok, "expired entries are not served")
+	assert.Empty(t, cache.entries)
+
+	cache.Set("gw-1", output)
+	cache.Invalidate("gw-1")
+	_, ok = cache.Get("gw-1")
+	assert.False(t, ok)
+}
+
+func TestNilGatewayCache(t *testing.T) {
+	var cache *GatewayCache
+	cache.Set("gw-1", &bedrockagentcorecontrol.GetGatewayOutput{})
+	_, ok := cache.Get("gw-1")
+	assert.False(t, ok)
+	cache.Invalidate("gw-1")
+}
+
+func TestWithGatewayCache(t *testing.T) {
+	cache := NewGatewayCache(time.Hour)
+	output := &bedrockagentcorecontrol.GetGatewayOutput{GatewayId: aws.String("gw-1")}
+	cache.Set("gw-1", output)
+
+	// Cached gateways are served without calling AWS
+	wrapper := NewBedrockClientWrapper(nil, logr.Discard(), WithGatewayCache(cache))
+	cached, err := wrapper.GetGateway(context.Background(), "gw-1")
+	require.NoError(t, err)
+	assert.Same(t, output, cached)
+
+	wrapper.InvalidateGateway("gw-1")
+	_, ok := cache.Get("gw-1")
+	assert.False(t, ok)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// toolDefinition is a tool of an inline Lambda tool schema, in the JSON form of spec.toolSchema.inline
+type toolDefinition struct {
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	InputSchema  *schemaDefinition `json:"inputSchema"`
+	OutputSchema *schemaDefinition `json:"outputSchema,omitempty"`
+}
+
+// schemaDefinition is the JSON schema of the input or output of a tool
+type schemaDefinition struct {
+	Type        string                      `json:"type"`
+	Description string                      `json:"description,omitempty"`
+	Items       *schemaDefinition           `json:"items,omitempty"`
+	Properties  map[string]schemaDefinition `json:"properties,omitempty"`
+	Required    []string                    `json:"required,omitempty"`
+}
+
+// buildLambdaTarget creates the TargetConfiguration of a Lambda function serving the tools of the
+// tool schema
+func buildLambdaTarget(lambdaArn string, toolSchema *mcpgatewayv1alpha1.ToolSchemaSpec) (types.TargetConfiguration, error) {
+	if lambdaArn == "" {
+		return nil, fmt.Errorf("lambdaArn is required when targetType is Lambda")
+	}
+
+	schema, err := BuildToolSchema(toolSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TargetConfigurationMemberMcp{
+		Value: &types.McpTargetConfigurationMemberLambda{
+			Value: types.McpLambdaTargetConfiguration{
+				LambdaArn:  aws.String(lambdaArn),
+				ToolSchema: schema,
+			},
+		},
+	}, nil
+}
+
+// BuildToolSchema converts the tool schema of a Lambda target to its AWS configuration.
+// Exactly one of the S3 URI and the inline tool definitions must be set.
+func BuildToolSchema(toolSchema *mcpgatewayv1alpha1.ToolSchemaSpec) (types.ToolSchema, error) {
+	if toolSchema == nil {
+		return nil, fmt.Errorf("toolSchema is required when targetType is Lambda")
+	}
+
+	switch {
+	case toolSchema.S3URI != "" && toolSchema.Inline != "":
+		return nil, fmt.Errorf("toolSchema.s3Uri and toolSchema.inline are mutually exclusive")
+
+	case toolSchema.S3URI != "":
+		s3 := types.S3Configuration{Uri: aws.String(toolSchema.S3URI)}
+		if toolSchema.S3BucketOwnerAccountID != "" {
+			s3.BucketOwnerAccountId = aws.String(toolSchema.S3BucketOwnerAccountID)
+		}
+		return &types.ToolSchemaMemberS3{Value: s3}, nil
+
+	case toolSchema.Inline != "":
+		var tools []toolDefinition
+		if err := json.Unmarshal([]byte(toolSchema.Inline), &tools); err != nil {
+			return nil, fmt.Errorf("toolSchema.inline is not a JSON array of tool definitions: %w", err)
+		}
+		if len(tools) == 0 {
+			return nil, fmt.Errorf("toolSchema.inline must define at least one tool")
+		}
+
+		definitions := make([]types.ToolDefinition, 0, len(tools))
+		for i, tool := range tools {
+			if tool.Name == "" || tool.Description == "" || tool.InputSchema == nil {
+				return nil, fmt.Errorf("toolSchema.inline[%d]: name, description and inputSchema are required", i)
+			}
+			path := fmt.Sprintf("toolSchema.inline[%d]", i)
+			inputSchema, err := tool.InputSchema.toAWS(path + ".inputSchema")
+			if err != nil {
+				return nil, err
+			}
+			definition := types.ToolDefinition{
+				Name:        aws.String(tool.Name),
+				Description: aws.String(tool.Description),
+				InputSchema: inputSchema,
+			}
+			if tool.OutputSchema != nil {
+				if definition.OutputSchema, err = tool.OutputSchema.toAWS(path + ".outputSchema"); err != nil {
+					return nil, err
+				}
+			}
+			definitions = append(definitions, definition)
+		}
+		return &types.ToolSchemaMemberInlinePayload{Value: definitions}, nil
+
+	default:
+		return nil, fmt.Errorf("one of toolSchema.s3Uri and toolSchema.inline is required")
+	}
+}
+
+// toAWS converts the schema to its AWS configuration
+func (s *schemaDefinition) toAWS(path string) (*types.SchemaDefinition, error) {
+	if !slices.Contains(types.SchemaType("").Values(), types.SchemaType(s.Type)) {
+		return nil, fmt.Errorf("%s.type: unsupported type %q", path, s.Type)
+	}
+
+	definition := &types.SchemaDefinition{
+		Type:     types.SchemaType(s.Type),
+		Required: s.Required,
+	}
+	if s.Description != "" {
+		definition.Description = aws.String(s.Description)
+	}
+	if s.Items != nil {
+		items, err := s.Items.toAWS(path + ".items")
+		if err != nil {
+			return nil, err
+		}
+		definition.Items = items
+	}
+	if len(s.Properties) > 0 {
+		definition.Properties = make(map[string]types.SchemaDefinition, len(s.Properties))
+		for name, property := range s.Properties {
+			converted, err := property.toAWS(path + ".properties." + name)
+			if err != nil {
+				return nil, err
+			}
+			definition.Properties[name] = *converted
+		}
+	}
+	return definition, nil
+}
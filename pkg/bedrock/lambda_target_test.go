@@ -0,0 +1,154 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+const testLambdaArn = "arn:aws:lambda:us-east-1:123456789012:function:tools"
+
+func TestBuildLambdaTarget(t *testing.T) {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			TargetType: mcpgatewayv1alpha1.TargetTypeLambda,
+			LambdaArn:  testLambdaArn,
+			ToolSchema: &mcpgatewayv1alpha1.ToolSchemaSpec{
+				S3URI:                  "s3://schemas/tools.json",
+				S3BucketOwnerAccountID: "123456789012",
+			},
+		},
+	}
+
+	config, err := NewTargetConfigBuilder().Build(mcpServer)
+	require.NoError(t, err)
+
+	mcp, ok := config.(*types.TargetConfigurationMemberMcp)
+	require.True(t, ok)
+	lambda, ok := mcp.Value.(*types.McpTargetConfigurationMemberLambda)
+	require.True(t, ok)
+	assert.Equal(t, testLambdaArn, aws.ToString(lambda.Value.LambdaArn))
+
+	s3, ok := lambda.Value.ToolSchema.(*types.ToolSchemaMemberS3)
+	require.True(t, ok)
+	assert.Equal(t, "s3://schemas/tools.json", aws.ToString(s3.Value.Uri))
+	assert.Equal(t, "123456789012", aws.ToString(s3.Value.BucketOwnerAccountId))
+}
+
+func TestBuildLambdaTargetRequiresArn(t *testing.T) {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			TargetType: mcpgatewayv1alpha1.TargetTypeLambda,
+			ToolSchema: &mcpgatewayv1alpha1.ToolSchemaSpec{S3URI: "s3://schemas/tools.json"},
+		},
+	}
+
+	_, err := NewTargetConfigBuilder().Build(mcpServer)
+	assert.ErrorContains(t, err, "lambdaArn is required")
+}
+
+func TestBuildToolSchemaInline(t *testing.T) {
+	schema, err := BuildToolSchema(&mcpgatewayv1alpha1.ToolSchemaSpec{
+		Inline: `[{
+			"name": "get_weather",
+			"description": "Returns the weather of a city",
+			"inputSchema": {
+				"type": "object",
+				"properties": {"city": {"type": "string", "description": "City name"}},
+				"required": ["city"]
+			},
+			"outputSchema": {"type": "array", "items": {"type": "number"}}
+		}]`,
+	})
+	require.NoError(t, err)
+
+	inline, ok := schema.(*types.ToolSchemaMemberInlinePayload)
+	require.True(t, ok)
+	require.Len(t, inline.Value, 1)
+
+	tool := inline.Value[0]
+	assert.Equal(t, "get_weather", aws.ToString(tool.Name))
+	assert.Equal(t, types.SchemaTypeObject, tool.InputSchema.Type)
+	assert.Equal(t, []string{"city"}, tool.InputSchema.Required)
+	assert.Equal(t, types.SchemaTypeString, tool.InputSchema.Properties["city"].Type)
+	assert.Equal(t, "City name", aws.ToString(tool.InputSchema.Properties["city"].Description))
+	require.NotNil(t, tool.OutputSchema)
+	assert.Equal(t, types.SchemaTypeArray, tool.OutputSchema.Type)
+	assert.Equal(t, types.SchemaTypeNumber, tool.OutputSchema.Items.Type)
+}
+
+func TestBuildToolSchemaErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		toolSchema *mcpgatewayv1alpha1.ToolSchemaSpec
+		wantErr    string
+	}{
+		{
+			name:    "missing",
+			wantErr: "toolSchema is required",
+		},
+		{
+			name:       "empty",
+			toolSchema: &mcpgatewayv1alpha1.ToolSchemaSpec{},
+			wantErr:    "one of toolSchema.s3Uri and toolSchema.inline is required",
+		},
+		{
+			name: "both sources",
+			toolSchema: &mcpgatewayv1alpha1.ToolSchemaSpec{
+				S3URI:  "s3://schemas/tools.json",
+				Inline: `[]`,
+			},
+			wantErr: "mutually exclusive",
+		},
+		{
+			name:       "not an array",
+			toolSchema: &mcpgatewayv1alpha1.ToolSchemaSpec{Inline: `{"name": "tool"}`},
+			wantErr:    "not a JSON array",
+		},
+		{
+			name:       "no tools",
+			toolSchema: &mcpgatewayv1alpha1.ToolSchemaSpec{Inline: `[]`},
+			wantErr:    "at least one tool",
+		},
+		{
+			name:       "missing input schema",
+			toolSchema: &mcpgatewayv1alpha1.ToolSchemaSpec{Inline: `[{"name": "tool", "description": "A tool"}]`},
+			wantErr:    "toolSchema.inline[0]: name, description and inputSchema are required",
+		},
+		{
+			name: "unsupported property type",
+			toolSchema: &mcpgatewayv1alpha1.ToolSchemaSpec{
+				Inline: `[{"name": "tool", "description": "A tool", "inputSchema": {"type": "object", "properties": {"when": {"type": "date"}}}}]`,
+			},
+			wantErr: `toolSchema.inline[0].inputSchema.properties.when.type: unsupported type "date"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildToolSchema(tt.toolSchema)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/textproto"
+	"slices"
+	"strings"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// NormalizeSpec returns a copy of spec in the form it is sent to AWS and hashed in. Surrounding
+// whitespace is trimmed from identifiers and list entries, the scheme and host of the endpoint
+// are lowercased, and header names are canonicalized, e.g. x-tenant-id to X-Tenant-Id, and
+// deduplicated. Without it, formatting differences between the spec and the gateway target would be
+// reported as drift on every reconciliation. OAuth scopes are sorted, as their order has no
+// meaning; the order of other list entries is kept, and omitted lists stay omitted. The
+// credential prefix is kept as is, as its whitespace is significant.
+func NormalizeSpec(spec mcpgatewayv1alpha1.MCPServerSpec) mcpgatewayv1alpha1.MCPServerSpec {
+	normalized := spec.DeepCopy()
+	normalized.Endpoint = NormalizeEndpoint(normalized.Endpoint)
+	normalized.LambdaArn = strings.TrimSpace(normalized.LambdaArn)
+	normalized.Description = strings.TrimSpace(normalized.Description)
+	normalized.OauthProviderArn = strings.TrimSpace(normalized.OauthProviderArn)
+	normalized.OauthScopes = normalizeScopes(normalized.OauthScopes)
+	for i := range normalized.CredentialProviders {
+		provider := &normalized.CredentialProviders[i]
+		provider.ProviderArn = strings.TrimSpace(provider.ProviderArn)
+		provider.Scopes = normalizeScopes(provider.Scopes)
+		provider.CredentialParameterName = strings.TrimSpace(provider.CredentialParameterName)
+	}
+	normalized.AllowedRequestHeaders = normalizeList(normalized.AllowedRequestHeaders, NormalizeHeaderName)
+	normalized.AllowedQueryParameters = normalizeList(normalized.AllowedQueryParameters, strings.TrimSpace)
+	normalized.AllowedResponseHeaders = normalizeList(normalized.AllowedResponseHeaders, NormalizeHeaderName)
+	return *normalized
+}
+
+// NormalizeEndpoint trims the endpoint and lowercases its scheme and host, which are
+// case-insensitive. The path and query are kept, since servers may treat their case as
+// significant. Endpoints with variables are only trimmed, since the names of the variables are
+// case-sensitive.
+func NormalizeEndpoint(endpoint string) string {
+	endpoint = strings.TrimSpace(endpoint)
+	scheme, rest, ok := strings.Cut(endpoint, "://")
+	if !ok || strings.Contains(endpoint, "{{") {
+		return endpoint
+	}
+	end := strings.IndexAny(rest, "/?#")
+	if end < 0 {
+		end = len(rest)
+	}
+	return strings.ToLower(scheme) + "://" + strings.ToLower(rest[:end]) + rest[end:]
+}
+
+// NormalizeHeaderName trims a header name and returns its canonical form, e.g. X-Tenant-Id, as
+// header names are case-insensitive
+func NormalizeHeaderName(name string) string {
+	return textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(name))
+}
+
+// normalizeList maps the entries of list with normalize, dropping empty entries and duplicates.
+// A nil list stays nil and an empty one empty, as they mean different things in the spec.
+func normalizeList(list []string, normalize func(string) string) []string {
+	if list == nil {
+		return nil
+	}
+	normalized := make([]string, 0, len(list))
+	seen := make(map[string]bool, len(list))
+	for _, entry := range list {
+		entry = normalize(entry)
+		if entry == "" || seen[entry] {
+			continue
+		}
+		seen[entry] = true
+		normalized = append(normalized, entry)
+	}
+	return normalized
+}
+
+// normalizeScopes trims and deduplicates OAuth scopes and sorts them
+func normalizeScopes(scopes []string) []string {
+	normalized := normalizeList(scopes, strings.TrimSpace)
+	slices.Sort(normalized)
+	return normalized
+}
+
+// HashSpec returns the SHA-256 hash of the normalized spec, see NormalizeSpec, so that specs
+// that differ only in formatting or in the order of their scopes hash the same. It returns an
+// empty string if the spec cannot be encoded.
+func HashSpec(spec mcpgatewayv1alpha1.MCPServerSpec) string {
+	data, err := json.Marshal(NormalizeSpec(spec))
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// withNormalizedSpec returns a shallow copy of the MCPServer with its spec normalized
+func withNormalizedSpec(mcpServer *mcpgatewayv1alpha1.MCPServer) *mcpgatewayv1alpha1.MCPServer {
+	copied := *mcpServer
+	copied.Spec = NormalizeSpec(mcpServer.Spec)
+	return &copied
+}
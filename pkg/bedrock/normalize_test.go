@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestNormalizeSpec(t *testing.T) {
+	spec := mcpgatewayv1alpha1.MCPServerSpec{
+		Endpoint:               " HTTPS://Weather.Example.com/MCP?Region=EU ",
+		Description:            "Weather tools\n",
+		OauthProviderArn:       " arn:aws:bedrock-agentcore:us-west-2:123456789012:token-vault/default/oauth2credentialprovider/p",
+		OauthScopes:            []string{"write ", " read", "read"},
+		AllowedRequestHeaders:  []string{"X-Tenant-Id", " x-tenant-id", "Authorization "},
+		AllowedResponseHeaders: []string{},
+		CredentialProviders: []mcpgatewayv1alpha1.CredentialProvider{{
+			Type:                    "ApiKey",
+			ProviderArn:             "arn:aws:bedrock-agentcore:us-west-2:123456789012:token-vault/default/apikeycredentialprovider/k ",
+			CredentialParameterName: " Authorization",
+			CredentialPrefix:        "Bearer ",
+		}},
+	}
+
+	normalized := NormalizeSpec(spec)
+
+	assert.Equal(t, "https://weather.example.com/MCP?Region=EU", normalized.Endpoint)
+	assert.Equal(t, "Weather tools", normalized.Description)
+	assert.Equal(t, "arn:aws:bedrock-agentcore:us-west-2:123456789012:token-vault/default/oauth2credentialprovider/p",
+		normalized.OauthProviderArn)
+	assert.Equal(t, []string{"read", "write"}, normalized.OauthScopes)
+	assert.Equal(t, []string{"X-Tenant-Id", "Authorization"}, normalized.AllowedRequestHeaders)
+	assert.Nil(t, normalized.AllowedQueryParameters)
+	assert.NotNil(t, normalized.AllowedResponseHeaders)
+	assert.Empty(t, normalized.AllowedResponseHeaders)
+	assert.Equal(t, "Authorization", normalized.CredentialProviders[0].CredentialParameterName)
+	assert.Equal(t, "Bearer ", normalized.CredentialProviders[0].CredentialPrefix)
+
+	// The spec itself is left alone
+	assert.Equal(t, " HTTPS://Weather.Example.com/MCP?Region=EU ", spec.Endpoint)
+	assert.Equal(t, "X-Tenant-Id", spec.AllowedRequestHeaders[0])
+}
+
+func TestHashSpec(t *testing.T) {
+	spec := mcpgatewayv1alpha1.MCPServerSpec{
+		Endpoint:    "https://weather.example.com/mcp",
+		OauthScopes: []string{"read", "write"},
+		CredentialProviders: []mcpgatewayv1alpha1.CredentialProvider{{
+			Type:   "OAuth2",
+			Scopes: []string{"a", "b"},
+		}},
+	}
+	hash := HashSpec(spec)
+	assert.Len(t, hash, 64)
+
+	// Reordered scopes and formatting hash the same
+	reordered := *spec.DeepCopy()
+	reordered.Endpoint = " HTTPS://Weather.Example.com/mcp"
+	reordered.OauthScopes = []string{"write", " read"}
+	reordered.CredentialProviders[0].Scopes = []string{"b", "a"}
+	assert.Equal(t, hash, HashSpec(reordered))
+
+	// Other changes do not
+	changed := *spec.DeepCopy()
+	changed.OauthScopes = []string{"read"}
+	assert.NotEqual(t, hash, HashSpec(changed))
+}
+
+func TestNormalizeEndpoint(t *testing.T) {
+	assert.Equal(t, "https://weather.example.com", NormalizeEndpoint("HTTPS://WEATHER.example.com"))
+	assert.Equal(t, "https://weather.example.com:8443/Path#Frag", NormalizeEndpoint("https://Weather.example.com:8443/Path#Frag"))
+	assert.Equal(t, "https://{{ .Values.Domain }}/mcp", NormalizeEndpoint("https://{{ .Values.Domain }}/mcp"))
+	assert.Equal(t, "not a url", NormalizeEndpoint(" not a url "))
+	assert.Equal(t, "", NormalizeEndpoint(""))
+}
+
+func TestBuild_NormalizedEndpoint(t *testing.T) {
+	builder := NewTargetConfigBuilder()
+	config, err := builder.Build(&mcpgatewayv1alpha1.MCPServer{
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{Endpoint: "https://Weather.Example.com/mcp "},
+	})
+	require.NoError(t, err)
+
+	mcp := config.(*types.TargetConfigurationMemberMcp)
+	server := mcp.Value.(*types.McpTargetConfigurationMemberMcpServer)
+	assert.Equal(t, "https://weather.example.com/mcp", aws.ToString(server.Value.Endpoint))
+}
+
+func TestMetadataDrift_FormattingIsNotDrift(t *testing.T) {
+	builder := NewTargetConfigBuilder()
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			AllowedRequestHeaders:  []string{"x-tenant-id ", "X-Tenant-Id"},
+			AllowedQueryParameters: []string{" region"},
+		},
+	}
+
+	config := builder.BuildMetadataConfig(mcpServer, nil)
+	assert.Equal(t, []string{"X-Tenant-Id"}, config.AllowedRequestHeaders)
+	assert.Equal(t, []string{"region"}, config.AllowedQueryParameters)
+
+	assert.Empty(t, builder.MetadataDrift(mcpServer, &types.MetadataConfiguration{
+		AllowedRequestHeaders:  []string{"x-tenant-id "},
+		AllowedQueryParameters: []string{"region"},
+	}))
+	assert.Equal(t, []string{`allowedQueryParameters: missing "region"`, `allowedQueryParameters: unexpected "Region"`},
+		builder.MetadataDrift(mcpServer, &types.MetadataConfiguration{
+			AllowedRequestHeaders:  []string{"X-TENANT-ID"},
+			AllowedQueryParameters: []string{"Region"},
+		}))
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"sigs.k8s.io/yaml"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// buildOpenAPITarget creates the TargetConfiguration of a REST API described by an OpenAPI schema
+func buildOpenAPITarget(schema *mcpgatewayv1alpha1.OpenAPISchemaSpec) (types.TargetConfiguration, error) {
+	apiSchema, err := BuildOpenAPISchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TargetConfigurationMemberMcp{
+		Value: &types.McpTargetConfigurationMemberOpenApiSchema{Value: apiSchema},
+	}, nil
+}
+
+// BuildOpenAPISchema converts the OpenAPI schema of an OpenApiSchema target to its AWS
+// configuration. A schema read from a ConfigMap must have been resolved into inline by the caller.
+func BuildOpenAPISchema(schema *mcpgatewayv1alpha1.OpenAPISchemaSpec) (types.ApiSchemaConfiguration, error) {
+	if schema == nil {
+		return nil, fmt.Errorf("openApiSchema is required when targetType is OpenApiSchema")
+	}
+
+	sources := 0
+	for _, set := range []bool{schema.Inline != "", schema.ConfigMapRef != nil, schema.S3URI != ""} {
+		if set {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return nil, fmt.Errorf("openApiSchema.inline, openApiSchema.configMapRef and openApiSchema.s3Uri are mutually exclusive")
+	}
+
+	switch {
+	case schema.Inline != "":
+		if err := validateOpenAPIDocument(schema.Inline); err != nil {
+			return nil, err
+		}
+		return &types.ApiSchemaConfigurationMemberInlinePayload{Value: schema.Inline}, nil
+
+	case schema.S3URI != "":
+		s3 := types.S3Configuration{Uri: aws.String(schema.S3URI)}
+		if schema.S3BucketOwnerAccountID != "" {
+			s3.BucketOwnerAccountId = aws.String(schema.S3BucketOwnerAccountID)
+		}
+		return &types.ApiSchemaConfigurationMemberS3{Value: s3}, nil
+
+	case schema.ConfigMapRef != nil:
+		return nil, fmt.Errorf("openApiSchema.configMapRef %s was not resolved", schema.ConfigMapRef.Name)
+
+	default:
+		return nil, fmt.Errorf("one of openApiSchema.inline, openApiSchema.configMapRef and openApiSchema.s3Uri is required")
+	}
+}
+
+// validateOpenAPIDocument checks that an inline schema is a JSON or YAML OpenAPI document.
+// The operations themselves are validated by AWS when the target is created.
+func validateOpenAPIDocument(document string) error {
+	var fields map[string]any
+	if err := yaml.Unmarshal([]byte(document), &fields); err != nil {
+		return fmt.Errorf("openApiSchema is not a JSON or YAML document: %w", err)
+	}
+	if version, ok := fields["openapi"].(string); !ok || version == "" {
+		return fmt.Errorf("openApiSchema has no openapi version field")
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+const testOpenAPIDocument = `openapi: 3.0.1
+info:
+  title: Weather API
+  version: 1.0.0
+paths: {}
+`
+
+func TestBuildOpenAPITarget(t *testing.T) {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			TargetType:    mcpgatewayv1alpha1.TargetTypeOpenAPISchema,
+			OpenAPISchema: &mcpgatewayv1alpha1.OpenAPISchemaSpec{Inline: testOpenAPIDocument},
+		},
+	}
+
+	config, err := NewTargetConfigBuilder().Build(mcpServer)
+	require.NoError(t, err)
+
+	mcp, ok := config.(*types.TargetConfigurationMemberMcp)
+	require.True(t, ok)
+	openAPI, ok := mcp.Value.(*types.McpTargetConfigurationMemberOpenApiSchema)
+	require.True(t, ok)
+	inline, ok := openAPI.Value.(*types.ApiSchemaConfigurationMemberInlinePayload)
+	require.True(t, ok)
+	assert.Equal(t, testOpenAPIDocument, inline.Value)
+}
+
+func TestBuildOpenAPISchemaS3(t *testing.T) {
+	schema, err := BuildOpenAPISchema(&mcpgatewayv1alpha1.OpenAPISchemaSpec{
+		S3URI:                  "s3://schemas/weather.yaml",
+		S3BucketOwnerAccountID: "123456789012",
+	})
+	require.NoError(t, err)
+
+	s3, ok := schema.(*types.ApiSchemaConfigurationMemberS3)
+	require.True(t, ok)
+	assert.Equal(t, "s3://schemas/weather.yaml", aws.ToString(s3.Value.Uri))
+	assert.Equal(t, "123456789012", aws.ToString(s3.Value.BucketOwnerAccountId))
+}
+
+func TestBuildOpenAPISchemaErrors(t *testing.T) {
+	configMapRef := &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "weather-api"},
+		Key:                  "openapi.yaml",
+	}
+
+	tests := []struct {
+		name    string
+		schema  *mcpgatewayv1alpha1.OpenAPISchemaSpec
+		wantErr string
+	}{
+		{
+			name:    "missing",
+			wantErr: "openApiSchema is required",
+		},
+		{
+			name:    "empty",
+			schema:  &mcpgatewayv1alpha1.OpenAPISchemaSpec{},
+			wantErr: "one of openApiSchema.inline, openApiSchema.configMapRef and openApiSchema.s3Uri is required",
+		},
+		{
+			name: "several sources",
+			schema: &mcpgatewayv1alpha1.OpenAPISchemaSpec{
+				Inline: testOpenAPIDocument,
+				S3URI:  "s3://schemas/weather.yaml",
+			},
+			wantErr: "mutually exclusive",
+		},
+		{
+			name:    "unresolved ConfigMap",
+			schema:  &mcpgatewayv1alpha1.OpenAPISchemaSpec{ConfigMapRef: configMapRef},
+			wantErr: "configMapRef weather-api was not resolved",
+		},
+		{
+			name:    "not a document",
+			schema:  &mcpgatewayv1alpha1.OpenAPISchemaSpec{Inline: "- openapi"},
+			wantErr: "not a JSON or YAML document",
+		},
+		{
+			name:    "no OpenAPI version",
+			schema:  &mcpgatewayv1alpha1.OpenAPISchemaSpec{Inline: `{"swagger": "2.0"}`},
+			wantErr: "no openapi version field",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildOpenAPISchema(tt.schema)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/aws/mcp-gateway-operator/pkg/audit"
+)
+
+// RetryPolicy controls how BedrockClientWrapper retries throttling and internal server errors.
+// Non-retryable errors are always returned immediately.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt
+	MaxRetries int
+
+	// InitialBackoff is the wait before the first retry
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the wait between retries
+	MaxBackoff time.Duration
+
+	// BackoffMultiplier is applied to the backoff after every retry
+	BackoffMultiplier float64
+}
+
+// DefaultRetryPolicy returns the retry policy used when no WithRetryPolicy option is given
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:        maxRetries,
+		InitialBackoff:    initialBackoff,
+		MaxBackoff:        maxBackoff,
+		BackoffMultiplier: backoffMultiplier,
+	}
+}
+
+type retryPolicyKey struct{}
+
+// WithContextRetryPolicy returns a context whose AWS calls made through a BedrockClientWrapper are
+// retried according to policy instead of the wrapper's own retry policy
+func WithContextRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// retryPolicyFor returns the retry policy stored in ctx, or the wrapper's retry policy
+func (w *BedrockClientWrapper) retryPolicyFor(ctx context.Context) RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy); ok {
+		return policy
+	}
+	return w.retryPolicy
+}
+
+// Option configures a BedrockClientWrapper
+type Option func(*BedrockClientWrapper)
+
+// WithRetryPolicy overrides the default retry policy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(w *BedrockClientWrapper) {
+		w.retryPolicy = policy
+	}
+}
+
+// WithCallBudget limits the calls made for each attributed resource.
+// Calls made with a context without attribution are not limited. A nil budget disables the limit.
+func WithCallBudget(budget *CallBudget) Option {
+	return func(w *BedrockClientWrapper) {
+		w.budget = budget
+	}
+}
+
+// WithFairShare limits the calls made for each tenant to its fair share.
+// Calls made with a context without tenant are not limited. A nil FairShare disables the limit.
+func WithFairShare(share *FairShare) Option {
+	return func(w *BedrockClientWrapper) {
+		w.fairShare = share
+	}
+}
+
+// WithAuditLogger writes an audit record for every mutating call. A nil logger disables auditing.
+func WithAuditLogger(logger *audit.Logger) Option {
+	return func(w *BedrockClientWrapper) {
+		w.auditLogger = logger
+	}
+}
+
+// WithGatewayCache serves GetGateway from cache. A nil cache disables caching.
+func WithGatewayCache(cache *GatewayCache) Option {
+	return func(w *BedrockClientWrapper) {
+		w.gateways = cache
+	}
+}
+
+// WithBackpressure records every call AWS throttles in backpressure. A nil Backpressure records
+// nothing.
+func WithBackpressure(backpressure *Backpressure) Option {
+	return func(w *BedrockClientWrapper) {
+		w.pressure = backpressure
+	}
+}
+
+// WithRateLimiter makes every attempt of a call wait for the bucket of its operation. A nil
+// RateLimiter does not limit calls.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(w *BedrockClientWrapper) {
+		w.limiter = limiter
+	}
+}
+
+// WithCircuitBreaker fails calls right away while breaker is open and records the outcome of every
+// call in it. A nil CircuitBreaker lets every call through.
+func WithCircuitBreaker(breaker *CircuitBreaker) Option {
+	return func(w *BedrockClientWrapper) {
+		w.breaker = breaker
+	}
+}
+
+// WithCallTimeout bounds every attempt of an AWS call, independently of the deadline of the
+// context it is made with. Zero leaves calls bounded only by their context.
+func WithCallTimeout(timeout time.Duration) Option {
+	return func(w *BedrockClientWrapper) {
+		w.callTimeout = timeout
+	}
+}
+
+// WithTracerProvider records the spans of AWS calls with the given provider instead of the
+// global one
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(w *BedrockClientWrapper) {
+		w.tracer = provider.Tracer(tracerName)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"strings"
+)
+
+// ownerMarkerPrefix and ownerMarkerSuffix enclose the owner marker the operator appends to the
+// description of the gateway targets it writes. Gateway targets cannot be tagged, so the
+// description is the only place on the AWS side to record which cluster manages a target.
+const (
+	ownerMarkerPrefix = "[managed-by: "
+	ownerMarkerSuffix = "]"
+)
+
+// WithOwnerMarker returns description with the owner marker of owner appended, replacing any
+// marker it already carries. Surrounding whitespace is trimmed from the description, as AWS would
+// otherwise report it back differently. An empty owner leaves the description without marker.
+func WithOwnerMarker(description, owner string) string {
+	description = strings.TrimSpace(StripOwnerMarker(description))
+	if owner == "" {
+		return description
+	}
+	marker := ownerMarkerPrefix + owner + ownerMarkerSuffix
+	if description == "" {
+		return marker
+	}
+	return description + " " + marker
+}
+
+// TargetOwner returns the owner recorded in the owner marker of a gateway target description,
+// and false if the description carries no marker, e.g. for targets created outside of the
+// operator or by operator versions without markers
+func TargetOwner(description string) (string, bool) {
+	i := strings.LastIndex(description, ownerMarkerPrefix)
+	if i < 0 || !strings.HasSuffix(description, ownerMarkerSuffix) {
+		return "", false
+	}
+	owner := description[i+len(ownerMarkerPrefix) : len(description)-len(ownerMarkerSuffix)]
+	if owner == "" {
+		return "", false
+	}
+	return owner, true
+}
+
+// StripOwnerMarker returns a gateway target description without its owner marker
+func StripOwnerMarker(description string) string {
+	if _, ok := TargetOwner(description); !ok {
+		return description
+	}
+	i := strings.LastIndex(description, ownerMarkerPrefix)
+	return strings.TrimSuffix(description[:i], " ")
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithOwnerMarker(t *testing.T) {
+	assert.Equal(t, "Weather tools [managed-by: east]", WithOwnerMarker("Weather tools", "east"))
+	assert.Equal(t, "[managed-by: east]", WithOwnerMarker("", "east"))
+	assert.Equal(t, "Weather tools", WithOwnerMarker("Weather tools", ""))
+	assert.Equal(t, "Weather tools [managed-by: west]", WithOwnerMarker("Weather tools [managed-by: east]", "west"))
+}
+
+func TestTargetOwner(t *testing.T) {
+	owner, ok := TargetOwner("Weather tools [managed-by: east/spoke-1]")
+	assert.True(t, ok)
+	assert.Equal(t, "east/spoke-1", owner)
+
+	_, ok = TargetOwner("Weather tools")
+	assert.False(t, ok)
+	_, ok = TargetOwner("[managed-by: ]")
+	assert.False(t, ok)
+	_, ok = TargetOwner("")
+	assert.False(t, ok)
+}
+
+func TestStripOwnerMarker(t *testing.T) {
+	assert.Equal(t, "Weather tools", StripOwnerMarker("Weather tools [managed-by: east]"))
+	assert.Equal(t, "", StripOwnerMarker("[managed-by: east]"))
+	assert.Equal(t, "Weather tools", StripOwnerMarker("Weather tools"))
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// providerRoleSessionName is the session name of the roles assumed for provider configs
+const providerRoleSessionName = "mcp-gateway-operator"
+
+// ProviderSettings are the settings of an AgentCore client that differ from the operator's own
+// AWS configuration, see AWSProviderConfig
+type ProviderSettings struct {
+	// Region is the region of the AgentCore control plane
+	Region string
+	// RoleARN is the role assumed with the operator's credentials. Empty uses them directly.
+	RoleARN string
+	// ExternalID is passed to sts:AssumeRole
+	ExternalID string
+	// EndpointURL overrides the endpoint of the AgentCore control plane
+	EndpointURL string
+}
+
+// ProviderClients creates the AgentCore clients of provider settings and keeps them for reuse, so
+// that assumed role credentials are cached across reconciles. Clients are derived from the
+// operator's AWS configuration and client options. Every provider settings may also get its own
+// circuit breaker, so that an account or endpoint that keeps failing does not stop the calls of
+// the others.
+type ProviderClients struct {
+	base             aws.Config
+	options          []func(*bedrockagentcorecontrol.Options)
+	endpointURL      string
+	breakerThreshold int
+	breakerCooldown  time.Duration
+
+	mu       sync.Mutex
+	clients  map[ProviderSettings]GatewayTargetAPI
+	breakers map[ProviderSettings]*CircuitBreaker
+}
+
+// NewProviderClients returns ProviderClients deriving clients from base and options
+func NewProviderClients(base aws.Config, options ...func(*bedrockagentcorecontrol.Options)) *ProviderClients {
+	return &ProviderClients{
+		base:     base,
+		options:  options,
+		clients:  make(map[ProviderSettings]GatewayTargetAPI),
+		breakers: make(map[ProviderSettings]*CircuitBreaker),
+	}
+}
+
+// WithDefaultEndpointURL sends the calls of provider settings without an endpoint URL to
+// endpointURL if they are in the region of the operator. Endpoints, e.g. VPC endpoints, are
+// regional, so settings of other regions keep using the regional endpoint.
+func (p *ProviderClients) WithDefaultEndpointURL(endpointURL string) *ProviderClients {
+	p.endpointURL = endpointURL
+	return p
+}
+
+// WithCircuitBreakers guards the calls of every provider settings with a circuit breaker of its
+// own that opens after threshold consecutive failures and probes AWS again after cooldown. A
+// threshold of zero disables the breakers.
+func (p *ProviderClients) WithCircuitBreakers(threshold int, cooldown time.Duration) *ProviderClients {
+	p.breakerThreshold = threshold
+	p.breakerCooldown = cooldown
+	return p
+}
+
+// CircuitBreaker returns the circuit breaker of the settings, creating it on first use. It is nil
+// if the circuit breakers are disabled.
+func (p *ProviderClients) CircuitBreaker(settings ProviderSettings) *CircuitBreaker {
+	if p.breakerThreshold <= 0 {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	breaker, ok := p.breakers[settings]
+	if !ok {
+		breaker = NewCircuitBreaker(p.breakerThreshold, p.breakerCooldown)
+		p.breakers[settings] = breaker
+	}
+	return breaker
+}
+
+// CircuitBreakerOpen reports whether the circuit breaker of any provider settings is open. Nil
+// ProviderClients have none.
+func (p *ProviderClients) CircuitBreakerOpen() bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, breaker := range p.breakers {
+		if breaker.Open() {
+			return true
+		}
+	}
+	return false
+}
+
+// Client returns the client of the settings, creating it on first use
+func (p *ProviderClients) Client(settings ProviderSettings) GatewayTargetAPI {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if client, ok := p.clients[settings]; ok {
+		return client
+	}
+
+	cfg := p.base.Copy()
+	cfg.Region = settings.Region
+	if settings.RoleARN != "" {
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), settings.RoleARN,
+			func(o *stscreds.AssumeRoleOptions) {
+				o.RoleSessionName = providerRoleSessionName
+				if settings.ExternalID != "" {
+					o.ExternalID = aws.String(settings.ExternalID)
+				}
+			}))
+	}
+	endpointURL := settings.EndpointURL
+	if endpointURL == "" && settings.Region == p.base.Region {
+		endpointURL = p.endpointURL
+	}
+	options := append(slices.Clone(p.options), WithEndpointURL(endpointURL))
+
+	client := bedrockagentcorecontrol.NewFromConfig(cfg, options...)
+	p.clients[settings] = client
+	return client
+}
+
+type clientKey struct{}
+
+// WithContextClient returns a context whose AWS calls made through a BedrockClientWrapper use
+// client instead of the wrapper's own client
+func WithContextClient(ctx context.Context, client GatewayTargetAPI) context.Context {
+	return context.WithValue(ctx, clientKey{}, client)
+}
+
+// clientFor returns the client stored in ctx, or the wrapper's client
+func (w *BedrockClientWrapper) clientFor(ctx context.Context) GatewayTargetAPI {
+	if client, ok := ctx.Value(clientKey{}).(GatewayTargetAPI); ok {
+		return client
+	}
+	return w.client
+}
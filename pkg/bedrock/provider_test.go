@@ -0,0 +1,109 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderClients(t *testing.T) {
+	base := aws.Config{
+		Region: "us-west-2",
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+		}),
+	}
+	clients := NewProviderClients(base, WithUserAgent("v1.0.0", "cluster-1"))
+
+	settings := ProviderSettings{Region: "eu-west-1", EndpointURL: "https://vpce-1.bedrock-agentcore-control.eu-west-1.vpce.amazonaws.com"}
+	client := clients.Client(settings)
+	assert.Same(t, client, clients.Client(settings), "clients are reused")
+
+	options := client.(*bedrockagentcorecontrol.Client).Options()
+	assert.Equal(t, "eu-west-1", options.Region)
+	assert.Equal(t, settings.EndpointURL, aws.ToString(options.BaseEndpoint))
+
+	assumed := clients.Client(ProviderSettings{Region: "eu-west-1", RoleARN: "arn:aws:iam::210987654321:role/operator"})
+	assert.NotSame(t, client, assumed)
+	options = assumed.(*bedrockagentcorecontrol.Client).Options()
+	assert.Nil(t, options.BaseEndpoint)
+	assert.IsType(t, &aws.CredentialsCache{}, options.Credentials, "assumed role credentials are cached")
+	assert.Equal(t, "us-west-2", base.Region, "the base configuration is not modified")
+}
+
+func TestProviderClients_DefaultEndpointURL(t *testing.T) {
+	endpoint := "https://vpce-1.bedrock-agentcore-control.us-west-2.vpce.amazonaws.com"
+	clients := NewProviderClients(aws.Config{Region: "us-west-2"}).WithDefaultEndpointURL(endpoint)
+
+	// Settings of the operator's region use the operator's endpoint
+	options := clients.Client(ProviderSettings{Region: "us-west-2"}).(*bedrockagentcorecontrol.Client).Options()
+	assert.Equal(t, endpoint, aws.ToString(options.BaseEndpoint))
+
+	// The endpoint of the settings wins
+	own := "https://agentcore.proxy.internal"
+	options = clients.Client(ProviderSettings{Region: "us-west-2", EndpointURL: own}).(*bedrockagentcorecontrol.Client).Options()
+	assert.Equal(t, own, aws.ToString(options.BaseEndpoint))
+
+	// Other regions keep their regional endpoint
+	options = clients.Client(ProviderSettings{Region: "eu-west-1"}).(*bedrockagentcorecontrol.Client).Options()
+	assert.Nil(t, options.BaseEndpoint)
+}
+
+func TestWithContextClient(t *testing.T) {
+	own := &stubGatewayTargetAPI{}
+	provider := &stubGatewayTargetAPI{}
+	wrapper := NewBedrockClientWrapper(own, logr.Discard())
+
+	_, err := wrapper.GetGatewayTarget(WithContextClient(context.Background(), provider), "gw-1", "TARGET1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, provider.calls)
+	assert.Equal(t, 0, own.calls)
+
+	_, err = wrapper.GetGatewayTarget(context.Background(), "gw-1", "TARGET1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, own.calls)
+}
+
+func TestProviderClients_CircuitBreaker(t *testing.T) {
+	clients := NewProviderClients(aws.Config{Region: "us-west-2"})
+	assert.Nil(t, clients.CircuitBreaker(ProviderSettings{Region: "us-west-2"}), "breakers are disabled by default")
+
+	clients.WithCircuitBreakers(1, time.Minute)
+	settings := ProviderSettings{Region: "eu-west-1", RoleARN: "arn:aws:iam::210987654321:role/operator"}
+	breaker := clients.CircuitBreaker(settings)
+	require.NotNil(t, breaker)
+	assert.Same(t, breaker, clients.CircuitBreaker(settings), "breakers are reused")
+	other := clients.CircuitBreaker(ProviderSettings{Region: "eu-west-1", EndpointURL: "https://agentcore.proxy.internal"})
+	assert.NotSame(t, breaker, other, "every settings has a breaker of its own")
+
+	assert.False(t, clients.CircuitBreakerOpen())
+	breaker.Record(&smithy.GenericAPIError{Code: "InternalServerException"})
+	assert.True(t, clients.CircuitBreakerOpen())
+	assert.False(t, other.Open())
+
+	var none *ProviderClients
+	assert.False(t, none.CircuitBreakerOpen())
+}
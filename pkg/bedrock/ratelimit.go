@@ -0,0 +1,134 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// sustainedRateLimitWait is how long a call has to wait for the RateLimiter before the wait is
+// recorded as throttling in the Backpressure
+const sustainedRateLimitWait = time.Second
+
+// RateLimiter is a token bucket per AWS operation shared by every reconcile, so that many
+// resources reconciling at the same time stay below the TPS quotas of the AgentCore control
+// plane instead of running into ThrottlingExceptions. The bucket of an operation refills at the
+// rate configured for it, or at the default rate, and holds one second of calls.
+type RateLimiter struct {
+	defaultRate float64
+	rates       map[string]float64
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewRateLimiter creates a RateLimiter allowing rates[operation] calls per second of each listed
+// operation and defaultRate calls per second of every other one. A rate of zero does not limit the
+// operation.
+func NewRateLimiter(defaultRate float64, rates map[string]float64) *RateLimiter {
+	return &RateLimiter{
+		defaultRate: defaultRate,
+		rates:       rates,
+		limiters:    make(map[string]*rate.Limiter),
+	}
+}
+
+// Rate returns the calls per second allowed for the operation, zero if it is not limited
+func (l *RateLimiter) Rate(operation string) float64 {
+	if r, ok := l.rates[operation]; ok {
+		return r
+	}
+	return l.defaultRate
+}
+
+// Wait blocks until the bucket of the operation allows a call and returns how long it waited.
+// It returns the error of ctx if ctx is done first. A nil RateLimiter never waits.
+func (l *RateLimiter) Wait(ctx context.Context, operation string) (time.Duration, error) {
+	if l == nil {
+		return 0, nil
+	}
+	limiter := l.limiter(operation)
+	if limiter == nil {
+		return 0, nil
+	}
+
+	reservation := limiter.Reserve()
+	delay := reservation.Delay()
+	if delay == 0 {
+		return 0, nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		reservation.Cancel()
+		return delay, ctx.Err()
+	case <-timer.C:
+		return delay, nil
+	}
+}
+
+// limiter returns the bucket of the operation, or nil if the operation is not limited
+func (l *RateLimiter) limiter(operation string) *rate.Limiter {
+	r := l.Rate(operation)
+	if r <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limiter, ok := l.limiters[operation]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(r), max(int(math.Ceil(r)), 1))
+		l.limiters[operation] = limiter
+	}
+	return limiter
+}
+
+// ParseRateLimits parses per-operation rates given as comma-separated <operation>=<calls per
+// second> pairs, e.g. "CreateGatewayTarget=5,ListGatewayTargets=10"
+func ParseRateLimits(s string) (map[string]float64, error) {
+	rates := make(map[string]float64)
+	for pair := range strings.SplitSeq(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		operation, value, ok := strings.Cut(pair, "=")
+		operation = strings.TrimSpace(operation)
+		if !ok || operation == "" {
+			return nil, fmt.Errorf("invalid rate limit %q, must be <operation>=<calls per second>", pair)
+		}
+		r, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || r < 0 || math.IsInf(r, 0) || math.IsNaN(r) {
+			return nil, fmt.Errorf("invalid rate of %s: %q", operation, value)
+		}
+		if _, dup := rates[operation]; dup {
+			return nil, fmt.Errorf("duplicate rate limit for %s", operation)
+		}
+		rates[operation] = r
+	}
+	return rates, nil
+}
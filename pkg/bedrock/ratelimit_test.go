@@ -0,0 +1,104 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	rates, err := ParseRateLimits("CreateGatewayTarget=5, ListGatewayTargets=0.5,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"CreateGatewayTarget": 5, "ListGatewayTargets": 0.5}, rates)
+
+	rates, err = ParseRateLimits("")
+	require.NoError(t, err)
+	assert.Empty(t, rates)
+
+	for _, invalid := range []string{"CreateGatewayTarget", "=5", "CreateGatewayTarget=fast",
+		"CreateGatewayTarget=-1", "CreateGatewayTarget=5,CreateGatewayTarget=6"} {
+		_, err := ParseRateLimits(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter(0, map[string]float64{"CreateGatewayTarget": 2})
+	ctx := context.Background()
+
+	assert.Equal(t, 2.0, limiter.Rate("CreateGatewayTarget"))
+	assert.Equal(t, 0.0, limiter.Rate("GetGatewayTarget"))
+
+	// The bucket holds one second of calls
+	for range 2 {
+		waited, err := limiter.Wait(ctx, "CreateGatewayTarget")
+		require.NoError(t, err)
+		assert.Zero(t, waited)
+	}
+	for range 10 {
+		waited, err := limiter.Wait(ctx, "GetGatewayTarget")
+		require.NoError(t, err)
+		assert.Zero(t, waited, "operations without rate are not limited")
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	waited, err := limiter.Wait(canceled, "CreateGatewayTarget")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Greater(t, waited, time.Duration(0))
+
+	var disabled *RateLimiter
+	waited, err = disabled.Wait(ctx, "CreateGatewayTarget")
+	require.NoError(t, err)
+	assert.Zero(t, waited)
+}
+
+func TestWithRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter(1, nil)
+	wrapper := NewBedrockClientWrapper(nil, logr.Discard(), WithRateLimiter(limiter))
+	call := func(context.Context) error { return nil }
+
+	require.NoError(t, wrapper.withRetry(context.Background(), "Test", call))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := wrapper.withRetry(ctx, "Test", call)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "the second call waits for the bucket to refill")
+}
+
+func TestWithRateLimiter_CallsWithoutRetries(t *testing.T) {
+	limiter := NewRateLimiter(0, map[string]float64{"GetGatewayTarget": 1, "ListGatewayTargets": 1})
+	wrapper := NewBedrockClientWrapper(nil, logr.Discard(), WithRateLimiter(limiter))
+	for _, operation := range []string{"GetGatewayTarget", "ListGatewayTargets"} {
+		_, err := limiter.Wait(context.Background(), operation)
+		require.NoError(t, err)
+	}
+
+	// Both calls wait for their empty bucket rather than calling AWS
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := wrapper.GetGatewayTarget(ctx, "gw-1", "TARGET1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	_, err = wrapper.FindGatewayTargetByName(ctx, "gw-1", "weather")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
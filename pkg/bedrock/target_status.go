@@ -0,0 +1,58 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+)
+
+// TargetStatusClass is how the operator treats a gateway target status
+type TargetStatusClass string
+
+const (
+	// TargetStatusClassReady is a target that serves requests
+	TargetStatusClassReady TargetStatusClass = "Ready"
+	// TargetStatusClassPending is a target in transition that will settle on its own
+	TargetStatusClassPending TargetStatusClass = "Pending"
+	// TargetStatusClassFailed is a target whose last change did not succeed
+	TargetStatusClassFailed TargetStatusClass = "Failed"
+	// TargetStatusClassUnknown is a status this operator version does not know, e.g. a value
+	// added to the AWS API after the operator was built
+	TargetStatusClassUnknown TargetStatusClass = "Unknown"
+)
+
+// targetStatusClasses classifies the target statuses known to the operator. They are listed
+// explicitly rather than taken from the SDK, as an SDK upgrade may add statuses the operator
+// has not been taught to handle.
+var targetStatusClasses = map[types.TargetStatus]TargetStatusClass{
+	types.TargetStatusReady:                   TargetStatusClassReady,
+	types.TargetStatusCreating:                TargetStatusClassPending,
+	types.TargetStatusUpdating:                TargetStatusClassPending,
+	types.TargetStatusDeleting:                TargetStatusClassPending,
+	types.TargetStatusSynchronizing:           TargetStatusClassPending,
+	types.TargetStatusFailed:                  TargetStatusClassFailed,
+	types.TargetStatusUpdateUnsuccessful:      TargetStatusClassFailed,
+	types.TargetStatusSynchronizeUnsuccessful: TargetStatusClassFailed,
+}
+
+// ClassifyTargetStatus returns the class of a gateway target status
+func ClassifyTargetStatus(status types.TargetStatus) TargetStatusClass {
+	if class, ok := targetStatusClasses[status]; ok {
+		return class
+	}
+	return TargetStatusClassUnknown
+}
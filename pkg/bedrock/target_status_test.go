@@ -0,0 +1,46 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyTargetStatus(t *testing.T) {
+	tests := map[types.TargetStatus]TargetStatusClass{
+		types.TargetStatusReady:                   TargetStatusClassReady,
+		types.TargetStatusCreating:                TargetStatusClassPending,
+		types.TargetStatusSynchronizing:           TargetStatusClassPending,
+		types.TargetStatusFailed:                  TargetStatusClassFailed,
+		types.TargetStatusSynchronizeUnsuccessful: TargetStatusClassFailed,
+		"ARCHIVED": TargetStatusClassUnknown,
+		"":         TargetStatusClassUnknown,
+	}
+	for status, want := range tests {
+		assert.Equal(t, want, ClassifyTargetStatus(status), "status %q", status)
+	}
+}
+
+func TestClassifyTargetStatus_CoversSDKValues(t *testing.T) {
+	// Fails when an SDK upgrade adds a status the operator has not been taught to handle
+	for _, status := range types.TargetStatus("").Values() {
+		assert.NotEqual(t, TargetStatusClassUnknown, ClassifyTargetStatus(status), "status %q", status)
+	}
+}
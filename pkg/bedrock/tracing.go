@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// tracerName is the instrumentation scope of the spans recorded by this package
+	tracerName = "github.com/aws/mcp-gateway-operator/pkg/bedrock"
+
+	// tracingMiddleware is the ID of the middleware recording a span per SDK operation
+	tracingMiddleware = "OTelSpan"
+
+	// spanAttributeResource is the span attribute holding the resource a call was made for
+	spanAttributeResource = "mcpgateway.resource"
+
+	// spanEventRetry is the span event recorded before a call is retried
+	spanEventRetry = "retry"
+)
+
+// WithTracing returns a client option that records a client span for every SDK operation, with
+// the service, operation, region and AWS request ID as attributes. SDK retries happen within the
+// span. Spans are children of the span in the context of the call, so that the AWS calls of a
+// reconcile show up within its trace.
+func WithTracing(provider trace.TracerProvider) func(*bedrockagentcorecontrol.Options) {
+	tracer := provider.Tracer(tracerName)
+	record := middleware.InitializeMiddlewareFunc(tracingMiddleware, func(
+		ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+	) (middleware.InitializeOutput, middleware.Metadata, error) {
+		service, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+		ctx, span := tracer.Start(ctx, service+"."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.RPCSystemKey.String("aws-api"),
+				semconv.RPCService(service),
+				semconv.RPCMethod(operation),
+				semconv.CloudRegion(awsmiddleware.GetRegion(ctx)),
+			))
+		defer span.End()
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+			span.SetAttributes(semconv.AWSRequestID(requestID))
+		}
+		recordSpanError(span, err)
+		return out, metadata, err
+	})
+
+	return func(o *bedrockagentcorecontrol.Options) {
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(record, middleware.After)
+		})
+	}
+}
+
+// withSpan calls fn within a span named after the operation, recording the resource the call
+// was made for and the final error. The spans of the SDK operations made by fn, see WithTracing,
+// are its children.
+func (w *BedrockClientWrapper) withSpan(ctx context.Context, operation string, fn func(context.Context) error) error {
+	ctx, span := w.tracer.Start(ctx, "bedrock."+operation)
+	defer span.End()
+	if resource := attribution(ctx); resource != "" {
+		span.SetAttributes(attribute.String(spanAttributeResource, resource))
+	}
+
+	err := fn(ctx)
+	recordSpanError(span, err)
+	return err
+}
+
+// recordRetry adds a retry event to the span in ctx, so that the time spent backing off is
+// visible in the trace
+func recordRetry(ctx context.Context, attempt int, backoff time.Duration) {
+	trace.SpanFromContext(ctx).AddEvent(spanEventRetry, trace.WithAttributes(
+		attribute.Int("attempt", attempt),
+		attribute.String("backoff", backoff.String()),
+	))
+}
+
+// recordSpanError marks the span as failed with err, if there is one
+func recordSpanError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bedrock
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// spanAttributes returns the attributes of span by key
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]string {
+	attributes := make(map[attribute.Key]string)
+	for _, kv := range span.Attributes() {
+		attributes[kv.Key] = kv.Value.Emit()
+	}
+	return attributes
+}
+
+func TestWithTracing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Amzn-Requestid", "req-1")
+		if r.Method == http.MethodDelete {
+			w.Header().Set("X-Amzn-Errortype", "ValidationException")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"invalid target"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"gatewayId":"gw-1","status":"READY"}`))
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client := bedrockagentcorecontrol.New(bedrockagentcorecontrol.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(server.URL),
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+		}),
+	}, WithTracing(provider))
+	wrapper := NewBedrockClientWrapper(client, logr.Discard(), WithTracerProvider(provider))
+
+	ctx := WithAttribution(context.Background(), "default", "weather")
+	_, err := wrapper.GetGateway(ctx, "gw-1")
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	sdkSpan, wrapperSpan := spans[0], spans[1]
+	assert.Equal(t, "bedrock.GetGateway", wrapperSpan.Name())
+	assert.Equal(t, "default.weather", spanAttributes(wrapperSpan)[spanAttributeResource])
+	assert.Equal(t, "Bedrock AgentCore Control.GetGateway", sdkSpan.Name())
+	assert.Equal(t, wrapperSpan.SpanContext().SpanID(), sdkSpan.Parent().SpanID())
+	attributes := spanAttributes(sdkSpan)
+	assert.Equal(t, "aws-api", attributes["rpc.system"])
+	assert.Equal(t, "GetGateway", attributes["rpc.method"])
+	assert.Equal(t, "us-east-1", attributes["cloud.region"])
+	assert.Equal(t, "req-1", attributes["aws.request_id"])
+	assert.Equal(t, codes.Unset, sdkSpan.Status().Code)
+
+	// Failed calls mark both spans as failed
+	err = wrapper.DeleteGatewayTarget(ctx, "gw-1", "TARGET1")
+	require.Error(t, err)
+	spans = recorder.Ended()
+	require.Len(t, spans, 4)
+	assert.Equal(t, codes.Error, spans[2].Status().Code)
+	assert.Equal(t, "bedrock.DeleteGatewayTarget", spans[3].Name())
+	assert.Equal(t, codes.Error, spans[3].Status().Code)
+
+	// Calls made without retries are recorded as well
+	_, err = wrapper.GetGatewayTarget(ctx, "gw-1", "TARGET1")
+	require.NoError(t, err)
+	_, err = wrapper.FindGatewayTargetByName(ctx, "gw-1", "weather")
+	require.NoError(t, err)
+	spans = recorder.Ended()
+	require.Len(t, spans, 8)
+	assert.Equal(t, "bedrock.GetGatewayTarget", spans[5].Name())
+	assert.Equal(t, "default.weather", spanAttributes(spans[5])[spanAttributeResource])
+	assert.Equal(t, spans[5].SpanContext().SpanID(), spans[4].Parent().SpanID())
+	assert.Equal(t, "bedrock.ListGatewayTargets", spans[7].Name())
+}
@@ -0,0 +1,5 @@
+// Package bulkimport generates MCPServers from an inventory of existing MCP servers for the import
+// command of the kubectl-mcpgateway plugin. Each inventory entry is merged onto a shared MCPServer
+// template and named by a name template, so that many hand-registered servers can be migrated at
+// once.
+package bulkimport
@@ -0,0 +1,194 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bulkimport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"regexp"
+	"strings"
+	"text/template"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// TemplateLabel is set on every generated MCPServer to the name of the template it was generated
+// from, so that an imported batch can be listed with a label selector
+const TemplateLabel = "mcpgateway.bedrock.aws/import-template"
+
+// DefaultNameTemplate names MCPServers after their inventory entry
+const DefaultNameTemplate = "{{ .Name | dnsName }}"
+
+// invalidNameChars matches runs of characters other than lowercase letters, digits and dashes
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// nameData is what name templates are executed with
+type nameData struct {
+	Entry
+	// Index is the position of the entry in the inventory, starting at 1
+	Index int
+	// Host is the host of the endpoint, without port
+	Host string
+}
+
+// Generator turns inventory entries into MCPServers
+type Generator struct {
+	template  *mcpgatewayv1alpha1.MCPServer
+	names     *template.Template
+	namespace string
+}
+
+// NewGenerator creates a Generator that merges entries onto mcpServerTemplate and names them with
+// nameTemplate, a Go template executed with the fields of the entry, its Index and the Host of its
+// endpoint. The dnsName function turns any string into a valid name, e.g.
+// "team-a-{{ .Host | dnsName }}". Generated MCPServers are placed in namespace, or in the namespace
+// of the template if namespace is empty.
+func NewGenerator(mcpServerTemplate *mcpgatewayv1alpha1.MCPServer, nameTemplate, namespace string) (*Generator, error) {
+	if mcpServerTemplate == nil {
+		mcpServerTemplate = &mcpgatewayv1alpha1.MCPServer{}
+	}
+	if nameTemplate == "" {
+		nameTemplate = DefaultNameTemplate
+	}
+	names, err := template.New("name").Option("missingkey=error").
+		Funcs(template.FuncMap{"dnsName": dnsName}).Parse(nameTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid name template: %w", err)
+	}
+	if namespace == "" {
+		namespace = mcpServerTemplate.Namespace
+	}
+	return &Generator{template: mcpServerTemplate, names: names, namespace: namespace}, nil
+}
+
+// Generate returns an MCPServer for each entry. Fields set on an entry replace those of the
+// template; all other fields, e.g. capabilities and metadata allowlists, are copied from it.
+// All entries are checked before anything is returned, so that an invalid inventory is rejected
+// as a whole, with the errors of every entry.
+func (g *Generator) Generate(entries []Entry) ([]mcpgatewayv1alpha1.MCPServer, error) {
+	mcpServers := make([]mcpgatewayv1alpha1.MCPServer, 0, len(entries))
+	seen := make(map[string]int, len(entries))
+	var problems []string
+	for i, entry := range entries {
+		mcpServer, err := g.generate(i+1, entry)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("entry %d (%s): %v", i+1, entry.Name, err))
+			continue
+		}
+		if previous, ok := seen[mcpServer.Name]; ok {
+			problems = append(problems, fmt.Sprintf("entry %d (%s): name %q is already used by entry %d",
+				i+1, entry.Name, mcpServer.Name, previous))
+			continue
+		}
+		seen[mcpServer.Name] = i + 1
+		mcpServers = append(mcpServers, *mcpServer)
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid inventory:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return mcpServers, nil
+}
+
+// generate merges one entry onto the template
+func (g *Generator) generate(index int, entry Entry) (*mcpgatewayv1alpha1.MCPServer, error) {
+	var name bytes.Buffer
+	if err := g.names.Execute(&name, nameData{Entry: entry, Index: index, Host: hostOf(entry.Endpoint)}); err != nil {
+		return nil, fmt.Errorf("failed to execute name template: %w", err)
+	}
+	if problems := validation.IsDNS1123Subdomain(name.String()); len(problems) > 0 {
+		return nil, fmt.Errorf("invalid name %q: %s", name.String(), strings.Join(problems, ", "))
+	}
+
+	spec := g.template.Spec.DeepCopy()
+	if entry.Endpoint != "" {
+		spec.Endpoint = entry.Endpoint
+	}
+	if entry.Description != "" {
+		spec.Description = entry.Description
+	}
+	if entry.GatewayID != "" {
+		spec.GatewayID = entry.GatewayID
+	}
+	if entry.AuthType != "" {
+		spec.AuthType = entry.AuthType
+	}
+	if entry.OauthProviderArn != "" {
+		spec.OauthProviderArn = entry.OauthProviderArn
+	}
+	if len(entry.OauthScopes) > 0 {
+		spec.OauthScopes = entry.OauthScopes
+	}
+	if spec.Endpoint == "" && spec.LambdaArn == "" && spec.OpenAPISchema == nil {
+		return nil, fmt.Errorf("no endpoint in the entry or the template")
+	}
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: mcpgatewayv1alpha1.GroupVersion.String(),
+			Kind:       "MCPServer",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name.String(),
+			Namespace:   g.namespace,
+			Labels:      maps.Clone(g.template.Labels),
+			Annotations: maps.Clone(g.template.Annotations),
+		},
+		Spec: *spec,
+	}
+	if g.template.Name != "" {
+		if mcpServer.Labels == nil {
+			mcpServer.Labels = map[string]string{}
+		}
+		mcpServer.Labels[TemplateLabel] = g.template.Name
+	}
+	return mcpServer, nil
+}
+
+// LoadTemplate reads an MCPServer template from YAML or JSON. Unknown fields are rejected.
+func LoadTemplate(r io.Reader) (*mcpgatewayv1alpha1.MCPServer, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template: %w", err)
+	}
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+	if err := yaml.UnmarshalStrict(data, mcpServer); err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	return mcpServer, nil
+}
+
+// dnsName lowercases s and replaces everything but letters, digits and dashes with dashes, e.g.
+// weather.example.com to weather-example-com
+func dnsName(s string) string {
+	return strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
+
+// hostOf returns the host of an endpoint, or an empty string if it is not a URL
+func hostOf(endpoint string) string {
+	u, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
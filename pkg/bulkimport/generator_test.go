@@ -0,0 +1,118 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bulkimport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTemplate = `
+apiVersion: mcpgateway.bedrock.aws/v1alpha1
+kind: MCPServer
+metadata:
+  name: migrated
+  namespace: team-a
+  labels:
+    team: a
+spec:
+  capabilities: ["tools"]
+  gatewayId: gw-one
+  authType: NoAuth
+  allowedRequestHeaders: ["X-Tenant-Id"]
+`
+
+func TestGenerate(t *testing.T) {
+	tmpl, err := LoadTemplate(strings.NewReader(testTemplate))
+	require.NoError(t, err)
+	generator, err := NewGenerator(tmpl, "mcp-{{ .Host | dnsName }}", "")
+	require.NoError(t, err)
+
+	mcpServers, err := generator.Generate([]Entry{
+		{Name: "Weather", Endpoint: "https://Weather.Example.com:8443/mcp"},
+		{Name: "search", Endpoint: "https://search.example.com/mcp", AuthType: "OAuth2",
+			OauthProviderArn: "arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/oauth2credentialprovider/search",
+			OauthScopes:      []string{"read"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, mcpServers, 2)
+
+	weather := mcpServers[0]
+	assert.Equal(t, "mcp-weather-example-com", weather.Name)
+	assert.Equal(t, "team-a", weather.Namespace)
+	assert.Equal(t, map[string]string{"team": "a", TemplateLabel: "migrated"}, weather.Labels)
+	assert.Equal(t, "MCPServer", weather.Kind)
+	assert.Equal(t, "https://Weather.Example.com:8443/mcp", weather.Spec.Endpoint)
+	assert.Equal(t, "NoAuth", weather.Spec.AuthType)
+	assert.Equal(t, "gw-one", weather.Spec.GatewayID)
+	assert.Equal(t, []string{"tools"}, weather.Spec.Capabilities)
+	assert.Equal(t, []string{"X-Tenant-Id"}, weather.Spec.AllowedRequestHeaders)
+
+	search := mcpServers[1]
+	assert.Equal(t, "mcp-search-example-com", search.Name)
+	assert.Equal(t, "OAuth2", search.Spec.AuthType)
+	assert.Equal(t, []string{"read"}, search.Spec.OauthScopes)
+
+	// The template is shared, so generated MCPServers must not alias it
+	search.Spec.Capabilities[0] = "prompts"
+	search.Labels["team"] = "b"
+	assert.Equal(t, []string{"tools"}, weather.Spec.Capabilities)
+	assert.Equal(t, "a", tmpl.Labels["team"])
+}
+
+func TestGenerate_DefaultName(t *testing.T) {
+	generator, err := NewGenerator(nil, "", "team-b")
+	require.NoError(t, err)
+
+	mcpServers, err := generator.Generate([]Entry{{Name: "Weather Tools", Endpoint: "https://weather.example.com/mcp"}})
+	require.NoError(t, err)
+	require.Len(t, mcpServers, 1)
+	assert.Equal(t, "weather-tools", mcpServers[0].Name)
+	assert.Equal(t, "team-b", mcpServers[0].Namespace)
+	assert.Empty(t, mcpServers[0].Labels)
+}
+
+func TestGenerate_ReportsAllInvalidEntries(t *testing.T) {
+	generator, err := NewGenerator(nil, "{{ .Name }}", "default")
+	require.NoError(t, err)
+
+	_, err = generator.Generate([]Entry{
+		{Name: "weather", Endpoint: "https://weather.example.com/mcp"},
+		{Name: "weather", Endpoint: "https://weather.example.org/mcp"},
+		{Name: "Search_Tools", Endpoint: "https://search.example.com/mcp"},
+		{Name: "docs"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `entry 2 (weather): name "weather" is already used by entry 1`)
+	assert.Contains(t, err.Error(), `entry 3 (Search_Tools): invalid name "Search_Tools"`)
+	assert.Contains(t, err.Error(), "entry 4 (docs): no endpoint in the entry or the template")
+}
+
+func TestNewGenerator_InvalidNameTemplate(t *testing.T) {
+	_, err := NewGenerator(nil, "{{ .Name", "default")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid name template")
+}
+
+func TestLoadTemplate_UnknownField(t *testing.T) {
+	_, err := LoadTemplate(strings.NewReader("spec:\n  endpoints: https://weather.example.com\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse template")
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bulkimport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Inventory formats accepted by ParseInventory
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// Entry is one MCP server of an inventory. Empty fields are taken from the template.
+type Entry struct {
+	// Name identifies the server in the inventory; it is available to the name template
+	Name string `json:"name"`
+	// Endpoint is the URL of the MCP server
+	Endpoint string `json:"endpoint,omitempty"`
+	// Description of the gateway target
+	Description string `json:"description,omitempty"`
+	// GatewayID overrides the gateway of the template
+	GatewayID string `json:"gatewayId,omitempty"`
+	// AuthType is the outbound authentication, e.g. NoAuth or OAuth2
+	AuthType string `json:"authType,omitempty"`
+	// OauthProviderArn is the OAuth2 credential provider
+	OauthProviderArn string `json:"oauthProviderArn,omitempty"`
+	// OauthScopes are the OAuth2 scopes to request
+	OauthScopes []string `json:"oauthScopes,omitempty"`
+}
+
+// csvColumns maps the CSV header names to the fields of an entry. Scopes are separated by spaces.
+var csvColumns = map[string]func(*Entry, string){
+	"name":             func(e *Entry, v string) { e.Name = v },
+	"endpoint":         func(e *Entry, v string) { e.Endpoint = v },
+	"description":      func(e *Entry, v string) { e.Description = v },
+	"gatewayId":        func(e *Entry, v string) { e.GatewayID = v },
+	"authType":         func(e *Entry, v string) { e.AuthType = v },
+	"oauthProviderArn": func(e *Entry, v string) { e.OauthProviderArn = v },
+	"oauthScopes":      func(e *Entry, v string) { e.OauthScopes = scopes(v) },
+}
+
+// ParseInventory reads an inventory in the given format. JSON inventories are an array of
+// entries. CSV inventories start with a header row naming the columns, in any order, after the
+// JSON fields of Entry; unknown columns are rejected so that typos do not silently drop settings.
+func ParseInventory(r io.Reader, format string) ([]Entry, error) {
+	var entries []Entry
+	switch format {
+	case FormatJSON:
+		decoder := json.NewDecoder(r)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&entries); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON inventory: %w", err)
+		}
+	case FormatCSV:
+		var err error
+		if entries, err = parseCSV(r); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown inventory format %q, must be %s or %s", format, FormatJSON, FormatCSV)
+	}
+
+	for i, entry := range entries {
+		if strings.TrimSpace(entry.Name) == "" {
+			return nil, fmt.Errorf("inventory entry %d has no name", i+1)
+		}
+	}
+	return entries, nil
+}
+
+// parseCSV reads a CSV inventory with a header row
+func parseCSV(r io.Reader) ([]Entry, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	setters := make([]func(*Entry, string), len(header))
+	for i, column := range header {
+		setter, ok := csvColumns[strings.TrimSpace(column)]
+		if !ok {
+			return nil, fmt.Errorf("unknown CSV column %q", column)
+		}
+		setters[i] = setter
+	}
+
+	var entries []Entry
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV inventory: %w", err)
+		}
+		var entry Entry
+		for i, value := range record {
+			setters[i](&entry, strings.TrimSpace(value))
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// scopes splits a space separated list of scopes, returning nil if there are none
+func scopes(value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return strings.Fields(value)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bulkimport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInventory_CSV(t *testing.T) {
+	inventory := `# migrated from the wiki
+endpoint, name, authType, oauthScopes
+https://weather.example.com/mcp, Weather, OAuth2, read write
+https://search.example.com/mcp, search, ,
+`
+	entries, err := ParseInventory(strings.NewReader(inventory), FormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{
+		{Name: "Weather", Endpoint: "https://weather.example.com/mcp", AuthType: "OAuth2", OauthScopes: []string{"read", "write"}},
+		{Name: "search", Endpoint: "https://search.example.com/mcp"},
+	}, entries)
+}
+
+func TestParseInventory_JSON(t *testing.T) {
+	inventory := `[{"name": "weather", "endpoint": "https://weather.example.com/mcp", "gatewayId": "gw-two"}]`
+	entries, err := ParseInventory(strings.NewReader(inventory), FormatJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{{Name: "weather", Endpoint: "https://weather.example.com/mcp", GatewayID: "gw-two"}}, entries)
+}
+
+func TestParseInventory_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		inventory string
+		format    string
+		wantErr   string
+	}{
+		{name: "unknown column", inventory: "name,url\nweather,https://weather.example.com\n", format: FormatCSV,
+			wantErr: `unknown CSV column "url"`},
+		{name: "missing name", inventory: "name,endpoint\n,https://weather.example.com\n", format: FormatCSV,
+			wantErr: "inventory entry 1 has no name"},
+		{name: "unknown field", inventory: `[{"name": "weather", "url": "https://weather.example.com"}]`,
+			format: FormatJSON, wantErr: `unknown field "url"`},
+		{name: "unknown format", inventory: "", format: "yaml", wantErr: `unknown inventory format "yaml"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseInventory(strings.NewReader(tt.inventory), tt.format)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canary
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+const (
+	// Label marks the synthetic MCPServer of the canary
+	Label = "mcpgateway.bedrock.aws/canary"
+
+	// PhaseCreate, PhaseUpdate and PhaseDelete are the steps of a canary run
+	PhaseCreate = "create"
+	PhaseUpdate = "update"
+	PhaseDelete = "delete"
+
+	// defaultPollInterval is how often the canary MCPServer is read while waiting for the operator
+	defaultPollInterval = 5 * time.Second
+)
+
+var (
+	// runsTotal counts canary phases by outcome
+	runsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mcpgateway_canary_runs_total",
+			Help: "Phases of the synthetic canary MCPServer by outcome",
+		},
+		[]string{"phase", "result"},
+	)
+
+	// phaseDuration is how long the operator took to complete a canary phase
+	phaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "mcpgateway_canary_phase_duration_seconds",
+			Help:    "Time from changing the synthetic canary MCPServer until the operator completed the change",
+			Buckets: []float64{1, 2, 5, 10, 30, 60, 120, 300, 600},
+		},
+		[]string{"phase"},
+	)
+
+	// success is 1 if the last canary run completed every phase
+	success = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "mcpgateway_canary_success",
+			Help: "1 if the last run of the synthetic canary MCPServer created, updated and deleted its gateway target, 0 otherwise",
+		},
+	)
+
+	// lastSuccessTimestamp is the time of the last canary run completing every phase
+	lastSuccessTimestamp = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "mcpgateway_canary_last_success_timestamp_seconds",
+			Help: "Unix time of the last run of the synthetic canary MCPServer that completed every phase",
+		},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(runsTotal, phaseDuration, success, lastSuccessTimestamp)
+}
+
+// Canary periodically creates, updates and deletes a synthetic MCPServer and waits for the
+// operator to carry each change through to its gateway target
+type Canary struct {
+	client       client.Client
+	key          types.NamespacedName
+	spec         mcpgatewayv1alpha1.MCPServerSpec
+	interval     time.Duration
+	timeout      time.Duration
+	pollInterval time.Duration
+	logger       logr.Logger
+}
+
+// NewCanary creates a new Canary running every interval with the MCPServer key and spec.
+// Each phase fails if the operator does not complete it within timeout.
+func NewCanary(
+	c client.Client,
+	key types.NamespacedName,
+	spec mcpgatewayv1alpha1.MCPServerSpec,
+	interval, timeout time.Duration,
+	logger logr.Logger,
+) *Canary {
+	return &Canary{
+		client:       c,
+		key:          key,
+		spec:         spec,
+		interval:     interval,
+		timeout:      timeout,
+		pollInterval: defaultPollInterval,
+		logger:       logger,
+	}
+}
+
+// Start runs the canary loop until ctx is cancelled. It implements manager.Runnable.
+func (c *Canary) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Run(ctx); err != nil && ctx.Err() == nil {
+			c.logger.Error(err, "Canary run failed", "mcpServer", c.key)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable so only the leader runs the canary.
+// With sharding, every shard elects a leader and the canary is only registered on shard 0.
+func (c *Canary) NeedLeaderElection() bool {
+	return true
+}
+
+// Run creates the canary MCPServer, updates its description and deletes it, waiting for the
+// operator after every step. A canary MCPServer left behind by an interrupted run is deleted first.
+func (c *Canary) Run(ctx context.Context) error {
+	if err := c.cleanup(ctx); err != nil {
+		success.Set(0)
+		return err
+	}
+
+	for _, phase := range []struct {
+		name string
+		run  func(context.Context) error
+	}{
+		{PhaseCreate, c.create},
+		{PhaseUpdate, c.update},
+		{PhaseDelete, c.delete},
+	} {
+		started := time.Now()
+		if err := phase.run(ctx); err != nil {
+			runsTotal.WithLabelValues(phase.name, "failure").Inc()
+			success.Set(0)
+			if phase.name != PhaseDelete {
+				// Do not leave the gateway target of a failed run behind until the next run
+				if cleanupErr := c.cleanup(ctx); cleanupErr != nil {
+					c.logger.Error(cleanupErr, "Failed to delete canary MCPServer", "mcpServer", c.key)
+				}
+			}
+			return fmt.Errorf("canary %s failed: %w", phase.name, err)
+		}
+		runsTotal.WithLabelValues(phase.name, "success").Inc()
+		phaseDuration.WithLabelValues(phase.name).Observe(time.Since(started).Seconds())
+	}
+
+	success.Set(1)
+	lastSuccessTimestamp.SetToCurrentTime()
+	c.logger.V(1).Info("Canary run succeeded", "mcpServer", c.key)
+	return nil
+}
+
+// create creates the canary MCPServer and waits for its gateway target to be ready
+func (c *Canary) create(ctx context.Context) error {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.key.Name,
+			Namespace: c.key.Namespace,
+			Labels: map[string]string{
+				Label:                          "true",
+				"app.kubernetes.io/managed-by": "mcp-gateway-operator",
+			},
+		},
+		Spec: *c.spec.DeepCopy(),
+	}
+	mcpServer.Spec.Description = description(time.Now())
+	if err := c.client.Create(ctx, mcpServer); err != nil {
+		return fmt.Errorf("failed to create MCPServer: %w", err)
+	}
+	return c.waitForSync(ctx, mcpServer.Generation)
+}
+
+// update changes the description of the canary MCPServer and waits for the gateway target to be updated
+func (c *Canary) update(ctx context.Context) error {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+	if err := c.client.Get(ctx, c.key, mcpServer); err != nil {
+		return fmt.Errorf("failed to get MCPServer: %w", err)
+	}
+	patch := client.MergeFrom(mcpServer.DeepCopy())
+	mcpServer.Spec.Description = description(time.Now())
+	if err := c.client.Patch(ctx, mcpServer, patch); err != nil {
+		return fmt.Errorf("failed to update MCPServer: %w", err)
+	}
+	return c.waitForSync(ctx, mcpServer.Generation)
+}
+
+// delete deletes the canary MCPServer and waits for the operator to remove its finalizer
+func (c *Canary) delete(ctx context.Context) error {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{ObjectMeta: metav1.ObjectMeta{Name: c.key.Name, Namespace: c.key.Namespace}}
+	if err := c.client.Delete(ctx, mcpServer); err != nil {
+		return fmt.Errorf("failed to delete MCPServer: %w", err)
+	}
+	return c.waitForDeletion(ctx)
+}
+
+// cleanup deletes the canary MCPServer if it exists
+func (c *Canary) cleanup(ctx context.Context) error {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{ObjectMeta: metav1.ObjectMeta{Name: c.key.Name, Namespace: c.key.Namespace}}
+	if err := c.client.Delete(ctx, mcpServer); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete leftover canary MCPServer: %w", err)
+	}
+	c.logger.Info("Deleting leftover canary MCPServer", "mcpServer", c.key)
+	return c.waitForDeletion(ctx)
+}
+
+// waitForSync waits until the operator has synchronized generation to a ready gateway target
+func (c *Canary) waitForSync(ctx context.Context, generation int64) error {
+	var state string
+	err := wait.PollUntilContextTimeout(ctx, c.pollInterval, c.timeout, true, func(ctx context.Context) (bool, error) {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		if err := c.client.Get(ctx, c.key, mcpServer); err != nil {
+			return false, err
+		}
+		state = mcpServer.Status.TargetStatus
+		if mcpServer.Status.ObservedGeneration < generation {
+			return false, nil
+		}
+		if failed(state) {
+			return false, fmt.Errorf("gateway target status %s: %s", state, strings.Join(mcpServer.Status.StatusReasons, "; "))
+		}
+		return state == "READY", nil
+	})
+	if err != nil && wait.Interrupted(err) {
+		return fmt.Errorf("gateway target not ready after %s (status %q)", c.timeout, state)
+	}
+	return err
+}
+
+// waitForDeletion waits until the canary MCPServer is gone
+func (c *Canary) waitForDeletion(ctx context.Context) error {
+	err := wait.PollUntilContextTimeout(ctx, c.pollInterval, c.timeout, true, func(ctx context.Context) (bool, error) {
+		err := c.client.Get(ctx, c.key, &mcpgatewayv1alpha1.MCPServer{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil && wait.Interrupted(err) {
+		return fmt.Errorf("MCPServer not deleted after %s", c.timeout)
+	}
+	return err
+}
+
+// failed reports whether a gateway target status is terminal and unsuccessful
+func failed(targetStatus string) bool {
+	return targetStatus == "FAILED" || strings.HasSuffix(targetStatus, "_UNSUCCESSFUL")
+}
+
+// description returns the target description of a canary change made at now, so that every
+// update changes the gateway target
+func description(now time.Time) string {
+	return "Synthetic canary of the MCP Gateway Operator, last changed " + now.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canary
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var canaryKey = types.NamespacedName{Namespace: "mcp-gateway-operator-system", Name: "mcp-gateway-canary"}
+
+func newTestCanary(t *testing.T, objects ...client.Object) (*Canary, client.Client) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&mcpgatewayv1alpha1.MCPServer{}).
+		Build()
+
+	spec := mcpgatewayv1alpha1.MCPServerSpec{
+		Endpoint:     "https://echo.example.com/mcp",
+		Capabilities: []string{"tools"},
+	}
+	canary := NewCanary(fakeClient, canaryKey, spec, time.Minute, 5*time.Second, logr.Discard())
+	canary.pollInterval = 10 * time.Millisecond
+	return canary, fakeClient
+}
+
+// fakeOperator sets the target status of the canary MCPServer until ctx is cancelled
+func fakeOperator(ctx context.Context, c client.Client, targetStatus string) {
+	for ctx.Err() == nil {
+		mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+		if err := c.Get(ctx, canaryKey, mcpServer); err == nil && mcpServer.Status.TargetStatus != targetStatus {
+			mcpServer.Status.TargetStatus = targetStatus
+			mcpServer.Status.ObservedGeneration = mcpServer.Generation
+			_ = c.Status().Update(ctx, mcpServer)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestRun(t *testing.T) {
+	canary, fakeClient := newTestCanary(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go fakeOperator(ctx, fakeClient, "READY")
+
+	createsBefore := testutil.ToFloat64(runsTotal.WithLabelValues(PhaseCreate, "success"))
+	deletesBefore := testutil.ToFloat64(runsTotal.WithLabelValues(PhaseDelete, "success"))
+
+	require.NoError(t, canary.Run(ctx))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(success))
+	assert.Positive(t, testutil.ToFloat64(lastSuccessTimestamp))
+	assert.Equal(t, createsBefore+1, testutil.ToFloat64(runsTotal.WithLabelValues(PhaseCreate, "success")))
+	assert.Equal(t, deletesBefore+1, testutil.ToFloat64(runsTotal.WithLabelValues(PhaseDelete, "success")))
+
+	err := fakeClient.Get(ctx, canaryKey, &mcpgatewayv1alpha1.MCPServer{})
+	assert.True(t, apierrors.IsNotFound(err), "canary MCPServer should be deleted")
+}
+
+func TestRunFailedTarget(t *testing.T) {
+	canary, fakeClient := newTestCanary(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go fakeOperator(ctx, fakeClient, "FAILED")
+
+	failuresBefore := testutil.ToFloat64(runsTotal.WithLabelValues(PhaseCreate, "failure"))
+
+	err := canary.Run(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "canary create failed")
+	assert.Contains(t, err.Error(), "FAILED")
+
+	assert.Equal(t, 0.0, testutil.ToFloat64(success))
+	assert.Equal(t, failuresBefore+1, testutil.ToFloat64(runsTotal.WithLabelValues(PhaseCreate, "failure")))
+
+	err = fakeClient.Get(ctx, canaryKey, &mcpgatewayv1alpha1.MCPServer{})
+	assert.True(t, apierrors.IsNotFound(err), "failed canary MCPServer should be cleaned up")
+}
+
+func TestRunTimeout(t *testing.T) {
+	canary, _ := newTestCanary(t)
+	canary.timeout = 50 * time.Millisecond
+
+	err := canary.Run(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not ready after")
+}
+
+func TestRunDeletesLeftover(t *testing.T) {
+	leftover := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: canaryKey.Name, Namespace: canaryKey.Namespace},
+		Status:     mcpgatewayv1alpha1.MCPServerStatus{TargetStatus: "READY"},
+	}
+	canary, fakeClient := newTestCanary(t, leftover)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go fakeOperator(ctx, fakeClient, "READY")
+
+	require.NoError(t, canary.Run(ctx))
+}
+
+func TestFailed(t *testing.T) {
+	assert.True(t, failed("FAILED"))
+	assert.True(t, failed("UPDATE_UNSUCCESSFUL"))
+	assert.False(t, failed("READY"))
+	assert.False(t, failed("CREATING"))
+	assert.False(t, failed(""))
+}
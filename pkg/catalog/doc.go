@@ -0,0 +1,22 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package catalog builds Backstage catalog entities that describe the MCP tools an MCPServer
+// exposes through its gateway, so that developer portals can list them.
+//
+// Entities are published in ConfigMaps owned by the MCPServer and are discovered by Backstage
+// through the Kubernetes ingestor of the catalog.
+package catalog
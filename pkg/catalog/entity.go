@@ -0,0 +1,158 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+const (
+	// ConfigMapLabel marks the catalog ConfigMaps written by the operator
+	ConfigMapLabel = "mcpgateway.bedrock.aws/catalog"
+
+	// EntityKey is the ConfigMap key holding the catalog entity
+	EntityKey = "catalog-info.yaml"
+
+	// OwnerAnnotation sets the owner of the entity on the MCPServer
+	OwnerAnnotation = "backstage.io/owner"
+
+	// LifecycleAnnotation sets the lifecycle of the entity on the MCPServer
+	LifecycleAnnotation = "backstage.io/lifecycle"
+
+	// annotationPrefix prefixes the annotations describing the gateway target
+	annotationPrefix = "mcpgateway.bedrock.aws/"
+
+	// ToolSeparator separates the target name from the tool name in the tool names of the gateway
+	ToolSeparator = "___"
+
+	defaultOwner     = "unknown"
+	defaultLifecycle = "production"
+)
+
+// Target describes the gateway target of an MCPServer
+type Target struct {
+	// Name is the name of the gateway target, which prefixes its tool names
+	Name string
+	// ID is the ID of the gateway target, empty before it was created
+	ID string
+	// GatewayID is the ID of the gateway the target belongs to
+	GatewayID string
+	// GatewayURL is the MCP URL of the gateway, empty if it is unknown
+	GatewayURL string
+}
+
+// ConfigMapName returns the name of the catalog ConfigMap of the MCPServer
+func ConfigMapName(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	return mcpServer.Name + "-catalog"
+}
+
+// NewConfigMap returns an empty catalog ConfigMap in the namespace of the MCPServer
+func NewConfigMap(mcpServer *mcpgatewayv1alpha1.MCPServer) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: mcpServer.Namespace,
+			Name:      ConfigMapName(mcpServer),
+		},
+	}
+}
+
+// SetConfigMapData sets the labels and the entity of the catalog ConfigMap of the MCPServer.
+// watchLabel is set as well so the ConfigMap stays visible to the operator's restricted cache.
+func SetConfigMapData(configMap *corev1.ConfigMap, mcpServer *mcpgatewayv1alpha1.MCPServer, target Target, watchLabel string) error {
+	entity, err := Entity(mcpServer, target)
+	if err != nil {
+		return fmt.Errorf("failed to build catalog entity: %w", err)
+	}
+	if configMap.Labels == nil {
+		configMap.Labels = map[string]string{}
+	}
+	configMap.Labels[ConfigMapLabel] = "true"
+	if watchLabel != "" {
+		configMap.Labels[watchLabel] = "true"
+	}
+	configMap.Data = map[string]string{EntityKey: string(entity)}
+	return nil
+}
+
+// Entity returns the Backstage API entity of the MCPServer as YAML.
+// The owner and lifecycle are taken from the backstage.io annotations of the MCPServer.
+func Entity(mcpServer *mcpgatewayv1alpha1.MCPServer, target Target) ([]byte, error) {
+	annotations := map[string]string{
+		annotationPrefix + "mcpserver":   mcpServer.Namespace + "/" + mcpServer.Name,
+		annotationPrefix + "endpoint":    mcpServer.Spec.Endpoint,
+		annotationPrefix + "tool-prefix": target.Name + ToolSeparator,
+	}
+	for key, value := range map[string]string{
+		"gateway-id":  target.GatewayID,
+		"gateway-url": target.GatewayURL,
+		"target-id":   target.ID,
+		"target-name": target.Name,
+	} {
+		if value != "" {
+			annotations[annotationPrefix+key] = value
+		}
+	}
+
+	owner := mcpServer.Annotations[OwnerAnnotation]
+	if owner == "" {
+		owner = defaultOwner
+	}
+	lifecycle := mcpServer.Annotations[LifecycleAnnotation]
+	if lifecycle == "" {
+		lifecycle = defaultLifecycle
+	}
+
+	metadata := map[string]any{
+		"name":        mcpServer.Namespace + "-" + mcpServer.Name,
+		"annotations": annotations,
+	}
+	if mcpServer.Spec.Description != "" {
+		metadata["description"] = mcpServer.Spec.Description
+	}
+	if len(mcpServer.Spec.Capabilities) > 0 {
+		metadata["tags"] = mcpServer.Spec.Capabilities
+	}
+
+	entity := map[string]any{
+		"apiVersion": "backstage.io/v1alpha1",
+		"kind":       "API",
+		"metadata":   metadata,
+		"spec": map[string]any{
+			"type":       "mcp",
+			"owner":      owner,
+			"lifecycle":  lifecycle,
+			"definition": definition(mcpServer, target),
+		},
+	}
+	return yaml.Marshal(entity)
+}
+
+// definition describes how agents reach the tools of the MCPServer
+func definition(mcpServer *mcpgatewayv1alpha1.MCPServer, target Target) string {
+	gatewayURL := target.GatewayURL
+	if gatewayURL == "" {
+		gatewayURL = "unknown"
+	}
+	return fmt.Sprintf("MCP server %s/%s at %s, exposed through gateway %s with tools prefixed %q\n",
+		mcpServer.Namespace, mcpServer.Name, mcpServer.Spec.Endpoint, gatewayURL, target.Name+ToolSeparator)
+}
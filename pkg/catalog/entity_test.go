@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func testMCPServer() *mcpgatewayv1alpha1.MCPServer {
+	return &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "tools", Name: "weather"},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://weather.example.com/mcp",
+			Capabilities: []string{"tools"},
+			Description:  "Weather forecasts",
+		},
+	}
+}
+
+func TestEntity(t *testing.T) {
+	mcpServer := testMCPServer()
+	mcpServer.Annotations = map[string]string{OwnerAnnotation: "team-weather"}
+	target := Target{
+		Name:       "weather",
+		ID:         "TARGET123",
+		GatewayID:  "gw-abcdef1234",
+		GatewayURL: "https://gw-abcdef1234.gateway.bedrock-agentcore.us-east-1.amazonaws.com/mcp",
+	}
+
+	data, err := Entity(mcpServer, target)
+	require.NoError(t, err)
+
+	var entity map[string]any
+	require.NoError(t, yaml.Unmarshal(data, &entity))
+	assert.Equal(t, "backstage.io/v1alpha1", entity["apiVersion"])
+	assert.Equal(t, "API", entity["kind"])
+
+	metadata := entity["metadata"].(map[string]any)
+	assert.Equal(t, "tools-weather", metadata["name"])
+	assert.Equal(t, "Weather forecasts", metadata["description"])
+	assert.Equal(t, []any{"tools"}, metadata["tags"])
+
+	annotations := metadata["annotations"].(map[string]any)
+	assert.Equal(t, "tools/weather", annotations["mcpgateway.bedrock.aws/mcpserver"])
+	assert.Equal(t, "https://weather.example.com/mcp", annotations["mcpgateway.bedrock.aws/endpoint"])
+	assert.Equal(t, "gw-abcdef1234", annotations["mcpgateway.bedrock.aws/gateway-id"])
+	assert.Equal(t, target.GatewayURL, annotations["mcpgateway.bedrock.aws/gateway-url"])
+	assert.Equal(t, "TARGET123", annotations["mcpgateway.bedrock.aws/target-id"])
+	assert.Equal(t, "weather___", annotations["mcpgateway.bedrock.aws/tool-prefix"])
+
+	spec := entity["spec"].(map[string]any)
+	assert.Equal(t, "mcp", spec["type"])
+	assert.Equal(t, "team-weather", spec["owner"])
+	assert.Equal(t, "production", spec["lifecycle"])
+	assert.Contains(t, spec["definition"], target.GatewayURL)
+}
+
+func TestEntityOmitsUnknownTarget(t *testing.T) {
+	data, err := Entity(testMCPServer(), Target{Name: "weather"})
+	require.NoError(t, err)
+
+	var entity map[string]any
+	require.NoError(t, yaml.Unmarshal(data, &entity))
+	annotations := entity["metadata"].(map[string]any)["annotations"].(map[string]any)
+	assert.NotContains(t, annotations, "mcpgateway.bedrock.aws/gateway-url")
+	assert.NotContains(t, annotations, "mcpgateway.bedrock.aws/target-id")
+	assert.Equal(t, "unknown", entity["spec"].(map[string]any)["owner"])
+}
+
+func TestSetConfigMapData(t *testing.T) {
+	mcpServer := testMCPServer()
+	configMap := NewConfigMap(mcpServer)
+	assert.Equal(t, "tools", configMap.Namespace)
+	assert.Equal(t, "weather-catalog", configMap.Name)
+
+	require.NoError(t, SetConfigMapData(configMap, mcpServer, Target{Name: "weather"}, "example.com/watch"))
+	assert.Equal(t, "true", configMap.Labels[ConfigMapLabel])
+	assert.Equal(t, "true", configMap.Labels["example.com/watch"])
+	assert.Contains(t, configMap.Data[EntityKey], "kind: API")
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	applyv1alpha1 "github.com/aws/mcp-gateway-operator/pkg/client/applyconfiguration/mcpgateway/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/client/clientset/versioned/fake"
+	"github.com/aws/mcp-gateway-operator/pkg/client/informers/externalversions"
+)
+
+// newClientset returns the object-tracker fake. fake.NewClientset manages fields through the
+// OpenAPI models of the types, which the CRD types do not publish.
+func newClientset(objects ...runtime.Object) *fake.Clientset {
+	return fake.NewSimpleClientset(objects...) //nolint:staticcheck
+}
+
+func TestClientsetRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	clientset := newClientset(&mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "weather"},
+		Spec:       mcpgatewayv1alpha1.MCPServerSpec{Endpoint: "https://weather.example.com/mcp"},
+	})
+
+	got, err := clientset.McpgatewayV1alpha1().MCPServers("team-a").Get(ctx, "weather", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "https://weather.example.com/mcp", got.Spec.Endpoint)
+
+	_, err = clientset.McpgatewayV1alpha1().AgentCoreStacks("team-a").Create(ctx, &mcpgatewayv1alpha1.AgentCoreStack{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "stack"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	stacks, err := clientset.McpgatewayV1alpha1().AgentCoreStacks("team-a").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, stacks.Items, 1)
+
+	_, err = clientset.McpgatewayV1alpha1().MCPServerGroups("team-a").Create(ctx, &mcpgatewayv1alpha1.MCPServerGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "tools"},
+		Spec:       mcpgatewayv1alpha1.MCPServerGroupSpec{MinMembers: 2},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	group, err := clientset.McpgatewayV1alpha1().MCPServerGroups("team-a").Get(ctx, "tools", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), group.Spec.MinMembers)
+
+	_, err = clientset.McpgatewayV1alpha1().AWSProviderConfigs().Create(ctx, &mcpgatewayv1alpha1.AWSProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "eu-account"},
+		Spec:       mcpgatewayv1alpha1.AWSProviderConfigSpec{Region: "eu-west-1"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	providerConfig, err := clientset.McpgatewayV1alpha1().AWSProviderConfigs().Get(ctx, "eu-account", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", providerConfig.Spec.Region)
+}
+
+func TestApplyConfiguration(t *testing.T) {
+	config := applyv1alpha1.MCPServer("search", "team-b").
+		WithLabels(map[string]string{"team": "b"}).
+		WithSpec(applyv1alpha1.MCPServerSpec().
+			WithEndpoint("https://search.example.com/mcp").
+			WithCapabilities("tools").
+			WithProviderConfigRef(applyv1alpha1.ProviderConfigReference().WithName("eu-account")))
+
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"apiVersion": "mcpgateway.bedrock.aws/v1alpha1",
+		"kind": "MCPServer",
+		"metadata": {"name": "search", "namespace": "team-b", "labels": {"team": "b"}},
+		"spec": {
+			"endpoint": "https://search.example.com/mcp",
+			"capabilities": ["tools"],
+			"providerConfigRef": {"name": "eu-account"}
+		}
+	}`, string(data))
+}
+
+func TestInformerLister(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clientset := newClientset(
+		&mcpgatewayv1alpha1.MCPServer{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "weather"}},
+		&mcpgatewayv1alpha1.MCPServer{ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "search"}},
+	)
+	factory := externalversions.NewSharedInformerFactory(clientset, time.Minute)
+	informer := factory.Mcpgateway().V1alpha1().MCPServers()
+	lister := informer.Lister()
+
+	factory.Start(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), informer.Informer().HasSynced))
+
+	got, err := lister.MCPServers("team-b").Get("search")
+	require.NoError(t, err)
+	assert.Equal(t, "search", got.Name)
+
+	_, err = lister.MCPServers("team-a").Get("search")
+	assert.Error(t, err)
+}
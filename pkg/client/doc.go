@@ -0,0 +1,4 @@
+// Package client holds the generated clientset, informers, listers and apply configurations for
+// the mcpgateway.bedrock.aws API group. Regenerate them with `make generate-client` after
+// changing the types in api/.
+package client
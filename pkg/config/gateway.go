@@ -0,0 +1,135 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// gatewayIDPattern matches gateway IDs, which AgentCore derives from the lowercased gateway
+	// name followed by a random suffix
+	gatewayIDPattern = regexp.MustCompile(`^[0-9a-z]([0-9a-z-]{0,110}[0-9a-z])?$`)
+	// gatewayNamePattern matches gateway names, which may contain upper case letters
+	gatewayNamePattern = regexp.MustCompile(`^([0-9a-zA-Z][-]?){1,100}$`)
+	// regionPattern matches AWS region names such as us-east-1 or us-gov-west-1
+	regionPattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+	// accountIDPattern matches AWS account IDs
+	accountIDPattern = regexp.MustCompile(`^[0-9]{12}$`)
+)
+
+// GatewayIdentifier is a gateway identifier given as a gateway ID or gateway ARN
+type GatewayIdentifier struct {
+	// ID is the canonical gateway ID, which the AgentCore APIs are called with
+	ID string
+	// Partition, Region and AccountID are set if the identifier is an ARN
+	Partition string
+	Region    string
+	AccountID string
+}
+
+// ParseGatewayIdentifier validates a gateway identifier and normalizes it to its gateway ID.
+// Gateway names cannot be resolved without calling AWS and are rejected if they can be told
+// apart from an ID, i.e. if they contain upper case letters.
+func ParseGatewayIdentifier(identifier string) (GatewayIdentifier, error) {
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		return GatewayIdentifier{}, fmt.Errorf("gateway identifier cannot be empty")
+	}
+
+	if strings.HasPrefix(identifier, "arn:") {
+		return parseGatewayArn(identifier)
+	}
+	if gatewayIDPattern.MatchString(identifier) {
+		return GatewayIdentifier{ID: identifier}, nil
+	}
+	if gatewayNamePattern.MatchString(identifier) {
+		return GatewayIdentifier{}, fmt.Errorf("%q looks like a gateway name; use the gateway ID or ARN instead", identifier)
+	}
+	return GatewayIdentifier{}, fmt.Errorf("%q is not a valid gateway ID or ARN", identifier)
+}
+
+// parseGatewayArn parses a gateway ARN (arn:<partition>:bedrock-agentcore:<region>:<account>:gateway/<id>)
+func parseGatewayArn(gatewayArn string) (GatewayIdentifier, error) {
+	parts := strings.SplitN(gatewayArn, ":", 6)
+	if len(parts) != 6 {
+		return GatewayIdentifier{}, fmt.Errorf("%q is not a valid ARN", gatewayArn)
+	}
+	partition, service, region, accountID, resource := parts[1], parts[2], parts[3], parts[4], parts[5]
+
+	if service != "bedrock-agentcore" {
+		return GatewayIdentifier{}, fmt.Errorf("ARN %q is not a bedrock-agentcore ARN", gatewayArn)
+	}
+	if !regionPattern.MatchString(region) {
+		return GatewayIdentifier{}, fmt.Errorf("ARN %q has an invalid region %q", gatewayArn, region)
+	}
+	if partition != PartitionForRegion(region) {
+		return GatewayIdentifier{}, fmt.Errorf("ARN %q has partition %q, but region %s is in partition %s",
+			gatewayArn, partition, region, PartitionForRegion(region))
+	}
+	if !accountIDPattern.MatchString(accountID) {
+		return GatewayIdentifier{}, fmt.Errorf("ARN %q has an invalid account ID %q", gatewayArn, accountID)
+	}
+	gatewayID, ok := strings.CutPrefix(resource, "gateway/")
+	if !ok || !gatewayIDPattern.MatchString(gatewayID) {
+		return GatewayIdentifier{}, fmt.Errorf("ARN %q is not a gateway ARN", gatewayArn)
+	}
+
+	return GatewayIdentifier{ID: gatewayID, Partition: partition, Region: region, AccountID: accountID}, nil
+}
+
+// PartitionForRegion returns the AWS partition of a region
+func PartitionForRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	case strings.HasPrefix(region, "us-isob-"):
+		return "aws-iso-b"
+	case strings.HasPrefix(region, "us-iso-"):
+		return "aws-iso"
+	default:
+		return "aws"
+	}
+}
+
+// dnsSuffixes are the DNS suffixes of the AWS partitions with other suffixes than amazonaws.com
+var dnsSuffixes = map[string]string{
+	"aws-cn":    "amazonaws.com.cn",
+	"aws-iso":   "c2s.ic.gov",
+	"aws-iso-b": "sc2s.sgov.gov",
+}
+
+// GatewayURL returns the MCP URL agents use to reach the gateway with the given ID in region,
+// or "" if the region is unknown
+func GatewayURL(gatewayID, region string) string {
+	if gatewayID == "" || region == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://%s.gateway.bedrock-agentcore.%s.%s/mcp", gatewayID, region, DNSSuffix(region))
+}
+
+// DNSSuffix returns the DNS suffix of the AWS endpoints in region
+func DNSSuffix(region string) string {
+	if suffix, ok := dnsSuffixes[PartitionForRegion(region)]; ok {
+		return suffix
+	}
+	return "amazonaws.com"
+}
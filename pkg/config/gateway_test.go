@@ -0,0 +1,174 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+)
+
+func TestParseGatewayIdentifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier string
+		want       GatewayIdentifier
+		errSubstr  string
+	}{
+		{
+			name:       "gateway ID",
+			identifier: "my-gateway-abcdef1234",
+			want:       GatewayIdentifier{ID: "my-gateway-abcdef1234"},
+		},
+		{
+			name:       "gateway ID with whitespace",
+			identifier: " my-gateway-abcdef1234\n",
+			want:       GatewayIdentifier{ID: "my-gateway-abcdef1234"},
+		},
+		{
+			name:       "gateway ARN",
+			identifier: "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/my-gateway-abcdef1234",
+			want: GatewayIdentifier{ID: "my-gateway-abcdef1234", Partition: "aws", Region: "us-east-1",
+				AccountID: "123456789012"},
+		},
+		{
+			name:       "GovCloud gateway ARN",
+			identifier: "arn:aws-us-gov:bedrock-agentcore:us-gov-west-1:123456789012:gateway/gw-abcdef1234",
+			want: GatewayIdentifier{ID: "gw-abcdef1234", Partition: "aws-us-gov", Region: "us-gov-west-1",
+				AccountID: "123456789012"},
+		},
+		{
+			name:       "partition does not match region",
+			identifier: "arn:aws:bedrock-agentcore:cn-north-1:123456789012:gateway/gw-abcdef1234",
+			errSubstr:  "partition aws-cn",
+		},
+		{
+			name:       "ARN of another service",
+			identifier: "arn:aws:bedrock:us-east-1:123456789012:gateway/gw-abcdef1234",
+			errSubstr:  "not a bedrock-agentcore ARN",
+		},
+		{
+			name:       "gateway target ARN",
+			identifier: "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-abcdef1234/target/t-1",
+			errSubstr:  "not a gateway ARN",
+		},
+		{
+			name:       "invalid account ID",
+			identifier: "arn:aws:bedrock-agentcore:us-east-1:1234:gateway/gw-abcdef1234",
+			errSubstr:  "invalid account ID",
+		},
+		{
+			name:       "gateway name",
+			identifier: "MyGateway",
+			errSubstr:  "looks like a gateway name",
+		},
+		{
+			name:       "arbitrary string",
+			identifier: "gateway id/with spaces",
+			errSubstr:  "not a valid gateway ID or ARN",
+		},
+		{
+			name:       "empty",
+			identifier: "  ",
+			errSubstr:  "cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseGatewayIdentifier(tt.identifier)
+			if tt.errSubstr != "" {
+				if err == nil {
+					t.Errorf("ParseGatewayIdentifier() expected error but got none")
+				} else if !contains(err.Error(), tt.errSubstr) {
+					t.Errorf("ParseGatewayIdentifier() error = %v, want substring %v", err, tt.errSubstr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseGatewayIdentifier() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseGatewayIdentifier() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeGatewayID(t *testing.T) {
+	parser := NewConfigParser("")
+	parser.SetRegion("us-east-1")
+
+	got, err := parser.NormalizeGatewayID("arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-abcdef1234")
+	if err != nil {
+		t.Fatalf("NormalizeGatewayID() unexpected error = %v", err)
+	}
+	if got != "gw-abcdef1234" {
+		t.Errorf("NormalizeGatewayID() = %v, want %v", got, "gw-abcdef1234")
+	}
+
+	_, err = parser.NormalizeGatewayID("arn:aws:bedrock-agentcore:eu-west-1:123456789012:gateway/gw-abcdef1234")
+	if err == nil || !contains(err.Error(), "operator manages region us-east-1") {
+		t.Errorf("NormalizeGatewayID() error = %v, want region mismatch", err)
+	}
+}
+
+func TestPartitionForRegion(t *testing.T) {
+	tests := map[string]string{
+		"us-east-1":      "aws",
+		"eu-central-1":   "aws",
+		"cn-north-1":     "aws-cn",
+		"us-gov-west-1":  "aws-us-gov",
+		"us-iso-east-1":  "aws-iso",
+		"us-isob-east-1": "aws-iso-b",
+	}
+	for region, want := range tests {
+		if got := PartitionForRegion(region); got != want {
+			t.Errorf("PartitionForRegion(%q) = %v, want %v", region, got, want)
+		}
+	}
+}
+
+func TestGatewayURL(t *testing.T) {
+	tests := []struct {
+		gatewayID string
+		region    string
+		want      string
+	}{
+		{"gw-abcdef1234", "us-east-1", "https://gw-abcdef1234.gateway.bedrock-agentcore.us-east-1.amazonaws.com/mcp"},
+		{"gw-abcdef1234", "cn-north-1", "https://gw-abcdef1234.gateway.bedrock-agentcore.cn-north-1.amazonaws.com.cn/mcp"},
+		{"gw-abcdef1234", "", ""},
+		{"", "us-east-1", ""},
+	}
+	for _, tt := range tests {
+		if got := GatewayURL(tt.gatewayID, tt.region); got != tt.want {
+			t.Errorf("GatewayURL(%q, %q) = %v, want %v", tt.gatewayID, tt.region, got, tt.want)
+		}
+	}
+}
+
+func TestDNSSuffix(t *testing.T) {
+	tests := map[string]string{
+		"us-east-1":     "amazonaws.com",
+		"us-gov-west-1": "amazonaws.com",
+		"cn-north-1":    "amazonaws.com.cn",
+		"us-iso-east-1": "c2s.ic.gov",
+	}
+	for region, want := range tests {
+		if got := DNSSuffix(region); got != want {
+			t.Errorf("DNSSuffix(%q) = %v, want %v", region, got, want)
+		}
+	}
+}
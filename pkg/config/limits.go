@@ -0,0 +1,103 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// AgentCore limits on gateway targets. They are enforced before calling AWS so that an MCPServer
+// exceeding them gets an error naming the offending field instead of a ValidationException.
+const (
+	// MaxTargetNameLength is the maximum length of a gateway target name
+	MaxTargetNameLength = 100
+	// MaxDescriptionLength is the maximum length of a gateway target description
+	MaxDescriptionLength = 200
+	// MaxOAuthScopes is the maximum number of scopes of an OAuth credential provider
+	MaxOAuthScopes = 100
+	// MaxOAuthScopeLength is the maximum length of an OAuth scope
+	MaxOAuthScopeLength = 64
+	// MaxAllowlistEntries is the maximum number of entries of each metadata allowlist
+	MaxAllowlistEntries = 10
+	// MaxAllowlistEntryLength is the maximum length of a header or query parameter name in a
+	// metadata allowlist
+	MaxAllowlistEntryLength = 100
+)
+
+// ValidateLimits checks the MCPServer and the name of its gateway target against the AgentCore
+// limits and returns an error for every field exceeding them
+func ValidateLimits(mcpServer *mcpgatewayv1alpha1.MCPServer, targetName string) field.ErrorList {
+	spec := &mcpServer.Spec
+	specPath := field.NewPath("spec")
+	var errs field.ErrorList
+
+	if len(targetName) > MaxTargetNameLength {
+		errs = append(errs, field.TooLong(specPath.Child("targetName"), targetName, MaxTargetNameLength))
+	}
+	if len(spec.Description) > MaxDescriptionLength {
+		errs = append(errs, field.TooLong(specPath.Child("description"), spec.Description, MaxDescriptionLength))
+	}
+
+	if len(spec.CredentialProviders) > 0 {
+		for i, provider := range spec.CredentialProviders {
+			errs = append(errs, validateScopes(specPath.Child("credentialProviders").Index(i).Child("scopes"), provider.Scopes)...)
+		}
+	} else {
+		errs = append(errs, validateScopes(specPath.Child("oauthScopes"), spec.OauthScopes)...)
+	}
+
+	errs = append(errs, validateAllowlist(specPath.Child("allowedRequestHeaders"), spec.AllowedRequestHeaders)...)
+	errs = append(errs, validateAllowlist(specPath.Child("allowedQueryParameters"), spec.AllowedQueryParameters)...)
+	errs = append(errs, validateAllowlist(specPath.Child("allowedResponseHeaders"), spec.AllowedResponseHeaders)...)
+	return errs
+}
+
+// validateScopes checks a list of OAuth scopes against the AgentCore limits
+func validateScopes(path *field.Path, scopes []string) field.ErrorList {
+	return validateList(path, scopes, MaxOAuthScopes, MaxOAuthScopeLength)
+}
+
+// validateAllowlist checks a metadata allowlist against the AgentCore limits
+func validateAllowlist(path *field.Path, entries []string) field.ErrorList {
+	return validateList(path, entries, MaxAllowlistEntries, MaxAllowlistEntryLength)
+}
+
+// validateList checks the number of entries of a list and the length of each entry
+func validateList(path *field.Path, entries []string, maxEntries, maxLength int) field.ErrorList {
+	var errs field.ErrorList
+	if len(entries) > maxEntries {
+		errs = append(errs, field.TooMany(path, len(entries), maxEntries))
+	}
+	for i, entry := range entries {
+		if len(entry) > maxLength {
+			errs = append(errs, field.TooLong(path.Index(i), entry, maxLength))
+		}
+	}
+	return errs
+}
+
+// LimitsError summarizes limit violations in a single error, or returns nil if there are none
+func LimitsError(errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("exceeds AgentCore limits: %w", errs.ToAggregate())
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestValidateLimits(t *testing.T) {
+	manyEntries := func(n int) []string {
+		entries := make([]string, n)
+		for i := range entries {
+			entries[i] = "entry"
+		}
+		return entries
+	}
+
+	tests := []struct {
+		name       string
+		spec       mcpgatewayv1alpha1.MCPServerSpec
+		targetName string
+		wantFields []string
+	}{
+		{
+			name: "within limits",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{
+				Description:           strings.Repeat("d", MaxDescriptionLength),
+				OauthScopes:           manyEntries(MaxOAuthScopes),
+				AllowedRequestHeaders: manyEntries(MaxAllowlistEntries),
+			},
+			targetName: strings.Repeat("t", MaxTargetNameLength),
+		},
+		{
+			name:       "target name too long",
+			targetName: strings.Repeat("t", MaxTargetNameLength+1),
+			wantFields: []string{"spec.targetName"},
+		},
+		{
+			name:       "description too long",
+			spec:       mcpgatewayv1alpha1.MCPServerSpec{Description: strings.Repeat("d", MaxDescriptionLength+1)},
+			wantFields: []string{"spec.description"},
+		},
+		{
+			name:       "too many OAuth scopes",
+			spec:       mcpgatewayv1alpha1.MCPServerSpec{OauthScopes: manyEntries(MaxOAuthScopes + 1)},
+			wantFields: []string{"spec.oauthScopes"},
+		},
+		{
+			name: "credential provider scope too long",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{
+				OauthScopes: manyEntries(MaxOAuthScopes + 1),
+				CredentialProviders: []mcpgatewayv1alpha1.CredentialProvider{
+					{Type: "GatewayIamRole"},
+					{Type: "OAuth2", Scopes: []string{"read", strings.Repeat("s", MaxOAuthScopeLength+1)}},
+				},
+			},
+			wantFields: []string{"spec.credentialProviders[1].scopes[1]"},
+		},
+		{
+			name: "allowlists exceeding limits",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{
+				AllowedRequestHeaders:  manyEntries(MaxAllowlistEntries + 1),
+				AllowedQueryParameters: []string{strings.Repeat("q", MaxAllowlistEntryLength+1)},
+				AllowedResponseHeaders: manyEntries(MaxAllowlistEntries + 1),
+			},
+			wantFields: []string{
+				"spec.allowedRequestHeaders",
+				"spec.allowedQueryParameters[0]",
+				"spec.allowedResponseHeaders",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mcpServer := &mcpgatewayv1alpha1.MCPServer{Spec: tt.spec}
+			errs := ValidateLimits(mcpServer, tt.targetName)
+
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("ValidateLimits() fields = %v, want %v", fields, tt.wantFields)
+			}
+			if err := LimitsError(errs); (err != nil) != (len(tt.wantFields) > 0) {
+				t.Errorf("LimitsError() = %v, want error %v", err, len(tt.wantFields) > 0)
+			}
+		})
+	}
+}
+
+func TestLimitsErrorMessage(t *testing.T) {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{OauthScopes: make([]string, MaxOAuthScopes+1)},
+	}
+
+	err := LimitsError(ValidateLimits(mcpServer, "my-target"))
+	if err == nil {
+		t.Fatal("LimitsError() = nil, want error")
+	}
+	want := "spec.oauthScopes: Too many: 101: must have at most 100 items"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("LimitsError() = %q, want it to contain %q", err.Error(), want)
+	}
+}
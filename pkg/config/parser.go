@@ -21,13 +21,20 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"sync"
 
 	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
 )
 
+// httpsPattern is the endpoint pattern enforced by the MCPServer CRD
+var httpsPattern = regexp.MustCompile(`^https://.*`)
+
 // ConfigParser validates and parses MCPServer spec fields
 type ConfigParser struct {
+	mu               sync.RWMutex
 	defaultGatewayID string
+	region           string
+	endpointPattern  *regexp.Regexp
 }
 
 // NewConfigParser creates a new ConfigParser with the specified default gateway ID
@@ -37,6 +44,52 @@ func NewConfigParser(defaultGatewayID string) *ConfigParser {
 	}
 }
 
+// SetDefaultGatewayID replaces the default gateway ID used by subsequent GetGatewayID calls
+func (p *ConfigParser) SetDefaultGatewayID(gatewayID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.defaultGatewayID = gatewayID
+}
+
+// SetRegion sets the AWS region of the operator. Gateway ARNs in other regions are rejected,
+// as the gateway would not be reachable with the operator's regional client.
+func (p *ConfigParser) SetRegion(region string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.region = region
+}
+
+// SetEndpointPattern sets an additional pattern that endpoints must match, e.g. to only allow
+// the egress hosts of a service mesh. Endpoints must still use HTTPS. An empty pattern removes it.
+func (p *ConfigParser) SetEndpointPattern(pattern string) error {
+	var re *regexp.Regexp
+	if pattern != "" {
+		var err error
+		if re, err = regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid endpoint pattern: %w", err)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.endpointPattern = re
+	return nil
+}
+
+// Region returns the AWS region of the operator, or "" if it was not set
+func (p *ConfigParser) Region() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.region
+}
+
+// DefaultGatewayID returns the current default gateway ID
+func (p *ConfigParser) DefaultGatewayID() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.defaultGatewayID
+}
+
 // AuthConfig represents parsed authentication configuration
 type AuthConfig struct {
 	Type             string
@@ -51,7 +104,8 @@ type MetadataConfig struct {
 	AllowedResponseHeaders []string
 }
 
-// ParseEndpoint validates that the endpoint matches the HTTPS pattern
+// ParseEndpoint validates that the endpoint matches the HTTPS pattern and, if set with
+// SetEndpointPattern, the endpoint pattern of the operator.
 // Returns the endpoint if valid, or an error if invalid
 func (p *ConfigParser) ParseEndpoint(endpoint string) (string, error) {
 	if endpoint == "" {
@@ -59,9 +113,15 @@ func (p *ConfigParser) ParseEndpoint(endpoint string) (string, error) {
 	}
 
 	// Validate HTTPS pattern
-	httpsPattern := regexp.MustCompile(`^https://.*`)
 	if !httpsPattern.MatchString(endpoint) {
-		return "", fmt.Errorf("endpoint must match pattern ^https://.* (got: %s)", endpoint)
+		return "", fmt.Errorf("endpoint must match pattern %s (got: %s)", httpsPattern, endpoint)
+	}
+
+	p.mu.RLock()
+	endpointPattern := p.endpointPattern
+	p.mu.RUnlock()
+	if endpointPattern != nil && !endpointPattern.MatchString(endpoint) {
+		return "", fmt.Errorf("endpoint must match pattern %s (got: %s)", endpointPattern, endpoint)
 	}
 
 	return endpoint, nil
@@ -107,8 +167,12 @@ func (p *ConfigParser) ParseAuthConfig(mcpServer *mcpgatewayv1alpha1.MCPServer)
 		if mcpServer.Spec.OauthProviderArn == "" {
 			return nil, fmt.Errorf("oauthProviderArn is required when authType is OAuth2")
 		}
+		scopes, err := p.ParseScopes(mcpServer.Spec.OauthScopes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid oauthScopes: %w", err)
+		}
 		config.OauthProviderArn = mcpServer.Spec.OauthProviderArn
-		config.OauthScopes = mcpServer.Spec.OauthScopes
+		config.OauthScopes = scopes
 		return config, nil
 
 	default:
@@ -117,8 +181,17 @@ func (p *ConfigParser) ParseAuthConfig(mcpServer *mcpgatewayv1alpha1.MCPServer)
 }
 
 // ParseMetadataConfig parses metadata propagation configuration
-// Returns MetadataConfig with the configured headers and parameters
+// Returns MetadataConfig with the configured headers and parameters, or with empty lists if
+// metadata propagation is disabled
 func (p *ConfigParser) ParseMetadataConfig(mcpServer *mcpgatewayv1alpha1.MCPServer) *MetadataConfig {
+	if mcpServer.Spec.DisableMetadataPropagation {
+		return &MetadataConfig{
+			AllowedRequestHeaders:  []string{},
+			AllowedQueryParameters: []string{},
+			AllowedResponseHeaders: []string{},
+		}
+	}
+
 	config := &MetadataConfig{
 		AllowedRequestHeaders:  mcpServer.Spec.AllowedRequestHeaders,
 		AllowedQueryParameters: mcpServer.Spec.AllowedQueryParameters,
@@ -128,22 +201,102 @@ func (p *ConfigParser) ParseMetadataConfig(mcpServer *mcpgatewayv1alpha1.MCPServ
 	return config
 }
 
-// GetGatewayID returns the gateway ID from the spec or the default gateway ID
-// Returns an error if no gateway ID is available
+// GetGatewayID returns the gateway ID from the spec or the default gateway ID.
+// A target created on the default gateway stays on it, identified by status.gatewayArn,
+// when the default gateway is changed afterwards.
+// Gateway ARNs are normalized to the gateway ID, see NormalizeGatewayID. The gateway ARNs of
+// MCPServers referencing an AWSProviderConfig may be in any region, as their targets are managed
+// in the region of the AWSProviderConfig.
+// Returns an error if no gateway ID is available or the gateway identifier is invalid
 func (p *ConfigParser) GetGatewayID(mcpServer *mcpgatewayv1alpha1.MCPServer) (string, error) {
 	// Use spec.GatewayID if present
 	if mcpServer.Spec.GatewayID != "" {
-		gatewayID := strings.TrimSpace(mcpServer.Spec.GatewayID)
-		if gatewayID == "" {
+		if strings.TrimSpace(mcpServer.Spec.GatewayID) == "" {
 			return "", fmt.Errorf("gatewayId cannot be empty")
 		}
+		if mcpServer.Spec.ProviderConfigRef != nil {
+			gateway, err := ParseGatewayIdentifier(mcpServer.Spec.GatewayID)
+			if err != nil {
+				return "", fmt.Errorf("invalid gatewayId: %w", err)
+			}
+			return gateway.ID, nil
+		}
+		gatewayID, err := p.NormalizeGatewayID(mcpServer.Spec.GatewayID)
+		if err != nil {
+			return "", fmt.Errorf("invalid gatewayId: %w", err)
+		}
+		return gatewayID, nil
+	}
+
+	// Keep existing targets on the gateway they were created on
+	if gatewayID := GatewayIDFromArn(mcpServer.Status.GatewayArn); gatewayID != "" {
 		return gatewayID, nil
 	}
 
 	// Fall back to default gateway ID
-	if p.defaultGatewayID == "" {
+	defaultGatewayID := p.DefaultGatewayID()
+	if defaultGatewayID == "" {
 		return "", fmt.Errorf("no gatewayId specified in spec and no default gateway ID configured")
 	}
 
-	return p.defaultGatewayID, nil
+	gatewayID, err := p.NormalizeGatewayID(defaultGatewayID)
+	if err != nil {
+		return "", fmt.Errorf("invalid default gateway ID: %w", err)
+	}
+	return gatewayID, nil
+}
+
+// NormalizeGatewayID validates a gateway ID or ARN and returns the gateway ID.
+// ARNs must be in the partition and, if set with SetRegion, the region of the operator.
+func (p *ConfigParser) NormalizeGatewayID(identifier string) (string, error) {
+	gateway, err := ParseGatewayIdentifier(identifier)
+	if err != nil {
+		return "", err
+	}
+
+	p.mu.RLock()
+	region := p.region
+	p.mu.RUnlock()
+	if gateway.Region != "" && region != "" && gateway.Region != region {
+		return "", fmt.Errorf("gateway %s is in region %s, but the operator manages region %s",
+			gateway.ID, gateway.Region, region)
+	}
+	return gateway.ID, nil
+}
+
+// GatewayIDFromArn extracts the gateway ID from a gateway ARN
+// (arn:<partition>:bedrock-agentcore:<region>:<account>:gateway/<id>).
+// Returns an empty string if the ARN is not a gateway ARN.
+func GatewayIDFromArn(gatewayArn string) string {
+	parts := strings.SplitN(gatewayArn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return ""
+	}
+	gatewayID, ok := strings.CutPrefix(parts[5], "gateway/")
+	if !ok || strings.Contains(gatewayID, "/") {
+		return ""
+	}
+	return gatewayID
+}
+
+// ParseConfigMapRef parses a ConfigMap reference of the form namespace/name
+func ParseConfigMapRef(ref string) (namespace, name string, err error) {
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("ConfigMap reference %q must have the form namespace/name", ref)
+	}
+	return namespace, name, nil
+}
+
+// ParseConfigMapKeyRef parses a ConfigMap key reference of the form namespace/name#key
+func ParseConfigMapKeyRef(ref string) (namespace, name, key string, err error) {
+	objectRef, key, ok := strings.Cut(ref, "#")
+	if !ok || key == "" {
+		return "", "", "", fmt.Errorf("ConfigMap reference %q must have the form namespace/name#key", ref)
+	}
+	namespace, name, ok = strings.Cut(objectRef, "/")
+	if !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", "", fmt.Errorf("ConfigMap reference %q must have the form namespace/name#key", ref)
+	}
+	return namespace, name, key, nil
 }
@@ -94,6 +94,40 @@ func TestParseEndpoint(t *testing.T) {
 	}
 }
 
+func TestParseEndpoint_EndpointPattern(t *testing.T) {
+	parser := NewConfigParser("default-gateway")
+	if err := parser.SetEndpointPattern(`^https://[^/]+\.mesh\.example\.com(:\d+)?(/|$)`); err != nil {
+		t.Fatalf("SetEndpointPattern() unexpected error = %v", err)
+	}
+
+	if _, err := parser.ParseEndpoint("https://weather.mesh.example.com/mcp"); err != nil {
+		t.Errorf("ParseEndpoint() unexpected error = %v", err)
+	}
+	if _, err := parser.ParseEndpoint("https://weather.example.com/mcp"); err == nil ||
+		!contains(err.Error(), "mesh") {
+		t.Errorf("ParseEndpoint() error = %v, want endpoint pattern mismatch", err)
+	}
+	if _, err := parser.ParseEndpoint("http://weather.mesh.example.com/mcp"); err == nil ||
+		!contains(err.Error(), "^https://.*") {
+		t.Errorf("ParseEndpoint() error = %v, want HTTPS pattern mismatch", err)
+	}
+
+	// An empty pattern removes the endpoint pattern
+	if err := parser.SetEndpointPattern(""); err != nil {
+		t.Fatalf("SetEndpointPattern() unexpected error = %v", err)
+	}
+	if _, err := parser.ParseEndpoint("https://weather.example.com/mcp"); err != nil {
+		t.Errorf("ParseEndpoint() unexpected error = %v", err)
+	}
+}
+
+func TestSetEndpointPattern_Invalid(t *testing.T) {
+	parser := NewConfigParser("default-gateway")
+	if err := parser.SetEndpointPattern("(unclosed"); err == nil {
+		t.Errorf("SetEndpointPattern() expected error but got none")
+	}
+}
+
 func TestParseCapabilities(t *testing.T) {
 	parser := NewConfigParser("default-gateway")
 
@@ -220,6 +254,34 @@ func TestParseAuthConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "OAuth2 with unsorted scopes",
+			mcpServer: &mcpgatewayv1alpha1.MCPServer{
+				Spec: mcpgatewayv1alpha1.MCPServerSpec{
+					AuthType:         "OAuth2",
+					OauthProviderArn: "arn:aws:bedrock-agentcore:us-east-1:123456789012:oauth-provider/my-provider",
+					OauthScopes:      []string{"write ", "read"},
+				},
+			},
+			want: &AuthConfig{
+				Type:             "OAuth2",
+				OauthProviderArn: "arn:aws:bedrock-agentcore:us-east-1:123456789012:oauth-provider/my-provider",
+				OauthScopes:      []string{"read", "write"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "OAuth2 with space-separated scopes",
+			mcpServer: &mcpgatewayv1alpha1.MCPServer{
+				Spec: mcpgatewayv1alpha1.MCPServerSpec{
+					AuthType:         "OAuth2",
+					OauthProviderArn: "arn:aws:bedrock-agentcore:us-east-1:123456789012:oauth-provider/my-provider",
+					OauthScopes:      []string{"read write"},
+				},
+			},
+			wantErr:   true,
+			errSubstr: "invalid oauthScopes",
+		},
 		{
 			name: "OAuth2 without provider ARN",
 			mcpServer: &mcpgatewayv1alpha1.MCPServer{
@@ -299,6 +361,19 @@ func TestParseMetadataConfig(t *testing.T) {
 				AllowedResponseHeaders: nil,
 			},
 		},
+		{
+			name: "metadata propagation disabled",
+			mcpServer: &mcpgatewayv1alpha1.MCPServer{
+				Spec: mcpgatewayv1alpha1.MCPServerSpec{
+					DisableMetadataPropagation: true,
+				},
+			},
+			want: &MetadataConfig{
+				AllowedRequestHeaders:  []string{},
+				AllowedQueryParameters: []string{},
+				AllowedResponseHeaders: []string{},
+			},
+		},
 		{
 			name: "no metadata fields",
 			mcpServer: &mcpgatewayv1alpha1.MCPServer{
@@ -401,6 +476,76 @@ func TestGetGatewayID(t *testing.T) {
 			wantErr:   true,
 			errSubstr: "gatewayId cannot be empty",
 		},
+		{
+			name:             "keep existing target on the gateway it was created on",
+			defaultGatewayID: "new-gateway",
+			mcpServer: &mcpgatewayv1alpha1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-server",
+				},
+				Status: mcpgatewayv1alpha1.MCPServerStatus{
+					GatewayArn: "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/old-gateway",
+				},
+			},
+			want:    "old-gateway",
+			wantErr: false,
+		},
+		{
+			name:             "spec gateway ID takes precedence over status",
+			defaultGatewayID: "new-gateway",
+			mcpServer: &mcpgatewayv1alpha1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-server",
+				},
+				Spec: mcpgatewayv1alpha1.MCPServerSpec{
+					GatewayID: "custom-gateway",
+				},
+				Status: mcpgatewayv1alpha1.MCPServerStatus{
+					GatewayArn: "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/old-gateway",
+				},
+			},
+			want:    "custom-gateway",
+			wantErr: false,
+		},
+		{
+			name:             "normalize spec gateway ARN",
+			defaultGatewayID: "default-gateway",
+			mcpServer: &mcpgatewayv1alpha1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-server",
+				},
+				Spec: mcpgatewayv1alpha1.MCPServerSpec{
+					GatewayID: "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/custom-gateway",
+				},
+			},
+			want:    "custom-gateway",
+			wantErr: false,
+		},
+		{
+			name:             "error when spec gateway ID is a gateway name",
+			defaultGatewayID: "default-gateway",
+			mcpServer: &mcpgatewayv1alpha1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-server",
+				},
+				Spec: mcpgatewayv1alpha1.MCPServerSpec{
+					GatewayID: "CustomGateway",
+				},
+			},
+			wantErr:   true,
+			errSubstr: "invalid gatewayId",
+		},
+		{
+			name:             "error when default gateway ID is invalid",
+			defaultGatewayID: "default gateway",
+			mcpServer: &mcpgatewayv1alpha1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-server",
+				},
+			},
+			wantErr:   true,
+			errSubstr: "invalid default gateway ID",
+		},
 	}
 
 	for _, tt := range tests {
@@ -425,6 +570,164 @@ func TestGetGatewayID(t *testing.T) {
 	}
 }
 
+func TestGetGatewayID_ProviderConfigRegion(t *testing.T) {
+	parser := NewConfigParser("")
+	parser.SetRegion("us-east-1")
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			GatewayID:         "arn:aws:bedrock-agentcore:eu-west-1:123456789012:gateway/gw-abcdef1234",
+			ProviderConfigRef: &mcpgatewayv1alpha1.ProviderConfigReference{Name: "prod-eu"},
+		},
+	}
+
+	// The region is checked against the AWSProviderConfig instead of the operator
+	got, err := parser.GetGatewayID(mcpServer)
+	if err != nil {
+		t.Fatalf("GetGatewayID() unexpected error = %v", err)
+	}
+	if got != "gw-abcdef1234" {
+		t.Errorf("GetGatewayID() = %v, want %v", got, "gw-abcdef1234")
+	}
+
+	mcpServer.Spec.ProviderConfigRef = nil
+	if _, err := parser.GetGatewayID(mcpServer); err == nil {
+		t.Errorf("GetGatewayID() without providerConfigRef, want region mismatch")
+	}
+}
+
+func TestSetDefaultGatewayID(t *testing.T) {
+	parser := NewConfigParser("initial-gateway")
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-server",
+		},
+	}
+
+	parser.SetDefaultGatewayID("rotated-gateway")
+
+	result, err := parser.GetGatewayID(mcpServer)
+	if err != nil {
+		t.Fatalf("GetGatewayID() unexpected error = %v", err)
+	}
+	if result != "rotated-gateway" {
+		t.Errorf("GetGatewayID() = %v, want %v", result, "rotated-gateway")
+	}
+	if parser.DefaultGatewayID() != "rotated-gateway" {
+		t.Errorf("DefaultGatewayID() = %v, want %v", parser.DefaultGatewayID(), "rotated-gateway")
+	}
+}
+
+func TestGatewayIDFromArn(t *testing.T) {
+	tests := []struct {
+		name string
+		arn  string
+		want string
+	}{
+		{
+			name: "gateway ARN",
+			arn:  "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-abc123",
+			want: "gw-abc123",
+		},
+		{
+			name: "empty ARN",
+			arn:  "",
+			want: "",
+		},
+		{
+			name: "gateway target ARN",
+			arn:  "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-abc123/target/t-1",
+			want: "",
+		},
+		{
+			name: "not an ARN",
+			arn:  "gw-abc123",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GatewayIDFromArn(tt.arn); got != tt.want {
+				t.Errorf("GatewayIDFromArn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConfigMapRef(t *testing.T) {
+	namespace, name, err := ParseConfigMapRef("operators/environments")
+	if err != nil {
+		t.Fatalf("ParseConfigMapRef() error = %v", err)
+	}
+	if namespace != "operators" || name != "environments" {
+		t.Errorf("ParseConfigMapRef() = %s/%s, want operators/environments", namespace, name)
+	}
+
+	for _, ref := range []string{"environments", "operators/", "/environments", "operators/a/b"} {
+		if _, _, err := ParseConfigMapRef(ref); err == nil {
+			t.Errorf("ParseConfigMapRef(%q) expected error", ref)
+		}
+	}
+}
+
+func TestParseConfigMapKeyRef(t *testing.T) {
+	tests := []struct {
+		name          string
+		ref           string
+		wantNamespace string
+		wantName      string
+		wantKey       string
+		wantErr       bool
+	}{
+		{
+			name:          "valid reference",
+			ref:           "operators/gateway-config#gatewayId",
+			wantNamespace: "operators",
+			wantName:      "gateway-config",
+			wantKey:       "gatewayId",
+		},
+		{
+			name:    "missing key",
+			ref:     "operators/gateway-config",
+			wantErr: true,
+		},
+		{
+			name:    "empty key",
+			ref:     "operators/gateway-config#",
+			wantErr: true,
+		},
+		{
+			name:    "missing namespace",
+			ref:     "gateway-config#gatewayId",
+			wantErr: true,
+		},
+		{
+			name:    "too many segments",
+			ref:     "operators/gateway/config#gatewayId",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespace, name, key, err := ParseConfigMapKeyRef(tt.ref)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseConfigMapKeyRef() expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseConfigMapKeyRef() unexpected error = %v", err)
+			}
+			if namespace != tt.wantNamespace || name != tt.wantName || key != tt.wantKey {
+				t.Errorf("ParseConfigMapKeyRef() = %v/%v#%v, want %v/%v#%v",
+					namespace, name, key, tt.wantNamespace, tt.wantName, tt.wantKey)
+			}
+		})
+	}
+}
+
 // Helper functions
 
 func contains(s, substr string) bool {
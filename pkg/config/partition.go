@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// fipsPartitions are the partitions with FIPS endpoints
+var fipsPartitions = map[string]bool{
+	"aws":        true,
+	"aws-us-gov": true,
+}
+
+// ValidatePartitions checks that the ARNs of the MCPServer, spec.oauthProviderArn, the provider
+// ARNs of spec.credentialProviders and spec.lambdaArn, are in the partition of region, the region
+// its gateway target is managed in. Resources cannot be used across partitions, so AWS would reject
+// the target. Nothing is checked if region is empty, and malformed ARNs are left to AWS.
+func ValidatePartitions(mcpServer *mcpgatewayv1alpha1.MCPServer, region string) field.ErrorList {
+	if region == "" {
+		return nil
+	}
+	spec := &mcpServer.Spec
+	specPath := field.NewPath("spec")
+
+	var errs field.ErrorList
+	if err := ValidateArnPartition(spec.OauthProviderArn, region); err != nil {
+		errs = append(errs, field.Invalid(specPath.Child("oauthProviderArn"), spec.OauthProviderArn, err.Error()))
+	}
+	for i, provider := range spec.CredentialProviders {
+		if err := ValidateArnPartition(provider.ProviderArn, region); err != nil {
+			errs = append(errs, field.Invalid(specPath.Child("credentialProviders").Index(i).Child("providerArn"),
+				provider.ProviderArn, err.Error()))
+		}
+	}
+	if err := ValidateArnPartition(spec.LambdaArn, region); err != nil {
+		errs = append(errs, field.Invalid(specPath.Child("lambdaArn"), spec.LambdaArn, err.Error()))
+	}
+	return errs
+}
+
+// PartitionsError returns an error describing the partition mismatches found by
+// ValidatePartitions, or nil if there are none
+func PartitionsError(errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("ARNs in another partition: %w", errs.ToAggregate())
+}
+
+// ValidateArnPartition checks that resourceArn is in the partition of region. Empty and malformed
+// ARNs pass.
+func ValidateArnPartition(resourceArn, region string) error {
+	parsed, err := arn.Parse(resourceArn)
+	if err != nil {
+		return nil
+	}
+	if want := PartitionForRegion(region); parsed.Partition != want {
+		return fmt.Errorf("partition %s does not match partition %s of region %s", parsed.Partition, want, region)
+	}
+	return nil
+}
+
+// ValidateFIPSRegion checks that the partition of region has FIPS endpoints
+func ValidateFIPSRegion(region string) error {
+	if partition := PartitionForRegion(region); !fipsPartitions[partition] {
+		return fmt.Errorf("partition %s of region %s has no FIPS endpoints", partition, region)
+	}
+	return nil
+}
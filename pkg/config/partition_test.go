@@ -0,0 +1,88 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestValidatePartitions(t *testing.T) {
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			OauthProviderArn: "arn:aws:bedrock-agentcore:us-gov-west-1:123456789012:token-vault/default/oauth2credentialprovider/github",
+			CredentialProviders: []mcpgatewayv1alpha1.CredentialProvider{
+				{Type: "OAuth2", ProviderArn: "arn:aws-us-gov:bedrock-agentcore:us-gov-west-1:123456789012:token-vault/default/oauth2credentialprovider/github"},
+				{Type: "ApiKey", ProviderArn: "arn:aws:bedrock-agentcore:us-east-1:123456789012:token-vault/default/apikeycredentialprovider/key"},
+				{Type: "GatewayIamRole"},
+			},
+		},
+	}
+
+	errs := ValidatePartitions(mcpServer, "us-gov-west-1")
+	if len(errs) != 2 {
+		t.Fatalf("ValidatePartitions() = %v, want 2 errors", errs)
+	}
+	if errs[0].Field != "spec.oauthProviderArn" || errs[1].Field != "spec.credentialProviders[1].providerArn" {
+		t.Errorf("ValidatePartitions() fields = %s, %s", errs[0].Field, errs[1].Field)
+	}
+	if !contains(PartitionsError(errs).Error(), "does not match partition aws-us-gov of region us-gov-west-1") {
+		t.Errorf("PartitionsError() = %v", PartitionsError(errs))
+	}
+
+	// Without a region nothing can be checked
+	if errs := ValidatePartitions(mcpServer, ""); len(errs) != 0 {
+		t.Errorf("ValidatePartitions() without region = %v, want none", errs)
+	}
+	if PartitionsError(nil) != nil {
+		t.Errorf("PartitionsError(nil) should be nil")
+	}
+}
+
+func TestValidateArnPartition(t *testing.T) {
+	tests := []struct {
+		arn     string
+		region  string
+		wantErr bool
+	}{
+		{"arn:aws:lambda:us-east-1:123456789012:function:weather", "us-east-1", false},
+		{"arn:aws-cn:lambda:cn-north-1:123456789012:function:weather", "cn-north-1", false},
+		{"arn:aws:lambda:us-east-1:123456789012:function:weather", "cn-north-1", true},
+		{"arn:aws-us-gov:lambda:us-gov-east-1:123456789012:function:weather", "us-east-1", true},
+		{"", "us-east-1", false},
+		{"not-an-arn", "us-east-1", false},
+	}
+	for _, tt := range tests {
+		if err := ValidateArnPartition(tt.arn, tt.region); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateArnPartition(%q, %q) error = %v, wantErr %v", tt.arn, tt.region, err, tt.wantErr)
+		}
+	}
+}
+
+func TestValidateFIPSRegion(t *testing.T) {
+	for region, wantErr := range map[string]bool{
+		"us-east-1":     false,
+		"us-gov-west-1": false,
+		"cn-north-1":    true,
+		"us-iso-east-1": true,
+	} {
+		if err := ValidateFIPSRegion(region); (err != nil) != wantErr {
+			t.Errorf("ValidateFIPSRegion(%q) error = %v, wantErr %v", region, err, wantErr)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// scopePattern matches a single OAuth scope token as defined by RFC 6749: printable ASCII
+// characters except spaces, double quotes and backslashes
+var scopePattern = regexp.MustCompile(`^[\x21\x23-\x5B\x5D-\x7E]+$`)
+
+// ValidateScopes checks the OAuth scopes of the MCPServer, those of spec.credentialProviders if
+// set and spec.oauthScopes otherwise. Every entry must be a single scope, so space-separated
+// scope strings are rejected, scopes must not be repeated, and the AgentCore limits on the number
+// and length of scopes apply. Surrounding whitespace is ignored, as it is trimmed before sending.
+func ValidateScopes(mcpServer *mcpgatewayv1alpha1.MCPServer) field.ErrorList {
+	spec := &mcpServer.Spec
+	specPath := field.NewPath("spec")
+	if len(spec.CredentialProviders) == 0 {
+		return validateScopeList(specPath.Child("oauthScopes"), spec.OauthScopes)
+	}
+
+	var errs field.ErrorList
+	for i, provider := range spec.CredentialProviders {
+		errs = append(errs, validateScopeList(specPath.Child("credentialProviders").Index(i).Child("scopes"), provider.Scopes)...)
+	}
+	return errs
+}
+
+// ParseScopes validates a list of OAuth scopes like ValidateScopes and returns them trimmed
+// and sorted, the order in which they are sent to AWS
+func (p *ConfigParser) ParseScopes(scopes []string) ([]string, error) {
+	if errs := validateScopeList(field.NewPath("oauthScopes"), scopes); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	if scopes == nil {
+		return nil, nil
+	}
+	parsed := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			parsed = append(parsed, scope)
+		}
+	}
+	slices.Sort(parsed)
+	return parsed, nil
+}
+
+// validateScopeList checks the format, uniqueness, number and length of a list of OAuth scopes
+func validateScopeList(path *field.Path, scopes []string) field.ErrorList {
+	errs := validateScopes(path, scopes)
+	seen := make(map[string]bool, len(scopes))
+	for i, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		switch {
+		case scope == "":
+		case !scopePattern.MatchString(scope):
+			errs = append(errs, field.Invalid(path.Index(i), scope, invalidScopeMessage(scope)))
+		case seen[scope]:
+			errs = append(errs, field.Duplicate(path.Index(i), scope))
+		default:
+			seen[scope] = true
+		}
+	}
+	return errs
+}
+
+// invalidScopeMessage explains why scope is not a single OAuth scope
+func invalidScopeMessage(scope string) string {
+	if strings.ContainsAny(scope, " \t\n") {
+		return fmt.Sprintf("must be a single scope; list each of the %d scopes as a separate entry",
+			len(strings.Fields(scope)))
+	}
+	return "must only contain printable ASCII characters other than spaces, double quotes and backslashes"
+}
+
+// ScopesError summarizes invalid scopes in a single error, or returns nil if there are none
+func ScopesError(errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid OAuth scopes: %w", errs.ToAggregate())
+}
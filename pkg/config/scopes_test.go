@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestValidateScopes(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       mcpgatewayv1alpha1.MCPServerSpec
+		wantFields []string
+		wantDetail string
+	}{
+		{
+			name: "valid scopes",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{
+				OauthScopes: []string{"read", " write ", "https://graph.microsoft.com/.default", "api:tools/*"},
+			},
+		},
+		{
+			name:       "space-separated scopes",
+			spec:       mcpgatewayv1alpha1.MCPServerSpec{OauthScopes: []string{"read write admin"}},
+			wantFields: []string{"spec.oauthScopes[0]"},
+			wantDetail: "list each of the 3 scopes as a separate entry",
+		},
+		{
+			name:       "illegal characters",
+			spec:       mcpgatewayv1alpha1.MCPServerSpec{OauthScopes: []string{`read"`, `a\b`, "café"}},
+			wantFields: []string{"spec.oauthScopes[0]", "spec.oauthScopes[1]", "spec.oauthScopes[2]"},
+			wantDetail: "printable ASCII characters",
+		},
+		{
+			name:       "duplicates after trimming",
+			spec:       mcpgatewayv1alpha1.MCPServerSpec{OauthScopes: []string{"read", "write", " read"}},
+			wantFields: []string{"spec.oauthScopes[2]"},
+			wantDetail: "Duplicate value",
+		},
+		{
+			name:       "scope too long",
+			spec:       mcpgatewayv1alpha1.MCPServerSpec{OauthScopes: []string{strings.Repeat("s", MaxOAuthScopeLength+1)}},
+			wantFields: []string{"spec.oauthScopes[0]"},
+			wantDetail: "Too long",
+		},
+		{
+			name: "credential provider scopes take precedence",
+			spec: mcpgatewayv1alpha1.MCPServerSpec{
+				OauthScopes: []string{"read read"},
+				CredentialProviders: []mcpgatewayv1alpha1.CredentialProvider{
+					{Type: "GatewayIamRole"},
+					{Type: "OAuth2", Scopes: []string{"read", "read"}},
+				},
+			},
+			wantFields: []string{"spec.credentialProviders[1].scopes[1]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateScopes(&mcpgatewayv1alpha1.MCPServer{Spec: tt.spec})
+
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("ValidateScopes() fields = %v, want %v", fields, tt.wantFields)
+			}
+			err := ScopesError(errs)
+			if (err != nil) != (len(tt.wantFields) > 0) {
+				t.Fatalf("ScopesError() = %v, want error %v", err, len(tt.wantFields) > 0)
+			}
+			if tt.wantDetail != "" && !strings.Contains(err.Error(), tt.wantDetail) {
+				t.Errorf("ScopesError() = %q, want it to contain %q", err.Error(), tt.wantDetail)
+			}
+		})
+	}
+}
+
+func TestParseScopes(t *testing.T) {
+	parser := NewConfigParser("default-gateway")
+
+	scopes, err := parser.ParseScopes([]string{" write", "read", "", "admin "})
+	if err != nil {
+		t.Fatalf("ParseScopes() error = %v", err)
+	}
+	if got := strings.Join(scopes, ","); got != "admin,read,write" {
+		t.Errorf("ParseScopes() = %v, want [admin read write]", scopes)
+	}
+
+	if scopes, err := parser.ParseScopes(nil); err != nil || scopes != nil {
+		t.Errorf("ParseScopes(nil) = %v, %v, want nil, nil", scopes, err)
+	}
+
+	if _, err := parser.ParseScopes([]string{"read write"}); err == nil {
+		t.Error("ParseScopes() error = nil, want error for space-separated scopes")
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package environment holds the profiles that change how MCPServers are reconciled depending on the
+// environment of their namespace, e.g. a separate gateway, more patient retries or mandatory
+// approval of changes in production.
+package environment
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// Profile is the behavior of the MCPServers in the namespaces of one environment
+type Profile struct {
+	// GatewayID is the gateway of MCPServers that name none, instead of the default gateway
+	GatewayID string `json:"gatewayId,omitempty"`
+
+	// Retry overrides the retry policy of the AWS calls made for the MCPServers
+	Retry *RetrySpec `json:"retry,omitempty"`
+
+	// RequireApproval holds back the creation and update of gateway targets until the generation
+	// of the MCPServer is approved
+	RequireApproval bool `json:"requireApproval,omitempty"`
+}
+
+// RetrySpec overrides fields of the operator's retry policy. Unset fields keep their value.
+type RetrySpec struct {
+	// MaxRetries is the number of retries after the first attempt
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// InitialBackoff is the wait before the first retry
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+
+	// MaxBackoff caps the wait between retries
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
+// RetryPolicy returns base with the overrides of the profile applied
+func (p *Profile) RetryPolicy(base bedrock.RetryPolicy) bedrock.RetryPolicy {
+	if p.Retry == nil {
+		return base
+	}
+	if p.Retry.MaxRetries != nil {
+		base.MaxRetries = *p.Retry.MaxRetries
+	}
+	if p.Retry.InitialBackoff != nil {
+		base.InitialBackoff = p.Retry.InitialBackoff.Duration
+	}
+	if p.Retry.MaxBackoff != nil {
+		base.MaxBackoff = p.Retry.MaxBackoff.Duration
+	}
+	return base
+}
+
+// validate checks the retry overrides of the profile
+func (p *Profile) validate() error {
+	if p.Retry == nil {
+		return nil
+	}
+	if p.Retry.MaxRetries != nil && *p.Retry.MaxRetries < 0 {
+		return fmt.Errorf("retry.maxRetries must not be negative")
+	}
+	if p.Retry.InitialBackoff != nil && p.Retry.InitialBackoff.Duration < 0 {
+		return fmt.Errorf("retry.initialBackoff must not be negative")
+	}
+	if p.Retry.MaxBackoff != nil && p.Retry.MaxBackoff.Duration < 0 {
+		return fmt.Errorf("retry.maxBackoff must not be negative")
+	}
+	return nil
+}
+
+// Parse parses the profiles of a ConfigMap, one YAML document per key named after the environment
+func Parse(data map[string]string) (map[string]Profile, error) {
+	names := make([]string, 0, len(data))
+	for name := range data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	profiles := make(map[string]Profile, len(data))
+	for _, name := range names {
+		var profile Profile
+		if err := yaml.UnmarshalStrict([]byte(data[name]), &profile); err != nil {
+			return nil, fmt.Errorf("environment %s: %w", name, err)
+		}
+		if err := profile.validate(); err != nil {
+			return nil, fmt.Errorf("environment %s: %w", name, err)
+		}
+		profiles[name] = profile
+	}
+	return profiles, nil
+}
+
+// Registry holds the current profiles. It is safe for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	profiles map[string]Profile
+}
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{profiles: map[string]Profile{}}
+}
+
+// Set replaces the profiles
+func (r *Registry) Set(profiles map[string]Profile) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.profiles = profiles
+}
+
+// Lookup returns the profile of the environment, or nil if there is none
+func (r *Registry) Lookup(environment string) *Profile {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	profile, ok := r.profiles[environment]
+	if !ok {
+		return nil
+	}
+	return &profile
+}
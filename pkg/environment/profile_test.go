@@ -0,0 +1,81 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+func TestParse(t *testing.T) {
+	profiles, err := Parse(map[string]string{
+		"prod": `
+gatewayId: gw-prod
+requireApproval: true
+retry:
+  maxRetries: 6
+  maxBackoff: 1m
+`,
+		"dev": "gatewayId: gw-dev",
+	})
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+
+	prod := profiles["prod"]
+	assert.Equal(t, "gw-prod", prod.GatewayID)
+	assert.True(t, prod.RequireApproval)
+
+	base := bedrock.DefaultRetryPolicy()
+	policy := prod.RetryPolicy(base)
+	assert.Equal(t, 6, policy.MaxRetries)
+	assert.Equal(t, time.Minute, policy.MaxBackoff)
+	assert.Equal(t, base.InitialBackoff, policy.InitialBackoff)
+
+	dev := profiles["dev"]
+	assert.False(t, dev.RequireApproval)
+	assert.Equal(t, base, dev.RetryPolicy(base))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"unknown field":    "gateway: gw-prod",
+		"negative retries": "retry:\n  maxRetries: -1",
+		"bad duration":     "retry:\n  maxBackoff: soon",
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(map[string]string{"prod": data})
+			assert.ErrorContains(t, err, "environment prod")
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+	assert.Nil(t, registry.Lookup("prod"))
+
+	registry.Set(map[string]Profile{"prod": {GatewayID: "gw-prod"}})
+	profile := registry.Lookup("prod")
+	require.NotNil(t, profile)
+	assert.Equal(t, "gw-prod", profile.GatewayID)
+	assert.Nil(t, registry.Lookup("dev"))
+}
@@ -0,0 +1,3 @@
+// Package fleet summarizes the health of the MCPServers in a cluster for the status command of
+// the kubectl-mcpgateway plugin.
+package fleet
@@ -0,0 +1,198 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+)
+
+// maxMessageLength is the length error summaries are truncated to
+const maxMessageLength = 80
+
+// problemConditions are the conditions, besides Ready, that report a problem when True,
+// in the order they are summarized
+var problemConditions = []string{
+	"GatewayDeleted",
+	"Quarantined",
+	"ConcurrentModification",
+	"Throttled",
+	"BackendUnavailable",
+	"ApprovalPending",
+	"CredentialsExpiring",
+	"ConfigDrift",
+	"MetadataDrift",
+	"DuplicateEndpoint",
+	"Draining",
+}
+
+// Row is the summary of one MCPServer
+type Row struct {
+	Namespace    string
+	Name         string
+	Gateway      string
+	TargetStatus string
+	Ready        string
+	// SyncAge is the time since the status was last synchronized with AWS
+	SyncAge string
+	// Message summarizes why the MCPServer is not healthy; empty if it is
+	Message string
+}
+
+// Summary is the health of a set of MCPServers
+type Summary struct {
+	Rows     []Row
+	Ready    int
+	NotReady int
+}
+
+// Summarize summarizes the MCPServers on the given gateway, or on every gateway if gateway is
+// empty. The gateway may be given as ID or ARN. Rows are sorted by namespace and name.
+func Summarize(mcpServers []mcpgatewayv1alpha1.MCPServer, gateway string, now time.Time) Summary {
+	gateway = normalizeGateway(gateway)
+
+	var summary Summary
+	for i := range mcpServers {
+		mcpServer := &mcpServers[i]
+		row := summarize(mcpServer, now)
+		if gateway != "" && row.Gateway != gateway {
+			continue
+		}
+		if row.Ready == string(metav1.ConditionTrue) {
+			summary.Ready++
+		} else {
+			summary.NotReady++
+		}
+		summary.Rows = append(summary.Rows, row)
+	}
+
+	sort.Slice(summary.Rows, func(i, j int) bool {
+		if summary.Rows[i].Namespace != summary.Rows[j].Namespace {
+			return summary.Rows[i].Namespace < summary.Rows[j].Namespace
+		}
+		return summary.Rows[i].Name < summary.Rows[j].Name
+	})
+	return summary
+}
+
+// summarize builds the row of a single MCPServer
+func summarize(mcpServer *mcpgatewayv1alpha1.MCPServer, now time.Time) Row {
+	row := Row{
+		Namespace:    mcpServer.Namespace,
+		Name:         mcpServer.Name,
+		Gateway:      gatewayOf(mcpServer),
+		TargetStatus: mcpServer.Status.TargetStatus,
+		Ready:        string(metav1.ConditionUnknown),
+		SyncAge:      "<never>",
+	}
+	if row.TargetStatus == "" {
+		row.TargetStatus = "<none>"
+	}
+	if ready := meta.FindStatusCondition(mcpServer.Status.Conditions, "Ready"); ready != nil {
+		row.Ready = string(ready.Status)
+	}
+	if mcpServer.Status.LastSynchronized != nil {
+		row.SyncAge = duration.HumanDuration(now.Sub(mcpServer.Status.LastSynchronized.Time))
+	}
+	row.Message = truncate(problemOf(mcpServer))
+	return row
+}
+
+// gatewayOf returns the ID of the gateway of the MCPServer: the gateway its target was created
+// on, or else the gateway in its spec. MCPServers on the default gateway without a target
+// have no known gateway.
+func gatewayOf(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	if mcpServer.Status.GatewayID != "" {
+		return mcpServer.Status.GatewayID
+	}
+	if gatewayID := config.GatewayIDFromArn(mcpServer.Status.GatewayArn); gatewayID != "" {
+		return gatewayID
+	}
+	return normalizeGateway(mcpServer.Spec.GatewayID)
+}
+
+// normalizeGateway returns the gateway ID of a gateway ID or ARN, or the identifier as is if it
+// is not valid
+func normalizeGateway(identifier string) string {
+	if gateway, err := config.ParseGatewayIdentifier(identifier); err == nil {
+		return gateway.ID
+	}
+	return strings.TrimSpace(identifier)
+}
+
+// problemOf summarizes why the MCPServer is not healthy: the Ready condition if it is False,
+// the status reasons of a failed target or the first problem condition that is True
+func problemOf(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	if ready := meta.FindStatusCondition(mcpServer.Status.Conditions, "Ready"); ready != nil &&
+		ready.Status == metav1.ConditionFalse {
+		return ready.Reason + ": " + ready.Message
+	}
+	if len(mcpServer.Status.StatusReasons) > 0 {
+		return strings.Join(mcpServer.Status.StatusReasons, "; ")
+	}
+	for _, conditionType := range problemConditions {
+		if condition := meta.FindStatusCondition(mcpServer.Status.Conditions, conditionType); condition != nil &&
+			condition.Status == metav1.ConditionTrue {
+			return condition.Type + ": " + condition.Message
+		}
+	}
+	return ""
+}
+
+// truncate shortens a message to a single line of at most maxMessageLength characters
+func truncate(message string) string {
+	message = strings.Join(strings.Fields(message), " ")
+	if len([]rune(message)) <= maxMessageLength {
+		return message
+	}
+	return string([]rune(message)[:maxMessageLength-3]) + "..."
+}
+
+// Write prints the summary as a table followed by the totals
+func Write(w io.Writer, summary Summary) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tNAME\tGATEWAY\tTARGET STATUS\tREADY\tLAST SYNC\tMESSAGE")
+	for _, row := range summary.Rows {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", row.Namespace, row.Name, orDefault(row.Gateway),
+			row.TargetStatus, row.Ready, row.SyncAge, row.Message)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "\n%d MCPServers: %d ready, %d not ready\n",
+		len(summary.Rows), summary.Ready, summary.NotReady)
+	return err
+}
+
+// orDefault returns the gateway, or <default> if the MCPServer uses the default gateway
+func orDefault(value string) string {
+	if value == "" {
+		return "<default>"
+	}
+	return value
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func TestSummarize(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	synced := metav1.NewTime(now.Add(-5 * time.Minute))
+
+	mcpServers := []mcpgatewayv1alpha1.MCPServer{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "search"},
+			Spec:       mcpgatewayv1alpha1.MCPServerSpec{GatewayID: "gw-two"},
+			Status: mcpgatewayv1alpha1.MCPServerStatus{
+				Conditions: []metav1.Condition{{
+					Type: "Ready", Status: metav1.ConditionFalse, Reason: "UpdateError", Message: "AccessDenied",
+				}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "weather"},
+			Status: mcpgatewayv1alpha1.MCPServerStatus{
+				GatewayArn:       "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-one",
+				TargetStatus:     "READY",
+				LastSynchronized: &synced,
+				Conditions: []metav1.Condition{
+					{Type: "Ready", Status: metav1.ConditionTrue, Reason: "GatewayTargetReady"},
+					{Type: "Throttled", Status: metav1.ConditionTrue, Reason: "CallBudgetExhausted", Message: "budget"},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "pending"},
+		},
+	}
+
+	summary := Summarize(mcpServers, "", now)
+	require.Len(t, summary.Rows, 3)
+	assert.Equal(t, 1, summary.Ready)
+	assert.Equal(t, 2, summary.NotReady)
+
+	assert.Equal(t, Row{Namespace: "team-a", Name: "pending", TargetStatus: "<none>", Ready: "Unknown",
+		SyncAge: "<never>"}, summary.Rows[0])
+	assert.Equal(t, Row{Namespace: "team-a", Name: "weather", Gateway: "gw-one", TargetStatus: "READY", Ready: "True",
+		SyncAge: "5m", Message: "Throttled: budget"}, summary.Rows[1])
+	assert.Equal(t, "UpdateError: AccessDenied", summary.Rows[2].Message)
+
+	t.Run("filter by gateway ARN", func(t *testing.T) {
+		summary := Summarize(mcpServers, "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-two", now)
+		require.Len(t, summary.Rows, 1)
+		assert.Equal(t, "search", summary.Rows[0].Name)
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "short message", truncate("short\n  message"))
+
+	long := truncate(strings.Repeat("x", 100))
+	assert.Len(t, long, maxMessageLength)
+	assert.True(t, strings.HasSuffix(long, "..."))
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, Summary{
+		Rows:  []Row{{Namespace: "default", Name: "weather", TargetStatus: "READY", Ready: "True", SyncAge: "1m"}},
+		Ready: 1,
+	}))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.True(t, strings.HasPrefix(lines[0], "NAMESPACE"))
+	assert.Contains(t, lines[1], "<default>")
+	assert.Equal(t, "1 MCPServers: 1 ready, 0 not ready", lines[3])
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package iampolicy generates the least-privilege IAM policy the operator's role needs for the
+// controllers and features it runs with, so that the policy grows with the operator instead of
+// being copied from the documentation by hand.
+package iampolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+)
+
+// PolicyVersion is the IAM policy language version of generated documents
+const PolicyVersion = "2012-10-17"
+
+// Features are the parts of the operator that call AWS. They mirror the operator's flags.
+type Features struct {
+	// MCPServers is set if the mcpserver controller runs
+	MCPServers bool
+	// AgentCoreStacks is set if the agentcorestack controller runs
+	AgentCoreStacks bool
+	// TargetStats is set if --target-stats-interval is non-zero
+	TargetStats bool
+	// TargetNameWebhook is set if --enable-target-name-webhook is set
+	TargetNameWebhook bool
+	// TokenVaultKMSKeyARN is the customer managed key of the token vault. Set it if an
+	// AgentCoreStack configures spec.tokenVault.kmsKeyArn.
+	TokenVaultKMSKeyARN string
+	// ActivityLogGroup is the CloudWatch Logs log group of --activity-log-group, if any
+	ActivityLogGroup string
+	// GatewayRoleARNs are the execution roles passed to the gateways of AgentCoreStacks.
+	// Every role is allowed if empty.
+	GatewayRoleARNs []string
+
+	// Partition, Region and AccountID scope the resources of the policy. Empty values
+	// match every region and account. The partition defaults to the one of Region, or aws.
+	Partition string
+	Region    string
+	AccountID string
+}
+
+// Document is an IAM policy document
+type Document struct {
+	Version   string      `json:"Version"`
+	Statement []Statement `json:"Statement"`
+}
+
+// Statement is a statement of an IAM policy document
+type Statement struct {
+	Sid       string                       `json:"Sid"`
+	Effect    string                       `json:"Effect"`
+	Action    []string                     `json:"Action"`
+	Resource  []string                     `json:"Resource"`
+	Condition map[string]map[string]string `json:"Condition,omitempty"`
+}
+
+// Generate returns the policy the enabled features need. It fails if no feature calls AWS.
+func Generate(f Features) (*Document, error) {
+	if f.Partition != "" && f.Region != "" && f.Partition != config.PartitionForRegion(f.Region) {
+		return nil, fmt.Errorf("region %s is not in partition %s", f.Region, f.Partition)
+	}
+	arn := f.arnBuilder()
+	gateways := arn("bedrock-agentcore", "gateway/*")
+	targets := arn("bedrock-agentcore", "gateway-target/*")
+
+	var statements []Statement
+
+	if f.MCPServers {
+		statements = append(statements, Statement{
+			Sid: "GatewayTargets",
+			Action: []string{
+				"bedrock-agentcore:CreateGatewayTarget",
+				"bedrock-agentcore:DeleteGatewayTarget",
+				"bedrock-agentcore:GetGatewayTarget",
+				"bedrock-agentcore:UpdateGatewayTarget",
+			},
+			Resource: []string{gateways, targets},
+		})
+	}
+
+	// GetGateway is called by both controllers, to check gateway compatibility and stack readiness
+	if f.MCPServers || f.AgentCoreStacks {
+		statements = append(statements, Statement{
+			Sid:      "ReadGateways",
+			Action:   []string{"bedrock-agentcore:GetGateway"},
+			Resource: []string{gateways},
+		})
+	}
+
+	if f.TargetNameWebhook {
+		statements = append(statements, Statement{
+			Sid:      "ListGatewayTargets",
+			Action:   []string{"bedrock-agentcore:ListGatewayTargets"},
+			Resource: []string{gateways, targets},
+		})
+	}
+
+	if f.AgentCoreStacks {
+		statements = append(statements, Statement{
+			Sid: "Gateways",
+			Action: []string{
+				"bedrock-agentcore:CreateGateway",
+				"bedrock-agentcore:DeleteGateway",
+			},
+			Resource: []string{gateways},
+		}, Statement{
+			Sid: "CredentialProviders",
+			Action: []string{
+				"bedrock-agentcore:CreateOauth2CredentialProvider",
+				"bedrock-agentcore:GetOauth2CredentialProvider",
+				"bedrock-agentcore:DeleteOauth2CredentialProvider",
+			},
+			Resource: []string{arn("bedrock-agentcore", "token-vault/*")},
+		}, Statement{
+			// The token vault stores the client secrets of credential providers in Secrets Manager
+			Sid: "CredentialProviderSecrets",
+			Action: []string{
+				"secretsmanager:CreateSecret",
+				"secretsmanager:DeleteSecret",
+			},
+			Resource: []string{arn("secretsmanager", "secret:bedrock-agentcore-identity!*")},
+		})
+
+		roles := f.GatewayRoleARNs
+		if len(roles) == 0 {
+			roles = []string{fmt.Sprintf("arn:%s:iam::%s:role/*", f.partition(), f.account())}
+		}
+		statements = append(statements, Statement{
+			Sid:       "PassGatewayRoles",
+			Action:    []string{"iam:PassRole"},
+			Resource:  sortedCopy(roles),
+			Condition: map[string]map[string]string{"StringEquals": {"iam:PassedToService": "bedrock-agentcore.amazonaws.com"}},
+		})
+
+		if f.TokenVaultKMSKeyARN != "" {
+			statements = append(statements, Statement{
+				Sid: "TokenVaultKey",
+				Action: []string{
+					"bedrock-agentcore:GetTokenVault",
+					"bedrock-agentcore:SetTokenVaultCMK",
+				},
+				Resource: []string{arn("bedrock-agentcore", "token-vault/*")},
+			}, Statement{
+				Sid: "TokenVaultKeyAccess",
+				Action: []string{
+					"kms:CreateGrant",
+					"kms:Decrypt",
+					"kms:DescribeKey",
+					"kms:GenerateDataKey",
+				},
+				Resource: []string{f.TokenVaultKMSKeyARN},
+			})
+		}
+	}
+
+	if f.TargetStats {
+		// GetMetricData does not support resource-level permissions
+		statements = append(statements, Statement{
+			Sid:      "TargetStats",
+			Action:   []string{"cloudwatch:GetMetricData"},
+			Resource: []string{"*"},
+		})
+	}
+
+	if f.ActivityLogGroup != "" {
+		statements = append(statements, Statement{
+			Sid:      "ActivityLog",
+			Action:   []string{"logs:CreateLogStream", "logs:PutLogEvents"},
+			Resource: []string{arn("logs", "log-group:"+f.ActivityLogGroup+":*")},
+		})
+	}
+
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("no enabled feature calls AWS")
+	}
+	for i := range statements {
+		statements[i].Effect = "Allow"
+	}
+	return &Document{Version: PolicyVersion, Statement: statements}, nil
+}
+
+// Marshal returns the indented JSON of the document
+func (d *Document) Marshal() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+// arnBuilder returns a function building ARNs in the partition, region and account of f
+func (f Features) arnBuilder() func(service, resource string) string {
+	region := f.Region
+	if region == "" {
+		region = "*"
+	}
+	return func(service, resource string) string {
+		return fmt.Sprintf("arn:%s:%s:%s:%s:%s", f.partition(), service, region, f.account(), resource)
+	}
+}
+
+func (f Features) partition() string {
+	if f.Partition != "" {
+		return f.Partition
+	}
+	if f.Region != "" {
+		return config.PartitionForRegion(f.Region)
+	}
+	return "aws"
+}
+
+func (f Features) account() string {
+	if f.AccountID == "" {
+		return "*"
+	}
+	return f.AccountID
+}
+
+func sortedCopy(values []string) []string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iampolicy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// actions returns the actions of the document by statement ID
+func actions(doc *Document) map[string][]string {
+	result := map[string][]string{}
+	for _, s := range doc.Statement {
+		result[s.Sid] = s.Action
+	}
+	return result
+}
+
+func TestGenerate_MCPServers(t *testing.T) {
+	doc, err := Generate(Features{MCPServers: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, PolicyVersion, doc.Version)
+	assert.Equal(t, map[string][]string{
+		"GatewayTargets": {
+			"bedrock-agentcore:CreateGatewayTarget",
+			"bedrock-agentcore:DeleteGatewayTarget",
+			"bedrock-agentcore:GetGatewayTarget",
+			"bedrock-agentcore:UpdateGatewayTarget",
+		},
+		"ReadGateways": {"bedrock-agentcore:GetGateway"},
+	}, actions(doc))
+	assert.Equal(t, []string{
+		"arn:aws:bedrock-agentcore:*:*:gateway/*",
+		"arn:aws:bedrock-agentcore:*:*:gateway-target/*",
+	}, doc.Statement[0].Resource)
+	for _, s := range doc.Statement {
+		assert.Equal(t, "Allow", s.Effect)
+	}
+}
+
+func TestGenerate_OptionalFeatures(t *testing.T) {
+	doc, err := Generate(Features{MCPServers: true, TargetStats: true, TargetNameWebhook: true})
+	require.NoError(t, err)
+
+	got := actions(doc)
+	assert.Equal(t, []string{"cloudwatch:GetMetricData"}, got["TargetStats"])
+	assert.Equal(t, []string{"bedrock-agentcore:ListGatewayTargets"}, got["ListGatewayTargets"])
+	assert.NotContains(t, got, "Gateways")
+}
+
+func TestGenerate_ActivityLog(t *testing.T) {
+	doc, err := Generate(Features{MCPServers: true, ActivityLogGroup: "operator", Region: "us-east-1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"logs:CreateLogStream", "logs:PutLogEvents"}, actions(doc)["ActivityLog"])
+	for _, s := range doc.Statement {
+		if s.Sid == "ActivityLog" {
+			assert.Equal(t, []string{"arn:aws:logs:us-east-1:*:log-group:operator:*"}, s.Resource)
+		}
+	}
+}
+
+func TestGenerate_AgentCoreStacks(t *testing.T) {
+	doc, err := Generate(Features{
+		AgentCoreStacks: true,
+		GatewayRoleARNs: []string{"arn:aws:iam::123456789012:role/b", "arn:aws:iam::123456789012:role/a"},
+		Region:          "us-east-1",
+		AccountID:       "123456789012",
+	})
+	require.NoError(t, err)
+
+	got := actions(doc)
+	assert.NotContains(t, got, "GatewayTargets")
+	assert.NotContains(t, got, "TokenVaultKey")
+	assert.Equal(t, []string{"bedrock-agentcore:CreateGateway", "bedrock-agentcore:DeleteGateway"}, got["Gateways"])
+
+	for _, s := range doc.Statement {
+		switch s.Sid {
+		case "Gateways":
+			assert.Equal(t, []string{"arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/*"}, s.Resource)
+		case "PassGatewayRoles":
+			assert.Equal(t, []string{"arn:aws:iam::123456789012:role/a", "arn:aws:iam::123456789012:role/b"}, s.Resource)
+			assert.Equal(t, "bedrock-agentcore.amazonaws.com", s.Condition["StringEquals"]["iam:PassedToService"])
+		}
+	}
+}
+
+func TestGenerate_TokenVaultKey(t *testing.T) {
+	key := "arn:aws:kms:us-east-1:123456789012:key/abc"
+	doc, err := Generate(Features{AgentCoreStacks: true, TokenVaultKMSKeyARN: key})
+	require.NoError(t, err)
+
+	got := actions(doc)
+	assert.Contains(t, got["TokenVaultKey"], "bedrock-agentcore:SetTokenVaultCMK")
+	for _, s := range doc.Statement {
+		if s.Sid == "TokenVaultKeyAccess" {
+			assert.Equal(t, []string{key}, s.Resource)
+		}
+	}
+
+	// The key is only used by AgentCoreStacks
+	doc, err = Generate(Features{MCPServers: true, TokenVaultKMSKeyARN: key})
+	require.NoError(t, err)
+	assert.NotContains(t, actions(doc), "TokenVaultKey")
+}
+
+func TestGenerate_Partition(t *testing.T) {
+	doc, err := Generate(Features{AgentCoreStacks: true, Partition: "aws-us-gov"})
+	require.NoError(t, err)
+
+	for _, s := range doc.Statement {
+		if s.Sid == "PassGatewayRoles" {
+			assert.Equal(t, []string{"arn:aws-us-gov:iam::*:role/*"}, s.Resource)
+		}
+		if s.Sid == "Gateways" {
+			assert.Equal(t, []string{"arn:aws-us-gov:bedrock-agentcore:*:*:gateway/*"}, s.Resource)
+		}
+	}
+}
+
+func TestGenerate_PartitionOfRegion(t *testing.T) {
+	doc, err := Generate(Features{MCPServers: true, Region: "cn-north-1"})
+	require.NoError(t, err)
+	assert.Contains(t, doc.Statement[0].Resource, "arn:aws-cn:bedrock-agentcore:cn-north-1:*:gateway-target/*")
+
+	_, err = Generate(Features{MCPServers: true, Partition: "aws", Region: "us-gov-west-1"})
+	assert.ErrorContains(t, err, "region us-gov-west-1 is not in partition aws")
+}
+
+func TestGenerate_NothingEnabled(t *testing.T) {
+	_, err := Generate(Features{TargetNameWebhook: false})
+	assert.Error(t, err)
+}
+
+func TestDocument_Marshal(t *testing.T) {
+	doc, err := Generate(Features{MCPServers: true})
+	require.NoError(t, err)
+
+	data, err := doc.Marshal()
+	require.NoError(t, err)
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, PolicyVersion, decoded["Version"])
+	statement := decoded["Statement"].([]any)[0].(map[string]any)
+	assert.NotContains(t, statement, "Condition")
+}
@@ -0,0 +1,3 @@
+// Package journal records mutating AWS operations in a ConfigMap before they are
+// issued, so that operations interrupted by a crash can be detected and repaired.
+package journal
@@ -0,0 +1,191 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package journal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Operation is a mutating AWS operation recorded in the journal
+type Operation string
+
+const (
+	// OperationCreate records a CreateGatewayTarget call
+	OperationCreate Operation = "Create"
+	// OperationUpdate records an UpdateGatewayTarget call
+	OperationUpdate Operation = "Update"
+	// OperationDelete records a DeleteGatewayTarget call
+	OperationDelete Operation = "Delete"
+)
+
+// Entry records the intent of a single mutating AWS call, and the AWSProviderConfig it is made
+// with unless it uses the operator's own AWS client
+type Entry struct {
+	Operation      Operation `json:"operation"`
+	Namespace      string    `json:"namespace"`
+	Name           string    `json:"name"`
+	UID            types.UID `json:"uid"`
+	Generation     int64     `json:"generation"`
+	GatewayID      string    `json:"gatewayId"`
+	TargetID       string    `json:"targetId,omitempty"`
+	ClientToken    string    `json:"clientToken,omitempty"`
+	StartedAt      time.Time `json:"startedAt"`
+	ProviderConfig string    `json:"providerConfig,omitempty"`
+}
+
+// Key returns the ConfigMap data key of the entry.
+// A resource has at most one pending entry per operation.
+func (e *Entry) Key() string {
+	return string(e.UID) + "." + string(e.Operation)
+}
+
+// Journal stores pending entries in a single ConfigMap
+type Journal struct {
+	client    client.Client
+	reader    client.Reader
+	namespace string
+	name      string
+}
+
+// NewJournal creates a new Journal backed by the ConfigMap namespace/name.
+// Reads go through reader, which should bypass the informer cache.
+func NewJournal(c client.Client, reader client.Reader, namespace, name string) *Journal {
+	return &Journal{
+		client:    c,
+		reader:    reader,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+// Begin records the intent to perform an operation, replacing any previous pending entry
+// for the same resource and operation
+func (j *Journal) Begin(ctx context.Context, entry *Entry) error {
+	if entry.StartedAt.IsZero() {
+		entry.StartedAt = time.Now().UTC()
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode journal entry: %w", err)
+	}
+
+	return j.mutate(ctx, func(cm *corev1.ConfigMap) {
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[entry.Key()] = string(data)
+	})
+}
+
+// Complete removes the pending entry once the operation's outcome has been persisted
+func (j *Journal) Complete(ctx context.Context, entry *Entry) error {
+	return j.mutate(ctx, func(cm *corev1.ConfigMap) {
+		delete(cm.Data, entry.Key())
+	})
+}
+
+// Lookup returns the pending entry for a resource and operation, or nil if there is none
+func (j *Journal) Lookup(ctx context.Context, uid types.UID, operation Operation) (*Entry, error) {
+	cm, err := j.get(ctx)
+	if err != nil || cm == nil {
+		return nil, err
+	}
+
+	raw, ok := cm.Data[string(uid)+"."+string(operation)]
+	if !ok {
+		return nil, nil
+	}
+	entry := &Entry{}
+	if err := json.Unmarshal([]byte(raw), entry); err != nil {
+		return nil, fmt.Errorf("failed to decode journal entry: %w", err)
+	}
+	return entry, nil
+}
+
+// Pending returns all pending entries, oldest first
+func (j *Journal) Pending(ctx context.Context) ([]Entry, error) {
+	cm, err := j.get(ctx)
+	if err != nil || cm == nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(cm.Data))
+	for key, raw := range cm.Data {
+		entry := Entry{}
+		if err := json.Unmarshal([]byte(raw), &entry); err != nil {
+			return nil, fmt.Errorf("failed to decode journal entry %s: %w", key, err)
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, k int) bool {
+		return entries[i].StartedAt.Before(entries[k].StartedAt)
+	})
+	return entries, nil
+}
+
+// get returns the journal ConfigMap, or nil if it has not been created yet
+func (j *Journal) get(ctx context.Context) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	err := j.reader.Get(ctx, types.NamespacedName{Namespace: j.namespace, Name: j.name}, cm)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read journal: %w", err)
+	}
+	return cm, nil
+}
+
+// mutate applies fn to the journal ConfigMap, creating it if needed and retrying on conflicts
+func (j *Journal) mutate(ctx context.Context, fn func(*corev1.ConfigMap)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := j.get(ctx)
+		if err != nil {
+			return err
+		}
+
+		if cm == nil {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: j.namespace,
+					Name:      j.name,
+				},
+			}
+			fn(cm)
+			err = j.client.Create(ctx, cm)
+			if apierrors.IsAlreadyExists(err) {
+				// Lost a race with another writer, retry against the existing object
+				return apierrors.NewConflict(corev1.Resource("configmaps"), j.name, err)
+			}
+			return err
+		}
+
+		fn(cm)
+		return j.client.Update(ctx, cm)
+	})
+}
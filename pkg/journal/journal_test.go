@@ -0,0 +1,90 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package journal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestJournal(t *testing.T) *Journal {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	return NewJournal(fakeClient, fakeClient, "operator-system", "journal")
+}
+
+func TestJournal_BeginAndComplete(t *testing.T) {
+	j := newTestJournal(t)
+	ctx := context.Background()
+
+	// An empty journal has no pending entries
+	pending, err := j.Pending(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	entry := &Entry{
+		Operation:   OperationCreate,
+		Namespace:   "default",
+		Name:        "test-server",
+		UID:         "uid-1",
+		Generation:  2,
+		GatewayID:   "gw-123",
+		ClientToken: "token-abc",
+	}
+	require.NoError(t, j.Begin(ctx, entry))
+
+	found, err := j.Lookup(ctx, "uid-1", OperationCreate)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, "token-abc", found.ClientToken)
+	assert.Equal(t, int64(2), found.Generation)
+	assert.False(t, found.StartedAt.IsZero())
+
+	missing, err := j.Lookup(ctx, "uid-1", OperationDelete)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	require.NoError(t, j.Complete(ctx, entry))
+
+	pending, err = j.Pending(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestJournal_PendingOrdersByStartTime(t *testing.T) {
+	j := newTestJournal(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	require.NoError(t, j.Begin(ctx, &Entry{Operation: OperationDelete, UID: "uid-2", StartedAt: now}))
+	require.NoError(t, j.Begin(ctx, &Entry{Operation: OperationUpdate, UID: "uid-1", StartedAt: now.Add(-time.Minute)}))
+
+	pending, err := j.Pending(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, OperationUpdate, pending[0].Operation)
+	assert.Equal(t, OperationDelete, pending[1].Operation)
+}
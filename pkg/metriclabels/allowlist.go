@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metriclabels
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// namePrefix is prepended to the metric label of every propagated label, as kube-state-metrics does
+const namePrefix = "label_"
+
+// Allowlist is the set of MCPServer labels added to per-target metrics. A nil Allowlist adds none.
+type Allowlist struct {
+	keys  []string
+	names []string
+}
+
+// ParseAllowlist parses a comma-separated list of label keys. The metric label of a key is the key
+// with every character that is invalid in metric label names replaced by an underscore, prefixed
+// with label_, e.g. label_tool_domain for tool-domain.
+func ParseAllowlist(value string) (*Allowlist, error) {
+	allowlist := &Allowlist{}
+	for _, key := range strings.Split(value, ",") {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		if problems := validation.IsQualifiedName(key); len(problems) > 0 {
+			return nil, fmt.Errorf("invalid label key %q: %s", key, strings.Join(problems, ", "))
+		}
+		name := MetricLabelName(key)
+		if i := slices.Index(allowlist.names, name); i >= 0 {
+			return nil, fmt.Errorf("label keys %q and %q both map to metric label %s", allowlist.keys[i], key, name)
+		}
+		allowlist.keys = append(allowlist.keys, key)
+		allowlist.names = append(allowlist.names, name)
+	}
+	return allowlist, nil
+}
+
+// MetricLabelName returns the metric label of the label key
+func MetricLabelName(key string) string {
+	var b strings.Builder
+	b.WriteString(namePrefix)
+	for _, r := range key {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
+
+// Names returns the metric labels in the order of the allowlist
+func (a *Allowlist) Names() []string {
+	if a == nil {
+		return nil
+	}
+	return slices.Clone(a.names)
+}
+
+// Empty reports whether no label is propagated
+func (a *Allowlist) Empty() bool {
+	return a == nil || len(a.keys) == 0
+}
+
+// Values returns the values of the allowlisted labels in the order of Names. Missing labels have
+// an empty value.
+func (a *Allowlist) Values(labels map[string]string) []string {
+	if a == nil {
+		return nil
+	}
+	values := make([]string, len(a.keys))
+	for i, key := range a.keys {
+		values[i] = labels[key]
+	}
+	return values
+}
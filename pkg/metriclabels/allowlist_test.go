@@ -0,0 +1,57 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metriclabels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAllowlist(t *testing.T) {
+	allowlist, err := ParseAllowlist("tool-domain, example.com/team,cost_center")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"label_tool_domain", "label_example_com_team", "label_cost_center"}, allowlist.Names())
+	assert.Equal(t, []string{"finance", "", "cc-1"}, allowlist.Values(map[string]string{
+		"tool-domain": "finance",
+		"cost_center": "cc-1",
+		"app":         "weather",
+	}))
+	assert.False(t, allowlist.Empty())
+}
+
+func TestParseAllowlist_Empty(t *testing.T) {
+	allowlist, err := ParseAllowlist("")
+	require.NoError(t, err)
+	assert.True(t, allowlist.Empty())
+	assert.Empty(t, allowlist.Names())
+
+	var none *Allowlist
+	assert.True(t, none.Empty())
+	assert.Nil(t, none.Names())
+	assert.Nil(t, none.Values(map[string]string{"tool-domain": "finance"}))
+}
+
+func TestParseAllowlist_Invalid(t *testing.T) {
+	_, err := ParseAllowlist("tool domain")
+	assert.ErrorContains(t, err, "invalid label key")
+
+	_, err = ParseAllowlist("tool-domain,tool.domain")
+	assert.ErrorContains(t, err, "both map to metric label label_tool_domain")
+}
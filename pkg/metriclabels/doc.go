@@ -0,0 +1,3 @@
+// Package metriclabels propagates an allowlist of MCPServer labels, e.g. tool-domain, to the
+// per-target metrics of the operator, so dashboards can group targets by business domain.
+package metriclabels
@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metriclabels
+
+import (
+	"maps"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// GaugeVec is a gauge vector labelled with the allowlisted labels of an object in addition to its
+// own labels. It describes no metrics, so it can be registered before the allowlist is known and
+// its label names change with SetAllowlist.
+type GaugeVec struct {
+	opts   prometheus.GaugeOpts
+	labels []string
+
+	mu        sync.RWMutex
+	allowlist *Allowlist
+	vec       *prometheus.GaugeVec
+}
+
+// NewGaugeVec creates a GaugeVec with the given labels and no allowlisted labels
+func NewGaugeVec(opts prometheus.GaugeOpts, labels []string) *GaugeVec {
+	g := &GaugeVec{opts: opts, labels: labels}
+	g.SetAllowlist(nil)
+	return g
+}
+
+// SetAllowlist adds the metric labels of allowlist to the gauges and drops every series
+func (g *GaugeVec) SetAllowlist(allowlist *Allowlist) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.allowlist = allowlist
+	g.vec = prometheus.NewGaugeVec(g.opts, append(append([]string(nil), g.labels...), allowlist.Names()...))
+}
+
+// For returns the gauge of the series with labels and the allowlisted labels of an object. Series
+// with the same labels and other values of the allowlisted labels are deleted, so a relabelled
+// object is not reported twice.
+func (g *GaugeVec) For(objectLabels map[string]string, labels prometheus.Labels) prometheus.Gauge {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.allowlist.Empty() {
+		return g.vec.With(labels)
+	}
+
+	g.vec.DeletePartialMatch(labels)
+	labels = maps.Clone(labels)
+	names := g.allowlist.Names()
+	for i, value := range g.allowlist.Values(objectLabels) {
+		labels[names[i]] = value
+	}
+	return g.vec.With(labels)
+}
+
+// With returns the gauge of the series with labels, which must include the allowlisted labels
+func (g *GaugeVec) With(labels prometheus.Labels) prometheus.Gauge {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.vec.With(labels)
+}
+
+// WithLabelValues returns the gauge of the series with the label values, which must include the
+// values of the allowlisted labels
+func (g *GaugeVec) WithLabelValues(values ...string) prometheus.Gauge {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.vec.WithLabelValues(values...)
+}
+
+// DeletePartialMatch deletes the series matching labels and returns how many were deleted
+func (g *GaugeVec) DeletePartialMatch(labels prometheus.Labels) int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.vec.DeletePartialMatch(labels)
+}
+
+// Reset deletes every series
+func (g *GaugeVec) Reset() {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	g.vec.Reset()
+}
+
+// Describe implements prometheus.Collector. It describes no metrics, which makes the GaugeVec an
+// unchecked collector whose label names may change after registration.
+func (g *GaugeVec) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector
+func (g *GaugeVec) Collect(ch chan<- prometheus.Metric) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	g.vec.Collect(ch)
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metriclabels
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGaugeVec(t *testing.T) {
+	gauge := NewGaugeVec(prometheus.GaugeOpts{Name: "test_gauge", Help: "Test gauge"}, []string{"namespace", "name"})
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(gauge))
+
+	gauge.For(map[string]string{"tool-domain": "finance"}, prometheus.Labels{"namespace": "default", "name": "ledger"}).Set(1)
+	assert.Equal(t, 1.0, testutil.ToFloat64(gauge.WithLabelValues("default", "ledger")))
+
+	// The allowlist can be set after registration
+	allowlist, err := ParseAllowlist("tool-domain")
+	require.NoError(t, err)
+	gauge.SetAllowlist(allowlist)
+	assert.Equal(t, 0, testutil.CollectAndCount(gauge))
+
+	labels := prometheus.Labels{"namespace": "default", "name": "ledger"}
+	gauge.For(map[string]string{"tool-domain": "finance"}, labels).Set(1)
+	gauge.For(map[string]string{"tool-domain": "treasury"}, labels).Set(2)
+	assert.Len(t, labels, 2, "the labels of the caller are not modified")
+
+	// Relabelling replaces the series
+	assert.Equal(t, 1, testutil.CollectAndCount(gauge))
+	assert.Equal(t, 2.0, testutil.ToFloat64(gauge.WithLabelValues("default", "ledger", "treasury")))
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP test_gauge Test gauge
+# TYPE test_gauge gauge
+test_gauge{label_tool_domain="treasury",name="ledger",namespace="default"} 2
+`)))
+
+	assert.Equal(t, 1, gauge.DeletePartialMatch(prometheus.Labels{"namespace": "default"}))
+}
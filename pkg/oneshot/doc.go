@@ -0,0 +1,20 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oneshot runs the operator as a batch job for CI/CD pipelines and environments that
+// cannot run a long-lived controller: the controllers reconcile every resource, and the operator
+// stops as soon as all of them are in sync or a timeout elapses, reporting the ones that are not.
+package oneshot
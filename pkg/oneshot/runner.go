@@ -0,0 +1,204 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oneshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// defaultPollInterval is how often the resources are read while waiting for the controllers
+const defaultPollInterval = 5 * time.Second
+
+// Runner waits for the controllers of the manager it is added to until every resource is in sync,
+// then stops the manager. Resources are in sync once their Ready condition is True for their
+// current generation; MCPServers also need a READY gateway target.
+type Runner struct {
+	client  client.Client
+	timeout time.Duration
+	stop    context.CancelFunc
+	logger  logr.Logger
+
+	// MCPServers and Stacks select the kinds to check, which must be reconciled by a controller
+	// of the manager
+	MCPServers bool
+	Stacks     bool
+	// Owns restricts the check to the MCPServers reconciled by this operator, e.g. of its shard.
+	// All MCPServers are checked if it is nil.
+	Owns func(client.Object) bool
+
+	pollInterval time.Duration
+
+	mu       sync.Mutex
+	done     bool
+	unsynced []string
+}
+
+// NewRunner returns a Runner that calls stop once every resource is in sync or the timeout elapsed
+func NewRunner(c client.Client, timeout time.Duration, stop context.CancelFunc, logger logr.Logger) *Runner {
+	return &Runner{
+		client:       c,
+		timeout:      timeout,
+		stop:         stop,
+		logger:       logger,
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// Start waits for the resources to be in sync, then stops the manager. The first check only
+// happens after a poll interval, so the controllers pick up every resource first, and the
+// resources must be in sync in two consecutive checks. It implements manager.Runnable.
+func (r *Runner) Start(ctx context.Context) error {
+	defer r.stop()
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	synced := 0
+	var unsynced []string
+	for {
+		select {
+		case <-ctx.Done():
+			r.finish(unsynced, false)
+			return nil
+		case <-ticker.C:
+		}
+
+		var err error
+		unsynced, err = r.check(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				r.logger.Error(err, "Failed to check whether resources are in sync")
+			}
+			synced = 0
+			continue
+		}
+		if len(unsynced) > 0 {
+			r.logger.V(1).Info("Waiting for resources to be in sync", "unsynced", len(unsynced))
+			synced = 0
+			continue
+		}
+		if synced++; synced == 2 {
+			r.finish(nil, true)
+			return nil
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. A one-shot run stops the manager
+// on its own, so it must run whether or not the operator became leader.
+func (r *Runner) NeedLeaderElection() bool {
+	return false
+}
+
+// Result returns the resources that were not in sync when the run ended, as <kind> <namespace>/<name>
+// with the reason, and whether the run completed before the timeout. A run that was interrupted,
+// e.g. because the manager failed to start, reports false.
+func (r *Runner) Result() ([]string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.unsynced, r.done
+}
+
+func (r *Runner) finish(unsynced []string, done bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.unsynced = unsynced
+	r.done = done
+}
+
+// check returns the resources that are not in sync, sorted
+func (r *Runner) check(ctx context.Context) ([]string, error) {
+	var unsynced []string
+
+	if r.MCPServers {
+		mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+		if err := r.client.List(ctx, mcpServers); err != nil {
+			return nil, fmt.Errorf("failed to list MCPServers: %w", err)
+		}
+		for i := range mcpServers.Items {
+			mcpServer := &mcpServers.Items[i]
+			if r.Owns != nil && !r.Owns(mcpServer) {
+				continue
+			}
+			if reason := MCPServerUnsynced(mcpServer); reason != "" {
+				unsynced = append(unsynced, fmt.Sprintf("MCPServer %s/%s: %s", mcpServer.Namespace, mcpServer.Name, reason))
+			}
+		}
+	}
+
+	if r.Stacks {
+		stacks := &mcpgatewayv1alpha1.AgentCoreStackList{}
+		if err := r.client.List(ctx, stacks); err != nil {
+			return nil, fmt.Errorf("failed to list AgentCoreStacks: %w", err)
+		}
+		for i := range stacks.Items {
+			stack := &stacks.Items[i]
+			reason := unsyncedReason(stack, stack.Status.ObservedGeneration, stack.Status.Conditions)
+			if reason != "" {
+				unsynced = append(unsynced, fmt.Sprintf("AgentCoreStack %s/%s: %s", stack.Namespace, stack.Name, reason))
+			}
+		}
+	}
+
+	sort.Strings(unsynced)
+	return unsynced, nil
+}
+
+// MCPServerUnsynced returns why the MCPServer is not in sync with its gateway target, or an empty
+// string if it is
+func MCPServerUnsynced(mcpServer *mcpgatewayv1alpha1.MCPServer) string {
+	if reason := unsyncedReason(mcpServer, mcpServer.Status.ObservedGeneration, mcpServer.Status.Conditions); reason != "" {
+		return reason
+	}
+	if mcpServer.Status.TargetStatus != "READY" {
+		return fmt.Sprintf("gateway target is %q", mcpServer.Status.TargetStatus)
+	}
+	return ""
+}
+
+// unsyncedReason returns why a resource with the given observed generation and conditions is not
+// in sync, or an empty string if it is
+func unsyncedReason(obj client.Object, observedGeneration int64, conditions []metav1.Condition) string {
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return "being deleted"
+	}
+	if observedGeneration != obj.GetGeneration() {
+		return fmt.Sprintf("generation %d not reconciled yet", obj.GetGeneration())
+	}
+	ready := meta.FindStatusCondition(conditions, "Ready")
+	if ready == nil {
+		return "no Ready condition"
+	}
+	if ready.Status != metav1.ConditionTrue {
+		return fmt.Sprintf("not ready: %s: %s", ready.Reason, ready.Message)
+	}
+	return ""
+}
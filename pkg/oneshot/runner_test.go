@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oneshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func newMCPServer(name string, ready metav1.ConditionStatus, targetStatus string) *mcpgatewayv1alpha1.MCPServer {
+	return &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: 2},
+		Status: mcpgatewayv1alpha1.MCPServerStatus{
+			ObservedGeneration: 2,
+			TargetStatus:       targetStatus,
+			Conditions: []metav1.Condition{{
+				Type: "Ready", Status: ready, Reason: "Testing", Message: "testing",
+			}},
+		},
+	}
+}
+
+func newTestRunner(t *testing.T, timeout time.Duration, objects ...client.Object) (*Runner, context.Context) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	runner := NewRunner(fakeClient, timeout, cancel, logr.Discard())
+	runner.MCPServers = true
+	runner.pollInterval = 10 * time.Millisecond
+	return runner, ctx
+}
+
+func TestMCPServerUnsynced(t *testing.T) {
+	assert.Empty(t, MCPServerUnsynced(newMCPServer("weather", metav1.ConditionTrue, "READY")))
+
+	assert.Contains(t, MCPServerUnsynced(newMCPServer("weather", metav1.ConditionFalse, "FAILED")), "not ready")
+	assert.Contains(t, MCPServerUnsynced(newMCPServer("weather", metav1.ConditionTrue, "UPDATING")), "UPDATING")
+
+	stale := newMCPServer("weather", metav1.ConditionTrue, "READY")
+	stale.Generation = 3
+	assert.Contains(t, MCPServerUnsynced(stale), "generation 3")
+
+	unobserved := newMCPServer("weather", metav1.ConditionTrue, "READY")
+	unobserved.Status.Conditions = nil
+	assert.Equal(t, "no Ready condition", MCPServerUnsynced(unobserved))
+}
+
+func TestStartStopsOnceSynced(t *testing.T) {
+	runner, ctx := newTestRunner(t, time.Minute,
+		newMCPServer("weather", metav1.ConditionTrue, "READY"),
+		newMCPServer("news", metav1.ConditionTrue, "READY"))
+
+	require.NoError(t, runner.Start(ctx))
+	assert.Error(t, ctx.Err(), "the manager is stopped")
+	unsynced, done := runner.Result()
+	assert.True(t, done)
+	assert.Empty(t, unsynced)
+}
+
+func TestStartReportsUnsyncedAfterTimeout(t *testing.T) {
+	runner, ctx := newTestRunner(t, 100*time.Millisecond,
+		newMCPServer("weather", metav1.ConditionTrue, "READY"),
+		newMCPServer("news", metav1.ConditionFalse, "FAILED"))
+
+	require.NoError(t, runner.Start(ctx))
+	assert.Error(t, ctx.Err(), "the manager is stopped")
+	unsynced, done := runner.Result()
+	assert.False(t, done)
+	require.Len(t, unsynced, 1)
+	assert.Contains(t, unsynced[0], "MCPServer default/news")
+}
+
+func TestStartSkipsResourcesOfOtherOperators(t *testing.T) {
+	runner, ctx := newTestRunner(t, time.Minute,
+		newMCPServer("weather", metav1.ConditionTrue, "READY"),
+		newMCPServer("news", metav1.ConditionFalse, "FAILED"))
+	runner.Owns = func(obj client.Object) bool { return obj.GetName() != "news" }
+
+	require.NoError(t, runner.Start(ctx))
+	_, done := runner.Result()
+	assert.True(t, done)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// targetsDeletedTotal counts the gateway targets deleted after their preview namespace was deleted
+var targetsDeletedTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: "mcpgateway_preview_targets_deleted_total",
+		Help: "Gateway targets of preview MCPServers deleted by the cleaner after their namespace was deleted",
+	},
+)
+
+func init() {
+	metrics.Registry.MustRegister(targetsDeletedTotal)
+}
+
+// TargetDeleter deletes gateway targets, treating targets that are already gone as deleted
+type TargetDeleter interface {
+	DeleteGatewayTarget(ctx context.Context, gatewayID, targetID string) error
+}
+
+// ContextFunc returns the context to delete the gateway target of an entry in, e.g. with the AWS
+// client of the AWSProviderConfig the target is managed with
+type ContextFunc func(ctx context.Context, entry Entry) (context.Context, error)
+
+// Option configures a Cleaner
+type Option func(*Cleaner)
+
+// WithTargetContext makes the Cleaner delete every target in the context returned by fn. Without
+// it targets are deleted in the context of the run.
+func WithTargetContext(fn ContextFunc) Option {
+	return func(c *Cleaner) {
+		c.targetContext = fn
+	}
+}
+
+// Cleaner periodically deletes the recorded gateway targets of preview namespaces that no longer
+// exist. The operator deletes the targets of MCPServers deleted with their namespace itself; the
+// cleaner catches those left behind when CI force-deletes a namespace by dropping finalizers.
+type Cleaner struct {
+	reader   client.Reader
+	registry *Registry
+	deleter  TargetDeleter
+	interval time.Duration
+	logger   logr.Logger
+
+	targetContext ContextFunc
+}
+
+// NewCleaner creates a new Cleaner running every interval. Namespaces are read through reader,
+// which should bypass the informer cache.
+func NewCleaner(
+	reader client.Reader,
+	registry *Registry,
+	deleter TargetDeleter,
+	interval time.Duration,
+	logger logr.Logger,
+	opts ...Option,
+) *Cleaner {
+	c := &Cleaner{
+		reader:   reader,
+		registry: registry,
+		deleter:  deleter,
+		interval: interval,
+		logger:   logger,
+		targetContext: func(ctx context.Context, _ Entry) (context.Context, error) {
+			return ctx, nil
+		},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Start runs the cleaner loop until ctx is cancelled. It implements manager.Runnable.
+func (c *Cleaner) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := c.Run(ctx); err != nil && ctx.Err() == nil {
+			c.logger.Error(err, "Preview target cleanup failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable so only the leader deletes targets
+func (c *Cleaner) NeedLeaderElection() bool {
+	return true
+}
+
+// Run deletes the recorded gateway targets whose namespace no longer exists and returns how many
+// were deleted. Targets that cannot be deleted are retried on the next run.
+func (c *Cleaner) Run(ctx context.Context) (int, error) {
+	entries, err := c.registry.Entries(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	var errs []error
+	for _, entry := range entries {
+		err := c.reader.Get(ctx, types.NamespacedName{Name: entry.Namespace}, &corev1.Namespace{})
+		if err == nil {
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+			continue
+		}
+
+		c.logger.Info("Deleting gateway target of deleted preview namespace", "namespace", entry.Namespace,
+			"mcpServer", entry.Name, "preview", entry.Preview, "gatewayId", entry.GatewayID, "targetId", entry.TargetID,
+			"providerConfig", entry.ProviderConfig)
+		targetCtx, err := c.targetContext(ctx, entry)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("gateway target %s: %w", entry.TargetID, err))
+			continue
+		}
+		if err := c.deleter.DeleteGatewayTarget(targetCtx, entry.GatewayID, entry.TargetID); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := c.registry.Forget(ctx, entry.GatewayID, entry.TargetID); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		targetsDeletedTotal.Inc()
+		deleted++
+	}
+	return deleted, errors.Join(errs...)
+}
@@ -0,0 +1,21 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package preview supports the MCPServers of per-pull-request preview environments: the label
+// marking them, the template naming their gateway targets, and a registry and cleaner that remove
+// the gateway targets of preview namespaces deleted without the operator deleting their targets,
+// so that CI churn cannot exhaust the target quota of a gateway.
+package preview
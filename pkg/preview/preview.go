@@ -0,0 +1,214 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// Label marks an MCPServer as part of the preview environment of a pull request, with the
+	// pull request number as value, e.g. mcpgateway.bedrock.aws/preview=42
+	Label = "mcpgateway.bedrock.aws/preview"
+
+	// DefaultTargetNameTemplate names the gateway targets of preview MCPServers without
+	// spec.targetName, so that the same MCPServer deployed for several pull requests, or by
+	// several spoke clusters, does not collide on the gateway
+	DefaultTargetNameTemplate = "{{ with .Cluster }}{{ . }}-{{ end }}pr{{ .Preview }}-{{ .Name }}"
+)
+
+// TargetNameData are the values available to the target name template
+type TargetNameData struct {
+	// Name and Namespace are those of the MCPServer
+	Name      string
+	Namespace string
+	// Preview is the value of the preview label, usually the pull request number
+	Preview string
+	// Cluster is the name of the spoke cluster of the MCPServer, empty in the hub cluster
+	Cluster string
+}
+
+// ParseTargetNameTemplate parses a target name template, failing on unknown fields
+func ParseTargetNameTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("targetName").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid preview target name template: %w", err)
+	}
+	if _, err := TargetName(tmpl, TargetNameData{Name: "server", Namespace: "default", Preview: "1"}); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// TargetName renders the gateway target name of a preview MCPServer
+func TargetName(tmpl *template.Template, data TargetNameData) (string, error) {
+	var name strings.Builder
+	if err := tmpl.Execute(&name, data); err != nil {
+		return "", fmt.Errorf("invalid preview target name template: %w", err)
+	}
+	if name.Len() == 0 {
+		return "", fmt.Errorf("preview target name template renders an empty name")
+	}
+	return name.String(), nil
+}
+
+// Entry records the gateway target of a preview MCPServer, and the AWSProviderConfig it is managed
+// with unless it uses the operator's own AWS client
+type Entry struct {
+	Namespace      string    `json:"namespace"`
+	Name           string    `json:"name"`
+	Preview        string    `json:"preview"`
+	GatewayID      string    `json:"gatewayId"`
+	TargetID       string    `json:"targetId"`
+	RecordedAt     time.Time `json:"recordedAt"`
+	ProviderConfig string    `json:"providerConfig,omitempty"`
+}
+
+// Key returns the ConfigMap data key of the entry
+func (e *Entry) Key() string {
+	return e.GatewayID + "." + e.TargetID
+}
+
+// Registry stores the gateway targets of preview MCPServers in a single ConfigMap. Unlike the
+// MCPServers it outlives their namespace, so targets left behind when a preview namespace is
+// force-deleted can still be found.
+type Registry struct {
+	client    client.Client
+	reader    client.Reader
+	namespace string
+	name      string
+}
+
+// NewRegistry creates a new Registry backed by the ConfigMap namespace/name.
+// Reads go through reader, which should bypass the informer cache.
+func NewRegistry(c client.Client, reader client.Reader, namespace, name string) *Registry {
+	return &Registry{
+		client:    c,
+		reader:    reader,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+// Record adds the gateway target of a preview MCPServer
+func (r *Registry) Record(ctx context.Context, entry *Entry) error {
+	if entry.RecordedAt.IsZero() {
+		entry.RecordedAt = time.Now().UTC()
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode preview target: %w", err)
+	}
+
+	return r.mutate(ctx, func(cm *corev1.ConfigMap) {
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[entry.Key()] = string(data)
+	})
+}
+
+// Forget removes the gateway target once it was deleted
+func (r *Registry) Forget(ctx context.Context, gatewayID, targetID string) error {
+	entry := Entry{GatewayID: gatewayID, TargetID: targetID}
+	cm, err := r.get(ctx)
+	if err != nil || cm == nil {
+		return err
+	}
+	if _, ok := cm.Data[entry.Key()]; !ok {
+		return nil
+	}
+	return r.mutate(ctx, func(cm *corev1.ConfigMap) {
+		delete(cm.Data, entry.Key())
+	})
+}
+
+// Entries returns all recorded gateway targets, oldest first
+func (r *Registry) Entries(ctx context.Context) ([]Entry, error) {
+	cm, err := r.get(ctx)
+	if err != nil || cm == nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(cm.Data))
+	for key, raw := range cm.Data {
+		entry := Entry{}
+		if err := json.Unmarshal([]byte(raw), &entry); err != nil {
+			return nil, fmt.Errorf("failed to decode preview target %s: %w", key, err)
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, k int) bool {
+		return entries[i].RecordedAt.Before(entries[k].RecordedAt)
+	})
+	return entries, nil
+}
+
+// get returns the registry ConfigMap, or nil if it has not been created yet
+func (r *Registry) get(ctx context.Context) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	err := r.reader.Get(ctx, types.NamespacedName{Namespace: r.namespace, Name: r.name}, cm)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read preview target registry: %w", err)
+	}
+	return cm, nil
+}
+
+// mutate applies fn to the registry ConfigMap, creating it if needed and retrying on conflicts
+func (r *Registry) mutate(ctx context.Context, fn func(*corev1.ConfigMap)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := r.get(ctx)
+		if err != nil {
+			return err
+		}
+
+		if cm == nil {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: r.namespace,
+					Name:      r.name,
+				},
+			}
+			fn(cm)
+			err = r.client.Create(ctx, cm)
+			if apierrors.IsAlreadyExists(err) {
+				// Lost a race with another writer, retry against the existing object
+				return apierrors.NewConflict(corev1.Resource("configmaps"), r.name, err)
+			}
+			return err
+		}
+
+		fn(cm)
+		return r.client.Update(ctx, cm)
+	})
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preview
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// providerConfigKey is the context key the tests put the AWSProviderConfig of a target under
+type providerConfigKey struct{}
+
+type fakeDeleter struct {
+	deleted []string
+	err     error
+}
+
+func (d *fakeDeleter) DeleteGatewayTarget(ctx context.Context, gatewayID, targetID string) error {
+	if d.err != nil {
+		return d.err
+	}
+	deleted := gatewayID + "/" + targetID
+	if providerConfig, ok := ctx.Value(providerConfigKey{}).(string); ok {
+		deleted = providerConfig + ":" + deleted
+	}
+	d.deleted = append(d.deleted, deleted)
+	return nil
+}
+
+func newTestClient(t *testing.T, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func TestTargetName(t *testing.T) {
+	tmpl, err := ParseTargetNameTemplate(DefaultTargetNameTemplate)
+	require.NoError(t, err)
+
+	name, err := TargetName(tmpl, TargetNameData{Name: "weather", Namespace: "pr-42", Preview: "42"})
+	require.NoError(t, err)
+	assert.Equal(t, "pr42-weather", name)
+
+	name, err = TargetName(tmpl, TargetNameData{Name: "weather", Namespace: "pr-42", Preview: "42", Cluster: "team-a"})
+	require.NoError(t, err)
+	assert.Equal(t, "team-a-pr42-weather", name)
+
+	_, err = ParseTargetNameTemplate("{{ .Branch }}-{{ .Name }}")
+	assert.ErrorContains(t, err, "invalid preview target name template")
+
+	_, err = ParseTargetNameTemplate("{{ if false }}x{{ end }}")
+	assert.ErrorContains(t, err, "empty name")
+}
+
+func TestRegistry(t *testing.T) {
+	c := newTestClient(t)
+	registry := NewRegistry(c, c, "operator-system", "preview-targets")
+	ctx := context.Background()
+
+	entries, err := registry.Entries(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	require.NoError(t, registry.Record(ctx, &Entry{
+		Namespace: "pr-42", Name: "weather", Preview: "42", GatewayID: "gw-1", TargetID: "target-1",
+	}))
+	entries, err = registry.Entries(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "pr-42", entries[0].Namespace)
+	assert.False(t, entries[0].RecordedAt.IsZero())
+
+	require.NoError(t, registry.Forget(ctx, "gw-1", "target-1"))
+	require.NoError(t, registry.Forget(ctx, "gw-1", "target-unknown"))
+	entries, err = registry.Entries(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestCleanerDeletesTargetsOfDeletedNamespaces(t *testing.T) {
+	c := newTestClient(t, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "pr-43"}})
+	registry := NewRegistry(c, c, "operator-system", "preview-targets")
+	ctx := context.Background()
+
+	for _, entry := range []*Entry{
+		{Namespace: "pr-42", Name: "weather", Preview: "42", GatewayID: "gw-1", TargetID: "target-1"},
+		{Namespace: "pr-43", Name: "weather", Preview: "43", GatewayID: "gw-1", TargetID: "target-2"},
+	} {
+		require.NoError(t, registry.Record(ctx, entry))
+	}
+
+	deleter := &fakeDeleter{}
+	cleaner := NewCleaner(c, registry, deleter, 0, logr.Discard())
+
+	deleted, err := cleaner.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	assert.Equal(t, []string{"gw-1/target-1"}, deleter.deleted)
+
+	entries, err := registry.Entries(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "target-2", entries[0].TargetID)
+}
+
+func TestCleanerKeepsTargetsItFailsToDelete(t *testing.T) {
+	c := newTestClient(t)
+	registry := NewRegistry(c, c, "operator-system", "preview-targets")
+	ctx := context.Background()
+	require.NoError(t, registry.Record(ctx, &Entry{
+		Namespace: "pr-42", Name: "weather", Preview: "42", GatewayID: "gw-1", TargetID: "target-1",
+	}))
+
+	cleaner := NewCleaner(c, registry, &fakeDeleter{err: errors.New("throttled")}, 0, logr.Discard())
+	deleted, err := cleaner.Run(ctx)
+	assert.ErrorContains(t, err, "throttled")
+	assert.Zero(t, deleted)
+
+	entries, err := registry.Entries(ctx)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestCleanerDeletesTargetsInTheirContext(t *testing.T) {
+	c := newTestClient(t)
+	registry := NewRegistry(c, c, "operator-system", "preview-targets")
+	ctx := context.Background()
+	for _, entry := range []*Entry{
+		{Namespace: "pr-42", Name: "weather", Preview: "42", GatewayID: "gw-1", TargetID: "target-1"},
+		{Namespace: "pr-42", Name: "search", Preview: "42", GatewayID: "gw-2", TargetID: "target-2", ProviderConfig: "eu"},
+		{Namespace: "pr-42", Name: "maps", Preview: "42", GatewayID: "gw-2", TargetID: "target-3", ProviderConfig: "gone"},
+	} {
+		require.NoError(t, registry.Record(ctx, entry))
+	}
+
+	deleter := &fakeDeleter{}
+	cleaner := NewCleaner(c, registry, deleter, 0, logr.Discard(),
+		WithTargetContext(func(ctx context.Context, entry Entry) (context.Context, error) {
+			switch entry.ProviderConfig {
+			case "":
+				return ctx, nil
+			case "gone":
+				return ctx, errors.New("AWSProviderConfig gone not found")
+			}
+			return context.WithValue(ctx, providerConfigKey{}, entry.ProviderConfig), nil
+		}))
+
+	deleted, err := cleaner.Run(ctx)
+	assert.ErrorContains(t, err, "AWSProviderConfig gone not found")
+	assert.Equal(t, 2, deleted)
+	assert.ElementsMatch(t, []string{"gw-1/target-1", "eu:gw-2/target-2"}, deleter.deleted)
+
+	// The target that could not be deleted is kept for the next run
+	entries, err := registry.Entries(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "target-3", entries[0].TargetID)
+}
@@ -0,0 +1,3 @@
+// Package probe provides helpers for inspecting MCP server endpoints directly,
+// independently of anything sent to AWS.
+package probe
@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+)
+
+// defaultTimeout bounds a single probe when the prober has no timeout configured
+const defaultTimeout = 10 * time.Second
+
+// Prober connects to MCP server endpoints to inspect their TLS configuration
+type Prober struct {
+	timeout time.Duration
+}
+
+// NewProber creates a new Prober with the given per-probe timeout
+func NewProber(timeout time.Duration) *Prober {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &Prober{
+		timeout: timeout,
+	}
+}
+
+// TLSOptions configures certificate verification for a probe
+type TLSOptions struct {
+	// RootCAs are the trusted CA certificates; nil trusts the system roots
+	RootCAs *x509.CertPool
+	// InsecureSkipVerify disables certificate verification
+	InsecureSkipVerify bool
+}
+
+// NewCertPool returns the system roots extended with the PEM-encoded CA certificates
+func NewCertPool(caPEM []byte) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("no PEM-encoded certificates found")
+	}
+	return pool, nil
+}
+
+// CertificateExpiry performs a TLS handshake with the endpoint and returns the
+// NotAfter time of the leaf certificate presented by the server
+func (p *Prober) CertificateExpiry(ctx context.Context, endpoint string, opts TLSOptions) (time.Time, error) {
+	address, serverName, err := dialAddress(endpoint)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	dialer := &tls.Dialer{
+		Config: &tls.Config{
+			ServerName:         serverName,
+			MinVersion:         tls.VersionTLS12,
+			RootCAs:            opts.RootCAs,
+			InsecureSkipVerify: opts.InsecureSkipVerify, //nolint:gosec // opt-in per MCPServer for development
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("TLS handshake with %s failed: %w", address, err)
+	}
+	defer conn.Close() //nolint:errcheck
+
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return time.Time{}, fmt.Errorf("no certificate presented by %s", address)
+	}
+
+	return certs[0].NotAfter, nil
+}
+
+// dialAddress returns the host:port to dial and the TLS server name for an HTTPS endpoint
+func dialAddress(endpoint string) (string, string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	if u.Scheme != "https" {
+		return "", "", fmt.Errorf("endpoint %q is not an HTTPS URL", endpoint)
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return "", "", fmt.Errorf("endpoint %q has no host", endpoint)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	return net.JoinHostPort(host, port), host, nil
+}
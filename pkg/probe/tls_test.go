@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialAddress(t *testing.T) {
+	tests := []struct {
+		name           string
+		endpoint       string
+		wantAddress    string
+		wantServerName string
+		wantErr        bool
+	}{
+		{
+			name:           "default port",
+			endpoint:       "https://mcp.example.com/mcp",
+			wantAddress:    "mcp.example.com:443",
+			wantServerName: "mcp.example.com",
+		},
+		{
+			name:           "explicit port",
+			endpoint:       "https://mcp.example.com:8443/mcp",
+			wantAddress:    "mcp.example.com:8443",
+			wantServerName: "mcp.example.com",
+		},
+		{
+			name:     "http endpoint",
+			endpoint: "http://mcp.example.com/mcp",
+			wantErr:  true,
+		},
+		{
+			name:     "missing host",
+			endpoint: "https:///mcp",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, serverName, err := dialAddress(tt.endpoint)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAddress, address)
+			assert.Equal(t, tt.wantServerName, serverName)
+		})
+	}
+}
+
+func TestCertificateExpiry(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	cert := server.Certificate()
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	pool, err := NewCertPool(caPEM)
+	require.NoError(t, err)
+
+	prober := NewProber(5 * time.Second)
+
+	tests := []struct {
+		name    string
+		opts    TLSOptions
+		wantErr bool
+	}{
+		{
+			name:    "untrusted private CA",
+			opts:    TLSOptions{},
+			wantErr: true,
+		},
+		{
+			name: "pinned CA",
+			opts: TLSOptions{RootCAs: pool},
+		},
+		{
+			name: "verification skipped",
+			opts: TLSOptions{InsecureSkipVerify: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expiry, err := prober.CertificateExpiry(context.Background(), server.URL, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, cert.NotAfter, expiry)
+		})
+	}
+}
+
+func TestNewCertPoolRejectsInvalidPEM(t *testing.T) {
+	_, err := NewCertPool([]byte("not a certificate"))
+	assert.Error(t, err)
+}
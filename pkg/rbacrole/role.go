@@ -0,0 +1,186 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rbacrole generates the ClusterRole the operator needs for the controllers and features
+// it runs with, so that installs which leave a feature disabled do not grant its permissions,
+// e.g. no Secret access without Secret references or AgentCoreStacks.
+package rbacrole
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultRoleName is the name of generated ClusterRoles, as in config/rbac/role.yaml
+const DefaultRoleName = "manager-role"
+
+// Features are the parts of the operator that access the Kubernetes API. They mirror the
+// operator's flags.
+type Features struct {
+	// MCPServers is set if the mcpserver controller runs
+	MCPServers bool
+	// AgentCoreStacks is set if the agentcorestack controller runs
+	AgentCoreStacks bool
+	// TargetReadiness is set if the targetreadiness controller runs
+	TargetReadiness bool
+	// MCPServerGroups is set if the mcpservergroup controller runs
+	MCPServerGroups bool
+	// SecretReferences is set if the SecretReferences feature gate is enabled
+	SecretReferences bool
+	// GatewayAPI is set if the GatewayAPI feature gate is enabled
+	GatewayAPI bool
+	// Namespaces is set if --environments-configmap or --preview-cleanup-interval is set
+	Namespaces bool
+	// Autoscaling is set if --keda-prometheus-address is set
+	Autoscaling bool
+	// SpokeClusters is set if --spoke-cluster-namespace is set
+	SpokeClusters bool
+}
+
+var (
+	readVerbs   = []string{"get", "list", "watch"}
+	manageVerbs = []string{"create", "delete", "get", "list", "update", "watch"}
+)
+
+// Generate returns the ClusterRole the enabled features need. Rules of the same API group and
+// verbs are merged and sorted, as controller-gen does. It fails if no controller is enabled.
+func Generate(name string, f Features) (*rbacv1.ClusterRole, error) {
+	if !f.MCPServers && !f.AgentCoreStacks && !f.TargetReadiness && !f.MCPServerGroups {
+		return nil, fmt.Errorf("no controller is enabled")
+	}
+
+	rules := ruleSet{}
+	// Storage versions of the CRDs are checked on startup
+	rules.grant("apiextensions.k8s.io", []string{"customresourcedefinitions"}, "get")
+	rules.grant("apiextensions.k8s.io", []string{"customresourcedefinitions/status"}, "update")
+	// Every controller reads MCPServers
+	rules.grant("mcpgateway.bedrock.aws", []string{"mcpservers"}, readVerbs...)
+
+	if f.MCPServers {
+		// The canary creates and deletes its own MCPServer
+		rules.grant("mcpgateway.bedrock.aws", []string{"mcpservers"}, "create", "delete", "patch", "update")
+		rules.grant("mcpgateway.bedrock.aws", []string{"mcpservers/status"}, "get", "patch", "update")
+		rules.grant("mcpgateway.bedrock.aws", []string{"mcpservers/finalizers"}, "update")
+		// Storage migrations rewrite every AWSProviderConfig
+		rules.grant("mcpgateway.bedrock.aws", []string{"awsproviderconfigs"}, "get", "list", "update", "watch")
+		rules.grant("", []string{"configmaps"}, manageVerbs...)
+		rules.grant("", []string{"services"}, readVerbs...)
+		rules.grant("apps", []string{"deployments", "statefulsets"}, readVerbs...)
+		rules.grant("events.k8s.io", []string{"events"}, "create", "patch")
+		if f.SecretReferences {
+			rules.grant("", []string{"secrets"}, readVerbs...)
+		}
+		if f.GatewayAPI {
+			rules.grant("gateway.networking.k8s.io", []string{"gateways", "httproutes"}, readVerbs...)
+		}
+		if f.Namespaces {
+			rules.grant("", []string{"namespaces"}, readVerbs...)
+		}
+		if f.Autoscaling {
+			rules.grant("keda.sh", []string{"scaledobjects"}, manageVerbs...)
+		}
+		if f.SpokeClusters {
+			rules.grant("", []string{"secrets"}, "list")
+		}
+	}
+
+	if f.AgentCoreStacks {
+		rules.grant("mcpgateway.bedrock.aws", []string{"agentcorestacks"}, "get", "list", "patch", "update", "watch")
+		rules.grant("mcpgateway.bedrock.aws", []string{"agentcorestacks/status"}, "get", "patch", "update")
+		rules.grant("mcpgateway.bedrock.aws", []string{"agentcorestacks/finalizers"}, "update")
+		// Stacks create, update and delete the MCPServers of their targets
+		rules.grant("mcpgateway.bedrock.aws", []string{"mcpservers"}, "create", "delete", "patch", "update")
+		// Client secrets of credential providers
+		rules.grant("", []string{"secrets"}, readVerbs...)
+	}
+
+	if f.TargetReadiness {
+		rules.grant("", []string{"pods"}, readVerbs...)
+		rules.grant("", []string{"pods/status"}, "patch")
+	}
+
+	if f.MCPServerGroups {
+		// Storage migrations rewrite every group
+		rules.grant("mcpgateway.bedrock.aws", []string{"mcpservergroups"}, "get", "list", "update", "watch")
+		rules.grant("mcpgateway.bedrock.aws", []string{"mcpservergroups/status"}, "get", "patch", "update")
+	}
+
+	return &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Rules:      rules.policyRules(),
+	}, nil
+}
+
+// Marshal returns the YAML of the ClusterRole
+func Marshal(role *rbacv1.ClusterRole) ([]byte, error) {
+	return yaml.Marshal(role)
+}
+
+// groupResource identifies a resource of an API group
+type groupResource struct {
+	group    string
+	resource string
+}
+
+// ruleSet collects the verbs granted on each resource
+type ruleSet map[groupResource][]string
+
+// grant adds the verbs on the resources of the API group
+func (s ruleSet) grant(group string, resources []string, verbs ...string) {
+	for _, resource := range resources {
+		key := groupResource{group: group, resource: resource}
+		s[key] = append(s[key], verbs...)
+	}
+}
+
+// policyRules returns one rule per API group and set of verbs, sorted by group and resource
+func (s ruleSet) policyRules() []rbacv1.PolicyRule {
+	byVerbs := map[string]*rbacv1.PolicyRule{}
+	var rules []*rbacv1.PolicyRule
+	for key, verbs := range s {
+		verbs = slices.Compact(slices.Sorted(slices.Values(verbs)))
+		id := key.group + "|" + strings.Join(verbs, ",")
+		rule, ok := byVerbs[id]
+		if !ok {
+			rule = &rbacv1.PolicyRule{APIGroups: []string{key.group}, Verbs: verbs}
+			byVerbs[id] = rule
+			rules = append(rules, rule)
+		}
+		rule.Resources = append(rule.Resources, key.resource)
+	}
+
+	for _, rule := range rules {
+		slices.Sort(rule.Resources)
+	}
+	slices.SortFunc(rules, func(a, b *rbacv1.PolicyRule) int {
+		if c := strings.Compare(a.APIGroups[0], b.APIGroups[0]); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Resources[0], b.Resources[0])
+	})
+
+	policyRules := make([]rbacv1.PolicyRule, 0, len(rules))
+	for _, rule := range rules {
+		policyRules = append(policyRules, *rule)
+	}
+	return policyRules
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbacrole
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// verbs returns the verbs granted on each resource of the role, keyed by group/resource
+func verbs(role *rbacv1.ClusterRole) map[string][]string {
+	result := map[string][]string{}
+	for _, rule := range role.Rules {
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				result[group+"/"+resource] = rule.Verbs
+			}
+		}
+	}
+	return result
+}
+
+func TestGenerate_MCPServers(t *testing.T) {
+	role, err := Generate(DefaultRoleName, Features{MCPServers: true, SecretReferences: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, "ClusterRole", role.Kind)
+	assert.Equal(t, DefaultRoleName, role.Name)
+	granted := verbs(role)
+	assert.Equal(t, []string{"get", "list", "watch"}, granted["/secrets"])
+	assert.Equal(t, []string{"create", "delete", "get", "list", "update", "watch"}, granted["/configmaps"])
+	assert.Equal(t, []string{"update"}, granted["mcpgateway.bedrock.aws/mcpservers/finalizers"])
+	assert.Equal(t, []string{"get", "list", "update", "watch"}, granted["mcpgateway.bedrock.aws/awsproviderconfigs"])
+	assert.NotContains(t, granted, "mcpgateway.bedrock.aws/agentcorestacks")
+	assert.NotContains(t, granted, "/pods")
+	assert.NotContains(t, granted, "keda.sh/scaledobjects")
+	assert.NotContains(t, granted, "gateway.networking.k8s.io/httproutes")
+}
+
+func TestGenerate_WithoutSecretReferences(t *testing.T) {
+	role, err := Generate(DefaultRoleName, Features{MCPServers: true, TargetReadiness: true})
+	require.NoError(t, err)
+	assert.NotContains(t, verbs(role), "/secrets")
+
+	// AgentCoreStacks read the client secrets of their credential providers
+	role, err = Generate(DefaultRoleName, Features{MCPServers: true, AgentCoreStacks: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"get", "list", "watch"}, verbs(role)["/secrets"])
+
+	// Spoke kubeconfigs are only listed
+	role, err = Generate(DefaultRoleName, Features{MCPServers: true, SpokeClusters: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"list"}, verbs(role)["/secrets"])
+}
+
+func TestGenerate_OptionalFeatures(t *testing.T) {
+	role, err := Generate(DefaultRoleName, Features{
+		MCPServers: true, GatewayAPI: true, Namespaces: true, Autoscaling: true,
+	})
+	require.NoError(t, err)
+
+	granted := verbs(role)
+	assert.Equal(t, []string{"get", "list", "watch"}, granted["gateway.networking.k8s.io/gateways"])
+	assert.Equal(t, []string{"get", "list", "watch"}, granted["gateway.networking.k8s.io/httproutes"])
+	assert.Equal(t, []string{"get", "list", "watch"}, granted["/namespaces"])
+	assert.Equal(t, []string{"create", "delete", "get", "list", "update", "watch"}, granted["keda.sh/scaledobjects"])
+
+	// Optional features of the mcpserver controller grant nothing without it
+	role, err = Generate(DefaultRoleName, Features{TargetReadiness: true, GatewayAPI: true, Autoscaling: true})
+	require.NoError(t, err)
+	granted = verbs(role)
+	assert.NotContains(t, granted, "gateway.networking.k8s.io/httproutes")
+	assert.NotContains(t, granted, "keda.sh/scaledobjects")
+	assert.Equal(t, []string{"patch"}, granted["/pods/status"])
+}
+
+func TestGenerate_MCPServerGroups(t *testing.T) {
+	role, err := Generate(DefaultRoleName, Features{MCPServerGroups: true})
+	require.NoError(t, err)
+
+	granted := verbs(role)
+	assert.Equal(t, []string{"get", "list", "update", "watch"}, granted["mcpgateway.bedrock.aws/mcpservergroups"])
+	assert.Equal(t, []string{"get", "patch", "update"}, granted["mcpgateway.bedrock.aws/mcpservergroups/status"])
+	assert.Equal(t, []string{"get", "list", "watch"}, granted["mcpgateway.bedrock.aws/mcpservers"])
+	assert.NotContains(t, granted, "/secrets")
+	assert.NotContains(t, granted, "/configmaps")
+}
+
+func TestGenerate_MergesRules(t *testing.T) {
+	role, err := Generate(DefaultRoleName, Features{MCPServers: true, AgentCoreStacks: true, SecretReferences: true})
+	require.NoError(t, err)
+
+	var statusRule *rbacv1.PolicyRule
+	for i, rule := range role.Rules {
+		if rule.Resources[0] == "agentcorestacks/status" {
+			statusRule = &role.Rules[i]
+		}
+	}
+	require.NotNil(t, statusRule)
+	assert.Equal(t, []string{"agentcorestacks/status", "mcpservers/status"}, statusRule.Resources)
+	assert.Equal(t, "", role.Rules[0].APIGroups[0])
+}
+
+func TestGenerate_NoController(t *testing.T) {
+	_, err := Generate(DefaultRoleName, Features{SecretReferences: true})
+	assert.Error(t, err)
+}
+
+func TestMarshal(t *testing.T) {
+	role, err := Generate("mcp-gateway-operator-manager-role", Features{MCPServers: true})
+	require.NoError(t, err)
+
+	data, err := Marshal(role)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "kind: ClusterRole")
+	assert.Contains(t, string(data), "name: mcp-gateway-operator-manager-role")
+	assert.NotContains(t, string(data), "secrets")
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// Reasons an update is held back
+const (
+	// ReasonWaitingForWave reports that too many targets of the gateway are still settling
+	ReasonWaitingForWave = "WaitingForWave"
+	// ReasonPaused reports that the rollout stopped because updated targets failed
+	ReasonPaused = "RolloutPaused"
+)
+
+// reservationTTL bounds how long an admitted update counts as in progress before the cache shows it
+const reservationTTL = time.Minute
+
+// maxListedFailures is the number of failed MCPServers named in a pause message
+const maxListedFailures = 5
+
+// failedTargetStatuses are the gateway target statuses of failed creates and updates
+var failedTargetStatuses = map[string]bool{
+	"FAILED":              true,
+	"UPDATE_UNSUCCESSFUL": true,
+}
+
+// Policy configures the waves of a rollout, following the semantics of a Deployment rollout
+type Policy struct {
+	// MaxUnavailable is the number of targets of a gateway that may be updating or not yet
+	// available at the same time, i.e. the size of a wave
+	MaxUnavailable int
+
+	// MinReady is how long a target must have been READY before it counts as available
+	MinReady time.Duration
+
+	// MaxFailures is the number of failed targets of a gateway that pauses the rollout.
+	// Zero never pauses.
+	MaxFailures int
+}
+
+// Decision is the outcome of asking the gate to admit an update
+type Decision struct {
+	// Admitted is true if the update may go ahead
+	Admitted bool
+	// Reason is ReasonWaitingForWave or ReasonPaused if the update is held back
+	Reason string
+	// Message explains why the update is held back
+	Message string
+}
+
+// Gate admits updates of MCPServers while the number of unavailable targets of their gateway
+// stays within the policy. The gate only holds back updates of existing targets; creates and
+// deletes are never delayed.
+type Gate struct {
+	policy Policy
+
+	mu       sync.Mutex
+	reserved map[types.NamespacedName]reservation
+}
+
+// reservation records an admitted update the cache may not reflect yet
+type reservation struct {
+	gatewayID  string
+	generation int64
+	admittedAt time.Time
+}
+
+// NewGate creates a Gate with the given policy
+func NewGate(policy Policy) *Gate {
+	return &Gate{
+		policy:   policy,
+		reserved: make(map[types.NamespacedName]reservation),
+	}
+}
+
+// Policy returns the policy of the gate
+func (g *Gate) Policy() Policy {
+	return g.policy
+}
+
+// Admit decides whether the pending update of candidate may be applied. fleet are the other
+// MCPServers of the gateway gatewayID; the candidate itself is ignored if it is part of it, so a
+// failed update can always be retried. Admitted updates count as in progress until fleet shows
+// them applied.
+func (g *Gate) Admit(candidate *mcpgatewayv1alpha1.MCPServer, gatewayID string, fleet []mcpgatewayv1alpha1.MCPServer, now time.Time) Decision {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	candidateKey := types.NamespacedName{Namespace: candidate.Namespace, Name: candidate.Name}
+	observed := make(map[types.NamespacedName]int64, len(fleet))
+	var failed []string
+	unavailable := 0
+	for i := range fleet {
+		mcpServer := &fleet[i]
+		key := types.NamespacedName{Namespace: mcpServer.Namespace, Name: mcpServer.Name}
+		if key == candidateKey || mcpServer.Status.TargetID == "" || !mcpServer.DeletionTimestamp.IsZero() {
+			continue
+		}
+		observed[key] = mcpServer.Status.ObservedGeneration
+
+		switch {
+		case isFailed(mcpServer):
+			failed = append(failed, key.String())
+		case !isAvailable(mcpServer, g.policy.MinReady, now):
+			unavailable++
+		}
+	}
+
+	// Count admitted updates the cache does not show yet
+	for key, r := range g.reserved {
+		if now.Sub(r.admittedAt) > reservationTTL || observed[key] >= r.generation {
+			delete(g.reserved, key)
+			continue
+		}
+		if key != candidateKey && r.gatewayID == gatewayID {
+			unavailable++
+		}
+	}
+
+	if g.policy.MaxFailures > 0 && len(failed) >= g.policy.MaxFailures {
+		sort.Strings(failed)
+		listed := failed
+		if len(listed) > maxListedFailures {
+			listed = listed[:maxListedFailures]
+		}
+		return Decision{
+			Reason: ReasonPaused,
+			Message: fmt.Sprintf("Rollout on gateway %s is paused: %d updated targets failed (%s); "+
+				"fix or delete them to resume", gatewayID, len(failed), strings.Join(listed, ", ")),
+		}
+	}
+	if unavailable >= g.policy.MaxUnavailable {
+		return Decision{
+			Reason: ReasonWaitingForWave,
+			Message: fmt.Sprintf("Waiting for %d targets of gateway %s to become available, at most %d may be unavailable",
+				unavailable, gatewayID, g.policy.MaxUnavailable),
+		}
+	}
+
+	g.reserved[candidateKey] = reservation{gatewayID: gatewayID, generation: candidate.Generation, admittedAt: now}
+	return Decision{Admitted: true}
+}
+
+// isFailed reports whether the gateway target of the MCPServer failed to be created or updated
+func isFailed(mcpServer *mcpgatewayv1alpha1.MCPServer) bool {
+	if failedTargetStatuses[mcpServer.Status.TargetStatus] {
+		return true
+	}
+	ready := meta.FindStatusCondition(mcpServer.Status.Conditions, "Ready")
+	return ready != nil && ready.Status == metav1.ConditionFalse && ready.Reason == "UpdateError"
+}
+
+// isAvailable reports whether the gateway target of the MCPServer has been READY for at least
+// minReady. status.lastSynchronized records the last change of the observed target status.
+func isAvailable(mcpServer *mcpgatewayv1alpha1.MCPServer, minReady time.Duration, now time.Time) bool {
+	if mcpServer.Status.TargetStatus != "READY" {
+		return false
+	}
+	if mcpServer.Status.LastSynchronized == nil {
+		return true
+	}
+	return !now.Before(mcpServer.Status.LastSynchronized.Add(minReady))
+}
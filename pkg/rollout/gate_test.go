@@ -0,0 +1,120 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+var now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// newMCPServer returns an MCPServer with a pending update whose target has the given status and
+// last changed at syncedAt
+func newMCPServer(name, targetStatus string, syncedAt time.Time) mcpgatewayv1alpha1.MCPServer {
+	synced := metav1.NewTime(syncedAt)
+	return mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: 2},
+		Status: mcpgatewayv1alpha1.MCPServerStatus{
+			ObservedGeneration: 1,
+			TargetID:           name + "-target",
+			TargetStatus:       targetStatus,
+			LastSynchronized:   &synced,
+		},
+	}
+}
+
+func TestGateAdmitsWaves(t *testing.T) {
+	gate := NewGate(Policy{MaxUnavailable: 2, MinReady: time.Minute, MaxFailures: 1})
+	longAgo := now.Add(-time.Hour)
+	fleet := []mcpgatewayv1alpha1.MCPServer{
+		newMCPServer("a", "READY", longAgo),
+		newMCPServer("b", "READY", longAgo),
+		newMCPServer("c", "READY", longAgo),
+		newMCPServer("d", "READY", longAgo),
+	}
+
+	// The first wave is admitted and reserved until the cache shows the updates
+	assert.True(t, gate.Admit(&fleet[0], "gw", fleet, now).Admitted)
+	assert.True(t, gate.Admit(&fleet[1], "gw", fleet, now).Admitted)
+	decision := gate.Admit(&fleet[2], "gw", fleet, now)
+	assert.False(t, decision.Admitted)
+	assert.Equal(t, ReasonWaitingForWave, decision.Reason)
+	assert.True(t, gate.Admit(&fleet[0], "gw", fleet, now).Admitted, "admitted updates are not held back by themselves")
+
+	// The wave is applied and its targets are updating
+	for i := range 2 {
+		fleet[i].Status.ObservedGeneration = 2
+		fleet[i].Status.TargetStatus = "UPDATING"
+	}
+	assert.False(t, gate.Admit(&fleet[2], "gw", fleet, now).Admitted)
+
+	// Ready targets become available after MinReady
+	for i := range 2 {
+		fleet[i].Status.TargetStatus = "READY"
+		fleet[i].Status.LastSynchronized = &metav1.Time{Time: now}
+	}
+	assert.False(t, gate.Admit(&fleet[2], "gw", fleet, now.Add(30*time.Second)).Admitted)
+	assert.True(t, gate.Admit(&fleet[2], "gw", fleet, now.Add(time.Minute)).Admitted)
+
+	// Other gateways roll out independently
+	assert.True(t, gate.Admit(&fleet[3], "other", nil, now.Add(time.Minute)).Admitted)
+}
+
+func TestGatePausesOnFailures(t *testing.T) {
+	gate := NewGate(Policy{MaxUnavailable: 5, MaxFailures: 1})
+	fleet := []mcpgatewayv1alpha1.MCPServer{
+		newMCPServer("a", "UPDATE_UNSUCCESSFUL", now),
+		newMCPServer("b", "READY", now),
+	}
+
+	decision := gate.Admit(&fleet[1], "gw", fleet, now)
+	require.False(t, decision.Admitted)
+	assert.Equal(t, ReasonPaused, decision.Reason)
+	assert.Contains(t, decision.Message, "default/a")
+
+	assert.True(t, gate.Admit(&fleet[0], "gw", fleet, now).Admitted, "failed targets can retry their update")
+
+	// A failed update call pauses the rollout as well
+	fleet[0].Status.TargetStatus = "READY"
+	fleet[0].Status.Conditions = []metav1.Condition{{Type: "Ready", Status: metav1.ConditionFalse, Reason: "UpdateError"}}
+	assert.Equal(t, ReasonPaused, gate.Admit(&fleet[1], "gw", fleet, now).Reason)
+
+	// Resolving the failure resumes the rollout
+	fleet[0].Status.Conditions[0].Status = metav1.ConditionTrue
+	fleet[0].Status.Conditions[0].Reason = "GatewayTargetReady"
+	fleet[0].Status.ObservedGeneration = 2
+	assert.True(t, gate.Admit(&fleet[1], "gw", fleet, now).Admitted)
+}
+
+func TestGateIgnoresTargetsWithoutUpdates(t *testing.T) {
+	gate := NewGate(Policy{MaxUnavailable: 1})
+	deleted := newMCPServer("deleted", "CREATING", now)
+	deleted.DeletionTimestamp = &metav1.Time{Time: now}
+	uncreated := newMCPServer("uncreated", "", now)
+	uncreated.Status.TargetID = ""
+	candidate := newMCPServer("candidate", "READY", now)
+
+	fleet := []mcpgatewayv1alpha1.MCPServer{deleted, uncreated, candidate}
+	assert.True(t, gate.Admit(&candidate, "gw", fleet, now).Admitted)
+}
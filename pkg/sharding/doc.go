@@ -0,0 +1,3 @@
+// Package sharding assigns MCPServer resources to operator replicas so that
+// several active replicas can split the fleet between them.
+package sharding
@@ -0,0 +1,107 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ShardLabel pins a resource to a specific shard, overriding hash-based assignment
+const ShardLabel = "mcpgateway.bedrock.aws/shard"
+
+// Sharder decides which resources are reconciled by this replica
+type Sharder struct {
+	count int
+	index int
+}
+
+// NewSharder creates a new Sharder for the replica with the given index out of count replicas.
+// A count of 1 or less disables sharding and the replica owns every resource.
+func NewSharder(count, index int) (*Sharder, error) {
+	if count < 1 {
+		count = 1
+	}
+	if index < 0 || index >= count {
+		return nil, fmt.Errorf("shard index %d out of range for %d shards", index, count)
+	}
+	return &Sharder{
+		count: count,
+		index: index,
+	}, nil
+}
+
+// Enabled reports whether the fleet is split across more than one shard
+func (s *Sharder) Enabled() bool {
+	return s.count > 1
+}
+
+// Index returns the shard index of this replica
+func (s *Sharder) Index() int {
+	return s.index
+}
+
+// Primary reports whether this replica belongs to shard 0, which runs the tasks that must not
+// run once per shard, such as the canary or the backup export. It is true when sharding is disabled.
+func (s *Sharder) Primary() bool {
+	return s.index == 0
+}
+
+// Count returns the total number of shards
+func (s *Sharder) Count() int {
+	return s.count
+}
+
+// ShardFor returns the shard a resource is assigned to.
+// A valid ShardLabel takes precedence; otherwise the shard is the FNV-1a hash of
+// namespace/name modulo the shard count.
+func (s *Sharder) ShardFor(obj client.Object) int {
+	if value, ok := obj.GetLabels()[ShardLabel]; ok {
+		if shard, err := strconv.Atoi(value); err == nil && shard >= 0 && shard < s.count {
+			return shard
+		}
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(obj.GetNamespace() + "/" + obj.GetName()))
+	return int(h.Sum32() % uint32(s.count))
+}
+
+// Owns reports whether this replica is responsible for the resource
+func (s *Sharder) Owns(obj client.Object) bool {
+	if !s.Enabled() {
+		return true
+	}
+	return s.ShardFor(obj) == s.index
+}
+
+// IndexFromHostname derives the shard index from a StatefulSet pod hostname such as "operator-2"
+func IndexFromHostname(hostname string) (int, error) {
+	i := strings.LastIndex(hostname, "-")
+	if i < 0 || i == len(hostname)-1 {
+		return 0, fmt.Errorf("hostname %q has no ordinal suffix", hostname)
+	}
+	index, err := strconv.Atoi(hostname[i+1:])
+	if err != nil {
+		return 0, fmt.Errorf("hostname %q has no ordinal suffix: %w", hostname, err)
+	}
+	return index, nil
+}
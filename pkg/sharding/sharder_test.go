@@ -0,0 +1,108 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+func newMCPServer(name string, labels map[string]string) *mcpgatewayv1alpha1.MCPServer {
+	return &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    labels,
+		},
+	}
+}
+
+func TestNewSharder(t *testing.T) {
+	_, err := NewSharder(3, 3)
+	assert.Error(t, err)
+
+	_, err = NewSharder(3, -1)
+	assert.Error(t, err)
+
+	sharder, err := NewSharder(0, 0)
+	require.NoError(t, err)
+	assert.False(t, sharder.Enabled())
+	assert.Equal(t, 1, sharder.Count())
+}
+
+func TestOwns_EveryResourceHasExactlyOneOwner(t *testing.T) {
+	const shards = 3
+	sharders := make([]*Sharder, shards)
+	for i := range shards {
+		sharder, err := NewSharder(shards, i)
+		require.NoError(t, err)
+		sharders[i] = sharder
+	}
+
+	for i := range 100 {
+		obj := newMCPServer(fmt.Sprintf("server-%d", i), nil)
+		owners := 0
+		for _, sharder := range sharders {
+			if sharder.Owns(obj) {
+				owners++
+			}
+		}
+		assert.Equal(t, 1, owners, "resource %s must have exactly one owner", obj.Name)
+	}
+}
+
+func TestShardFor_LabelOverride(t *testing.T) {
+	sharder, err := NewSharder(4, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, sharder.ShardFor(newMCPServer("pinned", map[string]string{ShardLabel: "2"})))
+
+	// Out-of-range and malformed labels fall back to hashing
+	hashed := sharder.ShardFor(newMCPServer("pinned", nil))
+	assert.Equal(t, hashed, sharder.ShardFor(newMCPServer("pinned", map[string]string{ShardLabel: "9"})))
+	assert.Equal(t, hashed, sharder.ShardFor(newMCPServer("pinned", map[string]string{ShardLabel: "x"})))
+}
+
+func TestPrimary(t *testing.T) {
+	unsharded, err := NewSharder(1, 0)
+	require.NoError(t, err)
+	assert.True(t, unsharded.Primary())
+
+	for index, primary := range []bool{true, false, false} {
+		sharder, err := NewSharder(3, index)
+		require.NoError(t, err)
+		assert.Equal(t, primary, sharder.Primary(), "shard %d", index)
+	}
+}
+
+func TestIndexFromHostname(t *testing.T) {
+	index, err := IndexFromHostname("mcp-gateway-operator-2")
+	require.NoError(t, err)
+	assert.Equal(t, 2, index)
+
+	_, err = IndexFromHostname("mcp-gateway-operator-")
+	assert.Error(t, err)
+
+	_, err = IndexFromHostname("operator")
+	assert.Error(t, err)
+}
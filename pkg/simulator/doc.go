@@ -0,0 +1,5 @@
+// Package simulator serves the AgentCore control plane API used by the operator from memory, so
+// that the controllers can be exercised in envtest and local end-to-end runs without AWS
+// credentials. Gateway targets and gateways go through the state transitions of AWS, e.g.
+// CREATING to READY, and throttling and other errors can be injected.
+package simulator
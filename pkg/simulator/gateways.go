@@ -0,0 +1,264 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+)
+
+// CreateGateway creates a CREATING MCP gateway. A create retried with the client token of an
+// earlier one returns the gateway created then.
+func (s *Simulator) CreateGateway(
+	_ context.Context,
+	params *bedrockagentcorecontrol.CreateGatewayInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.CreateGatewayOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("CreateGateway"); err != nil {
+		return nil, err
+	}
+	if token := aws.ToString(params.ClientToken); token != "" {
+		if id, ok := s.tokens[token]; ok {
+			if g, ok := s.gateways[id]; ok {
+				return (*bedrockagentcorecontrol.CreateGatewayOutput)(s.describeGateway(g)), nil
+			}
+		}
+	}
+	if aws.ToString(params.Name) == "" {
+		return nil, &types.ValidationException{Message: aws.String("name is required")}
+	}
+	for _, g := range s.gateways {
+		if g.name == aws.ToString(params.Name) {
+			return nil, conflict("a gateway named %s already exists", g.name)
+		}
+	}
+
+	now := s.opts.Clock()
+	g := &gateway{
+		id:      s.newID(aws.ToString(params.Name) + "-"),
+		name:    aws.ToString(params.Name),
+		status:  types.GatewayStatusCreating,
+		since:   now,
+		created: now,
+	}
+	s.gateways[g.id] = g
+	if token := aws.ToString(params.ClientToken); token != "" {
+		s.tokens[token] = g.id
+	}
+	s.advance()
+	return (*bedrockagentcorecontrol.CreateGatewayOutput)(s.describeGateway(g)), nil
+}
+
+// GetGateway returns a gateway
+func (s *Simulator) GetGateway(
+	_ context.Context,
+	params *bedrockagentcorecontrol.GetGatewayInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.GetGatewayOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("GetGateway"); err != nil {
+		return nil, err
+	}
+	g, err := s.gateway(params.GatewayIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	return s.describeGateway(g), nil
+}
+
+// DeleteGateway starts the deletion of a gateway. Like in AWS, a gateway that still has targets
+// cannot be deleted.
+func (s *Simulator) DeleteGateway(
+	_ context.Context,
+	params *bedrockagentcorecontrol.DeleteGatewayInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.DeleteGatewayOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("DeleteGateway"); err != nil {
+		return nil, err
+	}
+	g, err := s.gateway(params.GatewayIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range s.targets {
+		if t.gatewayID == g.id {
+			return nil, conflict("gateway %s still has targets", g.id)
+		}
+	}
+	if g.status != types.GatewayStatusDeleting {
+		g.status = types.GatewayStatusDeleting
+		g.since = s.opts.Clock()
+	}
+	output := &bedrockagentcorecontrol.DeleteGatewayOutput{GatewayId: aws.String(g.id), Status: g.status}
+	s.advance()
+	return output, nil
+}
+
+// describeGateway returns the gateway as GetGateway does. Gateways have an IAM role and a
+// workload identity, so that targets with any kind of credential provider can be added.
+func (s *Simulator) describeGateway(g *gateway) *bedrockagentcorecontrol.GetGatewayOutput {
+	return &bedrockagentcorecontrol.GetGatewayOutput{
+		GatewayId:      aws.String(g.id),
+		GatewayArn:     aws.String(s.gatewayArn(g.id)),
+		GatewayUrl:     aws.String(fmt.Sprintf("https://%s.gateway.bedrock-agentcore.%s.amazonaws.com/mcp", g.id, s.opts.Region)),
+		Name:           aws.String(g.name),
+		Status:         g.status,
+		ProtocolType:   types.GatewayProtocolTypeMcp,
+		AuthorizerType: types.AuthorizerTypeCustomJwt,
+		RoleArn:        aws.String(fmt.Sprintf("arn:aws:iam::%s:role/%s", s.opts.AccountID, g.name)),
+		WorkloadIdentityDetails: &types.WorkloadIdentityDetails{
+			WorkloadIdentityArn: aws.String(fmt.Sprintf("arn:aws:bedrock-agentcore:%s:%s:workload-identity-directory/default/workload-identity/%s",
+				s.opts.Region, s.opts.AccountID, g.id)),
+		},
+		CreatedAt: aws.Time(g.created),
+		UpdatedAt: aws.Time(g.since),
+	}
+}
+
+// CreateOauth2CredentialProvider creates an OAuth2 credential provider in the default token vault
+func (s *Simulator) CreateOauth2CredentialProvider(
+	_ context.Context,
+	params *bedrockagentcorecontrol.CreateOauth2CredentialProviderInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.CreateOauth2CredentialProviderOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("CreateOauth2CredentialProvider"); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.Name)
+	if name == "" {
+		return nil, &types.ValidationException{Message: aws.String("name is required")}
+	}
+	if _, ok := s.providers[name]; ok {
+		return nil, conflict("a credential provider named %s already exists", name)
+	}
+	arn := fmt.Sprintf("arn:aws:bedrock-agentcore:%s:%s:token-vault/default/oauth2credentialprovider/%s",
+		s.opts.Region, s.opts.AccountID, name)
+	s.providers[name] = arn
+	return &bedrockagentcorecontrol.CreateOauth2CredentialProviderOutput{
+		Name:                  aws.String(name),
+		CredentialProviderArn: aws.String(arn),
+		ClientSecretArn: &types.Secret{SecretArn: aws.String(fmt.Sprintf("arn:aws:secretsmanager:%s:%s:secret:%s",
+			s.opts.Region, s.opts.AccountID, name))},
+	}, nil
+}
+
+// GetOauth2CredentialProvider returns an OAuth2 credential provider of the default token vault
+func (s *Simulator) GetOauth2CredentialProvider(
+	_ context.Context,
+	params *bedrockagentcorecontrol.GetOauth2CredentialProviderInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.GetOauth2CredentialProviderOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("GetOauth2CredentialProvider"); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.Name)
+	arn, ok := s.providers[name]
+	if !ok {
+		return nil, notFound("credential provider %s", name)
+	}
+	return &bedrockagentcorecontrol.GetOauth2CredentialProviderOutput{
+		Name:                     aws.String(name),
+		CredentialProviderArn:    aws.String(arn),
+		CredentialProviderVendor: types.CredentialProviderVendorTypeCustomOauth2,
+	}, nil
+}
+
+// DeleteOauth2CredentialProvider deletes an OAuth2 credential provider
+func (s *Simulator) DeleteOauth2CredentialProvider(
+	_ context.Context,
+	params *bedrockagentcorecontrol.DeleteOauth2CredentialProviderInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.DeleteOauth2CredentialProviderOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("DeleteOauth2CredentialProvider"); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.Name)
+	if _, ok := s.providers[name]; !ok {
+		return nil, notFound("credential provider %s", name)
+	}
+	delete(s.providers, name)
+	return &bedrockagentcorecontrol.DeleteOauth2CredentialProviderOutput{}, nil
+}
+
+// GetTokenVault returns the default token vault, which uses a service managed key until
+// SetTokenVaultCMK sets another
+func (s *Simulator) GetTokenVault(
+	_ context.Context,
+	params *bedrockagentcorecontrol.GetTokenVaultInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.GetTokenVaultOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("GetTokenVault"); err != nil {
+		return nil, err
+	}
+	kms := s.vault
+	if kms == nil {
+		kms = &types.KmsConfiguration{KeyType: types.KeyTypeServiceManagedKey}
+	}
+	return &bedrockagentcorecontrol.GetTokenVaultOutput{
+		TokenVaultId:     aws.String(tokenVaultID(params.TokenVaultId)),
+		KmsConfiguration: kms,
+		LastModifiedDate: aws.Time(s.vaultAt),
+	}, nil
+}
+
+// SetTokenVaultCMK sets the key of the default token vault
+func (s *Simulator) SetTokenVaultCMK(
+	_ context.Context,
+	params *bedrockagentcorecontrol.SetTokenVaultCMKInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.SetTokenVaultCMKOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("SetTokenVaultCMK"); err != nil {
+		return nil, err
+	}
+	if params.KmsConfiguration == nil {
+		return nil, &types.ValidationException{Message: aws.String("kmsConfiguration is required")}
+	}
+	s.vault = params.KmsConfiguration
+	s.vaultAt = s.opts.Clock()
+	return &bedrockagentcorecontrol.SetTokenVaultCMKOutput{
+		TokenVaultId:     aws.String(tokenVaultID(params.TokenVaultId)),
+		KmsConfiguration: s.vault,
+		LastModifiedDate: aws.Time(s.vaultAt),
+	}, nil
+}
+
+// tokenVaultID returns the ID of the token vault, default unless set
+func tokenVaultID(id *string) string {
+	if aws.ToString(id) == "" {
+		return "default"
+	}
+	return aws.ToString(id)
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// DefaultTransitionDelay is how long resources stay CREATING, UPDATING or DELETING unless
+// Options.TransitionDelay says otherwise
+const DefaultTransitionDelay = 2 * time.Second
+
+// Options configure a Simulator
+type Options struct {
+	// Region is the region of the ARNs the simulator returns. Defaults to us-east-1.
+	Region string
+	// AccountID is the account of the ARNs the simulator returns. Defaults to 123456789012.
+	AccountID string
+	// TransitionDelay is how long gateways and gateway targets stay CREATING, UPDATING or
+	// DELETING. Defaults to DefaultTransitionDelay; negative values make transitions immediate.
+	TransitionDelay time.Duration
+	// Gateways are the IDs of the gateways that exist from the start
+	Gateways []string
+	// Clock returns the current time. Defaults to time.Now.
+	Clock func() time.Time
+}
+
+// Simulator implements bedrock.GatewayTargetAPI in memory. Creates are idempotent per client
+// token like in AWS, reads of unknown resources fail with ResourceNotFoundException, and names
+// must be unique per gateway. It is safe for concurrent use.
+type Simulator struct {
+	opts Options
+
+	mu        sync.Mutex
+	gateways  map[string]*gateway
+	targets   map[string]*target
+	providers map[string]string
+	vault     *types.KmsConfiguration
+	vaultAt   time.Time
+	tokens    map[string]string
+	calls     map[string]int
+	faults    []*fault
+	nextID    int
+}
+
+// Ensure Simulator satisfies GatewayTargetAPI
+var _ bedrock.GatewayTargetAPI = &Simulator{}
+
+// gateway is a gateway held by the simulator
+type gateway struct {
+	id      string
+	name    string
+	status  types.GatewayStatus
+	since   time.Time
+	created time.Time
+}
+
+// target is a gateway target held by the simulator
+type target struct {
+	id          string
+	gatewayID   string
+	name        string
+	description *string
+	config      types.TargetConfiguration
+	credentials []types.CredentialProviderConfiguration
+	metadata    *types.MetadataConfiguration
+	status      types.TargetStatus
+	since       time.Time
+	created     time.Time
+	updated     time.Time
+}
+
+// fault is an error injected into the next calls of an operation
+type fault struct {
+	operation string
+	err       error
+	remaining int
+}
+
+// New returns a Simulator
+func New(opts Options) *Simulator {
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+	if opts.AccountID == "" {
+		opts.AccountID = "123456789012"
+	}
+	if opts.TransitionDelay == 0 {
+		opts.TransitionDelay = DefaultTransitionDelay
+	}
+	if opts.Clock == nil {
+		opts.Clock = time.Now
+	}
+	s := &Simulator{
+		opts:      opts,
+		gateways:  map[string]*gateway{},
+		targets:   map[string]*target{},
+		providers: map[string]string{},
+		tokens:    map[string]string{},
+		calls:     map[string]int{},
+	}
+	now := opts.Clock()
+	for _, id := range opts.Gateways {
+		s.gateways[id] = &gateway{id: id, name: id, status: types.GatewayStatusReady, since: now, created: now}
+	}
+	return s
+}
+
+// Throttle makes the next n calls of operation, e.g. CreateGatewayTarget, fail with a
+// ThrottlingException. An empty operation throttles calls of any operation.
+func (s *Simulator) Throttle(operation string, n int) {
+	s.Fail(operation, n, &types.ThrottlingException{Message: aws.String("Rate exceeded")})
+}
+
+// Fail makes the next n calls of operation fail with err. An empty operation fails calls of any
+// operation.
+func (s *Simulator) Fail(operation string, n int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault{operation: operation, err: err, remaining: n})
+}
+
+// Calls returns how often the operation was called, including calls that failed
+func (s *Simulator) Calls(operation string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[operation]
+}
+
+// TargetIDs returns the IDs of the gateway targets that exist, sorted
+func (s *Simulator) TargetIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance()
+	return s.sortedTargetIDs()
+}
+
+// sortedTargetIDs returns the IDs of the targets, sorted. s.mu must be held.
+func (s *Simulator) sortedTargetIDs() []string {
+	ids := make([]string, 0, len(s.targets))
+	for id := range s.targets {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// SetTargetStatus sets the status of a gateway target, e.g. to FAILED, as if AWS changed it.
+// The status is kept until the target is updated or deleted.
+func (s *Simulator) SetTargetStatus(targetID string, status types.TargetStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t, ok := s.targets[targetID]; ok {
+		t.status = status
+		t.since = s.opts.Clock()
+	}
+}
+
+// call counts a call of operation, settles finished transitions and returns the error injected
+// into the call, if any. s.mu must be held.
+func (s *Simulator) call(operation string) error {
+	s.calls[operation]++
+	s.advance()
+	for i, f := range s.faults {
+		if f.operation != "" && f.operation != operation {
+			continue
+		}
+		f.remaining--
+		if f.remaining <= 0 {
+			s.faults = slices.Delete(s.faults, i, i+1)
+		}
+		return f.err
+	}
+	return nil
+}
+
+// advance completes the transitions that took TransitionDelay. s.mu must be held.
+func (s *Simulator) advance() {
+	now := s.opts.Clock()
+	done := func(since time.Time) bool {
+		return now.Sub(since) >= s.opts.TransitionDelay
+	}
+	for id, t := range s.targets {
+		if !done(t.since) {
+			continue
+		}
+		switch t.status {
+		case types.TargetStatusCreating, types.TargetStatusUpdating:
+			t.status = types.TargetStatusReady
+			t.since = now
+		case types.TargetStatusDeleting:
+			delete(s.targets, id)
+		}
+	}
+	for id, g := range s.gateways {
+		if !done(g.since) {
+			continue
+		}
+		switch g.status {
+		case types.GatewayStatusCreating, types.GatewayStatusUpdating:
+			g.status = types.GatewayStatusReady
+			g.since = now
+		case types.GatewayStatusDeleting:
+			delete(s.gateways, id)
+		}
+	}
+}
+
+// newID returns a new resource ID with the prefix
+func (s *Simulator) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s%08d", prefix, s.nextID)
+}
+
+func (s *Simulator) gatewayArn(gatewayID string) string {
+	return fmt.Sprintf("arn:aws:bedrock-agentcore:%s:%s:gateway/%s", s.opts.Region, s.opts.AccountID, gatewayID)
+}
+
+// gateway returns the gateway with the ID, or a ResourceNotFoundException
+func (s *Simulator) gateway(gatewayID *string) (*gateway, error) {
+	g, ok := s.gateways[aws.ToString(gatewayID)]
+	if !ok {
+		return nil, notFound("gateway %s", aws.ToString(gatewayID))
+	}
+	return g, nil
+}
+
+// target returns the target with the ID on the gateway, or a ResourceNotFoundException
+func (s *Simulator) target(gatewayID, targetID *string) (*target, error) {
+	t, ok := s.targets[aws.ToString(targetID)]
+	if !ok || t.gatewayID != aws.ToString(gatewayID) {
+		return nil, notFound("gateway target %s on gateway %s", aws.ToString(targetID), aws.ToString(gatewayID))
+	}
+	return t, nil
+}
+
+// nameTaken reports whether another target on the gateway has the name
+func (s *Simulator) nameTaken(gatewayID, name, exceptID string) bool {
+	for _, t := range s.targets {
+		if t.gatewayID == gatewayID && t.name == name && t.id != exceptID {
+			return true
+		}
+	}
+	return false
+}
+
+// validateTarget returns a ValidationException if the target has no name or configuration
+func validateTarget(name *string, config types.TargetConfiguration) error {
+	if aws.ToString(name) == "" {
+		return &types.ValidationException{Message: aws.String("name is required")}
+	}
+	if config == nil {
+		return &types.ValidationException{Message: aws.String("targetConfiguration is required")}
+	}
+	return nil
+}
+
+func notFound(format string, args ...any) error {
+	return &types.ResourceNotFoundException{Message: aws.String(fmt.Sprintf(format, args...) + " not found")}
+}
+
+func conflict(format string, args ...any) error {
+	return &types.ConflictException{Message: aws.String(fmt.Sprintf(format, args...))}
+}
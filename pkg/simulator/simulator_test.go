@@ -0,0 +1,186 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/mcp-gateway-operator/pkg/bedrock"
+)
+
+// clock is a settable time source
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+func newSimulator() (*Simulator, *clock) {
+	c := &clock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	return New(Options{Gateways: []string{"gw-1"}, Clock: c.Now, TransitionDelay: time.Second}), c
+}
+
+func createInput(name string) *bedrockagentcorecontrol.CreateGatewayTargetInput {
+	return &bedrockagentcorecontrol.CreateGatewayTargetInput{
+		GatewayIdentifier: aws.String("gw-1"),
+		Name:              aws.String(name),
+		ClientToken:       aws.String("token-" + name),
+		TargetConfiguration: &types.TargetConfigurationMemberMcp{
+			Value: &types.McpTargetConfigurationMemberMcpServer{
+				Value: types.McpServerTargetConfiguration{Endpoint: aws.String("https://" + name + ".example.com/mcp")},
+			},
+		},
+	}
+}
+
+func TestTargetLifecycle(t *testing.T) {
+	ctx := context.Background()
+	sim, c := newSimulator()
+
+	created, err := sim.CreateGatewayTarget(ctx, createInput("weather"))
+	require.NoError(t, err)
+	assert.Equal(t, types.TargetStatusCreating, created.Status)
+	assert.Equal(t, "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-1", aws.ToString(created.GatewayArn))
+
+	get := &bedrockagentcorecontrol.GetGatewayTargetInput{GatewayIdentifier: aws.String("gw-1"), TargetId: created.TargetId}
+	c.now = c.now.Add(time.Second)
+	current, err := sim.GetGatewayTarget(ctx, get)
+	require.NoError(t, err)
+	assert.Equal(t, types.TargetStatusReady, current.Status)
+
+	updated, err := sim.UpdateGatewayTarget(ctx, &bedrockagentcorecontrol.UpdateGatewayTargetInput{
+		GatewayIdentifier:   aws.String("gw-1"),
+		TargetId:            created.TargetId,
+		Name:                aws.String("weather"),
+		TargetConfiguration: createInput("forecast").TargetConfiguration,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.TargetStatusUpdating, updated.Status)
+	assert.Equal(t, "https://forecast.example.com/mcp", bedrock.MCPServerEndpoint(updated.TargetConfiguration))
+
+	deleted, err := sim.DeleteGatewayTarget(ctx, &bedrockagentcorecontrol.DeleteGatewayTargetInput{
+		GatewayIdentifier: aws.String("gw-1"), TargetId: created.TargetId,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.TargetStatusDeleting, deleted.Status)
+	assert.Len(t, sim.TargetIDs(), 1)
+
+	c.now = c.now.Add(time.Second)
+	_, err = sim.GetGatewayTarget(ctx, get)
+	assert.True(t, bedrock.IsResourceNotFoundError(err))
+	assert.Empty(t, sim.TargetIDs())
+}
+
+func TestCreateGatewayTarget(t *testing.T) {
+	ctx := context.Background()
+	sim, _ := newSimulator()
+
+	first, err := sim.CreateGatewayTarget(ctx, createInput("weather"))
+	require.NoError(t, err)
+	retried, err := sim.CreateGatewayTarget(ctx, createInput("weather"))
+	require.NoError(t, err)
+	assert.Equal(t, aws.ToString(first.TargetId), aws.ToString(retried.TargetId), "retries with the client token are idempotent")
+
+	duplicate := createInput("weather")
+	duplicate.ClientToken = aws.String("other")
+	_, err = sim.CreateGatewayTarget(ctx, duplicate)
+	assert.True(t, bedrock.IsConflictError(err), "names are unique per gateway")
+
+	unknown := createInput("news")
+	unknown.GatewayIdentifier = aws.String("gw-2")
+	_, err = sim.CreateGatewayTarget(ctx, unknown)
+	assert.True(t, bedrock.IsResourceNotFoundError(err))
+
+	list, err := sim.ListGatewayTargets(ctx, &bedrockagentcorecontrol.ListGatewayTargetsInput{GatewayIdentifier: aws.String("gw-1")})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "weather", aws.ToString(list.Items[0].Name))
+	assert.Equal(t, 4, sim.Calls("CreateGatewayTarget"))
+}
+
+func TestFaultInjection(t *testing.T) {
+	ctx := context.Background()
+	sim, _ := newSimulator()
+
+	sim.Throttle("CreateGatewayTarget", 2)
+	for range 2 {
+		_, err := sim.CreateGatewayTarget(ctx, createInput("weather"))
+		assert.True(t, bedrock.IsThrottlingError(err))
+	}
+	_, err := sim.CreateGatewayTarget(ctx, createInput("weather"))
+	require.NoError(t, err)
+
+	sim.Fail("", 1, &types.AccessDeniedException{Message: aws.String("denied")})
+	_, err = sim.GetGateway(ctx, &bedrockagentcorecontrol.GetGatewayInput{GatewayIdentifier: aws.String("gw-1")})
+	assert.True(t, bedrock.IsAccessDeniedError(err))
+	_, err = sim.GetGateway(ctx, &bedrockagentcorecontrol.GetGatewayInput{GatewayIdentifier: aws.String("gw-1")})
+	require.NoError(t, err)
+}
+
+func TestWrapperRetriesThrottledCalls(t *testing.T) {
+	sim := New(Options{Gateways: []string{"gw-1"}, TransitionDelay: -1})
+	sim.Throttle("", 1)
+	wrapper := bedrock.NewBedrockClientWrapper(sim, logr.Discard(), bedrock.WithRetryPolicy(bedrock.RetryPolicy{
+		MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond,
+	}))
+
+	output, err := wrapper.CreateGatewayTarget(context.Background(), createInput("weather"))
+	require.NoError(t, err)
+	assert.Equal(t, types.TargetStatusReady, output.Status, "transitions are immediate with a negative delay")
+	assert.Equal(t, 2, sim.Calls("CreateGatewayTarget"))
+}
+
+func TestGateways(t *testing.T) {
+	ctx := context.Background()
+	sim, c := newSimulator()
+
+	created, err := sim.CreateGateway(ctx, &bedrockagentcorecontrol.CreateGatewayInput{
+		Name: aws.String("team"), ClientToken: aws.String("token"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.GatewayStatusCreating, created.Status)
+
+	input := createInput("weather")
+	input.GatewayIdentifier = created.GatewayId
+	_, err = sim.CreateGatewayTarget(ctx, input)
+	assert.True(t, bedrock.IsValidationError(err), "gateways accept targets once they are READY")
+
+	c.now = c.now.Add(time.Second)
+	target, err := sim.CreateGatewayTarget(ctx, input)
+	require.NoError(t, err)
+
+	_, err = sim.DeleteGateway(ctx, &bedrockagentcorecontrol.DeleteGatewayInput{GatewayIdentifier: created.GatewayId})
+	assert.True(t, bedrock.IsConflictError(err), "gateways with targets cannot be deleted")
+
+	_, err = sim.DeleteGatewayTarget(ctx, &bedrockagentcorecontrol.DeleteGatewayTargetInput{
+		GatewayIdentifier: created.GatewayId, TargetId: target.TargetId,
+	})
+	require.NoError(t, err)
+	c.now = c.now.Add(time.Second)
+	_, err = sim.DeleteGateway(ctx, &bedrockagentcorecontrol.DeleteGatewayInput{GatewayIdentifier: created.GatewayId})
+	require.NoError(t, err)
+	c.now = c.now.Add(time.Second)
+	_, err = sim.GetGateway(ctx, &bedrockagentcorecontrol.GetGatewayInput{GatewayIdentifier: created.GatewayId})
+	assert.True(t, bedrock.IsResourceNotFoundError(err))
+}
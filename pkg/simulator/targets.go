@@ -0,0 +1,215 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+)
+
+// CreateGatewayTarget creates a CREATING gateway target. A create retried with the client token
+// of an earlier one returns the target created then.
+func (s *Simulator) CreateGatewayTarget(
+	_ context.Context,
+	params *bedrockagentcorecontrol.CreateGatewayTargetInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.CreateGatewayTargetOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("CreateGatewayTarget"); err != nil {
+		return nil, err
+	}
+	g, err := s.gateway(params.GatewayIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	if token := aws.ToString(params.ClientToken); token != "" {
+		if id, ok := s.tokens[token]; ok {
+			if t, ok := s.targets[id]; ok {
+				return (*bedrockagentcorecontrol.CreateGatewayTargetOutput)(s.describe(t)), nil
+			}
+		}
+	}
+	if g.status != types.GatewayStatusReady {
+		return nil, &types.ValidationException{Message: aws.String("gateway " + g.id + " is " + string(g.status))}
+	}
+	if err := validateTarget(params.Name, params.TargetConfiguration); err != nil {
+		return nil, err
+	}
+	if s.nameTaken(g.id, aws.ToString(params.Name), "") {
+		return nil, conflict("a target named %s already exists on gateway %s", aws.ToString(params.Name), g.id)
+	}
+
+	now := s.opts.Clock()
+	t := &target{
+		id:          s.newID("TARGET"),
+		gatewayID:   g.id,
+		name:        aws.ToString(params.Name),
+		description: params.Description,
+		config:      params.TargetConfiguration,
+		credentials: params.CredentialProviderConfigurations,
+		metadata:    params.MetadataConfiguration,
+		status:      types.TargetStatusCreating,
+		since:       now,
+		created:     now,
+		updated:     now,
+	}
+	s.targets[t.id] = t
+	if token := aws.ToString(params.ClientToken); token != "" {
+		s.tokens[token] = t.id
+	}
+	s.advance()
+	return (*bedrockagentcorecontrol.CreateGatewayTargetOutput)(s.describe(t)), nil
+}
+
+// GetGatewayTarget returns a gateway target
+func (s *Simulator) GetGatewayTarget(
+	_ context.Context,
+	params *bedrockagentcorecontrol.GetGatewayTargetInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.GetGatewayTargetOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("GetGatewayTarget"); err != nil {
+		return nil, err
+	}
+	t, err := s.target(params.GatewayIdentifier, params.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	return s.describe(t), nil
+}
+
+// ListGatewayTargets returns all targets of a gateway in a single page
+func (s *Simulator) ListGatewayTargets(
+	_ context.Context,
+	params *bedrockagentcorecontrol.ListGatewayTargetsInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.ListGatewayTargetsOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("ListGatewayTargets"); err != nil {
+		return nil, err
+	}
+	g, err := s.gateway(params.GatewayIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	output := &bedrockagentcorecontrol.ListGatewayTargetsOutput{}
+	for _, id := range s.sortedTargetIDs() {
+		t := s.targets[id]
+		if t.gatewayID != g.id {
+			continue
+		}
+		output.Items = append(output.Items, types.TargetSummary{
+			TargetId:    aws.String(t.id),
+			Name:        aws.String(t.name),
+			Description: t.description,
+			Status:      t.status,
+			CreatedAt:   aws.Time(t.created),
+			UpdatedAt:   aws.Time(t.updated),
+		})
+	}
+	return output, nil
+}
+
+// UpdateGatewayTarget replaces the configuration of a gateway target, which is UPDATING
+// afterwards. Targets that are still CREATING or DELETING cannot be updated.
+func (s *Simulator) UpdateGatewayTarget(
+	_ context.Context,
+	params *bedrockagentcorecontrol.UpdateGatewayTargetInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.UpdateGatewayTargetOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("UpdateGatewayTarget"); err != nil {
+		return nil, err
+	}
+	t, err := s.target(params.GatewayIdentifier, params.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	if t.status == types.TargetStatusCreating || t.status == types.TargetStatusDeleting {
+		return nil, conflict("gateway target %s is %s", t.id, t.status)
+	}
+	if err := validateTarget(params.Name, params.TargetConfiguration); err != nil {
+		return nil, err
+	}
+	if s.nameTaken(t.gatewayID, aws.ToString(params.Name), t.id) {
+		return nil, conflict("a target named %s already exists on gateway %s", aws.ToString(params.Name), t.gatewayID)
+	}
+
+	now := s.opts.Clock()
+	t.name = aws.ToString(params.Name)
+	t.description = params.Description
+	t.config = params.TargetConfiguration
+	t.credentials = params.CredentialProviderConfigurations
+	t.metadata = params.MetadataConfiguration
+	t.status = types.TargetStatusUpdating
+	t.since = now
+	t.updated = now
+	s.advance()
+	return (*bedrockagentcorecontrol.UpdateGatewayTargetOutput)(s.describe(t)), nil
+}
+
+// DeleteGatewayTarget starts the deletion of a gateway target, which is DELETING until it is
+// gone
+func (s *Simulator) DeleteGatewayTarget(
+	_ context.Context,
+	params *bedrockagentcorecontrol.DeleteGatewayTargetInput,
+	_ ...func(*bedrockagentcorecontrol.Options),
+) (*bedrockagentcorecontrol.DeleteGatewayTargetOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("DeleteGatewayTarget"); err != nil {
+		return nil, err
+	}
+	t, err := s.target(params.GatewayIdentifier, params.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	if t.status != types.TargetStatusDeleting {
+		t.status = types.TargetStatusDeleting
+		t.since = s.opts.Clock()
+	}
+	output := &bedrockagentcorecontrol.DeleteGatewayTargetOutput{
+		GatewayArn: aws.String(s.gatewayArn(t.gatewayID)),
+		TargetId:   aws.String(t.id),
+		Status:     t.status,
+	}
+	s.advance()
+	return output, nil
+}
+
+// describe returns the target as GetGatewayTarget does
+func (s *Simulator) describe(t *target) *bedrockagentcorecontrol.GetGatewayTargetOutput {
+	return &bedrockagentcorecontrol.GetGatewayTargetOutput{
+		TargetId:                         aws.String(t.id),
+		GatewayArn:                       aws.String(s.gatewayArn(t.gatewayID)),
+		Name:                             aws.String(t.name),
+		Description:                      t.description,
+		TargetConfiguration:              t.config,
+		CredentialProviderConfigurations: t.credentials,
+		MetadataConfiguration:            t.metadata,
+		Status:                           t.status,
+		CreatedAt:                        aws.Time(t.created),
+		UpdatedAt:                        aws.Time(t.updated),
+	}
+}
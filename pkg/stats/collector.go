@@ -0,0 +1,264 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+	"github.com/aws/mcp-gateway-operator/pkg/metriclabels"
+)
+
+const (
+	// metricNamespace is the CloudWatch namespace of AgentCore gateway metrics
+	metricNamespace = "AWS/Bedrock-AgentCore"
+
+	// invocationsMetric counts requests routed to a gateway target
+	invocationsMetric = "Invocations"
+	// systemErrorsMetric counts requests that failed with a server-side error
+	systemErrorsMetric = "SystemErrors"
+
+	// gatewayDimension and targetDimension identify a gateway target in CloudWatch
+	gatewayDimension = "GatewayId"
+	targetDimension  = "TargetId"
+
+	// maxQueriesPerRequest is the GetMetricData limit on metric queries per call
+	maxQueriesPerRequest = 500
+)
+
+var (
+	// targetRequestRate is the request rate of a gateway target averaged over the collection window
+	targetRequestRate = metriclabels.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "mcpgateway_target_requests_per_second",
+			Help: "Requests per second routed by the gateway to the target of an MCPServer",
+		},
+		[]string{"namespace", "name", "gateway_id", "target_id"},
+	)
+
+	// targetErrorRate is the server-side error rate of a gateway target averaged over the collection window
+	targetErrorRate = metriclabels.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "mcpgateway_target_errors_per_second",
+			Help: "Server-side errors per second returned by the target of an MCPServer",
+		},
+		[]string{"namespace", "name", "gateway_id", "target_id"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(targetRequestRate, targetErrorRate)
+}
+
+// SetMetricLabels adds the allowlisted labels of MCPServers to the gauges. It must be called before
+// the manager starts.
+func SetMetricLabels(allowlist *metriclabels.Allowlist) {
+	targetRequestRate.SetAllowlist(allowlist)
+	targetErrorRate.SetAllowlist(allowlist)
+}
+
+// CloudWatchAPI is the subset of the CloudWatch client used by the Collector
+type CloudWatchAPI interface {
+	GetMetricData(
+		ctx context.Context,
+		params *cloudwatch.GetMetricDataInput,
+		optFns ...func(*cloudwatch.Options),
+	) (*cloudwatch.GetMetricDataOutput, error)
+}
+
+// Collector periodically reads per-target traffic from CloudWatch and exports it as Prometheus gauges
+type Collector struct {
+	client       client.Client
+	cloudWatch   CloudWatchAPI
+	configParser *config.ConfigParser
+	interval     time.Duration
+	window       time.Duration
+	logger       logr.Logger
+}
+
+// NewCollector creates a new Collector.
+// Traffic is averaged over window and refreshed every interval.
+func NewCollector(
+	c client.Client,
+	cloudWatch CloudWatchAPI,
+	configParser *config.ConfigParser,
+	interval, window time.Duration,
+	logger logr.Logger,
+) *Collector {
+	return &Collector{
+		client:       c,
+		cloudWatch:   cloudWatch,
+		configParser: configParser,
+		interval:     interval,
+		window:       window,
+		logger:       logger,
+	}
+}
+
+// target identifies an MCPServer and its gateway target
+type target struct {
+	namespace string
+	name      string
+	gatewayID string
+	targetID  string
+	// labels are the labels of the MCPServer
+	labels map[string]string
+}
+
+// Start runs the collection loop until ctx is cancelled. It implements manager.Runnable.
+func (c *Collector) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.collect(ctx); err != nil {
+			c.logger.Error(err, "Failed to collect gateway target statistics")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable so only the leader queries CloudWatch
+func (c *Collector) NeedLeaderElection() bool {
+	return true
+}
+
+// collect refreshes the gauges for all MCPServers with a gateway target
+func (c *Collector) collect(ctx context.Context) error {
+	mcpServers := &mcpgatewayv1alpha1.MCPServerList{}
+	if err := c.client.List(ctx, mcpServers); err != nil {
+		return fmt.Errorf("failed to list MCPServers: %w", err)
+	}
+
+	targets := make([]target, 0, len(mcpServers.Items))
+	for i := range mcpServers.Items {
+		mcpServer := &mcpServers.Items[i]
+		if mcpServer.Status.TargetID == "" {
+			continue
+		}
+		gatewayID, err := c.configParser.GetGatewayID(mcpServer)
+		if err != nil {
+			continue
+		}
+		targets = append(targets, target{
+			namespace: mcpServer.Namespace,
+			name:      mcpServer.Name,
+			gatewayID: gatewayID,
+			targetID:  mcpServer.Status.TargetID,
+			labels:    mcpServer.Labels,
+		})
+	}
+
+	// Drop series of targets that no longer exist before republishing
+	targetRequestRate.Reset()
+	targetErrorRate.Reset()
+
+	end := time.Now()
+	start := end.Add(-c.window)
+	perBatch := maxQueriesPerRequest / 2
+	for first := 0; first < len(targets); first += perBatch {
+		batch := targets[first:min(first+perBatch, len(targets))]
+		if err := c.collectBatch(ctx, batch, start, end); err != nil {
+			return err
+		}
+	}
+
+	c.logger.V(1).Info("Collected gateway target statistics", "targets", len(targets))
+	return nil
+}
+
+// collectBatch queries request and error counts for a batch of targets in a single GetMetricData call
+func (c *Collector) collectBatch(ctx context.Context, batch []target, start, end time.Time) error {
+	queries := make([]cwtypes.MetricDataQuery, 0, 2*len(batch))
+	for i, t := range batch {
+		queries = append(queries,
+			sumQuery(fmt.Sprintf("requests%d", i), invocationsMetric, t, c.window),
+			sumQuery(fmt.Sprintf("errors%d", i), systemErrorsMetric, t, c.window),
+		)
+	}
+
+	sums := make(map[string]float64, len(queries))
+	input := &cloudwatch.GetMetricDataInput{
+		MetricDataQueries: queries,
+		StartTime:         aws.Time(start),
+		EndTime:           aws.Time(end),
+	}
+	for {
+		output, err := c.cloudWatch.GetMetricData(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to get metric data: %w", err)
+		}
+		for _, result := range output.MetricDataResults {
+			for _, value := range result.Values {
+				sums[aws.ToString(result.Id)] += value
+			}
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	seconds := c.window.Seconds()
+	for i, t := range batch {
+		labels := prometheus.Labels{
+			"namespace":  t.namespace,
+			"name":       t.name,
+			"gateway_id": t.gatewayID,
+			"target_id":  t.targetID,
+		}
+		targetRequestRate.For(t.labels, labels).Set(sums[fmt.Sprintf("requests%d", i)] / seconds)
+		targetErrorRate.For(t.labels, labels).Set(sums[fmt.Sprintf("errors%d", i)] / seconds)
+	}
+	return nil
+}
+
+// sumQuery builds a query summing a gateway metric for one target over the window
+func sumQuery(id, metricName string, t target, window time.Duration) cwtypes.MetricDataQuery {
+	return cwtypes.MetricDataQuery{
+		Id: aws.String(id),
+		MetricStat: &cwtypes.MetricStat{
+			Metric: &cwtypes.Metric{
+				Namespace:  aws.String(metricNamespace),
+				MetricName: aws.String(metricName),
+				Dimensions: []cwtypes.Dimension{
+					{Name: aws.String(gatewayDimension), Value: aws.String(t.gatewayID)},
+					{Name: aws.String(targetDimension), Value: aws.String(t.targetID)},
+				},
+			},
+			Period: aws.Int32(int32(window.Seconds())),
+			Stat:   aws.String("Sum"),
+		},
+		ReturnData: aws.Bool(true),
+	}
+}
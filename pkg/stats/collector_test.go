@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stats
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+	"github.com/aws/mcp-gateway-operator/pkg/metriclabels"
+)
+
+// fakeCloudWatch returns a fixed sum for every query whose ID starts with the map key
+type fakeCloudWatch struct {
+	sums  map[string]float64
+	calls int
+}
+
+func (f *fakeCloudWatch) GetMetricData(
+	_ context.Context,
+	params *cloudwatch.GetMetricDataInput,
+	_ ...func(*cloudwatch.Options),
+) (*cloudwatch.GetMetricDataOutput, error) {
+	f.calls++
+	output := &cloudwatch.GetMetricDataOutput{}
+	for _, query := range params.MetricDataQueries {
+		id := aws.ToString(query.Id)
+		for prefix, sum := range f.sums {
+			if strings.HasPrefix(id, prefix) {
+				output.MetricDataResults = append(output.MetricDataResults, cwtypes.MetricDataResult{
+					Id:     query.Id,
+					Values: []float64{sum},
+				})
+			}
+		}
+	}
+	return output, nil
+}
+
+func TestCollect(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	withTarget := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "with-target", Namespace: "default"},
+		Status:     mcpgatewayv1alpha1.MCPServerStatus{TargetID: "target-123"},
+	}
+	withoutTarget := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "without-target", Namespace: "default"},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(withTarget, withoutTarget).Build()
+
+	cw := &fakeCloudWatch{sums: map[string]float64{"requests": 600, "errors": 60}}
+	collector := NewCollector(fakeClient, cw, config.NewConfigParser("gw-default"),
+		time.Minute, time.Minute, logr.Discard())
+
+	require.NoError(t, collector.collect(context.Background()))
+
+	assert.Equal(t, 1, cw.calls)
+	assert.Equal(t, 1, testutil.CollectAndCount(targetRequestRate))
+	assert.InDelta(t, 10.0,
+		testutil.ToFloat64(targetRequestRate.WithLabelValues("default", "with-target", "gw-default", "target-123")), 0.001)
+	assert.InDelta(t, 1.0,
+		testutil.ToFloat64(targetErrorRate.WithLabelValues("default", "with-target", "gw-default", "target-123")), 0.001)
+}
+
+func TestCollect_MetricLabels(t *testing.T) {
+	allowlist, err := metriclabels.ParseAllowlist("tool-domain")
+	require.NoError(t, err)
+	SetMetricLabels(allowlist)
+	defer SetMetricLabels(nil)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+	finance := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ledger", Namespace: "default", Labels: map[string]string{"tool-domain": "finance"}},
+		Status:     mcpgatewayv1alpha1.MCPServerStatus{TargetID: "target-123"},
+	}
+	unlabelled := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "default"},
+		Status:     mcpgatewayv1alpha1.MCPServerStatus{TargetID: "target-456"},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(finance, unlabelled).Build()
+
+	cw := &fakeCloudWatch{sums: map[string]float64{"requests": 600, "errors": 60}}
+	collector := NewCollector(fakeClient, cw, config.NewConfigParser("gw-default"),
+		time.Minute, time.Minute, logr.Discard())
+	require.NoError(t, collector.collect(context.Background()))
+
+	assert.InDelta(t, 10.0, testutil.ToFloat64(targetRequestRate.With(prometheus.Labels{
+		"namespace": "default", "name": "ledger", "gateway_id": "gw-default", "target_id": "target-123",
+		"label_tool_domain": "finance",
+	})), 0.001)
+	assert.InDelta(t, 1.0, testutil.ToFloat64(targetErrorRate.With(prometheus.Labels{
+		"namespace": "default", "name": "weather", "gateway_id": "gw-default", "target_id": "target-456",
+		"label_tool_domain": "",
+	})), 0.001)
+}
@@ -0,0 +1,4 @@
+// Package stats exports gateway target traffic statistics read from CloudWatch
+// as Prometheus metrics, so workloads backing MCP servers can be autoscaled on
+// gateway traffic.
+package stats
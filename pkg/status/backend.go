@@ -0,0 +1,202 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+)
+
+// Status modes of an installation
+const (
+	// ModeFull writes the complete status of MCPServers to their status subresource
+	ModeFull = "full"
+	// ModeConditionsOnly writes only the conditions and observed generation to the status
+	// subresource, for GitOps setups that forbid controllers writing other status fields. The
+	// remaining fields, e.g. the target ID and gateway ARN, are stored in DetailsAnnotation.
+	ModeConditionsOnly = "conditions-only"
+)
+
+// DetailsAnnotation holds the status fields other than conditions as JSON in ModeConditionsOnly
+const DetailsAnnotation = "mcpgateway.bedrock.aws/status-details"
+
+// backendClient stores the status of MCPServers as required by the status mode. MCPServers read
+// through it get the fields stored in DetailsAnnotation restored into their status, so the rest of
+// the operator works with the complete status whatever the mode.
+type backendClient struct {
+	client.Client
+	conditionsOnly bool
+}
+
+// NewClient wraps c to store the status of MCPServers according to mode. Reads restore status
+// details from DetailsAnnotation in every mode, and status writes in ModeFull remove the
+// annotation, so that an installation can be switched back to ModeFull at any time.
+func NewClient(c client.Client, mode string) client.Client {
+	return &backendClient{Client: c, conditionsOnly: mode == ModeConditionsOnly}
+}
+
+// Get implements client.Reader
+func (c *backendClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if err := c.Client.Get(ctx, key, obj, opts...); err != nil {
+		return err
+	}
+	restoreDetails(obj)
+	return nil
+}
+
+// List implements client.Reader
+func (c *backendClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if err := c.Client.List(ctx, list, opts...); err != nil {
+		return err
+	}
+	if mcpServers, ok := list.(*mcpgatewayv1alpha1.MCPServerList); ok {
+		for i := range mcpServers.Items {
+			restoreDetails(&mcpServers.Items[i])
+		}
+	}
+	return nil
+}
+
+// Create implements client.Writer
+func (c *backendClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	err := c.Client.Create(ctx, obj, opts...)
+	restoreDetails(obj)
+	return err
+}
+
+// Update implements client.Writer
+func (c *backendClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	err := c.Client.Update(ctx, obj, opts...)
+	restoreDetails(obj)
+	return err
+}
+
+// Patch implements client.Writer
+func (c *backendClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	err := c.Client.Patch(ctx, obj, patch, opts...)
+	restoreDetails(obj)
+	return err
+}
+
+// Status returns a writer for the status subresource that honours the status mode
+func (c *backendClient) Status() client.SubResourceWriter {
+	return &backendStatusWriter{SubResourceWriter: c.Client.Status(), client: c}
+}
+
+// backendStatusWriter writes the status subresource of MCPServers as required by the status mode
+type backendStatusWriter struct {
+	client.SubResourceWriter
+	client *backendClient
+}
+
+// Update writes the status. In ModeConditionsOnly the details of an MCPServer are first stored in
+// its DetailsAnnotation, and only the conditions and observed generation are sent as its status.
+func (w *backendStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	mcpServer, ok := obj.(*mcpgatewayv1alpha1.MCPServer)
+	if !ok {
+		return w.SubResourceWriter.Update(ctx, obj, opts...)
+	}
+	if !w.client.conditionsOnly {
+		return w.updateFull(ctx, mcpServer, opts...)
+	}
+
+	full := mcpServer.Status.DeepCopy()
+	details, err := encodeDetails(full)
+	if err != nil {
+		return err
+	}
+	if mcpServer.Annotations[DetailsAnnotation] != details {
+		base := mcpServer.DeepCopy()
+		if mcpServer.Annotations == nil {
+			mcpServer.Annotations = map[string]string{}
+		}
+		mcpServer.Annotations[DetailsAnnotation] = details
+		if err := w.client.Client.Patch(ctx, mcpServer, client.MergeFrom(base)); err != nil {
+			mcpServer.Status = *full
+			return err
+		}
+	}
+
+	mcpServer.Status = mcpgatewayv1alpha1.MCPServerStatus{
+		Conditions:         full.Conditions,
+		ObservedGeneration: full.ObservedGeneration,
+	}
+	err = w.SubResourceWriter.Update(ctx, mcpServer, opts...)
+	// The details are not returned by the API server, but the caller keeps working with them
+	mcpServer.Status = *full
+	return err
+}
+
+// updateFull writes the complete status, then removes details left in the DetailsAnnotation by
+// ModeConditionsOnly, which would otherwise replace the status on the next read
+func (w *backendStatusWriter) updateFull(
+	ctx context.Context,
+	mcpServer *mcpgatewayv1alpha1.MCPServer,
+	opts ...client.SubResourceUpdateOption,
+) error {
+	if err := w.SubResourceWriter.Update(ctx, mcpServer, opts...); err != nil {
+		return err
+	}
+	if _, ok := mcpServer.Annotations[DetailsAnnotation]; !ok {
+		return nil
+	}
+
+	full := mcpServer.Status.DeepCopy()
+	base := mcpServer.DeepCopy()
+	delete(mcpServer.Annotations, DetailsAnnotation)
+	err := w.client.Client.Patch(ctx, mcpServer, client.MergeFrom(base))
+	mcpServer.Status = *full
+	return err
+}
+
+// encodeDetails returns the status without conditions and observed generation as JSON
+func encodeDetails(status *mcpgatewayv1alpha1.MCPServerStatus) (string, error) {
+	details := status.DeepCopy()
+	details.Conditions = nil
+	details.ObservedGeneration = 0
+	data, err := json.Marshal(details)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// restoreDetails copies the status details stored in DetailsAnnotation into the status of an
+// MCPServer. The conditions and observed generation of the status are kept. Other objects, and
+// MCPServers without or with an unreadable annotation, are left as they are.
+func restoreDetails(obj runtime.Object) {
+	mcpServer, ok := obj.(*mcpgatewayv1alpha1.MCPServer)
+	if !ok {
+		return
+	}
+	data, ok := mcpServer.Annotations[DetailsAnnotation]
+	if !ok {
+		return
+	}
+	details := mcpgatewayv1alpha1.MCPServerStatus{}
+	if err := json.Unmarshal([]byte(data), &details); err != nil {
+		return
+	}
+	details.Conditions = mcpServer.Status.Conditions
+	details.ObservedGeneration = mcpServer.Status.ObservedGeneration
+	mcpServer.Status = details
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"testing"
+
+	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newBackendTestClient(t *testing.T) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-server", Namespace: "default"},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+}
+
+func TestClient_ConditionsOnly(t *testing.T) {
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "test-server", Namespace: "default"}
+	fakeClient := newBackendTestClient(t)
+	manager := NewManager(NewClient(fakeClient, ModeConditionsOnly))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, manager.client.Get(ctx, key, mcpServer))
+	require.NoError(t, manager.UpdateTargetCreated(ctx, mcpServer, "target-123",
+		"arn:aws:bedrock:us-east-1:123456789012:gateway/gw-123", "CREATING", nil))
+	require.NoError(t, manager.SetReady(ctx, mcpServer))
+
+	// The caller keeps working with the complete status
+	assert.Equal(t, "target-123", mcpServer.Status.TargetID)
+
+	// Only conditions are written to the status subresource
+	stored := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, key, stored))
+	assert.Empty(t, stored.Status.TargetID)
+	assert.Empty(t, stored.Status.GatewayArn)
+	assert.Len(t, stored.Status.Conditions, 1)
+	assert.Contains(t, stored.Annotations[DetailsAnnotation], `"targetId":"target-123"`)
+	assert.NotContains(t, stored.Annotations[DetailsAnnotation], "conditions")
+
+	// Reads restore the details
+	restored := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, manager.client.Get(ctx, key, restored))
+	assert.Equal(t, "target-123", restored.Status.TargetID)
+	assert.Equal(t, "gw-123", restored.Status.GatewayID)
+	assert.Equal(t, "CREATING", restored.Status.TargetStatus)
+	assert.Len(t, restored.Status.Conditions, 1)
+
+	list := &mcpgatewayv1alpha1.MCPServerList{}
+	require.NoError(t, manager.client.List(ctx, list))
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "target-123", list.Items[0].Status.TargetID)
+}
+
+func TestClient_SwitchBackToFull(t *testing.T) {
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "test-server", Namespace: "default"}
+	fakeClient := newBackendTestClient(t)
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, key, mcpServer))
+	require.NoError(t, NewManager(NewClient(fakeClient, ModeConditionsOnly)).
+		UpdateTargetCreated(ctx, mcpServer, "target-123", "", "CREATING", nil))
+
+	manager := NewManager(NewClient(fakeClient, ModeFull))
+	mcpServer = &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, manager.client.Get(ctx, key, mcpServer))
+	require.NoError(t, manager.UpdateTargetStatus(ctx, mcpServer, "READY", nil, nil))
+	assert.Equal(t, "target-123", mcpServer.Status.TargetID)
+
+	stored := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, key, stored))
+	assert.NotContains(t, stored.Annotations, DetailsAnnotation)
+	assert.Equal(t, "target-123", stored.Status.TargetID)
+	assert.Equal(t, "READY", stored.Status.TargetStatus)
+}
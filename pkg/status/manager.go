@@ -18,16 +18,50 @@ package status
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
+	"github.com/aws/mcp-gateway-operator/pkg/config"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// SyncRecordInterval is how often a synchronization with an unchanged outcome is recorded.
+// Recording every attempt would write the status, and so trigger another reconcile, on every
+// poll of a target that is not READY.
+const SyncRecordInterval = time.Minute
+
+// TargetStatusDeleting is the TargetStatus of a gateway target that AWS is still deleting
+const TargetStatusDeleting = "DELETING"
+
+// Transition fields
+const (
+	// TransitionTargetStatus is a change of the gateway target status, e.g. CREATING to READY
+	TransitionTargetStatus = "TargetStatus"
+	// TransitionReady is a change of the status of the Ready condition
+	TransitionReady = "Ready"
+)
+
+// Transition is a change of the gateway target status or of the Ready condition status
+type Transition struct {
+	// Field is TransitionTargetStatus or TransitionReady
+	Field string
+	// From is the previous value; a missing Ready condition is Unknown
+	From string
+	// To is the new value
+	To string
+}
+
 // Manager manages MCPServer status updates.
 type Manager struct {
-	client client.Client
+	client   client.Client
+	recorder events.EventRecorder
 }
 
 // NewManager creates a new StatusManager.
@@ -37,36 +71,210 @@ func NewManager(client client.Client) *Manager {
 	}
 }
 
+// WithEventRecorder makes the manager emit an event for every transition written to the status.
+// Writes that only change reasons, messages or timestamps emit nothing, so polling a target does
+// not flood the event stream.
+func (m *Manager) WithEventRecorder(recorder events.EventRecorder) *Manager {
+	m.recorder = recorder
+	return m
+}
+
+// Transitions returns the changes of the gateway target status and of the Ready condition status
+// between two statuses. A target status that was cleared is not a transition.
+func Transitions(before, after *mcpgatewayv1alpha1.MCPServerStatus) []Transition {
+	var transitions []Transition
+	if after.TargetStatus != "" && after.TargetStatus != before.TargetStatus {
+		transitions = append(transitions, Transition{Field: TransitionTargetStatus, From: before.TargetStatus, To: after.TargetStatus})
+	}
+	from, to := readyStatus(before), readyStatus(after)
+	if from != to {
+		transitions = append(transitions, Transition{Field: TransitionReady, From: from, To: to})
+	}
+	return transitions
+}
+
+// readyStatus returns the status of the Ready condition, or Unknown if it is missing
+func readyStatus(status *mcpgatewayv1alpha1.MCPServerStatus) string {
+	if condition := meta.FindStatusCondition(status.Conditions, "Ready"); condition != nil {
+		return string(condition.Status)
+	}
+	return string(metav1.ConditionUnknown)
+}
+
+// recordTransitions emits an event for every transition between before and the current status
+func (m *Manager) recordTransitions(mcpServer *mcpgatewayv1alpha1.MCPServer, before *mcpgatewayv1alpha1.MCPServerStatus) {
+	if m.recorder == nil {
+		return
+	}
+	for _, transition := range Transitions(before, &mcpServer.Status) {
+		switch transition.Field {
+		case TransitionTargetStatus:
+			eventType := corev1.EventTypeNormal
+			if transition.To == "FAILED" || strings.HasSuffix(transition.To, "_UNSUCCESSFUL") {
+				eventType = corev1.EventTypeWarning
+			}
+			note := fmt.Sprintf("Gateway target status changed to %s", transition.To)
+			if transition.From != "" {
+				note = fmt.Sprintf("Gateway target status changed from %s to %s", transition.From, transition.To)
+			}
+			m.recorder.Eventf(mcpServer, nil, eventType, "TargetStatusChanged", "Reconcile", "%s", note)
+		case TransitionReady:
+			condition := meta.FindStatusCondition(mcpServer.Status.Conditions, "Ready")
+			if condition == nil {
+				continue
+			}
+			eventType := corev1.EventTypeNormal
+			if condition.Status != metav1.ConditionTrue {
+				eventType = corev1.EventTypeWarning
+			}
+			m.recorder.Eventf(mcpServer, nil, eventType, condition.Reason, "Reconcile", "%s", condition.Message)
+		}
+	}
+}
+
 // UpdateTargetCreated updates the MCPServer status after a gateway target is created.
-// It sets the TargetID, GatewayArn, TargetStatus fields and updates the LastSynchronized timestamp.
-func (m *Manager) UpdateTargetCreated(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, targetID, gatewayArn, targetStatus string) error {
+// It sets the TargetID, GatewayArn, GatewayID, TargetStatus and TargetUpdatedAt fields and updates
+// the LastSynchronized timestamp. The status is not written if none of the fields changed.
+func (m *Manager) UpdateTargetCreated(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, targetID, gatewayArn, targetStatus string, updatedAt *time.Time) error {
+	before := mcpServer.Status.DeepCopy()
 	mcpServer.Status.ObservedGeneration = mcpServer.Generation
 	mcpServer.Status.TargetID = targetID
 	mcpServer.Status.GatewayArn = gatewayArn
+	if gatewayID := config.GatewayIDFromArn(gatewayArn); gatewayID != "" {
+		mcpServer.Status.GatewayID = gatewayID
+	}
 	mcpServer.Status.TargetStatus = targetStatus
-	now := metav1.Now()
-	mcpServer.Status.LastSynchronized = &now
+	setTargetUpdatedAt(mcpServer, updatedAt)
 
-	return m.client.Status().Update(ctx, mcpServer)
+	return m.writeIfChanged(ctx, mcpServer, before)
+}
+
+// UpdateTargetRemoved clears the gateway target from the MCPServer status after the target was
+// deleted while the MCPServer remains, so that a new target is created later. GatewayArn is kept
+// so that the new target is created on the same gateway. TargetRemovals is incremented.
+func (m *Manager) UpdateTargetRemoved(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	before := mcpServer.Status.DeepCopy()
+	if mcpServer.Status.TargetID != "" {
+		mcpServer.Status.TargetRemovals++
+	}
+	mcpServer.Status.TargetID = ""
+	mcpServer.Status.TargetStatus = ""
+	mcpServer.Status.StatusReasons = nil
+	mcpServer.Status.TargetUpdatedAt = nil
+
+	return m.writeIfChanged(ctx, mcpServer, before)
+}
+
+// UpdateGatewayRemoved clears the gateway target and its gateway from the MCPServer status after
+// the gateway was deleted, so that a new target is created on the gateway the MCPServer resolves to.
+// TargetRemovals is incremented.
+func (m *Manager) UpdateGatewayRemoved(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer) error {
+	before := mcpServer.Status.DeepCopy()
+	if mcpServer.Status.TargetID != "" {
+		mcpServer.Status.TargetRemovals++
+	}
+	mcpServer.Status.TargetID = ""
+	mcpServer.Status.TargetStatus = ""
+	mcpServer.Status.StatusReasons = nil
+	mcpServer.Status.TargetUpdatedAt = nil
+	mcpServer.Status.GatewayArn = ""
+	mcpServer.Status.GatewayID = ""
+
+	return m.writeIfChanged(ctx, mcpServer, before)
 }
 
 // UpdateTargetStatus updates the MCPServer status with the current gateway target status.
-// It sets the TargetStatus and StatusReasons fields and updates the LastSynchronized timestamp.
-func (m *Manager) UpdateTargetStatus(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, targetStatus string, statusReasons []string) error {
+// It sets the TargetStatus, StatusReasons and TargetUpdatedAt fields and updates the
+// LastSynchronized timestamp. The status is not written if none of the fields changed, so
+// LastSynchronized records the last time the observed target status changed.
+func (m *Manager) UpdateTargetStatus(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, targetStatus string, statusReasons []string, updatedAt *time.Time) error {
+	before := mcpServer.Status.DeepCopy()
 	mcpServer.Status.ObservedGeneration = mcpServer.Generation
 	mcpServer.Status.TargetStatus = targetStatus
 	mcpServer.Status.StatusReasons = statusReasons
-	now := metav1.Now()
-	mcpServer.Status.LastSynchronized = &now
+	setTargetUpdatedAt(mcpServer, updatedAt)
+
+	return m.writeIfChanged(ctx, mcpServer, before)
+}
 
+// SetProvenance records the sources of the gateway target values in Provenance.
+// The status is not written if the provenance did not change.
+func (m *Manager) SetProvenance(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, provenance *mcpgatewayv1alpha1.FieldProvenance) error {
+	before := mcpServer.Status.DeepCopy()
+	mcpServer.Status.Provenance = provenance
+
+	return m.writeIfChanged(ctx, mcpServer, before)
+}
+
+// RecordSync records an attempted synchronization with the gateway target and its outcome in
+// LastAttemptedSync, LastSuccessfulSync and LastSyncOutcome. The status is only written if the
+// outcome changed or the last attempt was recorded more than SyncRecordInterval before now.
+func (m *Manager) RecordSync(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, succeeded bool, now time.Time) error {
+	outcome := mcpgatewayv1alpha1.SyncOutcomeFailed
+	if succeeded {
+		outcome = mcpgatewayv1alpha1.SyncOutcomeSucceeded
+	}
+	last := mcpServer.Status.LastAttemptedSync
+	if outcome == mcpServer.Status.LastSyncOutcome && last != nil && now.Sub(last.Time) < SyncRecordInterval {
+		return nil
+	}
+
+	attempted := metav1.NewTime(now)
+	mcpServer.Status.LastAttemptedSync = &attempted
+	mcpServer.Status.LastSyncOutcome = outcome
+	if succeeded {
+		mcpServer.Status.LastSuccessfulSync = &attempted
+	}
 	return m.client.Status().Update(ctx, mcpServer)
 }
 
 // UpdateCondition adds or updates a condition in the MCPServer status.
-// It uses meta.SetStatusCondition to handle the condition update logic.
+// It uses SetCondition to handle the condition update logic and skips the write if the
+// condition did not change.
 func (m *Manager) UpdateCondition(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, condition metav1.Condition) error {
-	meta.SetStatusCondition(&mcpServer.Status.Conditions, condition)
-	return m.client.Status().Update(ctx, mcpServer)
+	before := mcpServer.Status.DeepCopy()
+	if !SetCondition(&mcpServer.Status.Conditions, condition) {
+		return nil
+	}
+	if err := m.client.Status().Update(ctx, mcpServer); err != nil {
+		return err
+	}
+	m.recordTransitions(mcpServer, before)
+	return nil
+}
+
+// SetCondition adds or updates a condition and reports whether the conditions changed.
+// A condition only changes if its status, reason, message or observed generation differ;
+// LastTransitionTime is kept unless the status flips. It can be used for any resource
+// with a []metav1.Condition status.
+func SetCondition(conditions *[]metav1.Condition, condition metav1.Condition) bool {
+	return meta.SetStatusCondition(conditions, condition)
+}
+
+// setTargetUpdatedAt records the last modification time of the gateway target.
+// A nil time keeps the recorded one, as not every AWS response carries it.
+func setTargetUpdatedAt(mcpServer *mcpgatewayv1alpha1.MCPServer, updatedAt *time.Time) {
+	if updatedAt == nil {
+		return
+	}
+	// Status timestamps are stored with second precision, so compare and store them the same way
+	observed := metav1.NewTime(updatedAt.Truncate(time.Second))
+	mcpServer.Status.TargetUpdatedAt = &observed
+}
+
+// writeIfChanged writes the MCPServer status if it differs from before.
+// LastSynchronized is refreshed as part of a write, but a new timestamp alone never causes one.
+func (m *Manager) writeIfChanged(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, before *mcpgatewayv1alpha1.MCPServerStatus) error {
+	if equality.Semantic.DeepEqual(before, &mcpServer.Status) {
+		return nil
+	}
+	now := metav1.Now()
+	mcpServer.Status.LastSynchronized = &now
+	if err := m.client.Status().Update(ctx, mcpServer); err != nil {
+		return err
+	}
+	m.recordTransitions(mcpServer, before)
+	return nil
 }
 
 // SetReady sets the Ready condition to True, indicating the gateway target is ready.
@@ -95,3 +303,382 @@ func (m *Manager) SetError(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MC
 	}
 	return m.UpdateCondition(ctx, mcpServer, condition)
 }
+
+// SetCredentialsExpiring sets the CredentialsExpiring condition.
+// When expiring is true the condition warns that the endpoint certificate or OAuth credentials
+// expire soon; otherwise it records that no credentials are close to expiry.
+func (m *Manager) SetCredentialsExpiring(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, expiring bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "CredentialsExpiring",
+		Status:             metav1.ConditionFalse,
+		Reason:             "CredentialsValid",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if expiring {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "ExpiryThresholdReached"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetThrottled sets the Throttled condition, which reports that the MCPServer exhausted its
+// AWS call budget and is backing off until calls are available again
+func (m *Manager) SetThrottled(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, throttled bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "Throttled",
+		Status:             metav1.ConditionFalse,
+		Reason:             "CallBudgetAvailable",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if throttled {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "CallBudgetExhausted"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetDegraded sets the Degraded condition, which reports that the AWS calls of the MCPServer are
+// failed right away because AWS keeps failing, until a probe call succeeds again
+func (m *Manager) SetDegraded(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, degraded bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "Degraded",
+		Status:             metav1.ConditionFalse,
+		Reason:             "AWSAvailable",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if degraded {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "CircuitOpen"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetDraining sets the Draining condition to True, indicating that the MCPServer was deleted and
+// its gateway target is kept until the drain period has passed.
+func (m *Manager) SetDraining(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, message string) error {
+	condition := metav1.Condition{
+		Type:               "Draining",
+		Status:             metav1.ConditionTrue,
+		Reason:             "DrainPeriod",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetDeleting records that the gateway target is being deleted by AWS: TargetStatus is set to
+// DELETING and the Progressing condition to True with reason Deleting. The status is not written
+// if neither changed.
+func (m *Manager) SetDeleting(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, message string) error {
+	before := mcpServer.Status.DeepCopy()
+	mcpServer.Status.TargetStatus = TargetStatusDeleting
+	SetCondition(&mcpServer.Status.Conditions, metav1.Condition{
+		Type:               "Progressing",
+		Status:             metav1.ConditionTrue,
+		Reason:             "Deleting",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	})
+	return m.writeIfChanged(ctx, mcpServer, before)
+}
+
+// SetWaitingForSlot reports that the creation of the gateway target waits for one of the create
+// slots of its gateway. When waiting is true the Progressing condition is set to True with reason
+// WaitingForSlot; otherwise a Progressing condition with that reason is removed. The status is not
+// written if nothing changed.
+func (m *Manager) SetWaitingForSlot(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, waiting bool, message string) error {
+	before := mcpServer.Status.DeepCopy()
+	if waiting {
+		SetCondition(&mcpServer.Status.Conditions, metav1.Condition{
+			Type:               "Progressing",
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForSlot",
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+			ObservedGeneration: mcpServer.Generation,
+		})
+	} else if condition := meta.FindStatusCondition(mcpServer.Status.Conditions, "Progressing"); condition != nil &&
+		condition.Reason == "WaitingForSlot" {
+		meta.RemoveStatusCondition(&mcpServer.Status.Conditions, "Progressing")
+	}
+	return m.writeIfChanged(ctx, mcpServer, before)
+}
+
+// SetExpired sets the Expired condition.
+// When expired is true the condition reports that the ttl or expiresAt of the MCPServer has
+// passed and its gateway target was deleted; otherwise it records that the MCPServer no longer
+// expires, e.g. after its ttl was extended.
+func (m *Manager) SetExpired(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, expired bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "Expired",
+		Status:             metav1.ConditionFalse,
+		Reason:             "NotExpired",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if expired {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "TargetExpired"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetOwnershipConflict sets the OwnershipConflict condition.
+// When conflict is true the condition reports that the owner marker of the gateway target names
+// another cluster, so the operator stopped mutating the target; otherwise it records that the
+// target is owned by this cluster.
+func (m *Manager) SetOwnershipConflict(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, conflict bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "OwnershipConflict",
+		Status:             metav1.ConditionFalse,
+		Reason:             "OwnedByThisCluster",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if conflict {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "OwnedByAnotherCluster"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetMetadataDrift sets the MetadataDrift condition.
+// When drifted is true the condition reports that the metadata allowlists of the gateway target
+// differ from the MCPServer spec; otherwise it records that they match.
+func (m *Manager) SetMetadataDrift(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, drifted bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "MetadataDrift",
+		Status:             metav1.ConditionFalse,
+		Reason:             "MetadataInSync",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if drifted {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "AllowlistsDiffer"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetConfigDrift sets the ConfigDrift condition.
+// When drifted is true the condition reports that the gateway target differs from the MCPServer
+// spec, e.g. after an edit in the AWS console; otherwise it records that they match.
+func (m *Manager) SetConfigDrift(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, drifted bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "ConfigDrift",
+		Status:             metav1.ConditionFalse,
+		Reason:             "TargetInSync",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if drifted {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "TargetDiffers"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetConcurrentModification sets the ConcurrentModification condition.
+// When modified is true the condition reports that the gateway target was changed outside of
+// the operator since it was last observed, and that the operator refuses to overwrite it.
+func (m *Manager) SetConcurrentModification(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, modified bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "ConcurrentModification",
+		Status:             metav1.ConditionFalse,
+		Reason:             "TargetUnchanged",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if modified {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "TargetModifiedExternally"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetPartialPermissions sets the PartialPermissions condition.
+// When partial is true the condition reports, with the given reason, that the operator may call
+// some of the gateway target APIs but not others, and which features are disabled as a result;
+// otherwise it records that the operator has all the permissions it needs.
+func (m *Manager) SetPartialPermissions(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, partial bool, reason, message string) error {
+	condition := metav1.Condition{
+		Type:               "PartialPermissions",
+		Status:             metav1.ConditionFalse,
+		Reason:             "PermissionsComplete",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if partial {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = reason
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetAccessDeniedOnDelete sets the AccessDeniedOnDelete condition to True, indicating that the
+// MCPServer was deleted but the operator may not delete its gateway target. The message names
+// the IAM action that is missing.
+func (m *Manager) SetAccessDeniedOnDelete(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, message string) error {
+	condition := metav1.Condition{
+		Type:               "AccessDeniedOnDelete",
+		Status:             metav1.ConditionTrue,
+		Reason:             "MissingPermission",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetRolloutPending sets the RolloutPending condition.
+// When pending is true the condition reports, with the given reason, that the update of the
+// gateway target is held back by the rollout of its gateway; otherwise it records that the update
+// was admitted.
+func (m *Manager) SetRolloutPending(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, pending bool, reason, message string) error {
+	condition := metav1.Condition{
+		Type:               "RolloutPending",
+		Status:             metav1.ConditionFalse,
+		Reason:             "UpdateAdmitted",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if pending {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = reason
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetApprovalPending sets the ApprovalPending condition.
+// When pending is true the condition reports that the environment of the MCPServer requires the
+// current generation to be approved before its gateway target is created or updated; otherwise it
+// records that the generation was approved.
+func (m *Manager) SetApprovalPending(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, pending bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "ApprovalPending",
+		Status:             metav1.ConditionFalse,
+		Reason:             "Approved",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if pending {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "AwaitingApproval"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetQuarantined sets the Quarantined condition.
+// When quarantined is true the condition reports that reconciling the MCPServer panicked and that
+// it is retried with exponential backoff; otherwise it records that the last reconcile completed.
+func (m *Manager) SetQuarantined(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, quarantined bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "Quarantined",
+		Status:             metav1.ConditionFalse,
+		Reason:             "ReconcileCompleted",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if quarantined {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "ReconcilePanicked"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetBackendUnavailable sets the BackendUnavailable condition.
+// When unavailable is true the condition reports, with the given reason, that the gateway target is
+// held back or removed because the workload behind the endpoint has no available replicas;
+// otherwise it records that the workload is available.
+func (m *Manager) SetBackendUnavailable(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, unavailable bool, reason, message string) error {
+	condition := metav1.Condition{
+		Type:               "BackendUnavailable",
+		Status:             metav1.ConditionFalse,
+		Reason:             "WorkloadAvailable",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if unavailable {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = reason
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetGatewayDeleted sets the GatewayDeleted condition.
+// When deleted is true the condition reports that the gateway of the gateway target was deleted
+// from AWS, so the operator no longer calls AWS for the target; otherwise it records that the
+// MCPServer moved to a replacement gateway.
+func (m *Manager) SetGatewayDeleted(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, deleted bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "GatewayDeleted",
+		Status:             metav1.ConditionFalse,
+		Reason:             "GatewayReplaced",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if deleted {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "GatewayNotFound"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetDuplicateEndpoint sets the DuplicateEndpoint condition.
+// When duplicated is true the condition reports that other MCPServers register the same endpoint
+// on the same gateway, which exposes the same tools more than once; otherwise it records that the
+// endpoint is only registered by this MCPServer.
+func (m *Manager) SetDuplicateEndpoint(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, duplicated bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "DuplicateEndpoint",
+		Status:             metav1.ConditionFalse,
+		Reason:             "EndpointUnique",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if duplicated {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "EndpointShared"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
+
+// SetAwaitingConsistency sets the AwaitingConsistency condition.
+// When awaiting is true the condition reports that AWS does not return the gateway target yet,
+// shortly after it was written, and that the operator retries until it does; otherwise it records
+// that the target is visible.
+func (m *Manager) SetAwaitingConsistency(ctx context.Context, mcpServer *mcpgatewayv1alpha1.MCPServer, awaiting bool, message string) error {
+	condition := metav1.Condition{
+		Type:               "AwaitingConsistency",
+		Status:             metav1.ConditionFalse,
+		Reason:             "TargetVisible",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: mcpServer.Generation,
+	}
+	if awaiting {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "TargetNotYetVisible"
+	}
+	return m.UpdateCondition(ctx, mcpServer, condition)
+}
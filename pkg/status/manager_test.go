@@ -19,6 +19,7 @@ package status
 import (
 	"context"
 	"testing"
+	"time"
 
 	mcpgatewayv1alpha1 "github.com/aws/mcp-gateway-operator/api/v1alpha1"
 	"github.com/stretchr/testify/assert"
@@ -26,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -64,7 +66,7 @@ func TestUpdateTargetCreated(t *testing.T) {
 	manager := NewManager(fakeClient)
 	ctx := context.Background()
 
-	err := manager.UpdateTargetCreated(ctx, mcpServer, "target-123", "arn:aws:bedrock:us-east-1:123456789012:gateway/gw-123", "CREATING")
+	err := manager.UpdateTargetCreated(ctx, mcpServer, "target-123", "arn:aws:bedrock:us-east-1:123456789012:gateway/gw-123", "CREATING", nil)
 	require.NoError(t, err)
 
 	// Verify the status was updated
@@ -74,6 +76,7 @@ func TestUpdateTargetCreated(t *testing.T) {
 
 	assert.Equal(t, "target-123", updated.Status.TargetID)
 	assert.Equal(t, "arn:aws:bedrock:us-east-1:123456789012:gateway/gw-123", updated.Status.GatewayArn)
+	assert.Equal(t, "gw-123", updated.Status.GatewayID)
 	assert.Equal(t, "CREATING", updated.Status.TargetStatus)
 	assert.NotNil(t, updated.Status.LastSynchronized)
 }
@@ -107,7 +110,7 @@ func TestUpdateTargetStatus(t *testing.T) {
 	ctx := context.Background()
 
 	statusReasons := []string{"Waiting for DNS propagation"}
-	err := manager.UpdateTargetStatus(ctx, mcpServer, "READY", statusReasons)
+	err := manager.UpdateTargetStatus(ctx, mcpServer, "READY", statusReasons, nil)
 	require.NoError(t, err)
 
 	// Verify the status was updated
@@ -361,3 +364,1056 @@ func TestSetError_MultipleReasons(t *testing.T) {
 	assert.Equal(t, "AWSError", final.Status.Conditions[0].Reason)
 	assert.Equal(t, "Failed to create gateway target", final.Status.Conditions[0].Message)
 }
+
+func TestSetCredentialsExpiring(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	err := manager.SetCredentialsExpiring(ctx, mcpServer, true, "endpoint-certificate expires soon")
+	require.NoError(t, err)
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "CredentialsExpiring", updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "ExpiryThresholdReached", updated.Status.Conditions[0].Reason)
+
+	// Clearing the condition flips it to False
+	err = manager.SetCredentialsExpiring(ctx, updated, false, "Earliest expiry is far away")
+	require.NoError(t, err)
+
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, metav1.ConditionFalse, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "CredentialsValid", updated.Status.Conditions[0].Reason)
+}
+
+func TestSetThrottled(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	err := manager.SetThrottled(ctx, mcpServer, true, "AWS call budget exhausted")
+	require.NoError(t, err)
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "Throttled", updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "CallBudgetExhausted", updated.Status.Conditions[0].Reason)
+}
+
+func TestUpdateTargetStatus_SkipsUnchanged(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.UpdateTargetStatus(ctx, mcpServer, "READY", nil, nil))
+	resourceVersion := mcpServer.ResourceVersion
+	lastSynchronized := mcpServer.Status.LastSynchronized
+
+	// Same status again, including an empty instead of a nil slice
+	require.NoError(t, manager.UpdateTargetStatus(ctx, mcpServer, "READY", []string{}, nil))
+	assert.Equal(t, resourceVersion, mcpServer.ResourceVersion)
+	assert.Equal(t, lastSynchronized, mcpServer.Status.LastSynchronized)
+
+	// A changed status is written
+	require.NoError(t, manager.UpdateTargetStatus(ctx, mcpServer, "FAILED", []string{"Endpoint unreachable"}, nil))
+	assert.NotEqual(t, resourceVersion, mcpServer.ResourceVersion)
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated))
+	assert.Equal(t, "FAILED", updated.Status.TargetStatus)
+}
+
+func TestUpdateCondition_SkipsUnchanged(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetReady(ctx, mcpServer))
+	resourceVersion := mcpServer.ResourceVersion
+
+	require.NoError(t, manager.SetReady(ctx, mcpServer))
+	assert.Equal(t, resourceVersion, mcpServer.ResourceVersion)
+
+	// A new generation is a change
+	mcpServer.Generation = 2
+	require.NoError(t, manager.SetReady(ctx, mcpServer))
+	assert.NotEqual(t, resourceVersion, mcpServer.ResourceVersion)
+}
+
+func TestSetCondition(t *testing.T) {
+	var conditions []metav1.Condition
+	condition := metav1.Condition{
+		Type:    "Ready",
+		Status:  metav1.ConditionFalse,
+		Reason:  "Pending",
+		Message: "Waiting",
+	}
+
+	assert.True(t, SetCondition(&conditions, condition))
+	assert.False(t, SetCondition(&conditions, condition))
+
+	condition.Message = "Still waiting"
+	assert.True(t, SetCondition(&conditions, condition))
+
+	condition.Status = metav1.ConditionTrue
+	assert.True(t, SetCondition(&conditions, condition))
+	assert.Len(t, conditions, 1)
+}
+
+func TestUpdateTargetStatus_RecordsTargetUpdatedAt(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 500, time.UTC)
+	require.NoError(t, manager.UpdateTargetStatus(ctx, mcpServer, "READY", nil, &updatedAt))
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated))
+	require.NotNil(t, updated.Status.TargetUpdatedAt)
+	assert.True(t, updated.Status.TargetUpdatedAt.Equal(&metav1.Time{Time: updatedAt.Truncate(time.Second)}))
+
+	// A response without a modification time keeps the recorded one
+	require.NoError(t, manager.UpdateTargetStatus(ctx, updated, "READY", nil, nil))
+	assert.NotNil(t, updated.Status.TargetUpdatedAt)
+}
+
+func TestSetConcurrentModification(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	err := manager.SetConcurrentModification(ctx, mcpServer, true, "Gateway target was modified outside of the operator")
+	require.NoError(t, err)
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "ConcurrentModification", updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "TargetModifiedExternally", updated.Status.Conditions[0].Reason)
+}
+
+func TestSetDraining(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	err := manager.SetDraining(ctx, mcpServer, "Gateway target T1 will be deleted at 2026-01-01T00:00:00Z")
+	require.NoError(t, err)
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "Draining", updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "DrainPeriod", updated.Status.Conditions[0].Reason)
+}
+
+func TestSetDeleting(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+		Status: mcpgatewayv1alpha1.MCPServerStatus{TargetID: "T1", TargetStatus: "READY"},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	err := manager.SetDeleting(ctx, mcpServer, "Waiting for AWS to delete gateway target T1")
+	require.NoError(t, err)
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+
+	assert.Equal(t, TargetStatusDeleting, updated.Status.TargetStatus)
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "Progressing", updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "Deleting", updated.Status.Conditions[0].Reason)
+}
+
+func TestSetWaitingForSlot(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	err := manager.SetWaitingForSlot(ctx, mcpServer, true, "All 2 create slots of gateway gw-1 are in use")
+	require.NoError(t, err)
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "Progressing", updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "WaitingForSlot", updated.Status.Conditions[0].Reason)
+
+	// Acquiring a slot removes the condition again
+	err = manager.SetWaitingForSlot(ctx, updated, false, "")
+	require.NoError(t, err)
+
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+	assert.Empty(t, updated.Status.Conditions)
+}
+
+func TestSetAccessDeniedOnDelete(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	err := manager.SetAccessDeniedOnDelete(ctx, mcpServer, "Grant bedrock-agentcore:DeleteGatewayTarget")
+	require.NoError(t, err)
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "AccessDeniedOnDelete", updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "MissingPermission", updated.Status.Conditions[0].Reason)
+}
+
+func TestSetMetadataDrift(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	err := manager.SetMetadataDrift(ctx, mcpServer, true, `allowedRequestHeaders: unexpected "X-Debug"`)
+	require.NoError(t, err)
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "MetadataDrift", updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "AllowlistsDiffer", updated.Status.Conditions[0].Reason)
+}
+
+func TestSetConfigDrift(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	err := manager.SetConfigDrift(ctx, mcpServer, true, `endpoint: "https://other.example.com", want "https://example.com"`)
+	require.NoError(t, err)
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "ConfigDrift", updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "TargetDiffers", updated.Status.Conditions[0].Reason)
+}
+
+func TestSetAwaitingConsistency(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	err := manager.SetAwaitingConsistency(ctx, mcpServer, true, "Gateway target TARGET1 was written 2s ago and is not returned by AWS yet")
+	require.NoError(t, err)
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated)
+	require.NoError(t, err)
+
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "AwaitingConsistency", updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "TargetNotYetVisible", updated.Status.Conditions[0].Reason)
+}
+
+func TestSetPartialPermissions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetPartialPermissions(ctx, mcpServer, true, "ReadAccessDenied", "GetGatewayTarget is denied"))
+	require.Len(t, mcpServer.Status.Conditions, 1)
+	assert.Equal(t, "PartialPermissions", mcpServer.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "ReadAccessDenied", mcpServer.Status.Conditions[0].Reason)
+
+	require.NoError(t, manager.SetPartialPermissions(ctx, mcpServer, false, "", "All permissions granted"))
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated))
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, metav1.ConditionFalse, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "PermissionsComplete", updated.Status.Conditions[0].Reason)
+}
+
+func TestSetQuarantined(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetQuarantined(ctx, mcpServer, true, "reconcile panicked: boom"))
+	require.Len(t, mcpServer.Status.Conditions, 1)
+	assert.Equal(t, "Quarantined", mcpServer.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "ReconcilePanicked", mcpServer.Status.Conditions[0].Reason)
+
+	require.NoError(t, manager.SetQuarantined(ctx, mcpServer, false, "Reconcile completed"))
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated))
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, metav1.ConditionFalse, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "ReconcileCompleted", updated.Status.Conditions[0].Reason)
+}
+
+func TestSetApprovalPending(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-server",
+			Namespace:  "default",
+			Generation: 3,
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetApprovalPending(ctx, mcpServer, true, "Generation 3 requires approval"))
+	require.Len(t, mcpServer.Status.Conditions, 1)
+	assert.Equal(t, "ApprovalPending", mcpServer.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "AwaitingApproval", mcpServer.Status.Conditions[0].Reason)
+
+	require.NoError(t, manager.SetApprovalPending(ctx, mcpServer, false, "Generation 3 approved"))
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated))
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, metav1.ConditionFalse, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "Approved", updated.Status.Conditions[0].Reason)
+}
+
+func TestSetProvenance(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	provenance := &mcpgatewayv1alpha1.FieldProvenance{GatewayID: "environment/prod", TargetName: "spec"}
+	require.NoError(t, manager.SetProvenance(ctx, mcpServer, provenance))
+
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated))
+	assert.Equal(t, provenance, updated.Status.Provenance)
+	require.NotNil(t, updated.Status.LastSynchronized)
+
+	// An unchanged provenance is not written again
+	resourceVersion := updated.ResourceVersion
+	require.NoError(t, manager.SetProvenance(ctx, updated, provenance.DeepCopy()))
+	assert.Equal(t, resourceVersion, updated.ResourceVersion)
+}
+
+func TestRecordSync(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+		Spec: mcpgatewayv1alpha1.MCPServerSpec{
+			Endpoint:     "https://example.com",
+			Capabilities: []string{"tools"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+
+	// A successful sync is recorded as both attempted and successful
+	require.NoError(t, manager.RecordSync(ctx, mcpServer, true, now))
+	assert.Equal(t, mcpgatewayv1alpha1.SyncOutcomeSucceeded, mcpServer.Status.LastSyncOutcome)
+	assert.True(t, mcpServer.Status.LastAttemptedSync.Time.Equal(now))
+	assert.True(t, mcpServer.Status.LastSuccessfulSync.Time.Equal(now))
+	resourceVersion := mcpServer.ResourceVersion
+
+	// The same outcome within the record interval is not written
+	require.NoError(t, manager.RecordSync(ctx, mcpServer, true, now.Add(10*time.Second)))
+	assert.Equal(t, resourceVersion, mcpServer.ResourceVersion)
+
+	// A failure is recorded right away and keeps the last successful sync
+	failed := now.Add(20 * time.Second)
+	require.NoError(t, manager.RecordSync(ctx, mcpServer, false, failed))
+	assert.Equal(t, mcpgatewayv1alpha1.SyncOutcomeFailed, mcpServer.Status.LastSyncOutcome)
+	assert.True(t, mcpServer.Status.LastAttemptedSync.Time.Equal(failed))
+	assert.True(t, mcpServer.Status.LastSuccessfulSync.Time.Equal(now))
+
+	// The same outcome is recorded again once the interval has passed
+	later := failed.Add(SyncRecordInterval)
+	require.NoError(t, manager.RecordSync(ctx, mcpServer, false, later))
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated))
+	assert.True(t, updated.Status.LastAttemptedSync.Time.Equal(later))
+	assert.True(t, updated.Status.LastSuccessfulSync.Time.Equal(now))
+}
+
+func TestSetBackendUnavailable(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetBackendUnavailable(ctx, mcpServer, true, "WorkloadScaledToZero",
+		"Deployment weather is scaled to zero"))
+	require.Len(t, mcpServer.Status.Conditions, 1)
+	assert.Equal(t, "BackendUnavailable", mcpServer.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "WorkloadScaledToZero", mcpServer.Status.Conditions[0].Reason)
+
+	require.NoError(t, manager.SetBackendUnavailable(ctx, mcpServer, false, "", "Deployment weather is available"))
+	assert.Equal(t, metav1.ConditionFalse, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "WorkloadAvailable", mcpServer.Status.Conditions[0].Reason)
+}
+
+func TestUpdateTargetRemoved(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	updatedAt := metav1.Now()
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+		Status: mcpgatewayv1alpha1.MCPServerStatus{
+			TargetID:        "target-123",
+			GatewayArn:      "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-123",
+			TargetStatus:    "READY",
+			StatusReasons:   []string{"ok"},
+			TargetUpdatedAt: &updatedAt,
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.UpdateTargetRemoved(ctx, mcpServer))
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated))
+	assert.Empty(t, updated.Status.TargetID)
+	assert.Empty(t, updated.Status.TargetStatus)
+	assert.Empty(t, updated.Status.StatusReasons)
+	assert.Nil(t, updated.Status.TargetUpdatedAt)
+	assert.Equal(t, "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-123", updated.Status.GatewayArn)
+	assert.Equal(t, int64(1), updated.Status.TargetRemovals)
+
+	// Without a target there is nothing to count
+	require.NoError(t, manager.UpdateTargetRemoved(ctx, mcpServer))
+	assert.Equal(t, int64(1), mcpServer.Status.TargetRemovals)
+}
+
+func TestUpdateGatewayRemoved(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+		Status: mcpgatewayv1alpha1.MCPServerStatus{
+			TargetID:     "target-123",
+			GatewayArn:   "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-123",
+			GatewayID:    "gw-123",
+			TargetStatus: "READY",
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.UpdateGatewayRemoved(ctx, mcpServer))
+	updated := &mcpgatewayv1alpha1.MCPServer{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-server", Namespace: "default"}, updated))
+	assert.Empty(t, updated.Status.TargetID)
+	assert.Empty(t, updated.Status.TargetStatus)
+	assert.Empty(t, updated.Status.GatewayArn)
+	assert.Empty(t, updated.Status.GatewayID)
+	assert.Equal(t, int64(1), updated.Status.TargetRemovals)
+}
+
+func TestSetGatewayDeleted(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetGatewayDeleted(ctx, mcpServer, true, "Gateway gw-123 was deleted"))
+	require.Len(t, mcpServer.Status.Conditions, 1)
+	assert.Equal(t, "GatewayDeleted", mcpServer.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "GatewayNotFound", mcpServer.Status.Conditions[0].Reason)
+
+	require.NoError(t, manager.SetGatewayDeleted(ctx, mcpServer, false, "Moved to gateway gw-456"))
+	assert.Equal(t, metav1.ConditionFalse, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "GatewayReplaced", mcpServer.Status.Conditions[0].Reason)
+}
+
+func TestSetDuplicateEndpoint(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetDuplicateEndpoint(ctx, mcpServer, true, "Also registered by team-b/weather"))
+	require.Len(t, mcpServer.Status.Conditions, 1)
+	assert.Equal(t, "DuplicateEndpoint", mcpServer.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "EndpointShared", mcpServer.Status.Conditions[0].Reason)
+
+	require.NoError(t, manager.SetDuplicateEndpoint(ctx, mcpServer, false, "Endpoint is unique"))
+	assert.Equal(t, metav1.ConditionFalse, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "EndpointUnique", mcpServer.Status.Conditions[0].Reason)
+}
+
+func TestTransitions(t *testing.T) {
+	ready := []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "GatewayTargetReady"}}
+	notReady := []metav1.Condition{{Type: "Ready", Status: metav1.ConditionFalse, Reason: "AWSAPIError"}}
+
+	tests := []struct {
+		name   string
+		before mcpgatewayv1alpha1.MCPServerStatus
+		after  mcpgatewayv1alpha1.MCPServerStatus
+		want   []Transition
+	}{
+		{
+			name:   "target becomes ready",
+			before: mcpgatewayv1alpha1.MCPServerStatus{TargetStatus: "CREATING"},
+			after:  mcpgatewayv1alpha1.MCPServerStatus{TargetStatus: "READY", Conditions: ready},
+			want: []Transition{
+				{Field: TransitionTargetStatus, From: "CREATING", To: "READY"},
+				{Field: TransitionReady, From: "Unknown", To: "True"},
+			},
+		},
+		{
+			name:   "unchanged poll",
+			before: mcpgatewayv1alpha1.MCPServerStatus{TargetStatus: "READY", Conditions: ready},
+			after:  mcpgatewayv1alpha1.MCPServerStatus{TargetStatus: "READY", Conditions: ready, StatusReasons: []string{"x"}},
+		},
+		{
+			name:   "new reason of a failing target",
+			before: mcpgatewayv1alpha1.MCPServerStatus{Conditions: notReady},
+			after: mcpgatewayv1alpha1.MCPServerStatus{Conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionFalse, Reason: "ValidationError"},
+			}},
+		},
+		{
+			name:   "target removed",
+			before: mcpgatewayv1alpha1.MCPServerStatus{TargetStatus: "READY"},
+			after:  mcpgatewayv1alpha1.MCPServerStatus{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Transitions(&tt.before, &tt.after))
+		})
+	}
+}
+
+func TestEventsOnTransitionsOnly(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-server", Namespace: "default"},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+	recorder := events.NewFakeRecorder(10)
+	manager := NewManager(fakeClient).WithEventRecorder(recorder)
+	ctx := context.Background()
+
+	require.NoError(t, manager.UpdateTargetCreated(ctx, mcpServer, "T1", "arn:aws:bedrock-agentcore:us-east-1:123456789012:gateway/gw-1", "CREATING", nil))
+	assert.Equal(t, "Normal TargetStatusChanged Gateway target status changed to CREATING", <-recorder.Events)
+
+	// Polling the unchanged target emits nothing
+	require.NoError(t, manager.UpdateTargetStatus(ctx, mcpServer, "CREATING", nil, nil))
+	assert.Empty(t, recorder.Events)
+
+	require.NoError(t, manager.UpdateTargetStatus(ctx, mcpServer, "READY", nil, nil))
+	assert.Equal(t, "Normal TargetStatusChanged Gateway target status changed from CREATING to READY", <-recorder.Events)
+	require.NoError(t, manager.SetReady(ctx, mcpServer))
+	assert.Equal(t, "Normal GatewayTargetReady Gateway target is ready and accepting requests", <-recorder.Events)
+
+	require.NoError(t, manager.UpdateTargetStatus(ctx, mcpServer, "FAILED", []string{"boom"}, nil))
+	assert.Equal(t, "Warning TargetStatusChanged Gateway target status changed from READY to FAILED", <-recorder.Events)
+	require.NoError(t, manager.SetError(ctx, mcpServer, "TargetFailed", "boom"))
+	assert.Equal(t, "Warning TargetFailed boom", <-recorder.Events)
+
+	// A new reason while not ready is not a transition
+	require.NoError(t, manager.SetError(ctx, mcpServer, "AWSAPIError", "throttled"))
+	assert.Empty(t, recorder.Events)
+}
+
+func TestSetExpired(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetExpired(ctx, mcpServer, true, "MCPServer expired at 2026-01-01T00:00:00Z"))
+	require.Len(t, mcpServer.Status.Conditions, 1)
+	assert.Equal(t, "Expired", mcpServer.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "TargetExpired", mcpServer.Status.Conditions[0].Reason)
+
+	require.NoError(t, manager.SetExpired(ctx, mcpServer, false, "MCPServer expires at 2027-01-01T00:00:00Z"))
+	assert.Equal(t, metav1.ConditionFalse, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "NotExpired", mcpServer.Status.Conditions[0].Reason)
+}
+
+func TestSetOwnershipConflict(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, mcpgatewayv1alpha1.AddToScheme(scheme))
+
+	mcpServer := &mcpgatewayv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-server",
+			Namespace: "default",
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mcpServer).
+		WithStatusSubresource(mcpServer).
+		Build()
+
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetOwnershipConflict(ctx, mcpServer, true, "Gateway target is owned by cluster east"))
+	require.Len(t, mcpServer.Status.Conditions, 1)
+	assert.Equal(t, "OwnershipConflict", mcpServer.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "OwnedByAnotherCluster", mcpServer.Status.Conditions[0].Reason)
+
+	require.NoError(t, manager.SetOwnershipConflict(ctx, mcpServer, false, "Gateway target is owned by this cluster"))
+	assert.Equal(t, metav1.ConditionFalse, mcpServer.Status.Conditions[0].Status)
+	assert.Equal(t, "OwnedByThisCluster", mcpServer.Status.Conditions[0].Reason)
+}
@@ -0,0 +1,4 @@
+// Package storageversion detects custom resources stored in more than one API version
+// and migrates them to the current storage version, so that upgrades and downgrades
+// of the operator never decode objects written in a version they do not understand.
+package storageversion
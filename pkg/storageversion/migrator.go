@@ -0,0 +1,274 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageversion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// listPageSize is the number of objects rewritten per list page during a migration
+const listPageSize = 500
+
+// maxListedPending is the number of objects pending migration named in a single log line
+const maxListedPending = 100
+
+// ManagedCRDs are the CRDs installed with the operator
+var ManagedCRDs = []string{
+	"mcpservers.mcpgateway.bedrock.aws",
+	"agentcorestacks.mcpgateway.bedrock.aws",
+	"mcpservergroups.mcpgateway.bedrock.aws",
+	"awsproviderconfigs.mcpgateway.bedrock.aws",
+}
+
+// crdGVK is read as unstructured so the operator does not depend on the apiextensions types
+var crdGVK = schema.GroupVersionKind{
+	Group:   "apiextensions.k8s.io",
+	Version: "v1",
+	Kind:    "CustomResourceDefinition",
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=update
+
+// Versions describes the API versions of a CRD
+type Versions struct {
+	// Group is the API group of the resource
+	Group string
+	// Kind is the kind of the resource
+	Kind string
+	// Storage is the version new writes are persisted in
+	Storage string
+	// Stored are the versions objects may still be persisted in (status.storedVersions)
+	Stored []string
+}
+
+// Mixed reports whether objects may be persisted in more than one version
+func (v Versions) Mixed() bool {
+	return len(v.Stored) > 1
+}
+
+// Unknown returns the stored versions that are not in the given list of known versions
+func (v Versions) Unknown(known []string) []string {
+	var unknown []string
+	for _, version := range v.Stored {
+		if !slices.Contains(known, version) {
+			unknown = append(unknown, version)
+		}
+	}
+	return unknown
+}
+
+// UnknownVersionError reports objects stored in versions the running operator cannot read,
+// typically after a downgrade without migrating storage back first
+type UnknownVersionError struct {
+	CRD      string
+	Versions []string
+}
+
+// Error implements the error interface
+func (e *UnknownVersionError) Error() string {
+	return fmt.Sprintf("CRD %s has objects stored in versions %v unknown to this operator version; "+
+		"migrate storage to a known version with the newer operator before downgrading", e.CRD, e.Versions)
+}
+
+// Migrator inspects and migrates the storage versions of CRDs
+type Migrator struct {
+	client client.Client
+	logger logr.Logger
+}
+
+// NewMigrator creates a new Migrator. The client should not be cached, since migrations
+// rewrite every object and CRDs are not otherwise watched by the operator.
+func NewMigrator(c client.Client, logger logr.Logger) *Migrator {
+	return &Migrator{
+		client: c,
+		logger: logger,
+	}
+}
+
+// Versions returns the versions of the named CRD
+func (m *Migrator) Versions(ctx context.Context, crdName string) (Versions, error) {
+	crd, err := m.getCRD(ctx, crdName)
+	if err != nil {
+		return Versions{}, err
+	}
+	return versionsFromCRD(crd)
+}
+
+// Check returns the versions of the named CRD and an UnknownVersionError if objects may be
+// stored in a version that is not in known. Mixed stored versions are logged as a warning.
+func (m *Migrator) Check(ctx context.Context, crdName string, known []string) (Versions, error) {
+	versions, err := m.Versions(ctx, crdName)
+	if err != nil {
+		return Versions{}, err
+	}
+	if unknown := versions.Unknown(known); len(unknown) > 0 {
+		return versions, &UnknownVersionError{CRD: crdName, Versions: unknown}
+	}
+	if versions.Mixed() {
+		m.logger.Info("CRD has objects stored in several versions, run the storage migration",
+			"crd", crdName, "storedVersions", versions.Stored, "storageVersion", versions.Storage)
+	}
+	return versions, nil
+}
+
+// Migrate rewrites every object of the named CRD so that it is persisted in the current storage
+// version, then records that version as the only stored version. It returns the number of
+// objects rewritten. Running it again is harmless.
+func (m *Migrator) Migrate(ctx context.Context, crdName string) (int, error) {
+	crd, err := m.getCRD(ctx, crdName)
+	if err != nil {
+		return 0, err
+	}
+	versions, err := versionsFromCRD(crd)
+	if err != nil {
+		return 0, err
+	}
+
+	listGVK := schema.GroupVersionKind{Group: versions.Group, Version: versions.Storage, Kind: versions.Kind + "List"}
+	migrated := 0
+	continueToken := ""
+	for {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(listGVK)
+		if err := m.client.List(ctx, list, client.Limit(listPageSize), client.Continue(continueToken)); err != nil {
+			return migrated, fmt.Errorf("failed to list %s: %w", crdName, err)
+		}
+
+		for i := range list.Items {
+			if err := m.rewrite(ctx, &list.Items[i]); err != nil {
+				return migrated, err
+			}
+			migrated++
+		}
+
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			break
+		}
+	}
+
+	// Every object is now persisted in the storage version
+	if !slices.Equal(versions.Stored, []string{versions.Storage}) {
+		if err := unstructured.SetNestedStringSlice(crd.Object, []string{versions.Storage}, "status", "storedVersions"); err != nil {
+			return migrated, err
+		}
+		if err := m.client.Status().Update(ctx, crd); err != nil {
+			return migrated, fmt.Errorf("failed to update stored versions of %s: %w", crdName, err)
+		}
+	}
+
+	m.logger.Info("Migrated CRD storage version", "crd", crdName, "storageVersion", versions.Storage,
+		"previousStoredVersions", versions.Stored, "objects", migrated)
+	return migrated, nil
+}
+
+// Pending returns the objects of the named CRD that may still be persisted in a version other
+// than the storage version, as namespace/name, or name for cluster-scoped objects. The API does
+// not reveal the version an object is encoded in, so while the CRD has several stored versions
+// every object is pending until the storage migration rewrote it.
+func (m *Migrator) Pending(ctx context.Context, crdName string, versions Versions) ([]string, error) {
+	if !versions.Mixed() {
+		return nil, nil
+	}
+
+	listGVK := schema.GroupVersionKind{Group: versions.Group, Version: versions.Storage, Kind: versions.Kind + "List"}
+	var pending []string
+	continueToken := ""
+	for {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(listGVK)
+		if err := m.client.List(ctx, list, client.Limit(listPageSize), client.Continue(continueToken)); err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", crdName, err)
+		}
+		for i := range list.Items {
+			pending = append(pending, client.ObjectKeyFromObject(&list.Items[i]).String())
+		}
+
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			return pending, nil
+		}
+	}
+}
+
+// rewrite writes the object back unchanged, which persists it in the storage version
+func (m *Migrator) rewrite(ctx context.Context, obj *unstructured.Unstructured) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := m.client.Update(ctx, obj)
+		if apierrors.IsConflict(err) {
+			// Any successful write re-encodes the object, but retry on the latest version to be sure
+			if getErr := m.client.Get(ctx, client.ObjectKeyFromObject(obj), obj); getErr != nil {
+				return getErr
+			}
+		}
+		return err
+	})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to rewrite %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+	return nil
+}
+
+// getCRD reads the named CRD
+func (m *Migrator) getCRD(ctx context.Context, crdName string) (*unstructured.Unstructured, error) {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(crdGVK)
+	if err := m.client.Get(ctx, client.ObjectKey{Name: crdName}, crd); err != nil {
+		return nil, fmt.Errorf("failed to get CRD %s: %w", crdName, err)
+	}
+	return crd, nil
+}
+
+// versionsFromCRD extracts the versions of a CRD
+func versionsFromCRD(crd *unstructured.Unstructured) (Versions, error) {
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	stored, _, _ := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	versions := Versions{
+		Group:  group,
+		Kind:   kind,
+		Stored: stored,
+	}
+
+	specVersions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, item := range specVersions {
+		version, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		if storage, _, _ := unstructured.NestedBool(version, "storage"); storage {
+			versions.Storage, _, _ = unstructured.NestedString(version, "name")
+		}
+	}
+	if versions.Storage == "" {
+		return Versions{}, fmt.Errorf("CRD %s has no storage version", crd.GetName())
+	}
+	return versions, nil
+}
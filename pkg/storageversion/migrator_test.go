@@ -0,0 +1,145 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageversion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testCRD(storedVersions []any, specVersions ...map[string]any) *unstructured.Unstructured {
+	versions := make([]any, 0, len(specVersions))
+	for _, version := range specVersions {
+		versions = append(versions, version)
+	}
+	return &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "mcpservers.mcpgateway.bedrock.aws"},
+		"spec": map[string]any{
+			"group":    "mcpgateway.bedrock.aws",
+			"names":    map[string]any{"kind": "MCPServer"},
+			"versions": versions,
+		},
+		"status": map[string]any{"storedVersions": storedVersions},
+	}}
+}
+
+func TestVersionsFromCRD(t *testing.T) {
+	crd := testCRD([]any{"v1alpha1", "v1beta1"},
+		map[string]any{"name": "v1alpha1", "served": true, "storage": false},
+		map[string]any{"name": "v1beta1", "served": true, "storage": true},
+	)
+
+	versions, err := versionsFromCRD(crd)
+	require.NoError(t, err)
+	assert.Equal(t, "mcpgateway.bedrock.aws", versions.Group)
+	assert.Equal(t, "MCPServer", versions.Kind)
+	assert.Equal(t, "v1beta1", versions.Storage)
+	assert.Equal(t, []string{"v1alpha1", "v1beta1"}, versions.Stored)
+	assert.True(t, versions.Mixed())
+}
+
+func TestVersionsFromCRDWithoutStorageVersion(t *testing.T) {
+	crd := testCRD([]any{"v1alpha1"}, map[string]any{"name": "v1alpha1", "served": true})
+
+	_, err := versionsFromCRD(crd)
+	assert.Error(t, err)
+}
+
+func TestVersionsUnknown(t *testing.T) {
+	tests := []struct {
+		name   string
+		stored []string
+		known  []string
+		want   []string
+	}{
+		{
+			name:   "single known version",
+			stored: []string{"v1alpha1"},
+			known:  []string{"v1alpha1"},
+		},
+		{
+			name:   "downgrade below stored version",
+			stored: []string{"v1alpha1", "v1beta1"},
+			known:  []string{"v1alpha1"},
+			want:   []string{"v1beta1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions := Versions{Stored: tt.stored}
+			assert.Equal(t, tt.want, versions.Unknown(tt.known))
+		})
+	}
+}
+
+func TestRecordStoredVersions(t *testing.T) {
+	const crdName = "mcpservers.mcpgateway.bedrock.aws"
+
+	recordStoredVersions(crdName, Versions{Storage: "v1beta1", Stored: []string{"v1alpha1", "v1beta1"}})
+	assert.Equal(t, 2, testutil.CollectAndCount(storedVersions))
+	assert.Equal(t, 1.0, testutil.ToFloat64(storedVersions.WithLabelValues(crdName, "v1alpha1", "false")))
+
+	// Once migrated, only the storage version is left
+	recordStoredVersions(crdName, Versions{Storage: "v1beta1", Stored: []string{"v1beta1"}})
+	assert.Equal(t, 1, testutil.CollectAndCount(storedVersions))
+	assert.Equal(t, 1.0, testutil.ToFloat64(storedVersions.WithLabelValues(crdName, "v1beta1", "true")))
+}
+
+func TestPending(t *testing.T) {
+	const crdName = "mcpservers.mcpgateway.bedrock.aws"
+	server := func(namespace, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("mcpgateway.bedrock.aws/v1beta1")
+		obj.SetKind("MCPServer")
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+	c := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).
+		WithObjects(server("team-a", "weather"), server("team-b", "search")).Build()
+	migrator := NewMigrator(c, logr.Discard())
+
+	versions := Versions{Group: "mcpgateway.bedrock.aws", Kind: "MCPServer", Storage: "v1beta1"}
+	versions.Stored = []string{"v1alpha1", "v1beta1"}
+	pending, err := migrator.Pending(context.Background(), crdName, versions)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"team-a/weather", "team-b/search"}, pending)
+
+	reported := map[string]int{}
+	migrator.reportPending(context.Background(), crdName, versions, reported)
+	assert.Equal(t, 2.0, testutil.ToFloat64(pendingObjects.WithLabelValues(crdName)))
+	assert.Equal(t, 2, reported[crdName])
+
+	// Once migrated, no object is pending
+	versions.Stored = []string{"v1beta1"}
+	pending, err = migrator.Pending(context.Background(), crdName, versions)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	migrator.reportPending(context.Background(), crdName, versions, reported)
+	assert.Equal(t, 0.0, testutil.ToFloat64(pendingObjects.WithLabelValues(crdName)))
+	assert.NotContains(t, reported, crdName)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageversion
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// storedVersions reports the versions objects of a CRD may be persisted in
+var storedVersions = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "mcpgateway_crd_stored_versions",
+		Help: "1 for every version objects of an operator CRD may be persisted in; storage is true for the " +
+			"current storage version. Other versions remain until the storage migration ran.",
+	},
+	[]string{"crd", "version", "storage"},
+)
+
+// pendingObjects reports the objects of a CRD that may still be persisted in a previous version
+var pendingObjects = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "mcpgateway_crd_pending_migration_objects",
+		Help: "Number of objects of an operator CRD that may still be persisted in a previous version, " +
+			"0 once the storage migration ran.",
+	},
+	[]string{"crd"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(storedVersions, pendingObjects)
+}
+
+// Report exports the stored versions of the managed CRDs as the mcpgateway_crd_stored_versions
+// metric every interval until ctx is cancelled, so that the progress of a storage migration can be
+// tracked, e.g. by alerting while a CRD has more than one stored version. CRDs that cannot be read
+// keep their last reported versions. The number of objects still to be migrated is exported as
+// mcpgateway_crd_pending_migration_objects, and the objects are logged whenever their number changes.
+func (m *Migrator) Report(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	reported := make(map[string]int)
+	for {
+		for _, crdName := range ManagedCRDs {
+			versions, err := m.Versions(ctx, crdName)
+			if err != nil {
+				m.logger.V(1).Info("Unable to report CRD stored versions", "crd", crdName, "error", err.Error())
+				continue
+			}
+			recordStoredVersions(crdName, versions)
+			m.reportPending(ctx, crdName, versions, reported)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// recordStoredVersions replaces the reported stored versions of the CRD
+func recordStoredVersions(crdName string, versions Versions) {
+	storedVersions.DeletePartialMatch(prometheus.Labels{"crd": crdName})
+	for _, version := range versions.Stored {
+		storage := "false"
+		if version == versions.Storage {
+			storage = "true"
+		}
+		storedVersions.WithLabelValues(crdName, version, storage).Set(1)
+	}
+}
+
+// reportPending exports the number of objects of the CRD that are pending migration and logs them,
+// unless as many were logged last time. reported holds the number of objects last logged per CRD.
+func (m *Migrator) reportPending(ctx context.Context, crdName string, versions Versions, reported map[string]int) {
+	pending, err := m.Pending(ctx, crdName, versions)
+	if err != nil {
+		m.logger.V(1).Info("Unable to list objects pending storage migration", "crd", crdName, "error", err.Error())
+		return
+	}
+	pendingObjects.WithLabelValues(crdName).Set(float64(len(pending)))
+	if len(pending) == 0 {
+		delete(reported, crdName)
+		return
+	}
+	if count, ok := reported[crdName]; ok && count == len(pending) {
+		return
+	}
+	reported[crdName] = len(pending)
+
+	listed := pending[:min(len(pending), maxListedPending)]
+	m.logger.Info("Objects may still be stored in a previous version, run the storage migration",
+		"crd", crdName, "storedVersions", versions.Stored, "storageVersion", versions.Storage,
+		"pending", len(pending), "objects", listed)
+}
@@ -0,0 +1,3 @@
+// Package tracing exports OpenTelemetry spans of reconciles and AWS calls to an OTLP collector,
+// so that slow reconciles can be traced down to the AWS call that held them up.
+package tracing
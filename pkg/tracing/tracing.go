@@ -0,0 +1,109 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// ServiceName is the service name recorded on every span of the operator
+const ServiceName = "mcp-gateway-operator"
+
+// clusterAttribute is the resource attribute holding the cluster ID
+const clusterAttribute = "mcpgateway.cluster"
+
+// Options configures the export of spans
+type Options struct {
+	// Endpoint is the host:port of the OTLP gRPC collector. Tracing is disabled if it is empty.
+	Endpoint string
+	// Insecure sends spans without TLS, e.g. to a collector sidecar
+	Insecure bool
+	// SampleRatio is the fraction of reconciles traced, between 0 and 1. Spans of AWS calls are
+	// sampled along with the reconcile they were made for.
+	SampleRatio float64
+	// Version and ClusterID identify the operator on every span
+	Version   string
+	ClusterID string
+}
+
+// Validate checks the options
+func (o Options) Validate() error {
+	if o.SampleRatio < 0 || o.SampleRatio > 1 {
+		return fmt.Errorf("sample ratio %v is not between 0 and 1", o.SampleRatio)
+	}
+	return nil
+}
+
+// Setup installs a tracer provider exporting to the OTLP collector as the global provider, which
+// the reconcilers and AWS clients take their tracers from. It returns a function flushing the
+// spans still buffered, to be called before the operator exits. Without an endpoint, the global
+// provider is left as is and spans are dropped.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	if opts.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	exporterOptions := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Endpoint)}
+	if opts.Insecure {
+		exporterOptions = append(exporterOptions, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	provider := NewTracerProvider(exporter, opts)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
+
+// NewTracerProvider returns a tracer provider batching spans to exporter, sampled by the sample
+// ratio of opts unless the parent span decided already
+func NewTracerProvider(exporter sdktrace.SpanExporter, opts Options) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+		sdktrace.WithResource(newResource(opts)),
+	)
+}
+
+// newResource describes the operator as the source of the spans
+func newResource(opts Options) *resource.Resource {
+	version := opts.Version
+	if version == "" {
+		version = "dev"
+	}
+	attributes := []attribute.KeyValue{semconv.ServiceName(ServiceName), semconv.ServiceVersion(version)}
+	if opts.ClusterID != "" {
+		attributes = append(attributes, attribute.String(clusterAttribute, opts.ClusterID))
+	}
+	return resource.NewWithAttributes(semconv.SchemaURL, attributes...)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSetupWithoutEndpoint(t *testing.T) {
+	before := otel.GetTracerProvider()
+	shutdown, err := Setup(context.Background(), Options{SampleRatio: 5})
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+	assert.Equal(t, before, otel.GetTracerProvider())
+}
+
+func TestOptionsValidate(t *testing.T) {
+	assert.NoError(t, Options{SampleRatio: 0}.Validate())
+	assert.NoError(t, Options{SampleRatio: 1}.Validate())
+	assert.Error(t, Options{SampleRatio: -0.1}.Validate())
+	assert.Error(t, Options{SampleRatio: 1.5}.Validate())
+
+	_, err := Setup(context.Background(), Options{Endpoint: "collector:4317", SampleRatio: 2})
+	assert.ErrorContains(t, err, "sample ratio 2 is not between 0 and 1")
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	ctx := context.Background()
+	exporter := tracetest.NewInMemoryExporter()
+	provider := NewTracerProvider(exporter, Options{SampleRatio: 1, Version: "v1.2.3", ClusterID: "prod-1"})
+
+	_, span := provider.Tracer("test").Start(ctx, "reconcile")
+	span.End()
+	require.NoError(t, provider.ForceFlush(ctx))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	attributes := make(map[string]string)
+	for _, kv := range spans[0].Resource.Attributes() {
+		attributes[string(kv.Key)] = kv.Value.Emit()
+	}
+	assert.Equal(t, ServiceName, attributes["service.name"])
+	assert.Equal(t, "v1.2.3", attributes["service.version"])
+	assert.Equal(t, "prod-1", attributes[clusterAttribute])
+	require.NoError(t, provider.Shutdown(ctx))
+
+	// A sample ratio of 0 drops reconciles that are not part of a sampled trace
+	exporter.Reset()
+	provider = NewTracerProvider(exporter, Options{SampleRatio: 0})
+	_, span = provider.Tracer("test").Start(ctx, "reconcile")
+	span.End()
+	require.NoError(t, provider.ForceFlush(ctx))
+	assert.Empty(t, exporter.GetSpans())
+}